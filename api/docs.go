@@ -8,6 +8,191 @@ const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
     "components": {
         "schemas": {
+            "dtos.AllianceApplicationDecisionDtoRequest": {
+                "properties": {
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "enum": [
+                            "accepted",
+                            "rejected"
+                        ],
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "player",
+                    "status"
+                ],
+                "type": "object"
+            },
+            "dtos.AllianceApplicationDtoRequest": {
+                "properties": {
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "player"
+                ],
+                "type": "object"
+            },
+            "dtos.AllianceApplicationDtoResponse": {
+                "properties": {
+                    "alliance": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "alliance",
+                    "created_at",
+                    "id",
+                    "player"
+                ],
+                "type": "object"
+            },
+            "dtos.AllianceDtoRequest": {
+                "properties": {
+                    "founder": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "galactic empire",
+                        "type": "string"
+                    },
+                    "tag": {
+                        "example": "EMP",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "founder",
+                    "name",
+                    "tag"
+                ],
+                "type": "object"
+            },
+            "dtos.AllianceDtoResponse": {
+                "properties": {
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "members": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.AllianceMemberDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "name": {
+                        "example": "galactic empire",
+                        "type": "string"
+                    },
+                    "tag": {
+                        "example": "EMP",
+                        "type": "string"
+                    },
+                    "universe": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "created_at",
+                    "id",
+                    "members",
+                    "name",
+                    "tag",
+                    "universe"
+                ],
+                "type": "object"
+            },
+            "dtos.AllianceMemberDtoResponse": {
+                "properties": {
+                    "joined_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "emperor palpatine",
+                        "type": "string"
+                    },
+                    "permissions": {
+                        "items": {
+                            "enum": [
+                                "manage_applications",
+                                "manage_ranks",
+                                "disband"
+                            ],
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "rank": {
+                        "enum": [
+                            "founder",
+                            "officer",
+                            "member"
+                        ],
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "joined_at",
+                    "name",
+                    "permissions",
+                    "player",
+                    "rank"
+                ],
+                "type": "object"
+            },
+            "dtos.AllianceRankDtoRequest": {
+                "properties": {
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "rank": {
+                        "enum": [
+                            "officer",
+                            "member"
+                        ],
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "player",
+                    "rank"
+                ],
+                "type": "object"
+            },
             "dtos.BuildingActionCostDtoResponse": {
                 "properties": {
                     "amount": {
@@ -25,6 +210,14 @@ const docTemplate = `{
                     "building": {
                         "format": "uuid",
                         "type": "string"
+                    },
+                    "kind": {
+                        "default": "upgrade",
+                        "enum": [
+                            "upgrade",
+                            "demolish"
+                        ],
+                        "type": "string"
                     }
                 },
                 "required": [
@@ -60,6 +253,13 @@ const docTemplate = `{
                         "format": "uuid",
                         "type": "string"
                     },
+                    "kind": {
+                        "enum": [
+                            "upgrade",
+                            "demolish"
+                        ],
+                        "type": "string"
+                    },
                     "productions": {
                         "items": {
                             "$ref": "#/components/schemas/dtos.BuildingActionProductionDtoResponse"
@@ -82,6 +282,7 @@ const docTemplate = `{
                     "created_at",
                     "desired_level",
                     "id",
+                    "kind",
                     "productions",
                     "storages"
                 ],
@@ -111,6 +312,27 @@ const docTemplate = `{
                 },
                 "type": "object"
             },
+            "dtos.BuildingCostDtoRequest": {
+                "properties": {
+                    "cost": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "progress": {
+                        "type": "number"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "cost",
+                    "progress",
+                    "resource"
+                ],
+                "type": "object"
+            },
             "dtos.BuildingCostDtoResponse": {
                 "properties": {
                     "cost": {
@@ -131,8 +353,48 @@ const docTemplate = `{
                 ],
                 "type": "object"
             },
+            "dtos.BuildingDtoRequest": {
+                "properties": {
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingCostDtoRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "name": {
+                        "example": "metal mine",
+                        "type": "string"
+                    },
+                    "productions": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingResourceProductionDtoRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "storages": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingResourceStorageDtoRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "name"
+                ],
+                "type": "object"
+            },
             "dtos.BuildingDtoResponse": {
                 "properties": {
+                    "consumptions": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingResourceConsumptionDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
                     "costs": {
                         "items": {
                             "$ref": "#/components/schemas/dtos.BuildingCostDtoResponse"
@@ -159,6 +421,13 @@ const docTemplate = `{
                         "type": "array",
                         "uniqueItems": false
                     },
+                    "requirements": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingRequirementDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
                     "storages": {
                         "items": {
                             "$ref": "#/components/schemas/dtos.BuildingResourceStorageDtoResponse"
@@ -168,88 +437,89 @@ const docTemplate = `{
                     }
                 },
                 "required": [
+                    "consumptions",
                     "costs",
                     "created_at",
                     "id",
                     "name",
                     "productions",
+                    "requirements",
                     "storages"
                 ],
                 "type": "object"
             },
-            "dtos.BuildingResourceProductionDtoResponse": {
+            "dtos.BuildingLevelPreviewDtoResponse": {
                 "properties": {
-                    "base": {
-                        "type": "integer"
-                    },
-                    "progress": {
-                        "type": "number"
+                    "affordable": {
+                        "type": "boolean"
                     },
-                    "resource": {
+                    "building": {
                         "format": "uuid",
                         "type": "string"
-                    }
-                },
-                "required": [
-                    "base",
-                    "progress",
-                    "resource"
-                ],
-                "type": "object"
-            },
-            "dtos.BuildingResourceStorageDtoResponse": {
-                "properties": {
-                    "base": {
-                        "type": "integer"
                     },
-                    "progress": {
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingActionCostDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "duration": {
+                        "description": "Duration is expressed in seconds.",
                         "type": "number"
                     },
-                    "resource": {
-                        "format": "uuid",
-                        "type": "string"
+                    "level": {
+                        "type": "integer"
                     },
-                    "scale": {
-                        "type": "number"
+                    "productions": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingActionProductionDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "storages": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingActionStorageDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "required": [
-                    "base",
-                    "progress",
-                    "resource",
-                    "scale"
+                    "affordable",
+                    "building",
+                    "costs",
+                    "duration",
+                    "level",
+                    "productions",
+                    "storages"
                 ],
                 "type": "object"
             },
-            "dtos.CoordinateDtoResponse": {
+            "dtos.BuildingQueueDtoRequest": {
                 "properties": {
-                    "galaxy": {
-                        "minimum": 1,
-                        "type": "integer"
-                    },
-                    "position": {
-                        "minimum": 1,
-                        "type": "integer"
-                    },
-                    "solar_system": {
-                        "minimum": 1,
-                        "type": "integer"
+                    "actions": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "required": [
-                    "galaxy",
-                    "position",
-                    "solar_system"
+                    "actions"
                 ],
                 "type": "object"
             },
-            "dtos.PlanetBuildingDtoResponse": {
+            "dtos.BuildingRequirementDtoResponse": {
                 "properties": {
                     "building": {
                         "format": "uuid",
                         "type": "string"
                     },
                     "level": {
+                        "minimum": 1,
                         "type": "integer"
                     }
                 },
@@ -259,89 +529,33 @@ const docTemplate = `{
                 ],
                 "type": "object"
             },
-            "dtos.PlanetDtoResponse": {
+            "dtos.BuildingResourceConsumptionDtoResponse": {
                 "properties": {
-                    "building_action": {
-                        "$ref": "#/components/schemas/dtos.BuildingActionDtoResponse"
-                    },
-                    "buildings": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.PlanetBuildingDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
-                    },
-                    "coordinate": {
-                        "$ref": "#/components/schemas/dtos.CoordinateDtoResponse"
-                    },
-                    "created_at": {
-                        "format": "date-time",
-                        "type": "string"
-                    },
-                    "fields": {
-                        "minimum": 1,
+                    "base": {
                         "type": "integer"
                     },
-                    "homeworld": {
-                        "type": "boolean"
-                    },
-                    "id": {
-                        "format": "uuid",
-                        "type": "string"
-                    },
-                    "name": {
-                        "example": "colony",
-                        "type": "string"
+                    "progress": {
+                        "type": "number"
                     },
-                    "player": {
+                    "resource": {
                         "format": "uuid",
                         "type": "string"
-                    },
-                    "productions": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.PlanetResourceProductionDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
-                    },
-                    "resources": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.PlanetResourceDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
-                    },
-                    "storages": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.PlanetResourceStorageDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
-                    },
-                    "updated_at": {
-                        "format": "date-time",
-                        "type": "string"
                     }
                 },
                 "required": [
-                    "buildings",
-                    "coordinate",
-                    "created_at",
-                    "fields",
-                    "homeworld",
-                    "id",
-                    "name",
-                    "player",
-                    "productions",
-                    "resources",
-                    "storages",
-                    "updated_at"
+                    "base",
+                    "progress",
+                    "resource"
                 ],
                 "type": "object"
             },
-            "dtos.PlanetResourceDtoResponse": {
+            "dtos.BuildingResourceProductionDtoRequest": {
                 "properties": {
-                    "amount": {
+                    "base": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "progress": {
                         "type": "number"
                     },
                     "resource": {
@@ -350,121 +564,130 @@ const docTemplate = `{
                     }
                 },
                 "required": [
-                    "amount",
+                    "base",
+                    "progress",
                     "resource"
                 ],
                 "type": "object"
             },
-            "dtos.PlanetResourceProductionDtoResponse": {
+            "dtos.BuildingResourceProductionDtoResponse": {
                 "properties": {
-                    "building": {
-                        "format": "uuid",
-                        "type": "string"
-                    },
-                    "production": {
+                    "base": {
                         "type": "integer"
                     },
+                    "progress": {
+                        "type": "number"
+                    },
                     "resource": {
                         "format": "uuid",
                         "type": "string"
                     }
                 },
                 "required": [
-                    "building",
-                    "production",
+                    "base",
+                    "progress",
                     "resource"
                 ],
                 "type": "object"
             },
-            "dtos.PlanetResourceStorageDtoResponse": {
+            "dtos.BuildingResourceStorageDtoRequest": {
                 "properties": {
+                    "base": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "progress": {
+                        "type": "number"
+                    },
                     "resource": {
                         "format": "uuid",
                         "type": "string"
                     },
-                    "storage": {
-                        "type": "integer"
+                    "scale": {
+                        "type": "number"
                     }
                 },
                 "required": [
+                    "base",
+                    "progress",
                     "resource",
-                    "storage"
+                    "scale"
                 ],
                 "type": "object"
             },
-            "dtos.PlayerDtoRequest": {
+            "dtos.BuildingResourceStorageDtoResponse": {
                 "properties": {
-                    "api_user": {
-                        "format": "uuid",
-                        "type": "string"
+                    "base": {
+                        "type": "integer"
                     },
-                    "name": {
-                        "example": "count tesla",
-                        "type": "string"
+                    "progress": {
+                        "type": "number"
                     },
-                    "universe": {
+                    "resource": {
                         "format": "uuid",
                         "type": "string"
+                    },
+                    "scale": {
+                        "type": "number"
                     }
                 },
                 "required": [
-                    "api_user",
-                    "name",
-                    "universe"
+                    "base",
+                    "progress",
+                    "resource",
+                    "scale"
                 ],
                 "type": "object"
             },
-            "dtos.PlayerDtoResponse": {
+            "dtos.CoordinateDtoRequest": {
                 "properties": {
-                    "api_user": {
-                        "format": "uuid",
-                        "type": "string"
-                    },
-                    "created_at": {
-                        "format": "date-time",
-                        "type": "string"
-                    },
-                    "homeworld": {
-                        "format": "uuid",
-                        "type": "string"
+                    "galaxy": {
+                        "type": "integer"
                     },
-                    "id": {
-                        "format": "uuid",
-                        "type": "string"
+                    "position": {
+                        "type": "integer"
                     },
-                    "name": {
-                        "example": "emperor palpatine",
-                        "type": "string"
+                    "solar_system": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "galaxy",
+                    "position",
+                    "solar_system"
+                ],
+                "type": "object"
+            },
+            "dtos.CoordinateDtoResponse": {
+                "properties": {
+                    "galaxy": {
+                        "minimum": 1,
+                        "type": "integer"
                     },
-                    "planets": {
-                        "items": {
-                            "format": "uuid",
-                            "type": "string"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
+                    "position": {
+                        "minimum": 1,
+                        "type": "integer"
                     },
-                    "universe": {
-                        "format": "uuid",
-                        "type": "string"
+                    "solar_system": {
+                        "minimum": 1,
+                        "type": "integer"
                     }
                 },
                 "required": [
-                    "api_user",
-                    "created_at",
-                    "homeworld",
-                    "id",
-                    "name",
-                    "planets",
-                    "universe"
+                    "galaxy",
+                    "position",
+                    "solar_system"
                 ],
                 "type": "object"
             },
-            "dtos.ResourceDtoResponse": {
+            "dtos.DefenseDtoResponse": {
                 "properties": {
-                    "build_time_hours_per_unit": {
-                        "minimum": 1,
-                        "type": "number"
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.UnitCostDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
                     "created_at": {
                         "format": "date-time",
@@ -475,103 +698,106 @@ const docTemplate = `{
                         "type": "string"
                     },
                     "name": {
-                        "example": "metal",
+                        "example": "rocket launcher",
                         "type": "string"
-                    },
-                    "start_amount": {
-                        "minimum": 1,
-                        "type": "integer"
-                    },
-                    "start_production": {
-                        "minimum": 0,
-                        "type": "integer"
-                    },
-                    "start_storage": {
-                        "minimum": 1,
-                        "type": "integer"
                     }
                 },
                 "required": [
-                    "build_time_hours_per_unit",
+                    "costs",
                     "created_at",
                     "id",
-                    "name",
-                    "start_amount",
-                    "start_production",
-                    "start_storage"
+                    "name"
                 ],
                 "type": "object"
             },
-            "dtos.TopologyDtoRequest": {
+            "dtos.ExchangeRateDtoResponse": {
                 "properties": {
-                    "galaxies": {
+                    "rate": {
                         "minimum": 1,
                         "type": "integer"
                     },
-                    "orbits": {
-                        "minimum": 1,
-                        "type": "integer"
-                    },
-                    "solar_systems": {
-                        "minimum": 1,
-                        "type": "integer"
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
                     }
                 },
                 "required": [
-                    "galaxies",
-                    "orbits",
-                    "solar_systems"
+                    "rate",
+                    "resource"
                 ],
                 "type": "object"
             },
-            "dtos.TopologyDtoResponse": {
+            "dtos.FleetCargoDto": {
                 "properties": {
-                    "galaxies": {
-                        "minimum": 1,
-                        "type": "integer"
-                    },
-                    "orbits": {
+                    "amount": {
                         "minimum": 1,
                         "type": "integer"
                     },
-                    "solar_systems": {
-                        "minimum": 1,
-                        "type": "integer"
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
                     }
                 },
                 "required": [
-                    "galaxies",
-                    "orbits",
-                    "solar_systems"
+                    "amount",
+                    "resource"
                 ],
                 "type": "object"
             },
-            "dtos.UniverseDtoRequest": {
+            "dtos.FleetDtoRequest": {
                 "properties": {
-                    "name": {
-                        "example": "aquarius",
+                    "cargo": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.FleetCargoDto"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "mission": {
+                        "enum": [
+                            "transport",
+                            "deploy"
+                        ],
                         "type": "string"
                     },
-                    "topology": {
-                        "$ref": "#/components/schemas/dtos.TopologyDtoRequest"
+                    "ships": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.FleetShipDto"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "speed": {
+                        "maximum": 100,
+                        "minimum": 10,
+                        "multipleOf": 10,
+                        "type": "integer"
+                    },
+                    "target": {
+                        "$ref": "#/components/schemas/dtos.CoordinateDtoRequest"
                     }
                 },
                 "required": [
-                    "name",
-                    "topology"
+                    "mission",
+                    "ships",
+                    "target"
                 ],
                 "type": "object"
             },
-            "dtos.UniverseDtoResponse": {
+            "dtos.FleetDtoResponse": {
                 "properties": {
-                    "buildings": {
+                    "arrives_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "cargo": {
                         "items": {
-                            "$ref": "#/components/schemas/dtos.BuildingDtoResponse"
+                            "$ref": "#/components/schemas/dtos.FleetCargoDto"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "created_at": {
+                    "departed_at": {
                         "format": "date-time",
                         "type": "string"
                     },
@@ -579,280 +805,6266 @@ const docTemplate = `{
                         "format": "uuid",
                         "type": "string"
                     },
-                    "name": {
-                        "example": "oberon",
+                    "mission": {
+                        "enum": [
+                            "transport",
+                            "deploy"
+                        ],
                         "type": "string"
                     },
-                    "resources": {
+                    "origin": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "origin_coordinate": {
+                        "$ref": "#/components/schemas/dtos.CoordinateDtoResponse"
+                    },
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "returns_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "ships": {
                         "items": {
-                            "$ref": "#/components/schemas/dtos.ResourceDtoResponse"
+                            "$ref": "#/components/schemas/dtos.FleetShipDto"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "topology": {
-                        "$ref": "#/components/schemas/dtos.TopologyDtoResponse"
+                    "target": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "target_coordinate": {
+                        "$ref": "#/components/schemas/dtos.CoordinateDtoResponse"
                     }
                 },
                 "required": [
-                    "buildings",
-                    "created_at",
+                    "arrives_at",
+                    "cargo",
+                    "departed_at",
                     "id",
-                    "name",
-                    "resources",
-                    "topology"
+                    "mission",
+                    "origin",
+                    "origin_coordinate",
+                    "player",
+                    "ships",
+                    "target",
+                    "target_coordinate"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-array_dtos_PlanetDtoResponse": {
+            "dtos.FleetShipDto": {
                 "properties": {
-                    "details": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.PlanetDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
+                    "count": {
+                        "minimum": 1,
+                        "type": "integer"
                     },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                    "ship": {
                         "format": "uuid",
                         "type": "string"
-                    },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
-                    },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
                     }
                 },
                 "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
+                    "count",
+                    "ship"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-array_dtos_PlayerDtoResponse": {
+            "dtos.GatewayFrameDto": {
                 "properties": {
-                    "details": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.PlayerDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
-                    },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
-                        "format": "uuid",
+                    "id": {
                         "type": "string"
                     },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
+                    "payload": {
+                        "type": "object"
                     },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
+                    "type": {
+                        "type": "string"
                     }
                 },
                 "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
+                    "type"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-array_dtos_UniverseDtoResponse": {
+            "dtos.MarketOfferAcceptanceDtoRequest": {
                 "properties": {
-                    "details": {
-                        "items": {
-                            "$ref": "#/components/schemas/dtos.UniverseDtoResponse"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
-                    },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                    "planet": {
                         "format": "uuid",
                         "type": "string"
-                    },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
-                    },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
                     }
                 },
                 "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
+                    "planet"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-dtos_BuildingActionDtoResponse": {
+            "dtos.MarketOfferDtoRequest": {
                 "properties": {
-                    "details": {
-                        "$ref": "#/components/schemas/dtos.BuildingActionDtoResponse"
-                    },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
-                        "format": "uuid",
-                        "type": "string"
+                    "duration": {
+                        "description": "Duration is expressed in hours.",
+                        "maximum": 72,
+                        "minimum": 1,
+                        "type": "integer"
                     },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
+                    "offered": {
+                        "$ref": "#/components/schemas/dtos.TradedResourceDtoRequest"
                     },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
+                    "requested": {
+                        "$ref": "#/components/schemas/dtos.TradedResourceDtoRequest"
                     }
                 },
                 "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
+                    "duration",
+                    "offered",
+                    "requested"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-dtos_PlanetDtoResponse": {
+            "dtos.MarketOfferDtoResponse": {
                 "properties": {
-                    "details": {
-                        "$ref": "#/components/schemas/dtos.PlanetDtoResponse"
+                    "accepted_at": {
+                        "format": "date-time",
+                        "type": "string"
                     },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                    "buyer": {
+                        "description": "Buyer is only set once the offer has been accepted.",
                         "format": "uuid",
                         "type": "string"
                     },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
                     },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
-                    }
-                },
-                "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
-                ],
-                "type": "object"
-            },
-            "rest.ResponseEnvelope-dtos_PlayerDtoResponse": {
-                "properties": {
-                    "details": {
-                        "$ref": "#/components/schemas/dtos.PlayerDtoResponse"
+                    "expires_at": {
+                        "format": "date-time",
+                        "type": "string"
                     },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "offered": {
+                        "$ref": "#/components/schemas/dtos.TradedResourceDtoResponse"
+                    },
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "player": {
                         "format": "uuid",
                         "type": "string"
                     },
+                    "requested": {
+                        "$ref": "#/components/schemas/dtos.TradedResourceDtoResponse"
+                    },
                     "status": {
-                        "$ref": "#/components/schemas/rest.Status"
+                        "enum": [
+                            "open",
+                            "accepted",
+                            "settled",
+                            "cancelled",
+                            "expired"
+                        ],
+                        "type": "string"
                     },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
+                    "universe": {
+                        "format": "uuid",
+                        "type": "string"
                     }
                 },
                 "required": [
-                    "details",
-                    "request_id",
+                    "created_at",
+                    "expires_at",
+                    "id",
+                    "offered",
+                    "planet",
+                    "player",
+                    "requested",
                     "status",
-                    "status_code"
+                    "universe"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-dtos_UniverseDtoResponse": {
+            "dtos.MessageDtoRequest": {
                 "properties": {
-                    "details": {
-                        "$ref": "#/components/schemas/dtos.UniverseDtoResponse"
+                    "content": {
+                        "example": "would you exchange metal for crystal?",
+                        "type": "string"
                     },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                    "recipient": {
                         "format": "uuid",
                         "type": "string"
                     },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
-                    },
-                    "status_code": {
-                        "example": 200,
-                        "type": "integer"
+                    "subject": {
+                        "example": "trade proposal",
+                        "type": "string"
                     }
                 },
                 "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
+                    "content",
+                    "recipient",
+                    "subject"
                 ],
                 "type": "object"
             },
-            "rest.ResponseEnvelope-string": {
+            "dtos.MessageDtoResponse": {
                 "properties": {
-                    "details": {
+                    "content": {
+                        "example": "would you exchange metal for crystal?",
                         "type": "string"
                     },
-                    "request_id": {
-                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
                         "format": "uuid",
                         "type": "string"
                     },
-                    "status": {
-                        "$ref": "#/components/schemas/rest.Status"
+                    "kind": {
+                        "enum": [
+                            "player",
+                            "system"
+                        ],
+                        "type": "string"
                     },
-                    "status_code": {
-                        "example": 200,
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "read": {
+                        "type": "boolean"
+                    },
+                    "sender": {
+                        "description": "Sender is not set for system messages.",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "subject": {
+                        "example": "trade proposal",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "content",
+                    "created_at",
+                    "id",
+                    "kind",
+                    "player",
+                    "read",
+                    "subject"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetBuildingDtoResponse": {
+                "properties": {
+                    "building": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "level": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "building",
+                    "level"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetChangeDtoResponse": {
+                "properties": {
+                    "deleted": {
+                        "type": "boolean"
+                    },
+                    "kind": {
+                        "enum": [
+                            "creation",
+                            "update",
+                            "action_created",
+                            "action_cancelled",
+                            "action_applied",
+                            "deletion"
+                        ],
+                        "type": "string"
+                    },
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "version": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "deleted",
+                    "kind",
+                    "planet",
+                    "version"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetDefenseDtoResponse": {
+                "properties": {
+                    "count": {
+                        "type": "integer"
+                    },
+                    "defense": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "count",
+                    "defense"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetDivergenceDtoResponse": {
+                "properties": {
+                    "action": {
+                        "description": "Action is only set for action divergences.",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "building": {
+                        "description": "Building is only set for building divergences.",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "kind": {
+                        "enum": [
+                            "version_gap",
+                            "version",
+                            "resource",
+                            "building",
+                            "action"
+                        ],
+                        "type": "string"
+                    },
+                    "rebuilt": {
+                        "type": "number"
+                    },
+                    "resource": {
+                        "description": "Resource is only set for resource divergences.",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "stored": {
+                        "type": "number"
+                    }
+                },
+                "required": [
+                    "at",
+                    "kind",
+                    "rebuilt",
+                    "stored"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetDtoResponse": {
+                "properties": {
+                    "building_queue": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingActionDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "buildings": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetBuildingDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "coordinate": {
+                        "$ref": "#/components/schemas/dtos.CoordinateDtoResponse"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "defenses": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetDefenseDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "energy": {
+                        "$ref": "#/components/schemas/dtos.PlanetEnergyDtoResponse"
+                    },
+                    "fields": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "fleets": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.FleetDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "homeworld": {
+                        "type": "boolean"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "colony",
+                        "type": "string"
+                    },
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "productions": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetResourceProductionDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "research_action": {
+                        "$ref": "#/components/schemas/dtos.ResearchActionDtoResponse"
+                    },
+                    "resources": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetResourceDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "ships": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetShipDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "shipyard_queue": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ShipyardActionDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "storages": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetResourceStorageDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "updated_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "building_queue",
+                    "buildings",
+                    "coordinate",
+                    "created_at",
+                    "defenses",
+                    "energy",
+                    "fields",
+                    "fleets",
+                    "homeworld",
+                    "id",
+                    "name",
+                    "player",
+                    "productions",
+                    "resources",
+                    "ships",
+                    "shipyard_queue",
+                    "storages",
+                    "updated_at"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetEnergyDtoResponse": {
+                "properties": {
+                    "consumed": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "produced": {
+                        "minimum": 0,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "consumed",
+                    "produced"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetEventDtoResponse": {
+                "properties": {
+                    "action": {
+                        "description": "Action is the action created, cancelled or applied by the event.",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "kind": {
+                        "enum": [
+                            "update",
+                            "action_created",
+                            "action_cancelled",
+                            "action_applied",
+                            "deletion"
+                        ],
+                        "type": "string"
+                    },
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "resources": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetEventResourceDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "version_after": {
                         "type": "integer"
+                    },
+                    "version_before": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "created_at",
+                    "id",
+                    "kind",
+                    "planet",
+                    "resources",
+                    "version_after",
+                    "version_before"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetEventResourceDtoResponse": {
+                "properties": {
+                    "delta": {
+                        "description": "Delta is negative when the resource was spent.",
+                        "type": "number"
+                    },
+                    "produced": {
+                        "description": "Produced is the part of the delta produced by the planet.",
+                        "type": "number"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "dtos.PlanetReconstructionDtoResponse": {
+                "properties": {
+                    "at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "divergences": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetDivergenceDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "events": {
+                        "type": "integer"
+                    },
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "resources": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetResourceDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "version": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "at",
+                    "divergences",
+                    "events",
+                    "planet",
+                    "resources",
+                    "version"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetResourceDtoResponse": {
+                "properties": {
+                    "amount": {
+                        "type": "number"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "amount",
+                    "resource"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetResourceProductionDtoResponse": {
+                "properties": {
+                    "building": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "production": {
+                        "type": "integer"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "building",
+                    "production",
+                    "resource"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetResourceStorageDtoResponse": {
+                "properties": {
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "storage": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "resource",
+                    "storage"
+                ],
+                "type": "object"
+            },
+            "dtos.PlanetShipDtoResponse": {
+                "properties": {
+                    "count": {
+                        "type": "integer"
+                    },
+                    "ship": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "count",
+                    "ship"
+                ],
+                "type": "object"
+            },
+            "dtos.PlayerAllianceDtoResponse": {
+                "properties": {
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "galactic empire",
+                        "type": "string"
+                    },
+                    "rank": {
+                        "enum": [
+                            "founder",
+                            "officer",
+                            "member"
+                        ],
+                        "type": "string"
+                    },
+                    "tag": {
+                        "example": "EMP",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "id",
+                    "name",
+                    "rank",
+                    "tag"
+                ],
+                "type": "object"
+            },
+            "dtos.PlayerDtoRequest": {
+                "properties": {
+                    "api_user": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "count tesla",
+                        "type": "string"
+                    },
+                    "universe": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "api_user",
+                    "name",
+                    "universe"
+                ],
+                "type": "object"
+            },
+            "dtos.PlayerDtoResponse": {
+                "properties": {
+                    "alliance": {
+                        "$ref": "#/components/schemas/dtos.PlayerAllianceDtoResponse"
+                    },
+                    "api_user": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "homeworld": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "emperor palpatine",
+                        "type": "string"
+                    },
+                    "planets": {
+                        "items": {
+                            "format": "uuid",
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "points": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "rank": {
+                        "description": "Rank is 0 until the rankings of the universe are first computed.",
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "universe": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "api_user",
+                    "created_at",
+                    "homeworld",
+                    "id",
+                    "name",
+                    "planets",
+                    "points",
+                    "rank",
+                    "universe"
+                ],
+                "type": "object"
+            },
+            "dtos.PlayerTechnologyDtoResponse": {
+                "properties": {
+                    "level": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "technology": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "level",
+                    "technology"
+                ],
+                "type": "object"
+            },
+            "dtos.RankingDtoResponse": {
+                "properties": {
+                    "name": {
+                        "example": "emperor palpatine",
+                        "type": "string"
+                    },
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "points": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "rank": {
+                        "minimum": 1,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "name",
+                    "player",
+                    "points",
+                    "rank"
+                ],
+                "type": "object"
+            },
+            "dtos.ResearchActionCostDtoResponse": {
+                "properties": {
+                    "amount": {
+                        "type": "integer"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "dtos.ResearchActionDtoRequest": {
+                "properties": {
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "technology": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "planet",
+                    "technology"
+                ],
+                "type": "object"
+            },
+            "dtos.ResearchActionDtoResponse": {
+                "properties": {
+                    "completed_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ResearchActionCostDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "desired_level": {
+                        "type": "integer"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "technology": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "completed_at",
+                    "costs",
+                    "created_at",
+                    "desired_level",
+                    "id",
+                    "planet",
+                    "technology"
+                ],
+                "type": "object"
+            },
+            "dtos.ResearchDtoResponse": {
+                "properties": {
+                    "action": {
+                        "$ref": "#/components/schemas/dtos.ResearchActionDtoResponse"
+                    },
+                    "player": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "technologies": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlayerTechnologyDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "player",
+                    "technologies"
+                ],
+                "type": "object"
+            },
+            "dtos.ResourceDtoRequest": {
+                "properties": {
+                    "build_time_hours_per_unit": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "name": {
+                        "example": "metal",
+                        "type": "string"
+                    },
+                    "start_amount": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "start_production": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "start_storage": {
+                        "minimum": 0,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "name"
+                ],
+                "type": "object"
+            },
+            "dtos.ResourceDtoResponse": {
+                "properties": {
+                    "build_time_hours_per_unit": {
+                        "minimum": 1,
+                        "type": "number"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "metal",
+                        "type": "string"
+                    },
+                    "start_amount": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "start_production": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "start_storage": {
+                        "minimum": 1,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "build_time_hours_per_unit",
+                    "created_at",
+                    "id",
+                    "name",
+                    "start_amount",
+                    "start_production",
+                    "start_storage"
+                ],
+                "type": "object"
+            },
+            "dtos.ShipDtoResponse": {
+                "properties": {
+                    "cargo_capacity": {
+                        "type": "integer"
+                    },
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.UnitCostDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "small cargo",
+                        "type": "string"
+                    },
+                    "speed": {
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "cargo_capacity",
+                    "costs",
+                    "created_at",
+                    "id",
+                    "name",
+                    "speed"
+                ],
+                "type": "object"
+            },
+            "dtos.ShipyardActionCostDtoResponse": {
+                "properties": {
+                    "amount": {
+                        "type": "integer"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "dtos.ShipyardActionDtoRequest": {
+                "properties": {
+                    "count": {
+                        "maximum": 10000,
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "kind": {
+                        "enum": [
+                            "ship",
+                            "defense"
+                        ],
+                        "type": "string"
+                    },
+                    "unit": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "count",
+                    "kind",
+                    "unit"
+                ],
+                "type": "object"
+            },
+            "dtos.ShipyardActionDtoResponse": {
+                "properties": {
+                    "completed_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ShipyardActionCostDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "count": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "kind": {
+                        "enum": [
+                            "ship",
+                            "defense"
+                        ],
+                        "type": "string"
+                    },
+                    "next_unit_completed_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "unit": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "completed_at",
+                    "costs",
+                    "count",
+                    "created_at",
+                    "id",
+                    "kind",
+                    "next_unit_completed_at",
+                    "unit"
+                ],
+                "type": "object"
+            },
+            "dtos.SolarSystemDtoResponse": {
+                "properties": {
+                    "galaxy": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "slots": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.SolarSystemSlotDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "solar_system": {
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "universe": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "galaxy",
+                    "slots",
+                    "solar_system",
+                    "universe"
+                ],
+                "type": "object"
+            },
+            "dtos.SolarSystemPlanetDtoResponse": {
+                "properties": {
+                    "alliance": {
+                        "description": "Alliance is the tag of the alliance of the player, if any.",
+                        "example": "EMP",
+                        "type": "string"
+                    },
+                    "homeworld": {
+                        "type": "boolean"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "my-planet",
+                        "type": "string"
+                    },
+                    "player": {
+                        "example": "my-player",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "homeworld",
+                    "id",
+                    "name",
+                    "player"
+                ],
+                "type": "object"
+            },
+            "dtos.SolarSystemSlotDtoResponse": {
+                "properties": {
+                    "planet": {
+                        "$ref": "#/components/schemas/dtos.SolarSystemPlanetDtoResponse"
+                    },
+                    "position": {
+                        "minimum": 0,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "position"
+                ],
+                "type": "object"
+            },
+            "dtos.TechnologyCostDtoResponse": {
+                "properties": {
+                    "cost": {
+                        "type": "integer"
+                    },
+                    "progress": {
+                        "type": "number"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "cost",
+                    "progress",
+                    "resource"
+                ],
+                "type": "object"
+            },
+            "dtos.TechnologyDtoResponse": {
+                "properties": {
+                    "costs": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.TechnologyCostDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "energy technology",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "costs",
+                    "created_at",
+                    "id",
+                    "name"
+                ],
+                "type": "object"
+            },
+            "dtos.TopologyDtoRequest": {
+                "properties": {
+                    "galaxies": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "orbits": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "solar_systems": {
+                        "minimum": 1,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "galaxies",
+                    "orbits",
+                    "solar_systems"
+                ],
+                "type": "object"
+            },
+            "dtos.TopologyDtoResponse": {
+                "properties": {
+                    "galaxies": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "orbits": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "solar_systems": {
+                        "minimum": 1,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "galaxies",
+                    "orbits",
+                    "solar_systems"
+                ],
+                "type": "object"
+            },
+            "dtos.TradeDtoRequest": {
+                "properties": {
+                    "amount": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "bought": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "sold": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "amount",
+                    "bought",
+                    "sold"
+                ],
+                "type": "object"
+            },
+            "dtos.TradeDtoResponse": {
+                "properties": {
+                    "bought": {
+                        "$ref": "#/components/schemas/dtos.TradedResourceDtoResponse"
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "planet": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "sold": {
+                        "$ref": "#/components/schemas/dtos.TradedResourceDtoResponse"
+                    }
+                },
+                "required": [
+                    "bought",
+                    "created_at",
+                    "planet",
+                    "sold"
+                ],
+                "type": "object"
+            },
+            "dtos.TradedResourceDtoRequest": {
+                "properties": {
+                    "amount": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "amount",
+                    "resource"
+                ],
+                "type": "object"
+            },
+            "dtos.TradedResourceDtoResponse": {
+                "properties": {
+                    "amount": {
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "amount",
+                    "resource"
+                ],
+                "type": "object"
+            },
+            "dtos.UnitCostDtoResponse": {
+                "properties": {
+                    "cost": {
+                        "type": "integer"
+                    },
+                    "resource": {
+                        "format": "uuid",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "cost",
+                    "resource"
+                ],
+                "type": "object"
+            },
+            "dtos.UniverseDtoRequest": {
+                "properties": {
+                    "name": {
+                        "example": "aquarius",
+                        "type": "string"
+                    },
+                    "topology": {
+                        "$ref": "#/components/schemas/dtos.TopologyDtoRequest"
+                    }
+                },
+                "required": [
+                    "name",
+                    "topology"
+                ],
+                "type": "object"
+            },
+            "dtos.UniverseDtoResponse": {
+                "properties": {
+                    "buildings": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "format": "date-time",
+                        "type": "string"
+                    },
+                    "defenses": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.DefenseDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "exchange_rates": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ExchangeRateDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "id": {
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "name": {
+                        "example": "oberon",
+                        "type": "string"
+                    },
+                    "resources": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ResourceDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "ships": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ShipDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "technologies": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.TechnologyDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "topology": {
+                        "$ref": "#/components/schemas/dtos.TopologyDtoResponse"
+                    }
+                },
+                "required": [
+                    "buildings",
+                    "created_at",
+                    "defenses",
+                    "exchange_rates",
+                    "id",
+                    "name",
+                    "resources",
+                    "ships",
+                    "technologies",
+                    "topology"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_AllianceApplicationDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.AllianceApplicationDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_BuildingActionDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingActionDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_BuildingDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_BuildingLevelPreviewDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.BuildingLevelPreviewDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_FleetDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.FleetDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_MarketOfferDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.MarketOfferDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_MessageDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.MessageDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_PlanetDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_PlanetEventDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlanetEventDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_PlayerDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.PlayerDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_RankingDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.RankingDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_ResourceDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.ResourceDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-array_dtos_UniverseDtoResponse": {
+                "properties": {
+                    "details": {
+                        "items": {
+                            "$ref": "#/components/schemas/dtos.UniverseDtoResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_AllianceApplicationDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.AllianceApplicationDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_AllianceDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.AllianceDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_BuildingActionDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.BuildingActionDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_BuildingDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.BuildingDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_FleetDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.FleetDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_MarketOfferDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.MarketOfferDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_MessageDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.MessageDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_PlanetDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.PlanetDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_PlanetReconstructionDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.PlanetReconstructionDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_PlayerDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.PlayerDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_ResearchActionDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.ResearchActionDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_ResearchDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.ResearchDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_ResourceDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.ResourceDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_ShipyardActionDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.ShipyardActionDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_SolarSystemDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.SolarSystemDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_TradeDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.TradeDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-dtos_UniverseDtoResponse": {
+                "properties": {
+                    "details": {
+                        "$ref": "#/components/schemas/dtos.UniverseDtoResponse"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.ResponseEnvelope-string": {
+                "properties": {
+                    "details": {
+                        "type": "string"
+                    },
+                    "request_id": {
+                        "example": "669cd40f-ea15-40a8-ab03-81e704a3ecf9",
+                        "format": "uuid",
+                        "type": "string"
+                    },
+                    "status": {
+                        "$ref": "#/components/schemas/rest.Status"
+                    },
+                    "status_code": {
+                        "example": 200,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "details",
+                    "request_id",
+                    "status",
+                    "status_code"
+                ],
+                "type": "object"
+            },
+            "rest.Status": {
+                "enum": [
+                    "SUCCESS",
+                    "ERROR"
+                ],
+                "example": "SUCCESS",
+                "type": "string",
+                "x-enum-varnames": [
+                    "StatusSuccess",
+                    "StatusError"
+                ]
+            }
+        },
+        "securitySchemes": {
+            "BearerAuth": {
+                "description": "Signed token (JWT) of the api user, prefixed with \"Bearer \".",
+                "in": "header",
+                "name": "Authorization",
+                "type": "apiKey"
+            }
+        }
+    },
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "version": "{{.Version}}"
+    },
+    "externalDocs": {
+        "description": "",
+        "url": ""
+    },
+    "paths": {
+        "/alliances": {
+            "post": {
+                "description": "Creates an alliance in the universe of the founder. The name and the tag are unique within a universe.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.AllianceDtoRequest",
+                                "summary": "request",
+                                "description": "Alliance payload"
+                            }
+                        }
+                    },
+                    "description": "Alliance payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_AllianceDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Create alliance",
+                "tags": [
+                    "alliances"
+                ]
+            }
+        },
+        "/alliances/{id}": {
+            "delete": {
+                "description": "Deletes an alliance along with its memberships and applications. Only the founder can disband the alliance.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Id of the player disbanding (UUID)",
+                        "in": "query",
+                        "name": "player",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Disband alliance",
+                "tags": [
+                    "alliances"
+                ]
+            },
+            "get": {
+                "description": "Returns an alliance and its members.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_AllianceDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Get alliance",
+                "tags": [
+                    "alliances"
+                ]
+            }
+        },
+        "/alliances/{id}/applications": {
+            "get": {
+                "description": "Returns the pending applications of an alliance. The player needs to be allowed to manage applications.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Id of the player requesting (UUID)",
+                        "in": "query",
+                        "name": "player",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_AllianceApplicationDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List alliance applications",
+                "tags": [
+                    "alliances"
+                ]
+            },
+            "post": {
+                "description": "Submits an application for the player to join the alliance. The player needs to be part of the same universe and not to be in an alliance already.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.AllianceApplicationDtoRequest",
+                                "summary": "request",
+                                "description": "Application payload"
+                            }
+                        }
+                    },
+                    "description": "Application payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_AllianceApplicationDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Apply to alliance",
+                "tags": [
+                    "alliances"
+                ]
+            }
+        },
+        "/alliances/{id}/applications/{application}": {
+            "patch": {
+                "description": "Accepting an application makes the applicant a member of the alliance and removes its other applications. The player deciding needs to be allowed to manage applications.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Application id (UUID)",
+                        "in": "path",
+                        "name": "application",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.AllianceApplicationDecisionDtoRequest",
+                                "summary": "request",
+                                "description": "Decision payload"
+                            }
+                        }
+                    },
+                    "description": "Decision payload",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Accept or reject alliance application",
+                "tags": [
+                    "alliances"
+                ]
+            }
+        },
+        "/alliances/{id}/members/{player}": {
+            "delete": {
+                "description": "Removes the player from the alliance. The founder can not leave and needs to disband the alliance instead.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Member id (UUID)",
+                        "in": "path",
+                        "name": "player",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Leave alliance",
+                "tags": [
+                    "alliances"
+                ]
+            },
+            "patch": {
+                "description": "Promotes or demotes a member of the alliance. The player changing the rank needs to be allowed to manage ranks.",
+                "parameters": [
+                    {
+                        "description": "Alliance id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Member id (UUID)",
+                        "in": "path",
+                        "name": "player",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.AllianceRankDtoRequest",
+                                "summary": "request",
+                                "description": "Rank payload"
+                            }
+                        }
+                    },
+                    "description": "Rank payload",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Change rank of alliance member",
+                "tags": [
+                    "alliances"
+                ]
+            }
+        },
+        "/buildings": {
+            "get": {
+                "description": "Returns the buildings shared by all universes. Restricted to administrators.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_BuildingDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List buildings",
+                "tags": [
+                    "game data"
+                ]
+            },
+            "post": {
+                "description": "Creates a building with its costs, productions and storages. Restricted to administrators.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.BuildingDtoRequest",
+                                "summary": "request",
+                                "description": "Building payload"
+                            }
+                        }
+                    },
+                    "description": "Building payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_BuildingDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Create building",
+                "tags": [
+                    "game data"
+                ]
+            }
+        },
+        "/buildings/{id}": {
+            "patch": {
+                "description": "Replaces the costs, productions and storages of a building. Its consumptions and requirements are kept. The buildings some rules of the game depend on (e.g. the shipyard) can not be renamed. Restricted to administrators.",
+                "parameters": [
+                    {
+                        "description": "Building id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.BuildingDtoRequest",
+                                "summary": "request",
+                                "description": "Building payload"
+                            }
+                        }
+                    },
+                    "description": "Building payload",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_BuildingDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Update building",
+                "tags": [
+                    "game data"
+                ]
+            }
+        },
+        "/fleets/{id}/recall": {
+            "post": {
+                "description": "Makes a fleet fly back to its origin before it reaches its target. The return flight takes as long as the fleet already spent flying.",
+                "parameters": [
+                    {
+                        "description": "Fleet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_FleetDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Recall fleet",
+                "tags": [
+                    "fleets"
+                ]
+            }
+        },
+        "/healthcheck": {
+            "get": {
+                "description": "Returns service health based on database connectivity.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Health check",
+                "tags": [
+                    "healthcheck"
+                ]
+            }
+        },
+        "/offers/{id}": {
+            "delete": {
+                "description": "Cancels an open offer and refunds the escrow to the planet it was posted from.",
+                "parameters": [
+                    {
+                        "description": "Offer id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Cancel market offer",
+                "tags": [
+                    "market"
+                ]
+            }
+        },
+        "/offers/{id}/accept": {
+            "post": {
+                "description": "Pays for the offer provided in path parameter with the resources of the planet of the buyer, which receives the escrow. An offer can only be accepted once.",
+                "parameters": [
+                    {
+                        "description": "Offer id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.MarketOfferAcceptanceDtoRequest",
+                                "summary": "request",
+                                "description": "Acceptance payload"
+                            }
+                        }
+                    },
+                    "description": "Acceptance payload",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_MarketOfferDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Accept market offer",
+                "tags": [
+                    "market"
+                ]
+            }
+        },
+        "/planets/{id}": {
+            "delete": {
+                "description": "Deletes a planet by id.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Delete planet",
+                "tags": [
+                    "planets"
+                ]
+            },
+            "get": {
+                "description": "Returns a planet and all related game data.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlanetDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Get planet",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/actions": {
+            "delete": {
+                "description": "Deletes all the building actions queued for a planet. The costs of the actions are not refunded.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Delete building queue for a planet",
+                "tags": [
+                    "planets"
+                ]
+            },
+            "get": {
+                "description": "Returns the building actions queued for a planet in the order they will be processed. The first action is the one in progress.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_BuildingActionDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List building queue",
+                "tags": [
+                    "planets"
+                ]
+            },
+            "patch": {
+                "description": "Changes the order of the building actions queued for a planet. All the queued actions must be provided, the action in progress must stay first and upgrades of the same building must keep their relative order. An action can't be moved before the actions bringing its requirements.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.BuildingQueueDtoRequest",
+                                "summary": "request",
+                                "description": "Building queue payload"
+                            }
+                        }
+                    },
+                    "description": "Building queue payload",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_BuildingActionDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Reorder building queue",
+                "tags": [
+                    "planets"
+                ]
+            },
+            "post": {
+                "description": "Appends a building action to the queue of the planet provided in path parameter. The action either upgrades or demolishes one level of the building depending on its kind, upgrading when it is not provided. Demolishing costs a fraction of the construction of the level. The action starts when the previous one in the queue completes and its costs are deducted immediately. The planet field in the body is ignored and replaced with this path value.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.BuildingActionDtoRequest",
+                                "summary": "request",
+                                "description": "Building action payload"
+                            }
+                        }
+                    },
+                    "description": "Building action payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_BuildingActionDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Create building action",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/actions/{action}": {
+            "delete": {
+                "description": "Removes a building action from the queue of a planet and refunds its costs. Actions queued later for the same building, or whose requirements are not met anymore, are cancelled and refunded as well.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Action id (UUID)",
+                        "in": "path",
+                        "name": "action",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Cancel building action",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/buildings/{building}/preview": {
+            "get": {
+                "description": "Describes the next levels of the building on the planet, starting after the level reached once the actions already queued for it are completed. For each level, returns the costs, the time needed to complete it, the production and storage of the building once it is reached (the bonus of the energy technology applies on top of the production, as for the planet) and whether the planet can currently afford it. Nothing is queued nor modified on the planet.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Building id (UUID)",
+                        "in": "path",
+                        "name": "building",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Number of levels to preview",
+                        "in": "query",
+                        "name": "levels",
+                        "schema": {
+                            "default": 1,
+                            "maximum": 20,
+                            "minimum": 1,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_BuildingLevelPreviewDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Preview building upgrades",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/fleets": {
+            "post": {
+                "description": "Sends ships and cargo from the planet provided in path parameter to another planet of the same player located at the target coordinate. A transport fleet unloads what fits in the storage of the target and brings the rest back. The flight duration depends on the distance and on the slowest ship of the fleet. The number of fleets in flight is limited by the computer technology of the player.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.FleetDtoRequest",
+                                "summary": "request",
+                                "description": "Fleet payload"
+                            }
+                        }
+                    },
+                    "description": "Fleet payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_FleetDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Send fleet",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/history": {
+            "get": {
+                "description": "Returns the mutations committed to a planet, most recent first. Each event holds the version of the planet before and after the mutation, its kind and how the amount of each resource changed.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page index, starting at 0",
+                        "in": "query",
+                        "name": "page",
+                        "schema": {
+                            "default": 0,
+                            "minimum": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Page size",
+                        "in": "query",
+                        "name": "size",
+                        "schema": {
+                            "default": 20,
+                            "maximum": 100,
+                            "minimum": 1,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_PlanetEventDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List planet history",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/offers": {
+            "post": {
+                "description": "Puts the offered resource of the planet provided in path parameter in escrow and posts an offer on the market of its universe. The escrow is refunded if the offer is cancelled or expires.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.MarketOfferDtoRequest",
+                                "summary": "request",
+                                "description": "Offer payload"
+                            }
+                        }
+                    },
+                    "description": "Offer payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_MarketOfferDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Post market offer",
+                "tags": [
+                    "market"
+                ]
+            }
+        },
+        "/planets/{id}/reconstruction": {
+            "get": {
+                "description": "Replays the events of the planet onto the resources it started with to rebuild its state at a moment, by default the current time. When the moment is not older than the last update of the planet, the rebuilt state is compared to the stored one and the divergences are reported. Restricted to administrators.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Moment to rebuild the planet at (RFC 3339)",
+                        "in": "query",
+                        "name": "at",
+                        "schema": {
+                            "format": "date-time",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlanetReconstructionDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Rebuild planet from its history",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/shipyard": {
+            "post": {
+                "description": "Queues a batch of ships or defenses in the shipyard of the planet provided in path parameter. Batches are produced one after the other, and units of a batch are delivered one at a time.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.ShipyardActionDtoRequest",
+                                "summary": "request",
+                                "description": "Shipyard action payload"
+                            }
+                        }
+                    },
+                    "description": "Shipyard action payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_ShipyardActionDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Queue units in the shipyard",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/planets/{id}/trades": {
+            "post": {
+                "description": "Exchanges resources of the planet provided in path parameter with the merchant. The amount received depends on the exchange rates of the universe and is rounded down. The bought resource can not exceed the storage of the planet.",
+                "parameters": [
+                    {
+                        "description": "Planet id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.TradeDtoRequest",
+                                "summary": "request",
+                                "description": "Trade payload"
+                            }
+                        }
+                    },
+                    "description": "Trade payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_TradeDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Trade resources",
+                "tags": [
+                    "planets"
+                ]
+            }
+        },
+        "/players": {
+            "post": {
+                "description": "Creates a player and its homeworld.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.PlayerDtoRequest",
+                                "summary": "request",
+                                "description": "Player payload"
+                            }
+                        }
+                    },
+                    "description": "Player payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlayerDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Create player",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/players/{id}": {
+            "delete": {
+                "description": "Deletes a player by id.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Delete player",
+                "tags": [
+                    "players"
+                ]
+            },
+            "get": {
+                "description": "Returns a player by id.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlayerDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Get player",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/players/{id}/events": {
+            "get": {
+                "description": "Streams as Server-Sent Events the changes committed to the planets of a player. Each change is sent as a ` + "`" + `planet` + "`" + ` event with the kind of the mutation; clients are expected to fetch the planet again to get its new values. Browsers can't set the Authorization header on an EventSource: the token can also be passed in the ` + "`" + `access_token` + "`" + ` query parameter or cookie.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Token, when it can't be passed in the Authorization header",
+                        "in": "query",
+                        "name": "access_token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/dtos.PlanetChangeDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Stream changes of planets",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/players/{id}/fleets": {
+            "get": {
+                "description": "Returns the fleets sent by a player which did not come back yet.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_FleetDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List fleets",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/players/{id}/messages": {
+            "get": {
+                "description": "Returns the messages received by a player, most recent first.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page index, starting at 0",
+                        "in": "query",
+                        "name": "page",
+                        "schema": {
+                            "default": 0,
+                            "minimum": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Page size",
+                        "in": "query",
+                        "name": "size",
+                        "schema": {
+                            "default": 20,
+                            "maximum": 100,
+                            "minimum": 1,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_MessageDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List messages",
+                "tags": [
+                    "players"
+                ]
+            },
+            "post": {
+                "description": "Sends a message from the player provided in path parameter to the recipient of the payload. Both players need to be part of the same universe.",
+                "parameters": [
+                    {
+                        "description": "Sender id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.MessageDtoRequest",
+                                "summary": "request",
+                                "description": "Message payload"
+                            }
+                        }
+                    },
+                    "description": "Message payload",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_MessageDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Send message",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/players/{id}/messages/{message}": {
+            "delete": {
+                "description": "Deletes a message received by the player.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Message id (UUID)",
+                        "in": "path",
+                        "name": "message",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Delete message",
+                "tags": [
+                    "players"
+                ]
+            },
+            "patch": {
+                "description": "Marks a message received by the player as read.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Message id (UUID)",
+                        "in": "path",
+                        "name": "message",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
                     }
                 },
-                "required": [
-                    "details",
-                    "request_id",
-                    "status",
-                    "status_code"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
-                "type": "object"
+                "summary": "Mark message as read",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/players/{id}/planets": {
+            "get": {
+                "description": "Returns planets belonging to a player.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_PlanetDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List planets",
+                "tags": [
+                    "players"
+                ]
             },
-            "rest.Status": {
-                "enum": [
-                    "SUCCESS",
-                    "ERROR"
+            "post": {
+                "description": "Creates a new colony for the player provided in path parameter at a free position of its universe.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
                 ],
-                "example": "SUCCESS",
-                "type": "string",
-                "x-enum-varnames": [
-                    "StatusSuccess",
-                    "StatusError"
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlanetDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Colonize planet",
+                "tags": [
+                    "players"
                 ]
             }
-        }
-    },
-    "info": {
-        "description": "{{escape .Description}}",
-        "title": "{{.Title}}",
-        "version": "{{.Version}}"
-    },
-    "externalDocs": {
-        "description": "",
-        "url": ""
-    },
-    "paths": {
-        "/healthcheck": {
+        },
+        "/players/{id}/research": {
             "get": {
-                "description": "Returns service health based on database connectivity.",
+                "description": "Returns the technologies researched by a player along with the research in progress if any.",
+                "parameters": [
+                    {
+                        "description": "Player id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_ResearchDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -860,9 +7072,9 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Not Found"
                     },
-                    "503": {
+                    "500": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -870,21 +7082,24 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Service Unavailable"
+                        "description": "Internal Server Error"
                     }
                 },
-                "summary": "Health check",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Get research",
                 "tags": [
-                    "healthcheck"
+                    "players"
                 ]
-            }
-        },
-        "/planets/{id}": {
-            "delete": {
-                "description": "Deletes a planet by id.",
+            },
+            "post": {
+                "description": "Starts researching the next level of a technology for the player provided in path parameter. The research runs on the planet provided in the body which pays for it. A player can only research one technology at a time.",
                 "parameters": [
                     {
-                        "description": "Planet id (UUID)",
+                        "description": "Player id (UUID)",
                         "in": "path",
                         "name": "id",
                         "required": true,
@@ -894,16 +7109,29 @@ const docTemplate = `{
                         }
                     }
                 ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.ResearchActionDtoRequest",
+                                "summary": "request",
+                                "description": "Research action payload"
+                            }
+                        }
+                    },
+                    "description": "Research action payload",
+                    "required": true
+                },
                 "responses": {
-                    "204": {
+                    "201": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "type": "string"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_ResearchActionDtoResponse"
                                 }
                             }
                         },
-                        "description": "No Content"
+                        "description": "Created"
                     },
                     "400": {
                         "content": {
@@ -915,6 +7143,36 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
                     "409": {
                         "content": {
                             "application/json": {
@@ -936,37 +7194,32 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Delete planet",
-                "tags": [
-                    "planets"
-                ]
-            },
-            "get": {
-                "description": "Returns a planet and all related game data.",
-                "parameters": [
+                "security": [
                     {
-                        "description": "Planet id (UUID)",
-                        "in": "path",
-                        "name": "id",
-                        "required": true,
-                        "schema": {
-                            "format": "uuid",
-                            "type": "string"
-                        }
+                        "BearerAuth": []
                     }
                 ],
+                "summary": "Create research action",
+                "tags": [
+                    "players"
+                ]
+            }
+        },
+        "/resources": {
+            "get": {
+                "description": "Returns the resources shared by all universes. Restricted to administrators.",
                 "responses": {
                     "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlanetDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_ResourceDtoResponse"
                                 }
                             }
                         },
                         "description": "OK"
                     },
-                    "400": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -974,9 +7227,9 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "Unauthorized"
                     },
-                    "404": {
+                    "403": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -984,7 +7237,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Not Found"
+                        "description": "Forbidden"
                     },
                     "500": {
                         "content": {
@@ -997,37 +7250,41 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Get planet",
-                "tags": [
-                    "planets"
-                ]
-            }
-        },
-        "/planets/{id}/actions": {
-            "delete": {
-                "description": "Deletes an existing building action for a planet.",
-                "parameters": [
+                "security": [
                     {
-                        "description": "Planet id (UUID)",
-                        "in": "path",
-                        "name": "id",
-                        "required": true,
-                        "schema": {
-                            "format": "uuid",
-                            "type": "string"
-                        }
+                        "BearerAuth": []
                     }
                 ],
+                "summary": "List resources",
+                "tags": [
+                    "game data"
+                ]
+            },
+            "post": {
+                "description": "Creates a resource available in all universes. Restricted to administrators.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "$ref": "#/components/schemas/dtos.ResourceDtoRequest",
+                                "summary": "request",
+                                "description": "Resource payload"
+                            }
+                        }
+                    },
+                    "description": "Resource payload",
+                    "required": true
+                },
                 "responses": {
-                    "204": {
+                    "201": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "type": "string"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_ResourceDtoResponse"
                                 }
                             }
                         },
-                        "description": "No Content"
+                        "description": "Created"
                     },
                     "400": {
                         "content": {
@@ -1039,7 +7296,7 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
-                    "404": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1047,7 +7304,27 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Not Found"
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
                     },
                     "500": {
                         "content": {
@@ -1060,16 +7337,23 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Delete building action for a planet",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Create resource",
                 "tags": [
-                    "planets"
+                    "game data"
                 ]
-            },
-            "post": {
-                "description": "Creates a building action for the planet provided in path parameter. The planet field in the body is ignored and replaced with this path value.",
+            }
+        },
+        "/resources/{id}": {
+            "patch": {
+                "description": "Replaces the starting values and build time of a resource. The resources some rules of the game depend on (e.g. energy) can not be renamed. Restricted to administrators.",
                 "parameters": [
                     {
-                        "description": "Planet id (UUID)",
+                        "description": "Resource id (UUID)",
                         "in": "path",
                         "name": "id",
                         "required": true,
@@ -1083,25 +7367,25 @@ const docTemplate = `{
                     "content": {
                         "application/json": {
                             "schema": {
-                                "$ref": "#/components/schemas/dtos.BuildingActionDtoRequest",
+                                "$ref": "#/components/schemas/dtos.ResourceDtoRequest",
                                 "summary": "request",
-                                "description": "Building action payload"
+                                "description": "Resource payload"
                             }
                         }
                     },
-                    "description": "Building action payload",
+                    "description": "Resource payload",
                     "required": true
                 },
                 "responses": {
-                    "201": {
+                    "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_BuildingActionDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_ResourceDtoResponse"
                                 }
                             }
                         },
-                        "description": "Created"
+                        "description": "OK"
                     },
                     "400": {
                         "content": {
@@ -1113,6 +7397,26 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
                     "404": {
                         "content": {
                             "application/json": {
@@ -1144,26 +7448,75 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Create building action",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Update resource",
                 "tags": [
-                    "planets"
+                    "game data"
                 ]
             }
         },
-        "/players": {
+        "/universes": {
+            "get": {
+                "description": "Returns all universes.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_UniverseDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List universes",
+                "tags": [
+                    "universes"
+                ]
+            },
             "post": {
-                "description": "Creates a player and its homeworld.",
+                "description": "Creates a universe. Restricted to administrators.",
                 "requestBody": {
                     "content": {
                         "application/json": {
                             "schema": {
-                                "$ref": "#/components/schemas/dtos.PlayerDtoRequest",
+                                "$ref": "#/components/schemas/dtos.UniverseDtoRequest",
                                 "summary": "request",
-                                "description": "Player payload"
+                                "description": "Universe payload"
                             }
                         }
                     },
-                    "description": "Player payload",
+                    "description": "Universe payload",
                     "required": true
                 },
                 "responses": {
@@ -1171,13 +7524,33 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlayerDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_UniverseDtoResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
                                 }
                             }
                         },
-                        "description": "Created"
+                        "description": "Unauthorized"
                     },
-                    "400": {
+                    "403": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1185,7 +7558,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "Forbidden"
                     },
                     "409": {
                         "content": {
@@ -1208,18 +7581,23 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Create player",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Create universe",
                 "tags": [
-                    "players"
+                    "universes"
                 ]
             }
         },
-        "/players/{id}": {
+        "/universes/{id}": {
             "delete": {
-                "description": "Deletes a player by id.",
+                "description": "Deletes a universe by id. Restricted to administrators.",
                 "parameters": [
                     {
-                        "description": "Player id (UUID)",
+                        "description": "Universe id (UUID)",
                         "in": "path",
                         "name": "id",
                         "required": true,
@@ -1250,7 +7628,7 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
-                    "500": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1258,40 +7636,9 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Internal Server Error"
-                    }
-                },
-                "summary": "Delete player",
-                "tags": [
-                    "players"
-                ]
-            },
-            "get": {
-                "description": "Returns a player by id.",
-                "parameters": [
-                    {
-                        "description": "Player id (UUID)",
-                        "in": "path",
-                        "name": "id",
-                        "required": true,
-                        "schema": {
-                            "format": "uuid",
-                            "type": "string"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "content": {
-                            "application/json": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_PlayerDtoResponse"
-                                }
-                            }
-                        },
-                        "description": "OK"
+                        "description": "Unauthorized"
                     },
-                    "400": {
+                    "403": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1299,9 +7646,9 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "Forbidden"
                     },
-                    "404": {
+                    "409": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1309,7 +7656,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Not Found"
+                        "description": "Conflict"
                     },
                     "500": {
                         "content": {
@@ -1322,18 +7669,21 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Get player",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Delete universe",
                 "tags": [
-                    "players"
+                    "universes"
                 ]
-            }
-        },
-        "/players/{id}/planets": {
+            },
             "get": {
-                "description": "Returns planets belonging to a player.",
+                "description": "Returns a universe and related resources/buildings.",
                 "parameters": [
                     {
-                        "description": "Player id (UUID)",
+                        "description": "Universe id (UUID)",
                         "in": "path",
                         "name": "id",
                         "required": true,
@@ -1348,7 +7698,7 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_PlanetDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_UniverseDtoResponse"
                                 }
                             }
                         },
@@ -1364,7 +7714,7 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
-                    "500": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1372,28 +7722,17 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Internal Server Error"
-                    }
-                },
-                "summary": "List planets",
-                "tags": [
-                    "players"
-                ]
-            }
-        },
-        "/universes": {
-            "get": {
-                "description": "Returns all universes.",
-                "responses": {
-                    "200": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_UniverseDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Not Found"
                     },
                     "500": {
                         "content": {
@@ -1406,36 +7745,60 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "List universes",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Get universe",
                 "tags": [
                     "universes"
                 ]
-            },
-            "post": {
-                "description": "Creates a universe.",
-                "requestBody": {
-                    "content": {
-                        "application/json": {
-                            "schema": {
-                                "$ref": "#/components/schemas/dtos.UniverseDtoRequest",
-                                "summary": "request",
-                                "description": "Universe payload"
-                            }
+            }
+        },
+        "/universes/{id}/galaxies/{galaxy}/systems/{system}": {
+            "get": {
+                "description": "Returns the planets orbiting in a solar system of a universe. Free orbits have no planet.",
+                "parameters": [
+                    {
+                        "description": "Universe id (UUID)",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "format": "uuid",
+                            "type": "string"
                         }
                     },
-                    "description": "Universe payload",
-                    "required": true
-                },
+                    {
+                        "description": "Galaxy index",
+                        "in": "path",
+                        "name": "galaxy",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Solar system index",
+                        "in": "path",
+                        "name": "system",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
                 "responses": {
-                    "201": {
+                    "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_UniverseDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_SolarSystemDtoResponse"
                                 }
                             }
                         },
-                        "description": "Created"
+                        "description": "OK"
                     },
                     "400": {
                         "content": {
@@ -1447,7 +7810,7 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
-                    "409": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1455,7 +7818,17 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Conflict"
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
                     },
                     "500": {
                         "content": {
@@ -1468,15 +7841,20 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Create universe",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Get solar system",
                 "tags": [
                     "universes"
                 ]
             }
         },
-        "/universes/{id}": {
-            "delete": {
-                "description": "Deletes a universe by id.",
+        "/universes/{id}/offers": {
+            "get": {
+                "description": "Returns the open offers of the universe provided in path parameter, most recent first.",
                 "parameters": [
                     {
                         "description": "Universe id (UUID)",
@@ -1487,18 +7865,39 @@ const docTemplate = `{
                             "format": "uuid",
                             "type": "string"
                         }
+                    },
+                    {
+                        "description": "Page index, starting at 0",
+                        "in": "query",
+                        "name": "page",
+                        "schema": {
+                            "default": 0,
+                            "minimum": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Page size",
+                        "in": "query",
+                        "name": "size",
+                        "schema": {
+                            "default": 20,
+                            "maximum": 100,
+                            "minimum": 1,
+                            "type": "integer"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
+                    "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "type": "string"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_MarketOfferDtoResponse"
                                 }
                             }
                         },
-                        "description": "No Content"
+                        "description": "OK"
                     },
                     "400": {
                         "content": {
@@ -1510,7 +7909,7 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
-                    "409": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1518,7 +7917,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Conflict"
+                        "description": "Unauthorized"
                     },
                     "500": {
                         "content": {
@@ -1531,13 +7930,20 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Delete universe",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List market offers",
                 "tags": [
-                    "universes"
+                    "market"
                 ]
-            },
+            }
+        },
+        "/universes/{id}/rankings": {
             "get": {
-                "description": "Returns a universe and related resources/buildings.",
+                "description": "Returns the players of a universe ordered by rank. Points are the resources spent on buildings and are refreshed periodically.",
                 "parameters": [
                     {
                         "description": "Universe id (UUID)",
@@ -1548,6 +7954,27 @@ const docTemplate = `{
                             "format": "uuid",
                             "type": "string"
                         }
+                    },
+                    {
+                        "description": "Page index, starting at 0",
+                        "in": "query",
+                        "name": "page",
+                        "schema": {
+                            "default": 0,
+                            "minimum": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Page size",
+                        "in": "query",
+                        "name": "size",
+                        "schema": {
+                            "default": 20,
+                            "maximum": 100,
+                            "minimum": 1,
+                            "type": "integer"
+                        }
                     }
                 ],
                 "responses": {
@@ -1555,7 +7982,7 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-dtos_UniverseDtoResponse"
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-array_dtos_RankingDtoResponse"
                                 }
                             }
                         },
@@ -1571,6 +7998,16 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
                     "404": {
                         "content": {
                             "application/json": {
@@ -1592,9 +8029,14 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "Get universe",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "List rankings",
                 "tags": [
-                    "universes"
+                    "rankings"
                 ]
             }
         },
@@ -1622,6 +8064,26 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/rest.ResponseEnvelope-string"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
                     "500": {
                         "content": {
                             "application/json": {
@@ -1633,11 +8095,62 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "summary": "List players belonging to a user",
                 "tags": [
                     "users"
                 ]
             }
+        },
+        "/ws": {
+            "get": {
+                "description": "Upgrades the connection to a WebSocket exchanging JSON frames with an ` + "`" + `id` + "`" + ` chosen by the client, a ` + "`" + `type` + "`" + ` and a ` + "`" + `payload` + "`" + `. Clients send ` + "`" + `subscribe` + "`" + ` and ` + "`" + `unsubscribe` + "`" + ` frames with a ` + "`" + `{scope, id}` + "`" + ` payload where the scope is a player, a planet or a universe, and ` + "`" + `create_building_action` + "`" + ` and ` + "`" + `cancel_building_action` + "`" + ` commands with the same payload as the matching routes plus the ` + "`" + `planet` + "`" + ` they act on, and the ` + "`" + `action` + "`" + ` to cancel. The gateway answers with ` + "`" + `subscribed` + "`" + `, ` + "`" + `unsubscribed` + "`" + `, ` + "`" + `building_action_created` + "`" + `, ` + "`" + `building_action_cancelled` + "`" + ` or ` + "`" + `error` + "`" + ` frames carrying the id of the request, and pushes ` + "`" + `planet_change` + "`" + ` frames for the subscriptions. Universe subscriptions report the planets created and deleted in the universe. Browsers can't set the Authorization header on a WebSocket: the token can also be passed in the ` + "`" + `access_token` + "`" + ` query parameter or cookie.",
+                "parameters": [
+                    {
+                        "description": "Token, when it can't be passed in the Authorization header",
+                        "in": "query",
+                        "name": "access_token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/dtos.GatewayFrameDto"
+                                }
+                            }
+                        },
+                        "description": "Switching Protocols"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "summary": "Open a WebSocket connection",
+                "tags": [
+                    "gateway"
+                ]
+            }
         }
     },
     "openapi": "3.1.0",
@@ -5,11 +5,16 @@ import (
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/db/postgresql"
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/server"
+	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
 )
 
 type Configuration struct {
-	Server   server.Config
-	Database postgresql.Config
+	Server    server.Config
+	Database  postgresql.Config
+	Scheduler drivingadapters.SchedulerConfig
+	Rankings  drivingadapters.RankingRefresherConfig
+	Auth      drivingadapters.AuthConfig
+	Ruleset   drivingadapters.RulesetConfig
 }
 
 func DefaultConfig() Configuration {
@@ -27,5 +32,15 @@ func DefaultConfig() Configuration {
 			defaultDatabaseUser,
 			"comes-from-the-environment",
 		),
+		Scheduler: drivingadapters.SchedulerConfig{
+			PollInterval: 10 * time.Second,
+			Concurrency:  4,
+		},
+		Rankings: drivingadapters.RankingRefresherConfig{
+			RefreshInterval: 5 * time.Minute,
+		},
+		Auth: drivingadapters.AuthConfig{
+			Algorithm: "HS256",
+		},
 	}
 }
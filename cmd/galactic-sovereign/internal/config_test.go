@@ -1,9 +1,16 @@
 package internal
 
 import (
+	"os"
 	"testing"
+	"time"
 
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
 )
 
 func TestUnit_DefaultConfig_DefinesCorrectRestConfiguration(t *testing.T) {
@@ -26,3 +33,48 @@ func TestUnit_DefaultConfig_DoesNotSetDbPassword(t *testing.T) {
 
 	assert.Equal(t, "comes-from-the-environment", config.Database.Password)
 }
+
+func TestUnit_DefaultConfig_DefinesSchedulerConfiguration(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.Equal(t, 10*time.Second, config.Scheduler.PollInterval)
+	assert.Equal(t, 4, config.Scheduler.Concurrency)
+}
+
+func TestUnit_DefaultConfig_DefinesRankingsConfiguration(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.Equal(t, 5*time.Minute, config.Rankings.RefreshInterval)
+}
+
+func TestUnit_DefaultConfig_DefinesAuthConfiguration(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.Equal(t, "HS256", config.Auth.Algorithm)
+	assert.Empty(t, config.Auth.Secret)
+	assert.Empty(t, config.Auth.PublicKey)
+	assert.Empty(t, config.Auth.Admins)
+}
+
+func TestUnit_DefaultConfig_DoesNotLoadRuleset(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.Empty(t, config.Ruleset.Path)
+}
+
+func TestUnit_DefaultRuleset_IsValid(t *testing.T) {
+	content, err := os.ReadFile("../configs/ruleset-default.yml")
+	require.NoError(t, err, "Actual err: %v", err)
+
+	var dto dtos.RulesetDto
+	err = yaml.UnmarshalStrict(content, &dto)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	ruleset := request.FromRulesetRequest(mappers.ToRulesetRequest(dto))
+	err = ruleset.Validate()
+	assert.NoError(t, err, "Actual err: %v", err)
+	assert.Len(t, ruleset.Resources, 4)
+	assert.Len(t, ruleset.Buildings, 9)
+	assert.Len(t, ruleset.FieldRanges, 15)
+	assert.Equal(t, 9, ruleset.MaxPlanetsPerPlayer)
+}
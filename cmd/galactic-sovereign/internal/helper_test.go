@@ -2,6 +2,9 @@ package internal
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,13 +16,15 @@ import (
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/server"
+	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	testServerHost = "localhost"
+	testServerHost  = "localhost"
+	testTokenSecret = "test-secret"
 )
 
 var (
@@ -78,18 +83,17 @@ func asyncStartServer(t *testing.T, s server.Server) {
 	time.Sleep(startupDelay)
 }
 
-func doGet[T any](t *testing.T, url string) T {
+func doGet[T any](t *testing.T, url string, token string) T {
 	t.Helper()
 
-	resp, err := http.Get(url)
-	require.NoError(t, err, "GET %s: %v", url, err)
+	resp := doRequest(t, http.MethodGet, url, token, nil)
 	defer resp.Body.Close() // nolint:errcheck
 	require.Equal(t, http.StatusOK, resp.StatusCode, "GET %s returned %d", url, resp.StatusCode)
 
 	return decodeResponseBody[T](t, resp.Body)
 }
 
-func doPost[T any](t *testing.T, url string, body any) T {
+func doPost[T any](t *testing.T, url string, token string, body any) T {
 	t.Helper()
 
 	var payload io.Reader
@@ -100,25 +104,70 @@ func doPost[T any](t *testing.T, url string, body any) T {
 		payload = bytes.NewReader(raw)
 	}
 
-	resp, err := http.Post(url, "application/json", payload) // nolint:noctx
-	require.NoError(t, err, "POST %s: %v", url, err)
+	resp := doRequest(t, http.MethodPost, url, token, payload)
 	defer resp.Body.Close() // nolint:errcheck
 	require.Equal(t, http.StatusCreated, resp.StatusCode, "POST %s returned %d", url, resp.StatusCode)
 
 	return decodeResponseBody[T](t, resp.Body)
 }
 
-func doDelete(t *testing.T, url string) {
+func doDelete(t *testing.T, url string, token string) {
 	t.Helper()
 
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	resp := doRequest(t, http.MethodDelete, url, token, nil)
+	defer resp.Body.Close() // nolint:errcheck
+	require.Equal(t, http.StatusNoContent, resp.StatusCode, "DELETE %s returned %d", url, resp.StatusCode)
+}
+
+func doRequest(t *testing.T, method string, url string, token string, body io.Reader) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, body) // nolint:noctx
 	require.NoError(t, err, "Actual err: %v", err)
 
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
 	client := &http.Client{}
 	resp, err := client.Do(req)
-	require.NoError(t, err, "DELETE %s: %v", url, err)
-	defer resp.Body.Close() // nolint:errcheck
-	require.Equal(t, http.StatusNoContent, resp.StatusCode, "DELETE %s returned %d", url, resp.StatusCode)
+	require.NoError(t, err, "%s %s: %v", method, url, err)
+
+	return resp
+}
+
+func newTestTokenVerifier(t *testing.T) *drivingadapters.TokenVerifier {
+	t.Helper()
+
+	conf := drivingadapters.AuthConfig{
+		Algorithm: "HS256",
+		Secret:    testTokenSecret,
+	}
+	verifier, err := drivingadapters.NewTokenVerifier(conf)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return verifier
+}
+
+// signTestToken signs a token valid for an hour for the api user.
+func signTestToken(t *testing.T, apiUser uuid.UUID) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	require.NoError(t, err, "Actual err: %v", err)
+	claims, err := json.Marshal(map[string]any{
+		"sub": apiUser,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err, "Actual err: %v", err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	mac := hmac.New(sha256.New, []byte(testTokenSecret))
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
 }
 
 func decodeResponseBody[T any](t *testing.T, body io.ReadCloser) T {
@@ -0,0 +1,15 @@
+package internal
+
+import (
+	"io"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
+)
+
+func CreatePlanetReconstructionCommand(
+	conn db.Connection,
+	out io.Writer,
+) *drivingadapters.PlanetReconstructionCommand {
+	return drivingadapters.NewPlanetReconstructionCommand(newPlanetReconstructionUseCase(conn), out)
+}
@@ -0,0 +1,21 @@
+package internal
+
+import (
+	"log/slog"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	drivenadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven"
+	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases"
+)
+
+func CreateRulesetLoader(
+	conf drivingadapters.RulesetConfig,
+	conn db.Connection,
+	log *slog.Logger,
+) *drivingadapters.RulesetLoader {
+	repo := drivenadapters.NewGameDataRepository(conn)
+	usecase := usecases.NewGameDataUseCase(repo)
+
+	return drivingadapters.NewRulesetLoader(conf, usecase, log)
+}
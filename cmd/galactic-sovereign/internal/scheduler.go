@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"log/slog"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	drivenadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven"
+	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases"
+)
+
+func CreateScheduler(
+	conf drivingadapters.SchedulerConfig,
+	conn db.Connection,
+	log *slog.Logger,
+) *drivingadapters.Scheduler {
+	actionRepo := drivenadapters.NewBuildingActionRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewCompleteActionsUseCase(actionRepo, planetMutator, clock)
+
+	return drivingadapters.NewScheduler(conf, usecase, log)
+}
+
+func CreateRankingRefresher(
+	conf drivingadapters.RankingRefresherConfig,
+	conn db.Connection,
+	log *slog.Logger,
+) *drivingadapters.RankingRefresher {
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+	rankingRepo := drivenadapters.NewRankingRepository(conn)
+
+	usecase := usecases.NewRankingUseCase(universeRepo, rankingRepo)
+
+	return drivingadapters.NewRankingRefresher(conf, usecase, log)
+}
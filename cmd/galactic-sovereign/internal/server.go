@@ -4,22 +4,72 @@ import (
 	"log/slog"
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/server"
 	drivenadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven"
 	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases"
+	"github.com/google/uuid"
 )
 
-func CreateGameServer(conf server.Config, conn db.Connection, log *slog.Logger) server.Server {
-	s := server.NewWithLogger(conf, log)
+func CreateGameServer(
+	conf server.Config,
+	verifier *drivingadapters.TokenVerifier,
+	admins []uuid.UUID,
+	conn db.Connection,
+	changes *drivenadapters.PlanetChangeBus,
+	log *slog.Logger,
+) server.Server {
+	out := server.NewWithLogger(conf, log)
+
+	ownerRepo := drivenadapters.NewOwnerRepository(conn)
+	s := &authorizingServer{
+		Server:   out,
+		verifier: verifier,
+		usecase:  usecases.NewAuthorizeRequestUseCase(ownerRepo, admins),
+	}
 
 	registerUniversesRoutes(conn, s, log)
 	registerPlayersRoutes(conn, s, log)
 	registerPlanetsRoutes(conn, s, log)
+	registerPlanetEventRoutes(conn, changes, s, log)
+	registerPlanetHistoryRoutes(conn, s, log)
+	registerPlanetReconstructionRoutes(conn, s, log)
+	registerGatewayRoutes(conn, changes, s.usecase, s, log)
+	registerColonizationRoutes(conn, s, log)
 	registerBuildingActionsRoutes(conn, s, log)
+	registerBuildingPreviewRoutes(conn, s, log)
+	registerShipyardRoutes(conn, s, log)
+	registerFleetRoutes(conn, s, log)
+	registerTradeRoutes(conn, s, log)
+	registerMarketOfferRoutes(conn, s, log)
+	registerResearchRoutes(conn, s, log)
+	registerRankingRoutes(conn, s, log)
+	registerMessageRoutes(conn, s, log)
+	registerAllianceRoutes(conn, s, log)
+	registerGameDataRoutes(conn, s, log)
 	registerHealthRoutes(conn, s, log)
 
-	return s
+	return out
+}
+
+// authorizingServer protects the routes added to the wrapped server so that
+// only the owner of the targeted resource can call them.
+type authorizingServer struct {
+	server.Server
+	verifier *drivingadapters.TokenVerifier
+	usecase  drivingports.ForAuthorizingRequest
+}
+
+func (s *authorizingServer) AddRoute(route rest.Route) error {
+	for _, authorized := range drivingadapters.AuthorizedEndpoints(rest.Routes{route}, s.verifier, s.usecase) {
+		if err := s.Server.AddRoute(authorized); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func registerUniversesRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
@@ -37,7 +87,9 @@ func registerPlayersRoutes(conn db.Connection, s server.Server, log *slog.Logger
 	playerRepo := drivenadapters.NewPlayerRepository(conn)
 	universeRepo := drivenadapters.NewUniverseRepository(conn)
 	planetRepo := drivenadapters.NewPlanetRepository(conn)
-	usecase := usecases.NewPlayerUseCase(playerRepo, universeRepo, planetRepo)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+	usecase := usecases.NewPlayerUseCase(playerRepo, universeRepo, planetRepo, planetMutator, clock)
 
 	for _, route := range drivingadapters.PlayerEndpoints(usecase) {
 		if err := s.AddRoute(route); err != nil {
@@ -60,6 +112,98 @@ func registerPlanetsRoutes(conn db.Connection, s server.Server, log *slog.Logger
 	}
 }
 
+func registerPlanetEventRoutes(
+	conn db.Connection,
+	changes *drivenadapters.PlanetChangeBus,
+	s server.Server,
+	log *slog.Logger,
+) {
+	playerRepo := drivenadapters.NewPlayerRepository(conn)
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+
+	usecase := usecases.NewWatchPlanetChangesUseCase(playerRepo, planetRepo, universeRepo, changes)
+
+	for _, route := range drivingadapters.PlanetEventEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerPlanetHistoryRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	eventRepo := drivenadapters.NewPlanetEventRepository(conn)
+
+	usecase := usecases.NewPlanetHistoryUseCase(planetRepo, eventRepo)
+
+	for _, route := range drivingadapters.PlanetHistoryEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerPlanetReconstructionRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	usecase := newPlanetReconstructionUseCase(conn)
+
+	for _, route := range drivingadapters.PlanetReconstructionEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+// newPlanetReconstructionUseCase is shared by the admin route and the
+// command line.
+func newPlanetReconstructionUseCase(conn db.Connection) *usecases.PlanetReconstructionUseCase {
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+	eventRepo := drivenadapters.NewPlanetEventRepository(conn)
+	technologyRepo := drivenadapters.NewTechnologyRepository(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	return usecases.NewPlanetReconstructionUseCase(planetRepo, universeRepo, eventRepo, technologyRepo, clock)
+}
+
+func registerGatewayRoutes(
+	conn db.Connection,
+	changes *drivenadapters.PlanetChangeBus,
+	authorizer drivingports.ForAuthorizingRequest,
+	s server.Server,
+	log *slog.Logger,
+) {
+	playerRepo := drivenadapters.NewPlayerRepository(conn)
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+	buildingRepo := drivenadapters.NewBuildingRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	watcher := usecases.NewWatchPlanetChangesUseCase(playerRepo, planetRepo, universeRepo, changes)
+	createUseCase := usecases.NewCreateBuildingActionUseCase(buildingRepo, planetMutator, clock)
+	queueUsecase := usecases.NewBuildingQueueUseCase(buildingRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.GatewayEndpoints(authorizer, watcher, createUseCase, queueUsecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerColonizationRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	playerRepo := drivenadapters.NewPlayerRepository(conn)
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+
+	usecase := usecases.NewColonizePlanetUseCase(playerRepo, universeRepo)
+
+	for _, route := range drivingadapters.ColonizationEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
 func registerBuildingActionsRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
 	buildingRepo := drivenadapters.NewBuildingRepository(conn)
 	planetMutator := drivenadapters.NewPlanetMutator(conn)
@@ -67,8 +211,151 @@ func registerBuildingActionsRoutes(conn db.Connection, s server.Server, log *slo
 
 	createUseCase := usecases.NewCreateBuildingActionUseCase(buildingRepo, planetMutator, clock)
 	deleteUsecase := usecases.NewDeleteBuildingActionUseCase(planetMutator, clock)
+	queueUsecase := usecases.NewBuildingQueueUseCase(buildingRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.BuildingActionEndpoints(createUseCase, deleteUsecase, queueUsecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerBuildingPreviewRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	buildingRepo := drivenadapters.NewBuildingRepository(conn)
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewBuildingPreviewUseCase(buildingRepo, planetRepo, clock)
+
+	for _, route := range drivingadapters.BuildingPreviewEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerShipyardRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	unitRepo := drivenadapters.NewShipyardRepository(conn)
+	gameDataRepo := drivenadapters.NewGameDataRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewCreateShipyardActionUseCase(unitRepo, gameDataRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.ShipyardEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerFleetRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	unitRepo := drivenadapters.NewShipyardRepository(conn)
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	fleetRepo := drivenadapters.NewFleetRepository(conn)
+	gameDataRepo := drivenadapters.NewGameDataRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	sendUseCase := usecases.NewSendFleetUseCase(unitRepo, planetRepo, fleetRepo, gameDataRepo, planetMutator, clock)
+	usecase := usecases.NewFleetUseCase(fleetRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.FleetEndpoints(sendUseCase, usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerTradeRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewTradeResourcesUseCase(universeRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.TradeEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerMarketOfferRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	offerRepo := drivenadapters.NewMarketOfferRepository(conn)
+	planetRepo := drivenadapters.NewPlanetRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewMarketOfferUseCase(offerRepo, planetRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.MarketOfferEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerResearchRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	technologyRepo := drivenadapters.NewTechnologyRepository(conn)
+	planetMutator := drivenadapters.NewPlanetMutator(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewResearchUseCase(technologyRepo, planetMutator, clock)
+
+	for _, route := range drivingadapters.ResearchEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerRankingRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	universeRepo := drivenadapters.NewUniverseRepository(conn)
+	rankingRepo := drivenadapters.NewRankingRepository(conn)
+
+	usecase := usecases.NewRankingUseCase(universeRepo, rankingRepo)
+
+	for _, route := range drivingadapters.RankingEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerMessageRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	messageRepo := drivenadapters.NewMessageRepository(conn)
+	playerRepo := drivenadapters.NewPlayerRepository(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewMessageUseCase(messageRepo, playerRepo, clock)
+
+	for _, route := range drivingadapters.MessageEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerAllianceRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	allianceRepo := drivenadapters.NewAllianceRepository(conn)
+	playerRepo := drivenadapters.NewPlayerRepository(conn)
+	clock := drivenadapters.NewTimeAdapter()
+
+	usecase := usecases.NewAllianceUseCase(allianceRepo, playerRepo, clock)
+
+	for _, route := range drivingadapters.AllianceEndpoints(usecase) {
+		if err := s.AddRoute(route); err != nil {
+			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
+		}
+	}
+}
+
+func registerGameDataRoutes(conn db.Connection, s server.Server, log *slog.Logger) {
+	repo := drivenadapters.NewGameDataRepository(conn)
+	usecase := usecases.NewGameDataUseCase(repo)
 
-	for _, route := range drivingadapters.BuildingActionEndpoints(createUseCase, deleteUsecase) {
+	for _, route := range drivingadapters.GameDataEndpoints(usecase) {
 		if err := s.AddRoute(route); err != nil {
 			log.Error("Failed to register route", slog.String("route", route.Path()), slog.Any("error", err))
 		}
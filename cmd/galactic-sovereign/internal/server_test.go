@@ -1,12 +1,19 @@
 package internal
 
 import (
+	"bufio"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"testing"
 
+	drivenadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	integrationdb "github.com/Knoblauchpilze/galactic-sovereign/pkg/testing/integrationdb"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,17 +24,20 @@ func TestIT_Server_PlayerBuildingActionLifecycle(t *testing.T) {
 	conn := dbContainer.NewTestConnection(t)
 	conf := newTestServerConfig()
 
-	s := CreateGameServer(conf, conn, slog.Default())
+	s := CreateGameServer(conf, newTestTokenVerifier(t), nil, conn, drivenadapters.NewPlanetChangeBus(), slog.Default())
 	asyncStartServer(t, s)
 
+	apiUser := uuid.New()
+	token := signTestToken(t, apiUser)
+
 	// Create a player
 	playerReq := dtos.PlayerDtoRequest{
-		ApiUser:  uuid.New(),
+		ApiUser:  apiUser,
 		Universe: oberonUniverseId,
 		Name:     "test-player",
 	}
 	player := doPost[dtos.PlayerDtoResponse](
-		t, urlFor(conf, "players"), playerReq,
+		t, urlFor(conf, "players"), token, playerReq,
 	)
 	assert.Equal(t, oberonUniverseId, player.Universe)
 	assert.Equal(t, "test-player", player.Name)
@@ -35,40 +45,48 @@ func TestIT_Server_PlayerBuildingActionLifecycle(t *testing.T) {
 
 	// Get the homeworld and assert basic properties
 	homeworld := doGet[dtos.PlanetDtoResponse](
-		t, urlFor(conf, "planets", player.Homeworld.String()),
+		t, urlFor(conf, "planets", player.Homeworld.String()), token,
 	)
 	assert.True(t, homeworld.Homeworld)
 	assert.Equal(t, "homeworld", homeworld.Name)
 	assert.Equal(t, player.Id, homeworld.Player)
 	assert.Len(t, homeworld.Resources, 3)
 	assert.Len(t, homeworld.Buildings, 7)
-	assert.Nil(t, homeworld.BuildingAction)
+	assert.Empty(t, homeworld.BuildingQueue)
+
+	// Preview the next levels of the building before upgrading it
+	previews := doGet[[]dtos.BuildingLevelPreviewDtoResponse](
+		t, urlFor(conf, "planets", homeworld.Id.String(), "buildings", metalMineId.String(), "preview?levels=3"), token,
+	)
+	require.Len(t, previews, 3)
+	assert.Equal(t, 1, previews[0].Level)
+	assert.True(t, previews[0].Affordable)
 
 	// Create a building action on the planet
 	actionReq := dtos.BuildingActionDtoRequest{
 		Building: metalMineId,
 	}
 	action := doPost[dtos.BuildingActionDtoResponse](
-		t, urlFor(conf, "planets", homeworld.Id.String(), "actions"), actionReq,
+		t, urlFor(conf, "planets", homeworld.Id.String(), "actions"), token, actionReq,
 	)
 	assert.Equal(t, metalMineId, action.Building)
+	assert.Equal(t, previews[0].Costs, action.Costs)
 	assert.Len(t, action.Costs, 2)
 	assert.Len(t, action.Productions, 1)
 	assert.Empty(t, action.Storages)
 
 	homeworld = doGet[dtos.PlanetDtoResponse](
-		t, urlFor(conf, "planets", player.Homeworld.String()),
+		t, urlFor(conf, "planets", player.Homeworld.String()), token,
 	)
-	require.NotNil(t, homeworld.BuildingAction)
-	assert.Equal(t, action, *homeworld.BuildingAction)
+	assert.Equal(t, []dtos.BuildingActionDtoResponse{action}, homeworld.BuildingQueue)
 
 	// Cancel the building action
-	doDelete(t, urlFor(conf, "planets", homeworld.Id.String(), "actions"))
+	doDelete(t, urlFor(conf, "planets", homeworld.Id.String(), "actions"), token)
 
 	homeworld = doGet[dtos.PlanetDtoResponse](
-		t, urlFor(conf, "planets", player.Homeworld.String()),
+		t, urlFor(conf, "planets", player.Homeworld.String()), token,
 	)
-	assert.Nil(t, homeworld.BuildingAction)
+	assert.Empty(t, homeworld.BuildingQueue)
 }
 
 func TestIT_Server_PlayerDeletionRemovesPlanetsAndAction(t *testing.T) {
@@ -76,47 +94,230 @@ func TestIT_Server_PlayerDeletionRemovesPlanetsAndAction(t *testing.T) {
 	conn := dbContainer.NewTestConnection(t)
 	conf := newTestServerConfig()
 
-	s := CreateGameServer(conf, conn, slog.Default())
+	s := CreateGameServer(conf, newTestTokenVerifier(t), nil, conn, drivenadapters.NewPlanetChangeBus(), slog.Default())
 	asyncStartServer(t, s)
 
+	apiUser := uuid.New()
+	token := signTestToken(t, apiUser)
+
 	// Create a player
 	playerReq := dtos.PlayerDtoRequest{
-		ApiUser:  uuid.New(),
+		ApiUser:  apiUser,
 		Universe: oberonUniverseId,
 		Name:     "test-player-b",
 	}
 	player := doPost[dtos.PlayerDtoResponse](
-		t, urlFor(conf, "players"), playerReq,
+		t, urlFor(conf, "players"), token, playerReq,
 	)
 
 	// Create a building action
 	actionReq := dtos.BuildingActionDtoRequest{Building: metalMineId}
 	action := doPost[dtos.BuildingActionDtoResponse](
-		t, urlFor(conf, "planets", player.Homeworld.String(), "actions"), actionReq,
+		t, urlFor(conf, "planets", player.Homeworld.String(), "actions"), token, actionReq,
 	)
 
 	homeworld := doGet[dtos.PlanetDtoResponse](
-		t, urlFor(conf, "planets", player.Homeworld.String()),
+		t, urlFor(conf, "planets", player.Homeworld.String()), token,
 	)
 
 	assert.Equal(t, player.Id, homeworld.Player)
 	assert.True(t, homeworld.Homeworld)
-	require.NotNil(t, homeworld.BuildingAction)
-	assert.Equal(t, action.Id, homeworld.BuildingAction.Id)
-	assert.Equal(t, metalMineId, homeworld.BuildingAction.Building)
-	assert.Equal(t, 1, homeworld.BuildingAction.DesiredLevel)
+	require.Len(t, homeworld.BuildingQueue, 1)
+	assert.Equal(t, action.Id, homeworld.BuildingQueue[0].Id)
+	assert.Equal(t, metalMineId, homeworld.BuildingQueue[0].Building)
+	assert.Equal(t, 1, homeworld.BuildingQueue[0].DesiredLevel)
 
 	// Delete the player
-	doDelete(t, urlFor(conf, "players", player.Id.String()))
+	doDelete(t, urlFor(conf, "players", player.Id.String()), token)
+
+	assertGetStatus(t, urlFor(conf, "planets", homeworld.Id.String()), token, http.StatusNotFound)
+	assertGetStatus(t, urlFor(conf, "players", player.Id.String()), token, http.StatusNotFound)
+}
+
+func TestIT_Server_RejectsRequestsNotFromOwner(t *testing.T) {
+	dbContainer := integrationdb.NewDatabaseSharedContainer(t)
+	conn := dbContainer.NewTestConnection(t)
+	conf := newTestServerConfig()
+
+	s := CreateGameServer(conf, newTestTokenVerifier(t), nil, conn, drivenadapters.NewPlanetChangeBus(), slog.Default())
+	asyncStartServer(t, s)
+
+	apiUser := uuid.New()
+	token := signTestToken(t, apiUser)
+	otherToken := signTestToken(t, uuid.New())
+
+	playerReq := dtos.PlayerDtoRequest{
+		ApiUser:  apiUser,
+		Universe: oberonUniverseId,
+		Name:     "test-player-c",
+	}
+	player := doPost[dtos.PlayerDtoResponse](
+		t, urlFor(conf, "players"), token, playerReq,
+	)
+
+	assertGetStatus(t, urlFor(conf, "healthcheck"), "", http.StatusOK)
+	assertGetStatus(t, urlFor(conf, "planets", player.Homeworld.String()), "", http.StatusUnauthorized)
+	assertGetStatus(t, urlFor(conf, "planets", player.Homeworld.String()), "not-a-token", http.StatusUnauthorized)
+	assertGetStatus(t, urlFor(conf, "planets", player.Homeworld.String()), otherToken, http.StatusForbidden)
+	assertGetStatus(t, urlFor(conf, "players", player.Id.String()), otherToken, http.StatusForbidden)
+	assertGetStatus(t, urlFor(conf, "universes"), otherToken, http.StatusOK)
+}
+
+func TestIT_Server_RestrictsGameDataToAdmins(t *testing.T) {
+	dbContainer := integrationdb.NewDatabaseSharedContainer(t)
+	conn := dbContainer.NewTestConnection(t)
+	conf := newTestServerConfig()
+
+	admin := uuid.New()
+	s := CreateGameServer(conf, newTestTokenVerifier(t), []uuid.UUID{admin}, conn, drivenadapters.NewPlanetChangeBus(), slog.Default())
+	asyncStartServer(t, s)
+
+	adminToken := signTestToken(t, admin)
+	otherToken := signTestToken(t, uuid.New())
+
+	resources := doGet[[]dtos.ResourceDtoResponse](t, urlFor(conf, "resources"), adminToken)
+	assert.Len(t, resources, 3)
+	buildings := doGet[[]dtos.BuildingDtoResponse](t, urlFor(conf, "buildings"), adminToken)
+	assert.Len(t, buildings, 7)
+
+	assertGetStatus(t, urlFor(conf, "resources"), otherToken, http.StatusForbidden)
+	assertGetStatus(t, urlFor(conf, "buildings"), otherToken, http.StatusForbidden)
+}
+
+func TestIT_Server_ReconstructsPlanetForAdmins(t *testing.T) {
+	dbContainer := integrationdb.NewDatabaseSharedContainer(t)
+	conn := dbContainer.NewTestConnection(t)
+	conf := newTestServerConfig()
+
+	admin := uuid.New()
+	s := CreateGameServer(conf, newTestTokenVerifier(t), []uuid.UUID{admin}, conn, drivenadapters.NewPlanetChangeBus(), slog.Default())
+	asyncStartServer(t, s)
+
+	apiUser := uuid.New()
+	token := signTestToken(t, apiUser)
+	adminToken := signTestToken(t, admin)
+
+	playerReq := dtos.PlayerDtoRequest{
+		ApiUser:  apiUser,
+		Universe: oberonUniverseId,
+		Name:     "audited-player",
+	}
+	player := doPost[dtos.PlayerDtoResponse](t, urlFor(conf, "players"), token, playerReq)
+
+	actionReq := dtos.BuildingActionDtoRequest{
+		Building: metalMineId,
+	}
+	doPost[dtos.BuildingActionDtoResponse](
+		t, urlFor(conf, "planets", player.Homeworld.String(), "actions"), token, actionReq,
+	)
+
+	history := doGet[[]dtos.PlanetEventDtoResponse](
+		t, urlFor(conf, "planets", player.Homeworld.String(), "history"), token,
+	)
+	require.NotEmpty(t, history)
+	assert.Equal(t, string(models.PlanetActionCreated), history[0].Kind)
+
+	reconstruction := doGet[dtos.PlanetReconstructionDtoResponse](
+		t, urlFor(conf, "planets", player.Homeworld.String(), "reconstruction"), adminToken,
+	)
+	assert.Equal(t, player.Homeworld, reconstruction.Planet)
+	assert.Equal(t, len(history), reconstruction.Events)
+	assert.Empty(t, reconstruction.Divergences)
+
+	assertGetStatus(t, urlFor(conf, "planets", player.Homeworld.String(), "reconstruction"), token, http.StatusForbidden)
+}
+
+func TestIT_Server_StreamsPlanetChanges(t *testing.T) {
+	dbContainer := integrationdb.NewDatabaseSharedContainer(t)
+	conn := dbContainer.NewTestConnection(t)
+	conf := newTestServerConfig()
+
+	changes := drivenadapters.NewPlanetChangeBus()
+	s := CreateGameServer(conf, newTestTokenVerifier(t), nil, conn, changes, slog.Default())
+	asyncStartServer(t, s)
+
+	apiUser := uuid.New()
+	token := signTestToken(t, apiUser)
+
+	playerReq := dtos.PlayerDtoRequest{
+		ApiUser:  apiUser,
+		Universe: oberonUniverseId,
+		Name:     "test-player-d",
+	}
+	player := doPost[dtos.PlayerDtoResponse](
+		t, urlFor(conf, "players"), token, playerReq,
+	)
+
+	// The subscription is registered before the headers are sent.
+	resp := doRequest(t, http.MethodGet, urlFor(conf, "players", player.Id.String(), "events"), token, nil)
+	defer resp.Body.Close() // nolint:errcheck
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	changes.Publish(models.PlanetChange{Planet: player.Homeworld, Player: player.Id, Version: 3})
+
+	reader := bufio.NewReader(resp.Body)
+	event, err := reader.ReadString('\n')
+	require.NoError(t, err, "Actual err: %v", err)
+	assert.Equal(t, "event: planet\n", event)
+	data, err := reader.ReadString('\n')
+	require.NoError(t, err, "Actual err: %v", err)
+	expected := fmt.Sprintf(`data: {"planet":"%s","version":3,"deleted":false}`+"\n", player.Homeworld)
+	assert.Equal(t, expected, data)
+}
+
+func TestIT_Server_GatewayForwardsPlanetChanges(t *testing.T) {
+	dbContainer := integrationdb.NewDatabaseSharedContainer(t)
+	conn := dbContainer.NewTestConnection(t)
+	conf := newTestServerConfig()
+
+	changes := drivenadapters.NewPlanetChangeBus()
+	s := CreateGameServer(conf, newTestTokenVerifier(t), nil, conn, changes, slog.Default())
+	asyncStartServer(t, s)
+
+	apiUser := uuid.New()
+	token := signTestToken(t, apiUser)
+
+	playerReq := dtos.PlayerDtoRequest{
+		ApiUser:  apiUser,
+		Universe: oberonUniverseId,
+		Name:     "test-player-e",
+	}
+	player := doPost[dtos.PlayerDtoResponse](
+		t, urlFor(conf, "players"), token, playerReq,
+	)
+
+	opts := &websocket.DialOptions{
+		HTTPHeader: http.Header{"Authorization": []string{"Bearer " + token}},
+	}
+	ws, _, err := websocket.Dial(t.Context(), urlFor(conf, "ws"), opts)
+	require.NoError(t, err, "Actual err: %v", err)
+	defer ws.CloseNow() // nolint:errcheck
+
+	payload, err := json.Marshal(dtos.GatewaySubscriptionDto{Scope: "planet", Id: player.Homeworld})
+	require.NoError(t, err, "Actual err: %v", err)
+	subscribe := dtos.GatewayFrameDto{Id: "1", Type: "subscribe", Payload: payload}
+	err = wsjson.Write(t.Context(), ws, subscribe)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	// The subscription is registered before it is acknowledged.
+	var frame dtos.GatewayFrameDto
+	err = wsjson.Read(t.Context(), ws, &frame)
+	require.NoError(t, err, "Actual err: %v", err)
+	assert.Equal(t, "subscribed", frame.Type)
+
+	changes.Publish(models.PlanetChange{Planet: player.Homeworld, Player: player.Id, Version: 3})
 
-	assertGetStatus(t, urlFor(conf, "planets", homeworld.Id.String()), http.StatusNotFound)
-	assertGetStatus(t, urlFor(conf, "players", player.Id.String()), http.StatusNotFound)
+	err = wsjson.Read(t.Context(), ws, &frame)
+	require.NoError(t, err, "Actual err: %v", err)
+	assert.Equal(t, "planet_change", frame.Type)
+	expected := fmt.Sprintf(`{"planet":"%s","version":3,"deleted":false}`, player.Homeworld)
+	assert.JSONEq(t, expected, string(frame.Payload))
 }
 
-func assertGetStatus(t *testing.T, url string, expectedStatus int) {
+func assertGetStatus(t *testing.T, url string, token string, expectedStatus int) {
 	t.Helper()
 
-	resp, err := http.Get(url)
-	require.NoError(t, err, "GET %s: %v", url, err)
+	resp := doRequest(t, http.MethodGet, url, token, nil)
+	defer resp.Body.Close() // nolint:errcheck
 	require.Equal(t, expectedStatus, resp.StatusCode, "GET %s returned %d", url, resp.StatusCode)
 }
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -13,25 +15,85 @@ import (
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
 	_ "github.com/Knoblauchpilze/galactic-sovereign/api"
 	"github.com/Knoblauchpilze/galactic-sovereign/cmd/galactic-sovereign/internal"
+	drivenadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven"
+	drivingadapters "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving"
 	echoSwagger "github.com/swaggo/echo-swagger/v2"
 )
 
+const defaultConfigName = "galactic-sovereign-prod.yml"
+
 func determineConfigName() string {
 	if len(os.Args) < 2 {
-		return "galactic-sovereign-prod.yml"
+		return defaultConfigName
 	}
 
 	return os.Args[1]
 }
 
+// reconstructCommand rebuilds a planet from its event log instead of
+// starting the server, for example:
+// galactic-sovereign reconstruct -config galactic-sovereign-dev <planet> [<moment>]
+const reconstructCommand = "reconstruct"
+
+// reconstructLimits lists what the events do not allow to rebuild.
+const reconstructLimits = `
+The planet is rebuilt from the current game data of its universe: changing
+the resources and buildings since the planet was created can lead to
+divergences. The following are not replayed:
+  - fleets and market offers: only their effect on the resources is taken
+    from the events, the ships are neither rebuilt nor compared,
+  - the fields of the planet, which are the ones of a new planet.
+
+`
+
+func reconstruct(args []string) error {
+	flags := flag.NewFlagSet(reconstructCommand, flag.ExitOnError)
+	configName := flags.String("config", defaultConfigName, "name of the configuration to load")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s %s [-config <name>] <planet> [<moment>]\n", os.Args[0], reconstructCommand)
+		fmt.Fprint(flags.Output(), reconstructLimits)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	conf, err := config.Load(*configName, internal.DefaultConfig())
+	if err != nil {
+		return err
+	}
+
+	conn, err := db.New(context.Background(), conf.Database)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	command := internal.CreatePlanetReconstructionCommand(conn, os.Stdout)
+	return command.Run(context.Background(), flags.Args())
+}
+
 // @title			Galactic Sovereign API
 // @version		1.0
 // @description	REST API for the Galactic Sovereign backend service.
 // @servers.url /v1/galactic-sovereign
 // @servers.description Base path for the galactic-sovereign API
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Signed token (JWT) of the api user, prefixed with "Bearer ".
 func main() {
 	log := logger.New(os.Stdout)
 
+	if len(os.Args) > 1 && os.Args[1] == reconstructCommand {
+		if err := reconstruct(os.Args[2:]); err != nil {
+			log.Error("Failed to reconstruct planet", slog.Any("error", err))
+			os.Exit(1)
+		}
+		return
+	}
+
 	conf, err := config.Load(determineConfigName(), internal.DefaultConfig())
 	if err != nil {
 		log.Error("Failed to load configuration", slog.Any("error", err))
@@ -45,7 +107,20 @@ func main() {
 	}
 	defer conn.Close(context.Background())
 
-	s := internal.CreateGameServer(conf.Server, conn, log)
+	loader := internal.CreateRulesetLoader(conf.Ruleset, conn, log)
+	if err := loader.Load(context.Background()); err != nil {
+		log.Error("Failed to load ruleset", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	verifier, err := drivingadapters.NewTokenVerifier(conf.Auth)
+	if err != nil {
+		log.Error("Failed to create token verifier", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	changes := drivenadapters.NewPlanetChangeBus()
+	s := internal.CreateGameServer(conf.Server, verifier, conf.Auth.Admins, conn, changes, log)
 
 	swaggerUi := rest.NewRawRoute(http.MethodGet, "/swagger/*", echoSwagger.WrapHandlerV3)
 	if err := s.AddRoute(swaggerUi); err != nil {
@@ -53,15 +128,58 @@ func main() {
 		os.Exit(1)
 	}
 
-	wait, err := process.StartWithSignalHandler(context.Background(), s)
+	// The background processes are stopped along with the server, including
+	// when the server fails on its own.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheduler := internal.CreateScheduler(conf.Scheduler, conn, log)
+	waitScheduler, err := process.StartWithSignalHandler(ctx, scheduler)
 	if err != nil {
-		log.Error("Failed to start server", slog.Any("error", err))
+		log.Error("Failed to start scheduler", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	refresher := internal.CreateRankingRefresher(conf.Rankings, conn, log)
+	waitRefresher, err := process.StartWithSignalHandler(ctx, refresher)
+	if err != nil {
+		log.Error("Failed to start ranking refresher", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	listener := drivenadapters.NewPlanetChangeListener(conf.Database, changes, log)
+	waitListener, err := process.StartWithSignalHandler(ctx, listener)
+	if err != nil {
+		log.Error("Failed to start planet change listener", slog.Any("error", err))
 		os.Exit(1)
 	}
 
-	err = wait()
+	wait, err := process.StartWithSignalHandler(ctx, s)
 	if err != nil {
-		log.Error("Error while serving", slog.Any("error", err))
+		log.Error("Failed to start server", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	serverErr := wait()
+	cancel()
+	schedulerErr := waitScheduler()
+	refresherErr := waitRefresher()
+	listenerErr := waitListener()
+
+	if serverErr != nil {
+		log.Error("Error while serving", slog.Any("error", serverErr))
+		os.Exit(1)
+	}
+	if schedulerErr != nil {
+		log.Error("Error while scheduling actions", slog.Any("error", schedulerErr))
+		os.Exit(1)
+	}
+	if refresherErr != nil {
+		log.Error("Error while refreshing rankings", slog.Any("error", refresherErr))
+		os.Exit(1)
+	}
+	if listenerErr != nil {
+		log.Error("Error while listening to planet changes", slog.Any("error", listenerErr))
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,12 @@
+
+DROP TRIGGER trigger_defense_updated_at ON defense;
+DROP TRIGGER trigger_ship_updated_at ON ship;
+
+DROP TABLE shipyard_action_cost;
+DROP TABLE shipyard_action;
+DROP TABLE planet_defense;
+DROP TABLE planet_ship;
+DROP TABLE defense_cost;
+DROP TABLE defense;
+DROP TABLE ship_cost;
+DROP TABLE ship;
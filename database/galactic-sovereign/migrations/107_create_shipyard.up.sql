@@ -0,0 +1,96 @@
+
+CREATE TABLE ship(
+  id UUID NOT NULL,
+  name text NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE (name)
+);
+
+CREATE TRIGGER trigger_ship_updated_at
+  BEFORE UPDATE OR INSERT ON ship
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE ship_cost(
+  ship UUID NOT NULL,
+  resource UUID NOT NULL,
+  cost INTEGER NOT NULL,
+  FOREIGN KEY (ship) REFERENCES ship(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (ship, resource)
+);
+
+CREATE TABLE defense(
+  id UUID NOT NULL,
+  name text NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE (name)
+);
+
+CREATE TRIGGER trigger_defense_updated_at
+  BEFORE UPDATE OR INSERT ON defense
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE defense_cost(
+  defense UUID NOT NULL,
+  resource UUID NOT NULL,
+  cost INTEGER NOT NULL,
+  FOREIGN KEY (defense) REFERENCES defense(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (defense, resource)
+);
+
+CREATE TABLE planet_ship(
+  planet UUID NOT NULL,
+  ship UUID NOT NULL,
+  count INTEGER NOT NULL,
+  FOREIGN KEY (planet) REFERENCES planet(id),
+  FOREIGN KEY (ship) REFERENCES ship(id),
+  UNIQUE (planet, ship)
+);
+
+CREATE INDEX planet_ship_planet_index ON planet_ship(planet);
+
+CREATE TABLE planet_defense(
+  planet UUID NOT NULL,
+  defense UUID NOT NULL,
+  count INTEGER NOT NULL,
+  FOREIGN KEY (planet) REFERENCES planet(id),
+  FOREIGN KEY (defense) REFERENCES defense(id),
+  UNIQUE (planet, defense)
+);
+
+CREATE INDEX planet_defense_planet_index ON planet_defense(planet);
+
+CREATE TABLE shipyard_action(
+  id UUID NOT NULL,
+  planet UUID NOT NULL,
+  ship UUID,
+  defense UUID,
+  count INTEGER NOT NULL,
+  unit_build_time_ms BIGINT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  completed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (planet) REFERENCES planet(id),
+  FOREIGN KEY (ship) REFERENCES ship(id),
+  FOREIGN KEY (defense) REFERENCES defense(id),
+  -- Each batch produces either ships or defenses
+  CHECK ((ship IS NULL) <> (defense IS NULL))
+);
+
+CREATE INDEX shipyard_action_planet_index ON shipyard_action(planet);
+
+CREATE TABLE shipyard_action_cost(
+  action UUID NOT NULL,
+  resource UUID NOT NULL,
+  amount INTEGER NOT NULL,
+  FOREIGN KEY (action) REFERENCES shipyard_action(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (action, resource)
+);
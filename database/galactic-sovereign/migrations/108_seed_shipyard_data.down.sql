@@ -0,0 +1,10 @@
+
+DELETE FROM shipyard_action_cost;
+DELETE FROM shipyard_action;
+DELETE FROM planet_defense;
+DELETE FROM planet_ship;
+
+DELETE FROM defense_cost;
+DELETE FROM defense;
+DELETE FROM ship_cost;
+DELETE FROM ship;
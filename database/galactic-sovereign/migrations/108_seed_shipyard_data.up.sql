@@ -0,0 +1,145 @@
+
+-- Ships
+-- https://ogame.fandom.com/wiki/Ships
+-- small cargo
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('4f9dbb8d-3302-46f2-a284-915d965975f5', 'small cargo');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('4f9dbb8d-3302-46f2-a284-915d965975f5', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 2000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('4f9dbb8d-3302-46f2-a284-915d965975f5', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 2000);
+
+-- large cargo
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('6ac1e7e8-390f-42c1-9fba-0cade98481ce', 'large cargo');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('6ac1e7e8-390f-42c1-9fba-0cade98481ce', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 6000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('6ac1e7e8-390f-42c1-9fba-0cade98481ce', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 6000);
+
+-- light fighter
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('113090a1-e18f-4222-be89-7d4b8a1d35cd', 'light fighter');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('113090a1-e18f-4222-be89-7d4b8a1d35cd', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 3000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('113090a1-e18f-4222-be89-7d4b8a1d35cd', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 1000);
+
+-- heavy fighter
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('97872647-4ab0-4cf4-8aee-dbb6b92c1108', 'heavy fighter');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('97872647-4ab0-4cf4-8aee-dbb6b92c1108', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 6000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('97872647-4ab0-4cf4-8aee-dbb6b92c1108', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 4000);
+
+-- cruiser
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('d0a3329f-7158-4dd9-9f60-86d455e866eb', 'cruiser');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('d0a3329f-7158-4dd9-9f60-86d455e866eb', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 20000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('d0a3329f-7158-4dd9-9f60-86d455e866eb', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 7000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('d0a3329f-7158-4dd9-9f60-86d455e866eb', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 2000);
+
+-- battleship
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('8bbfff1f-acb8-4e5f-81f3-ee6041047211', 'battleship');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('8bbfff1f-acb8-4e5f-81f3-ee6041047211', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 45000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('8bbfff1f-acb8-4e5f-81f3-ee6041047211', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 15000);
+
+-- colony ship
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('c61c5e7b-492f-4b08-ae2a-1486fdcb20bb', 'colony ship');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('c61c5e7b-492f-4b08-ae2a-1486fdcb20bb', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 10000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('c61c5e7b-492f-4b08-ae2a-1486fdcb20bb', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 20000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('c61c5e7b-492f-4b08-ae2a-1486fdcb20bb', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 10000);
+
+-- espionage probe
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('97ac1b33-015b-4f36-9bf4-ef18e6ec2f35', 'espionage probe');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('97ac1b33-015b-4f36-9bf4-ef18e6ec2f35', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 1000);
+
+-- recycler
+INSERT INTO galactic_sovereign_schema.ship("id", "name")
+  VALUES ('e342b364-22fe-41a0-b0e6-8e4c79446fc8', 'recycler');
+
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('e342b364-22fe-41a0-b0e6-8e4c79446fc8', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 10000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('e342b364-22fe-41a0-b0e6-8e4c79446fc8', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 6000);
+INSERT INTO galactic_sovereign_schema.ship_cost("ship", "resource", "cost")
+  VALUES ('e342b364-22fe-41a0-b0e6-8e4c79446fc8', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 2000);
+
+-- Defenses
+-- https://ogame.fandom.com/wiki/Defense
+-- rocket launcher
+INSERT INTO galactic_sovereign_schema.defense("id", "name")
+  VALUES ('0b6ee6ee-59e3-41e9-90b0-5deacfd19320', 'rocket launcher');
+
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('0b6ee6ee-59e3-41e9-90b0-5deacfd19320', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 2000);
+
+-- light laser
+INSERT INTO galactic_sovereign_schema.defense("id", "name")
+  VALUES ('ab12f91e-e55f-4313-8e13-18c5dd710c8c', 'light laser');
+
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('ab12f91e-e55f-4313-8e13-18c5dd710c8c', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 1500);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('ab12f91e-e55f-4313-8e13-18c5dd710c8c', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 500);
+
+-- heavy laser
+INSERT INTO galactic_sovereign_schema.defense("id", "name")
+  VALUES ('7d0f235a-01bc-4129-a61c-2fd53b794910', 'heavy laser');
+
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('7d0f235a-01bc-4129-a61c-2fd53b794910', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 6000);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('7d0f235a-01bc-4129-a61c-2fd53b794910', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 2000);
+
+-- gauss cannon
+INSERT INTO galactic_sovereign_schema.defense("id", "name")
+  VALUES ('d8dd4c88-364d-4dbf-9e54-45ad03569afd', 'gauss cannon');
+
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('d8dd4c88-364d-4dbf-9e54-45ad03569afd', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 20000);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('d8dd4c88-364d-4dbf-9e54-45ad03569afd', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 15000);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('d8dd4c88-364d-4dbf-9e54-45ad03569afd', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 2000);
+
+-- ion cannon
+INSERT INTO galactic_sovereign_schema.defense("id", "name")
+  VALUES ('5a7517b8-1909-4693-9b81-67b672c212d8', 'ion cannon');
+
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('5a7517b8-1909-4693-9b81-67b672c212d8', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 5000);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('5a7517b8-1909-4693-9b81-67b672c212d8', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 3000);
+
+-- plasma turret
+INSERT INTO galactic_sovereign_schema.defense("id", "name")
+  VALUES ('f9778a40-1b13-4494-8783-d0365d675797', 'plasma turret');
+
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('f9778a40-1b13-4494-8783-d0365d675797', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 50000);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('f9778a40-1b13-4494-8783-d0365d675797', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 50000);
+INSERT INTO galactic_sovereign_schema.defense_cost("defense", "resource", "cost")
+  VALUES ('f9778a40-1b13-4494-8783-d0365d675797', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 30000);
@@ -0,0 +1,9 @@
+
+DROP TRIGGER trigger_fleet_updated_at ON fleet;
+
+DROP TABLE fleet_cargo;
+DROP TABLE fleet_ship;
+DROP TABLE fleet;
+
+ALTER TABLE ship DROP COLUMN cargo_capacity;
+ALTER TABLE ship DROP COLUMN speed;
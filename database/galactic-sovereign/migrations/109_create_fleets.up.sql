@@ -0,0 +1,48 @@
+
+ALTER TABLE ship ADD COLUMN speed INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ship ADD COLUMN cargo_capacity INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE fleet(
+  id UUID NOT NULL,
+  player UUID NOT NULL,
+  mission TEXT NOT NULL,
+  origin UUID NOT NULL,
+  target UUID NOT NULL,
+  departed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  arrives_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  returns_at TIMESTAMP WITH TIME ZONE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  version INTEGER DEFAULT 0,
+  PRIMARY KEY (id),
+  FOREIGN KEY (player) REFERENCES player(id),
+  FOREIGN KEY (origin) REFERENCES planet(id),
+  FOREIGN KEY (target) REFERENCES planet(id)
+);
+
+CREATE TRIGGER trigger_fleet_updated_at
+  BEFORE UPDATE OR INSERT ON fleet
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX fleet_player_index ON fleet(player);
+CREATE INDEX fleet_origin_index ON fleet(origin);
+CREATE INDEX fleet_target_index ON fleet(target);
+
+CREATE TABLE fleet_ship(
+  fleet UUID NOT NULL,
+  ship UUID NOT NULL,
+  count INTEGER NOT NULL,
+  FOREIGN KEY (fleet) REFERENCES fleet(id),
+  FOREIGN KEY (ship) REFERENCES ship(id),
+  UNIQUE (fleet, ship)
+);
+
+CREATE TABLE fleet_cargo(
+  fleet UUID NOT NULL,
+  resource UUID NOT NULL,
+  amount INTEGER NOT NULL,
+  FOREIGN KEY (fleet) REFERENCES fleet(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (fleet, resource)
+);
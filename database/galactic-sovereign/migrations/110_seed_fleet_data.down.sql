@@ -0,0 +1,6 @@
+
+DELETE FROM fleet_cargo;
+DELETE FROM fleet_ship;
+DELETE FROM fleet;
+
+UPDATE ship SET speed = 0, cargo_capacity = 0;
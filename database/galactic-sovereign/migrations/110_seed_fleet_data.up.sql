@@ -0,0 +1,47 @@
+
+-- Ships movement
+-- https://ogame.fandom.com/wiki/Ships
+-- small cargo
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 5000, cargo_capacity = 5000
+  WHERE id = '4f9dbb8d-3302-46f2-a284-915d965975f5';
+
+-- large cargo
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 7500, cargo_capacity = 25000
+  WHERE id = '6ac1e7e8-390f-42c1-9fba-0cade98481ce';
+
+-- light fighter
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 12500, cargo_capacity = 50
+  WHERE id = '113090a1-e18f-4222-be89-7d4b8a1d35cd';
+
+-- heavy fighter
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 10000, cargo_capacity = 100
+  WHERE id = '97872647-4ab0-4cf4-8aee-dbb6b92c1108';
+
+-- cruiser
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 15000, cargo_capacity = 800
+  WHERE id = 'd0a3329f-7158-4dd9-9f60-86d455e866eb';
+
+-- battleship
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 10000, cargo_capacity = 1500
+  WHERE id = '8bbfff1f-acb8-4e5f-81f3-ee6041047211';
+
+-- colony ship
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 2500, cargo_capacity = 7500
+  WHERE id = 'c61c5e7b-492f-4b08-ae2a-1486fdcb20bb';
+
+-- espionage probe
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 100000000, cargo_capacity = 0
+  WHERE id = '97ac1b33-015b-4f36-9bf4-ef18e6ec2f35';
+
+-- recycler
+UPDATE galactic_sovereign_schema.ship
+  SET speed = 2000, cargo_capacity = 20000
+  WHERE id = 'e342b364-22fe-41a0-b0e6-8e4c79446fc8';
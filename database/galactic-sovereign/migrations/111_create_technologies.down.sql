@@ -0,0 +1,9 @@
+
+DROP TABLE research_action_cost;
+DROP TABLE research_action;
+DROP TABLE player_technology;
+
+DROP TRIGGER trigger_technology_updated_at ON technology;
+
+DROP TABLE technology_cost;
+DROP TABLE technology;
@@ -0,0 +1,58 @@
+
+CREATE TABLE technology(
+  id UUID NOT NULL,
+  name text NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE (name)
+);
+
+CREATE TRIGGER trigger_technology_updated_at
+  BEFORE UPDATE OR INSERT ON technology
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE technology_cost(
+  technology UUID NOT NULL,
+  resource UUID NOT NULL,
+  cost INTEGER NOT NULL,
+  progress NUMERIC(15, 5) NOT NULL,
+  FOREIGN KEY (technology) REFERENCES technology(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (technology, resource)
+);
+
+CREATE TABLE player_technology(
+  player UUID NOT NULL,
+  technology UUID NOT NULL,
+  level INTEGER NOT NULL,
+  FOREIGN KEY (player) REFERENCES player(id),
+  FOREIGN KEY (technology) REFERENCES technology(id),
+  UNIQUE (player, technology)
+);
+
+CREATE TABLE research_action(
+  id UUID NOT NULL,
+  player UUID NOT NULL,
+  planet UUID NOT NULL,
+  technology UUID NOT NULL,
+  desired_level INTEGER NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  completed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (player) REFERENCES player(id),
+  FOREIGN KEY (planet) REFERENCES planet(id),
+  FOREIGN KEY (technology) REFERENCES technology(id),
+  UNIQUE (player),
+  UNIQUE (planet)
+);
+
+CREATE TABLE research_action_cost(
+  action UUID NOT NULL,
+  resource UUID NOT NULL,
+  amount INTEGER NOT NULL,
+  FOREIGN KEY (action) REFERENCES research_action(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (action, resource)
+);
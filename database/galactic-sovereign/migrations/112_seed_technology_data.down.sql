@@ -0,0 +1,7 @@
+
+DELETE FROM research_action_cost;
+DELETE FROM research_action;
+DELETE FROM player_technology;
+
+DELETE FROM technology_cost;
+DELETE FROM technology;
@@ -0,0 +1,40 @@
+
+-- Technologies
+-- https://ogame.fandom.com/wiki/Research
+-- energy technology
+INSERT INTO galactic_sovereign_schema.technology("id", "name")
+  VALUES ('48315497-51de-4e2a-afe0-9bf3c9f00278', 'energy technology');
+
+INSERT INTO galactic_sovereign_schema.technology_cost("technology", "resource", "cost", "progress")
+  VALUES (
+    '48315497-51de-4e2a-afe0-9bf3c9f00278',
+    'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3',
+    800,
+    2
+  );
+INSERT INTO galactic_sovereign_schema.technology_cost("technology", "resource", "cost", "progress")
+  VALUES (
+    '48315497-51de-4e2a-afe0-9bf3c9f00278',
+    '9665303f-d37f-41e3-ad12-70f8ba8edd14',
+    400,
+    2
+  );
+
+-- computer technology
+INSERT INTO galactic_sovereign_schema.technology("id", "name")
+  VALUES ('78e99c8b-99f6-4e14-be92-cd275dc7c05b', 'computer technology');
+
+INSERT INTO galactic_sovereign_schema.technology_cost("technology", "resource", "cost", "progress")
+  VALUES (
+    '78e99c8b-99f6-4e14-be92-cd275dc7c05b',
+    'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3',
+    400,
+    2
+  );
+INSERT INTO galactic_sovereign_schema.technology_cost("technology", "resource", "cost", "progress")
+  VALUES (
+    '78e99c8b-99f6-4e14-be92-cd275dc7c05b',
+    '9665303f-d37f-41e3-ad12-70f8ba8edd14',
+    600,
+    2
+  );
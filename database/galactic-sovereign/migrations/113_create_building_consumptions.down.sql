@@ -0,0 +1,2 @@
+
+DROP TABLE building_resource_consumption;
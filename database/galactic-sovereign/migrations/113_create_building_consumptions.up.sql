@@ -0,0 +1,9 @@
+CREATE TABLE building_resource_consumption(
+  building UUID NOT NULL,
+  resource UUID NOT NULL,
+  base INTEGER NOT NULL,
+  progress NUMERIC(15, 5) NOT NULL,
+  FOREIGN KEY (building) REFERENCES building(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (building, resource)
+);
@@ -0,0 +1,19 @@
+
+DELETE FROM planet_resource_production WHERE resource = '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4';
+DELETE FROM building_action_resource_production WHERE resource = '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4';
+DELETE FROM building_action_cost WHERE action IN (
+  SELECT id FROM building_action WHERE building = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68'
+);
+DELETE FROM building_action_resource_storage WHERE action IN (
+  SELECT id FROM building_action WHERE building = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68'
+);
+DELETE FROM building_action WHERE building = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68';
+DELETE FROM planet_building WHERE building = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68';
+
+DELETE FROM building_resource_consumption;
+
+DELETE FROM building_resource_production WHERE building = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68';
+DELETE FROM building_cost WHERE building = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68';
+DELETE FROM building WHERE id = 'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68';
+
+DELETE FROM resource WHERE id = '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4';
@@ -0,0 +1,83 @@
+
+-- Resources
+-- energy is neither stored nor available at the start
+INSERT INTO galactic_sovereign_schema.resource("id", "name", "start_amount", "start_production", "start_storage", "build_time_hours_per_unit")
+  VALUES ('3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4', 'energy', 0, 0, 0, 0);
+
+-- Buildings
+-- solar plant
+-- https://ogame.fandom.com/wiki/Solar_Plant
+INSERT INTO galactic_sovereign_schema.building("id", "name")
+  VALUES ('c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68', 'solar plant');
+
+INSERT INTO galactic_sovereign_schema.building_cost("building", "resource", "cost", "progress")
+  VALUES (
+    'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68',
+    'b4419b6b-b3bf-4576-aa92-055283addbc8',
+    75,
+    1.5
+  );
+INSERT INTO galactic_sovereign_schema.building_cost("building", "resource", "cost", "progress")
+  VALUES (
+    'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68',
+    'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3',
+    30,
+    1.5
+  );
+
+INSERT INTO galactic_sovereign_schema.building_resource_production("building", "resource", "base", "progress")
+  VALUES (
+    'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68',
+    '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4',
+    20,
+    1.1
+  );
+
+-- Consumptions
+-- https://ogame.fandom.com/wiki/Metal_Mine#Energy_Consumption
+-- metal mine
+INSERT INTO galactic_sovereign_schema.building_resource_consumption("building", "resource", "base", "progress")
+  VALUES (
+    'd176e82d-f2ca-4611-996b-c4804096caef',
+    '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4',
+    10,
+    1.1
+  );
+
+-- crystal mine
+INSERT INTO galactic_sovereign_schema.building_resource_consumption("building", "resource", "base", "progress")
+  VALUES (
+    '3904d34d-9a7e-47d4-a332-091700e2c5c3',
+    '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4',
+    10,
+    1.1
+  );
+
+-- deuterium synthetizer
+INSERT INTO galactic_sovereign_schema.building_resource_consumption("building", "resource", "base", "progress")
+  VALUES (
+    '54a0ce97-bf8b-4fae-ba6e-caa9ae96265f',
+    '3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4',
+    20,
+    1.1
+  );
+
+-- Existing planets
+-- the solar plant is available on all planets
+INSERT INTO galactic_sovereign_schema.planet_building("planet", "building", "level")
+  SELECT
+    id,
+    'c2f4b8e5-9d31-47a6-b0e2-7f5a1c3d9e68',
+    0
+  FROM galactic_sovereign_schema.planet;
+
+-- mines already built consume energy according to their level
+INSERT INTO galactic_sovereign_schema.planet_resource_production("planet", "building", "resource", "production")
+  SELECT
+    pb.planet,
+    pb.building,
+    brc.resource,
+    -FLOOR(brc.base * pb.level * POWER(brc.progress, pb.level))
+  FROM galactic_sovereign_schema.planet_building AS pb
+    INNER JOIN galactic_sovereign_schema.building_resource_consumption AS brc ON brc.building = pb.building
+  WHERE pb.level > 0;
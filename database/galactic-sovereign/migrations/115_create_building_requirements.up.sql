@@ -0,0 +1,9 @@
+CREATE TABLE building_requirement(
+  building UUID NOT NULL,
+  requirement UUID NOT NULL,
+  level INTEGER NOT NULL,
+  FOREIGN KEY (building) REFERENCES building(id),
+  FOREIGN KEY (requirement) REFERENCES building(id),
+  UNIQUE (building, requirement),
+  CHECK (building <> requirement)
+);
@@ -0,0 +1,11 @@
+
+DELETE FROM building_requirement;
+
+DELETE FROM building_action_cost WHERE action IN (
+  SELECT id FROM building_action WHERE building = 'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15'
+);
+DELETE FROM building_action WHERE building = 'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15';
+DELETE FROM planet_building WHERE building = 'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15';
+
+DELETE FROM building_cost WHERE building = 'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15';
+DELETE FROM building WHERE id = 'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15';
@@ -0,0 +1,47 @@
+
+-- Buildings
+-- robotics factory
+-- https://ogame.fandom.com/wiki/Robotics_Factory
+INSERT INTO galactic_sovereign_schema.building("id", "name")
+  VALUES ('e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15', 'robotics factory');
+
+INSERT INTO galactic_sovereign_schema.building_cost("building", "resource", "cost", "progress")
+  VALUES (
+    'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15',
+    'b4419b6b-b3bf-4576-aa92-055283addbc8',
+    400,
+    2.0
+  );
+INSERT INTO galactic_sovereign_schema.building_cost("building", "resource", "cost", "progress")
+  VALUES (
+    'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15',
+    'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3',
+    120,
+    2.0
+  );
+INSERT INTO galactic_sovereign_schema.building_cost("building", "resource", "cost", "progress")
+  VALUES (
+    'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15',
+    '9665303f-d37f-41e3-ad12-70f8ba8edd14',
+    200,
+    2.0
+  );
+
+-- Requirements
+-- https://ogame.fandom.com/wiki/Shipyard
+-- shipyard requires robotics factory level 2
+INSERT INTO galactic_sovereign_schema.building_requirement("building", "requirement", "level")
+  VALUES (
+    '58d75842-6dc0-4ac0-b36d-55f91b8d060d',
+    'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15',
+    2
+  );
+
+-- Existing planets
+-- the robotics factory is available on all planets
+INSERT INTO galactic_sovereign_schema.planet_building("planet", "building", "level")
+  SELECT
+    id,
+    'e7a3d5c9-2f1b-4c86-9a4e-6b0d8f2c7a15',
+    0
+  FROM galactic_sovereign_schema.planet;
@@ -0,0 +1,4 @@
+
+ALTER TABLE building_action DROP CONSTRAINT building_action_planet_key;
+ALTER TABLE building_action ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE building_action ADD CONSTRAINT building_action_planet_position_key UNIQUE (planet, position);
@@ -0,0 +1,2 @@
+
+ALTER TABLE building_action DROP COLUMN kind;
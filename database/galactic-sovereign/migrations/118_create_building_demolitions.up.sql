@@ -0,0 +1,2 @@
+
+ALTER TABLE building_action ADD COLUMN kind TEXT NOT NULL DEFAULT 'upgrade';
@@ -0,0 +1,2 @@
+
+DROP INDEX building_action_completed_at_index;
@@ -0,0 +1,2 @@
+
+CREATE INDEX building_action_completed_at_index ON building_action(completed_at);
@@ -0,0 +1,12 @@
+CREATE TABLE player_ranking(
+  player UUID NOT NULL,
+  universe UUID NOT NULL,
+  points BIGINT NOT NULL,
+  rank INTEGER NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (player),
+  FOREIGN KEY (player) REFERENCES player(id) ON DELETE CASCADE,
+  FOREIGN KEY (universe) REFERENCES universe(id)
+);
+
+CREATE INDEX player_ranking_universe_rank_index ON player_ranking(universe, rank);
@@ -0,0 +1,4 @@
+
+DROP TRIGGER trigger_message_updated_at ON message;
+
+DROP TABLE message;
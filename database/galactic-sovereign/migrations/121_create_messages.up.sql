@@ -0,0 +1,21 @@
+CREATE TABLE message(
+  id UUID NOT NULL,
+  kind TEXT NOT NULL,
+  player UUID NOT NULL,
+  sender UUID,
+  subject TEXT NOT NULL,
+  content TEXT NOT NULL,
+  read BOOLEAN NOT NULL DEFAULT false,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  FOREIGN KEY (player) REFERENCES player(id) ON DELETE CASCADE,
+  FOREIGN KEY (sender) REFERENCES player(id) ON DELETE SET NULL
+);
+
+CREATE TRIGGER trigger_message_updated_at
+  BEFORE UPDATE OR INSERT ON message
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX message_player_created_at_index ON message(player, created_at);
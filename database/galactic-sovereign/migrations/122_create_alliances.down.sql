@@ -0,0 +1,8 @@
+
+DROP TABLE alliance_application;
+
+DROP TRIGGER trigger_alliance_member_updated_at ON alliance_member;
+DROP TABLE alliance_member;
+
+DROP TRIGGER trigger_alliance_updated_at ON alliance;
+DROP TABLE alliance;
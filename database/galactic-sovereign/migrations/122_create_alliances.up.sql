@@ -0,0 +1,46 @@
+CREATE TABLE alliance(
+  id UUID NOT NULL,
+  universe UUID NOT NULL,
+  name TEXT NOT NULL,
+  tag TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  FOREIGN KEY (universe) REFERENCES universe(id) ON DELETE CASCADE,
+  UNIQUE (universe, name),
+  UNIQUE (universe, tag)
+);
+
+CREATE TRIGGER trigger_alliance_updated_at
+  BEFORE UPDATE OR INSERT ON alliance
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE alliance_member(
+  player UUID NOT NULL,
+  alliance UUID NOT NULL,
+  rank TEXT NOT NULL,
+  joined_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (player),
+  FOREIGN KEY (player) REFERENCES player(id) ON DELETE CASCADE,
+  FOREIGN KEY (alliance) REFERENCES alliance(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER trigger_alliance_member_updated_at
+  BEFORE UPDATE OR INSERT ON alliance_member
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX alliance_member_alliance_index ON alliance_member(alliance);
+
+CREATE TABLE alliance_application(
+  id UUID NOT NULL,
+  alliance UUID NOT NULL,
+  player UUID NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (alliance) REFERENCES alliance(id) ON DELETE CASCADE,
+  FOREIGN KEY (player) REFERENCES player(id) ON DELETE CASCADE,
+  UNIQUE (alliance, player)
+);
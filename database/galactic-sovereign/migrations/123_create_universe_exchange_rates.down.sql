@@ -0,0 +1,2 @@
+
+DROP TABLE universe_exchange_rate;
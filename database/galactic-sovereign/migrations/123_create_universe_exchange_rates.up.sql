@@ -0,0 +1,9 @@
+CREATE TABLE universe_exchange_rate (
+  universe UUID NOT NULL,
+  resource UUID NOT NULL,
+  rate INTEGER NOT NULL,
+  PRIMARY KEY (universe, resource),
+  FOREIGN KEY (universe) REFERENCES universe(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  CHECK (rate > 0)
+);
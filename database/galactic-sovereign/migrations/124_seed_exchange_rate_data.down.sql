@@ -0,0 +1,2 @@
+
+DELETE FROM universe_exchange_rate;
@@ -0,0 +1,18 @@
+
+-- Exchange rates of the merchant
+-- https://ogame.fandom.com/wiki/Merchant
+-- Universe: Oberon
+INSERT INTO galactic_sovereign_schema.universe_exchange_rate("universe", "resource", "rate")
+  VALUES ('9682f17b-f5f0-4eda-a747-2537d2151837', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 3);
+INSERT INTO galactic_sovereign_schema.universe_exchange_rate("universe", "resource", "rate")
+  VALUES ('9682f17b-f5f0-4eda-a747-2537d2151837', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 2);
+INSERT INTO galactic_sovereign_schema.universe_exchange_rate("universe", "resource", "rate")
+  VALUES ('9682f17b-f5f0-4eda-a747-2537d2151837', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 1);
+
+-- Universe: Aquarius
+INSERT INTO galactic_sovereign_schema.universe_exchange_rate("universe", "resource", "rate")
+  VALUES ('0ac6c027-11d6-47e6-ab15-514cfac48200', 'b4419b6b-b3bf-4576-aa92-055283addbc8', 3);
+INSERT INTO galactic_sovereign_schema.universe_exchange_rate("universe", "resource", "rate")
+  VALUES ('0ac6c027-11d6-47e6-ab15-514cfac48200', 'cd2ac9aa-9968-4ff5-b746-88f1f810fbb3', 2);
+INSERT INTO galactic_sovereign_schema.universe_exchange_rate("universe", "resource", "rate")
+  VALUES ('0ac6c027-11d6-47e6-ab15-514cfac48200', '9665303f-d37f-41e3-ad12-70f8ba8edd14', 1);
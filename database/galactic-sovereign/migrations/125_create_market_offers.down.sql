@@ -0,0 +1,3 @@
+
+DROP TRIGGER trigger_market_offer_updated_at ON market_offer;
+DROP TABLE market_offer;
@@ -0,0 +1,36 @@
+CREATE TABLE market_offer(
+  id UUID NOT NULL,
+  universe UUID NOT NULL,
+  player UUID NOT NULL,
+  planet UUID NOT NULL,
+  offered_resource UUID NOT NULL,
+  offered_amount INTEGER NOT NULL,
+  requested_resource UUID NOT NULL,
+  requested_amount INTEGER NOT NULL,
+  status TEXT NOT NULL,
+  buyer UUID,
+  buyer_planet UUID,
+  accepted_at TIMESTAMP WITH TIME ZONE,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  version INTEGER DEFAULT 0,
+  PRIMARY KEY (id),
+  FOREIGN KEY (universe) REFERENCES universe(id),
+  FOREIGN KEY (player) REFERENCES player(id),
+  FOREIGN KEY (planet) REFERENCES planet(id),
+  FOREIGN KEY (offered_resource) REFERENCES resource(id),
+  FOREIGN KEY (requested_resource) REFERENCES resource(id),
+  FOREIGN KEY (buyer) REFERENCES player(id) ON DELETE SET NULL,
+  FOREIGN KEY (buyer_planet) REFERENCES planet(id) ON DELETE SET NULL,
+  CHECK (offered_amount > 0),
+  CHECK (requested_amount > 0)
+);
+
+CREATE TRIGGER trigger_market_offer_updated_at
+  BEFORE UPDATE OR INSERT ON market_offer
+  FOR EACH ROW
+  EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX market_offer_universe_status_index ON market_offer(universe, status);
+CREATE INDEX market_offer_planet_index ON market_offer(planet);
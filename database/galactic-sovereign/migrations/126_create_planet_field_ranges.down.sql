@@ -0,0 +1 @@
+DROP TABLE planet_field_range;
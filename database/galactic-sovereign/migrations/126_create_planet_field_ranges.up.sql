@@ -0,0 +1,9 @@
+CREATE TABLE planet_field_range (
+  position INTEGER NOT NULL,
+  min_fields INTEGER NOT NULL,
+  max_fields INTEGER NOT NULL,
+  PRIMARY KEY (position),
+  CHECK (position >= 0),
+  CHECK (min_fields > 0),
+  CHECK (max_fields > min_fields)
+);
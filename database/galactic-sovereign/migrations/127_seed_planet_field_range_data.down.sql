@@ -0,0 +1,2 @@
+
+DELETE FROM planet_field_range;
@@ -0,0 +1,33 @@
+
+-- Fields of the planets per position
+-- https://board.en.ogame.gameforge.com/index.php?thread/790879-minimum-planet-size/
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (0, 95, 108);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (1, 97, 110);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (2, 98, 139);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (3, 123, 210);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (4, 148, 215);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (5, 148, 239);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (6, 141, 242);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (7, 163, 248);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (8, 155, 243);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (9, 151, 225);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (10, 139, 205);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (11, 134, 180);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (12, 109, 121);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (13, 81, 93);
+INSERT INTO galactic_sovereign_schema.planet_field_range("position", "min_fields", "max_fields")
+  VALUES (14, 65, 74);
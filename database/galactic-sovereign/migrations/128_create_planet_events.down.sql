@@ -0,0 +1,3 @@
+DROP TABLE planet_event_resource;
+
+DROP TABLE planet_event;
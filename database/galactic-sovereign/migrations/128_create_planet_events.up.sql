@@ -0,0 +1,25 @@
+-- The events are kept when the planet is deleted: there is no foreign key
+-- to the planet table.
+CREATE TABLE planet_event(
+  id UUID NOT NULL,
+  planet UUID NOT NULL,
+  kind TEXT NOT NULL,
+  action UUID,
+  action_state JSONB,
+  version_before INTEGER NOT NULL,
+  version_after INTEGER NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX planet_event_planet_created_at_index ON planet_event(planet, created_at);
+
+CREATE TABLE planet_event_resource(
+  event UUID NOT NULL,
+  resource UUID NOT NULL,
+  delta NUMERIC(15, 5) NOT NULL,
+  produced NUMERIC(15, 5) NOT NULL,
+  FOREIGN KEY (event) REFERENCES planet_event(id),
+  FOREIGN KEY (resource) REFERENCES resource(id),
+  UNIQUE (event, resource)
+);
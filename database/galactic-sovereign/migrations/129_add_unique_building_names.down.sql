@@ -0,0 +1 @@
+ALTER TABLE building DROP CONSTRAINT building_name_key;
@@ -0,0 +1,2 @@
+-- Some rules of the game look the buildings up by name.
+ALTER TABLE building ADD CONSTRAINT building_name_key UNIQUE (name);
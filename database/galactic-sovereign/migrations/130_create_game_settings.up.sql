@@ -0,0 +1,12 @@
+-- The settings shared by all the universes: the table holds a single row.
+CREATE TABLE game_setting (
+  id BOOLEAN NOT NULL DEFAULT TRUE,
+  max_planets_per_player INTEGER NOT NULL,
+  PRIMARY KEY (id),
+  CHECK (id),
+  CHECK (max_planets_per_player > 0)
+);
+
+-- The limit which was hardcoded so far, including the homeworld.
+INSERT INTO game_setting (max_planets_per_player)
+  VALUES (9);
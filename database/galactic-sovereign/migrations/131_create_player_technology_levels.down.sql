@@ -0,0 +1,21 @@
+CREATE TABLE player_technology(
+  player UUID NOT NULL,
+  technology UUID NOT NULL,
+  level INTEGER NOT NULL,
+  FOREIGN KEY (player) REFERENCES player(id),
+  FOREIGN KEY (technology) REFERENCES technology(id),
+  UNIQUE (player, technology)
+);
+
+INSERT INTO player_technology (player, technology, level)
+  SELECT
+    player,
+    technology,
+    MAX(level)
+  FROM
+    player_technology_level
+  GROUP BY
+    player,
+    technology;
+
+DROP TABLE player_technology_level;
@@ -0,0 +1,25 @@
+-- Each level reached by a player for a technology, along with the time at
+-- which it was reached: the planets of the player apply it from this time.
+CREATE TABLE player_technology_level(
+  player UUID NOT NULL,
+  technology UUID NOT NULL,
+  level INTEGER NOT NULL,
+  reached_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  FOREIGN KEY (player) REFERENCES player(id),
+  FOREIGN KEY (technology) REFERENCES technology(id),
+  UNIQUE (player, technology, level)
+);
+
+-- The time at which the levels researched so far were reached is not
+-- known: they are considered reached when the player was created.
+INSERT INTO player_technology_level (player, technology, level, reached_at)
+  SELECT
+    pt.player,
+    pt.technology,
+    pt.level,
+    COALESCE(p.created_at, CURRENT_TIMESTAMP)
+  FROM
+    player_technology AS pt
+    INNER JOIN player AS p ON p.id = pt.player;
+
+DROP TABLE player_technology;
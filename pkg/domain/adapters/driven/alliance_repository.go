@@ -0,0 +1,278 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	createAllianceQuery = `
+INSERT INTO
+	alliance (id, universe, name, tag, created_at)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	getAllianceQuery = `
+SELECT
+	id,
+	universe,
+	name,
+	tag,
+	created_at
+FROM
+	alliance
+WHERE
+	id = $1`
+
+	deleteAllianceQuery = `DELETE FROM alliance WHERE id = $1`
+
+	createAllianceMemberQuery = `
+INSERT INTO
+	alliance_member (player, alliance, rank, joined_at)
+	VALUES ($1, $2, $3, $4)`
+
+	listAllianceMembersQuery = `
+SELECT
+	am.player,
+	p.name,
+	am.rank,
+	am.joined_at
+FROM
+	alliance_member AS am
+	INNER JOIN player AS p ON p.id = am.player
+WHERE
+	am.alliance = $1
+ORDER BY
+	am.joined_at,
+	p.name`
+
+	updateAllianceMemberQuery = `
+UPDATE
+	alliance_member
+SET
+	rank = $1
+WHERE
+	alliance = $2
+	AND player = $3`
+
+	deleteAllianceMemberQuery = `DELETE FROM alliance_member WHERE alliance = $1 AND player = $2`
+
+	createAllianceApplicationQuery = `
+INSERT INTO
+	alliance_application (id, alliance, player, created_at)
+	VALUES ($1, $2, $3, $4)`
+
+	getAllianceApplicationQuery = `
+SELECT
+	id,
+	alliance,
+	player,
+	created_at
+FROM
+	alliance_application
+WHERE
+	id = $1`
+
+	listAllianceApplicationsQuery = `
+SELECT
+	id,
+	alliance,
+	player,
+	created_at
+FROM
+	alliance_application
+WHERE
+	alliance = $1
+ORDER BY
+	created_at,
+	id`
+
+	deleteAllianceApplicationQuery = `DELETE FROM alliance_application WHERE id = $1`
+
+	deleteApplicationsForPlayerQuery = `DELETE FROM alliance_application WHERE player = $1`
+)
+
+type AllianceRepository struct {
+	conn db.Connection
+}
+
+func NewAllianceRepository(conn db.Connection) *AllianceRepository {
+	return &AllianceRepository{
+		conn: conn,
+	}
+}
+
+func (r *AllianceRepository) Create(ctx context.Context, alliance models.Alliance) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	_, err = tx.Exec(
+		ctx,
+		createAllianceQuery,
+		alliance.Id,
+		alliance.Universe,
+		alliance.Name,
+		alliance.Tag,
+		alliance.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	for _, member := range alliance.Members {
+		err = addAllianceMember(ctx, tx, alliance.Id, member)
+		if err != nil {
+			return parseDbError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AllianceRepository) Get(ctx context.Context, id uuid.UUID) (models.Alliance, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Alliance{}, err
+	}
+	defer tx.Close(ctx)
+
+	dbAlliance, err := db.QueryOneTx[mappers.DbAlliance](ctx, tx, getAllianceQuery, id)
+	if err != nil {
+		return models.Alliance{}, parseDbError(err)
+	}
+
+	alliance := dbAlliance.ToDomain()
+	alliance.Members, err = db.QueryAllTx[models.AllianceMember](ctx, tx, listAllianceMembersQuery, id)
+	if err != nil {
+		return models.Alliance{}, err
+	}
+
+	return alliance, nil
+}
+
+func (r *AllianceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.conn.Exec(ctx, deleteAllianceQuery, id)
+	return parseDbError(err)
+}
+
+func (r *AllianceRepository) CreateApplication(
+	ctx context.Context,
+	application models.AllianceApplication,
+) error {
+	_, err := r.conn.Exec(
+		ctx,
+		createAllianceApplicationQuery,
+		application.Id,
+		application.Alliance,
+		application.Player,
+		application.CreatedAt.UTC(),
+	)
+	return parseDbError(err)
+}
+
+func (r *AllianceRepository) GetApplication(
+	ctx context.Context,
+	id uuid.UUID,
+) (models.AllianceApplication, error) {
+	application, err := db.QueryOne[models.AllianceApplication](ctx, r.conn, getAllianceApplicationQuery, id)
+	if err != nil {
+		return models.AllianceApplication{}, parseDbError(err)
+	}
+
+	return application, nil
+}
+
+func (r *AllianceRepository) ListApplications(
+	ctx context.Context,
+	alliance uuid.UUID,
+) ([]models.AllianceApplication, error) {
+	return db.QueryAll[models.AllianceApplication](ctx, r.conn, listAllianceApplicationsQuery, alliance)
+}
+
+func (r *AllianceRepository) DeleteApplication(ctx context.Context, id uuid.UUID) error {
+	affected, err := r.conn.Exec(ctx, deleteAllianceApplicationQuery, id)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *AllianceRepository) AddMember(
+	ctx context.Context,
+	alliance uuid.UUID,
+	member models.AllianceMember,
+) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	err = addAllianceMember(ctx, tx, alliance, member)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	_, err = tx.Exec(ctx, deleteApplicationsForPlayerQuery, member.Player)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	return nil
+}
+
+func (r *AllianceRepository) UpdateMember(
+	ctx context.Context,
+	alliance uuid.UUID,
+	member models.AllianceMember,
+) error {
+	affected, err := r.conn.Exec(ctx, updateAllianceMemberQuery, member.Rank, alliance, member.Player)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *AllianceRepository) RemoveMember(ctx context.Context, alliance uuid.UUID, player uuid.UUID) error {
+	affected, err := r.conn.Exec(ctx, deleteAllianceMemberQuery, alliance, player)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func addAllianceMember(
+	ctx context.Context,
+	tx db.Transaction,
+	alliance uuid.UUID,
+	member models.AllianceMember,
+) error {
+	_, err := tx.Exec(
+		ctx,
+		createAllianceMemberQuery,
+		member.Player,
+		alliance,
+		member.Rank,
+		member.JoinedAt.UTC(),
+	)
+	return err
+}
@@ -0,0 +1,281 @@
+package drivenadapters
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_AllianceRepository_Create(t *testing.T) {
+	repo, conn := newTestAllianceRepository(t)
+
+	t.Run("creates alliance with founder", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+
+		alliance := models.Alliance{
+			Id:        uuid.New(),
+			Universe:  universe.Id,
+			Name:      fmt.Sprintf("alliance-%s", uuid.NewString()),
+			Tag:       "ALLY",
+			CreatedAt: someTime,
+			Members: []models.AllianceMember{
+				{Player: founder.Id, Name: founder.Name, Rank: models.FounderRank, JoinedAt: someTime},
+			},
+		}
+		err := repo.Create(t.Context(), alliance)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), alliance.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, alliance, actual)
+	})
+
+	t.Run("returns error when tag is already used in universe", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		existing := insertTestAlliance(t, conn, founder)
+		other := insertTestPlayer(t, conn, universe.Id)
+
+		alliance := models.Alliance{
+			Id:        uuid.New(),
+			Universe:  universe.Id,
+			Name:      fmt.Sprintf("alliance-%s", uuid.NewString()),
+			Tag:       existing.Tag,
+			CreatedAt: someTime,
+			Members: []models.AllianceMember{
+				{Player: other.Id, Rank: models.FounderRank, JoinedAt: someTime},
+			},
+		}
+		err := repo.Create(t.Context(), alliance)
+
+		assert.ErrorIs(t, err, domainerrors.ErrTagAlreadyTaken, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when founder is already in an alliance", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		insertTestAlliance(t, conn, founder)
+
+		alliance := models.Alliance{
+			Id:        uuid.New(),
+			Universe:  universe.Id,
+			Name:      fmt.Sprintf("alliance-%s", uuid.NewString()),
+			Tag:       "OTHER",
+			CreatedAt: someTime,
+			Members: []models.AllianceMember{
+				{Player: founder.Id, Rank: models.FounderRank, JoinedAt: someTime},
+			},
+		}
+		err := repo.Create(t.Context(), alliance)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyInAlliance, "Actual err: %v", err)
+	})
+}
+
+func TestIT_AllianceRepository_Get(t *testing.T) {
+	repo, _ := newTestAllianceRepository(t)
+
+	t.Run("returns error when alliance does not exist", func(t *testing.T) {
+		_, err := repo.Get(t.Context(), uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_AllianceRepository_Delete(t *testing.T) {
+	repo, conn := newTestAllianceRepository(t)
+
+	t.Run("deletes alliance and its memberships", func(t *testing.T) {
+		founder, _ := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+
+		err := repo.Delete(t.Context(), alliance.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		_, err = repo.Get(t.Context(), alliance.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+
+		player, err := NewPlayerRepository(conn).Get(t.Context(), founder.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Nil(t, player.Alliance)
+	})
+}
+
+func TestIT_AllianceRepository_Applications(t *testing.T) {
+	repo, conn := newTestAllianceRepository(t)
+
+	t.Run("creates and lists applications", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+		applicant := insertTestPlayer(t, conn, universe.Id)
+
+		application := models.AllianceApplication{
+			Id:        uuid.New(),
+			Alliance:  alliance.Id,
+			Player:    applicant.Id,
+			CreatedAt: someTime,
+		}
+		err := repo.CreateApplication(t.Context(), application)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetApplication(t.Context(), application.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, application, actual)
+
+		applications, err := repo.ListApplications(t.Context(), alliance.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, []models.AllianceApplication{application}, applications)
+	})
+
+	t.Run("returns error when player already applied", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+		applicant := insertTestPlayer(t, conn, universe.Id)
+
+		application := models.AllianceApplication{
+			Id:        uuid.New(),
+			Alliance:  alliance.Id,
+			Player:    applicant.Id,
+			CreatedAt: someTime,
+		}
+		err := repo.CreateApplication(t.Context(), application)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		application.Id = uuid.New()
+		err = repo.CreateApplication(t.Context(), application)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyApplied, "Actual err: %v", err)
+	})
+
+	t.Run("deletes application", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+		applicant := insertTestPlayer(t, conn, universe.Id)
+		application := models.AllianceApplication{
+			Id:        uuid.New(),
+			Alliance:  alliance.Id,
+			Player:    applicant.Id,
+			CreatedAt: someTime,
+		}
+		err := repo.CreateApplication(t.Context(), application)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		err = repo.DeleteApplication(t.Context(), application.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		_, err = repo.GetApplication(t.Context(), application.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_AllianceRepository_Members(t *testing.T) {
+	repo, conn := newTestAllianceRepository(t)
+
+	t.Run("adds member and removes its applications", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+		applicant := insertTestPlayer(t, conn, universe.Id)
+		application := models.AllianceApplication{
+			Id:        uuid.New(),
+			Alliance:  alliance.Id,
+			Player:    applicant.Id,
+			CreatedAt: someTime,
+		}
+		err := repo.CreateApplication(t.Context(), application)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		member := models.AllianceMember{
+			Player:   applicant.Id,
+			Name:     applicant.Name,
+			Rank:     models.MemberRank,
+			JoinedAt: someOtherTime,
+		}
+		err = repo.AddMember(t.Context(), alliance.Id, member)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), alliance.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, append(alliance.Members, member), actual.Members)
+
+		_, err = repo.GetApplication(t.Context(), application.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("updates rank of member", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+		player := insertTestPlayer(t, conn, universe.Id)
+		member := models.AllianceMember{Player: player.Id, Rank: models.MemberRank, JoinedAt: someOtherTime}
+		err := repo.AddMember(t.Context(), alliance.Id, member)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		member.Rank = models.OfficerRank
+		err = repo.UpdateMember(t.Context(), alliance.Id, member)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := NewPlayerRepository(conn).Get(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, models.OfficerRank, actual.Alliance.Rank)
+	})
+
+	t.Run("removes member", func(t *testing.T) {
+		founder, universe := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+		player := insertTestPlayer(t, conn, universe.Id)
+		member := models.AllianceMember{Player: player.Id, Rank: models.MemberRank, JoinedAt: someOtherTime}
+		err := repo.AddMember(t.Context(), alliance.Id, member)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		err = repo.RemoveMember(t.Context(), alliance.Id, player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), alliance.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, alliance.Members, actual.Members)
+	})
+
+	t.Run("returns error when removing unknown member", func(t *testing.T) {
+		founder, _ := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, founder)
+
+		err := repo.RemoveMember(t.Context(), alliance.Id, uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func newTestAllianceRepository(t *testing.T) (*AllianceRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewAllianceRepository(conn), conn
+}
+
+func insertTestAlliance(t *testing.T, conn db.Connection, founder models.Player) models.Alliance {
+	t.Helper()
+
+	alliance := models.Alliance{
+		Id:        uuid.New(),
+		Universe:  founder.Universe,
+		Name:      fmt.Sprintf("alliance-%s", uuid.NewString()),
+		Tag:       "TAG",
+		CreatedAt: someTime,
+		Members: []models.AllianceMember{
+			{Player: founder.Id, Name: founder.Name, Rank: models.FounderRank, JoinedAt: someTime},
+		},
+	}
+
+	sqlQuery := `INSERT INTO alliance (id, universe, name, tag, created_at) VALUES ($1, $2, $3, $4, $5)`
+	_, err := conn.Exec(t.Context(), sqlQuery, alliance.Id, alliance.Universe, alliance.Name, alliance.Tag, alliance.CreatedAt)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	sqlQuery = `INSERT INTO alliance_member (player, alliance, rank, joined_at) VALUES ($1, $2, $3, $4)`
+	_, err = conn.Exec(t.Context(), sqlQuery, founder.Id, alliance.Id, models.FounderRank, someTime)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return alliance
+}
@@ -12,15 +12,16 @@ import (
 const (
 	upsertBuildingActionQuery = `
 INSERT INTO
-	building_action (id, planet, building, desired_level, created_at, completed_at)
-	VALUES ($1, $2, $3, $4, $5, $6)
+	building_action (id, planet, building, kind, desired_level, position, created_at, completed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 ON CONFLICT (id) DO UPDATE
 SET
+	position = excluded.position,
 	completed_at = excluded.completed_at`
 
 	// For this query and the following ones, voluntarily doing nothing on conflict.
 	// This allows the action dependencies to behave as a single aggregate for which
-	// only the completion time can be updated.
+	// only the position in the queue and the completion time can be updated.
 	upsertBuildingActionCostQuery = `
 INSERT INTO
 	building_action_cost (action, resource, amount)
@@ -39,17 +40,20 @@ INSERT INTO
 	VALUES ($1, $2, $3)
 ON CONFLICT (action, resource) DO NOTHING`
 
-	getBuildingActionQuery = `
+	listBuildingActionForPlanetQuery = `
 SELECT
 	id,
 	building,
+	kind,
 	desired_level,
 	created_at,
 	completed_at
 FROM
 	building_action
 WHERE
-	id = $1`
+	planet = $1
+ORDER BY
+	position`
 
 	listBuildingActionCostForActionQuery = `
 SELECT
@@ -113,6 +117,7 @@ func upsertBuildingActionWithDetails(
 	ctx context.Context,
 	tx db.Transaction,
 	planet uuid.UUID,
+	position int,
 	action models.BuildingAction,
 ) error {
 	_, err := tx.Exec(
@@ -121,7 +126,9 @@ func upsertBuildingActionWithDetails(
 		action.Id,
 		planet,
 		action.Building,
+		action.Kind,
 		action.DesiredLevel,
+		position,
 		action.CreatedAt,
 		action.CompletedAt,
 	)
@@ -171,54 +178,59 @@ func upsertBuildingActionWithDetails(
 	return nil
 }
 
-func loadBuildingActionAndDetails(
+func loadBuildingQueueForPlanet(
 	ctx context.Context,
 	tx db.Transaction,
-	id uuid.UUID,
-) (models.BuildingAction, error) {
-	dbAction, err := db.QueryOneTx[mappers.DbBuildingAction](
+	planet uuid.UUID,
+) ([]models.BuildingAction, error) {
+	dbActions, err := db.QueryAllTx[mappers.DbBuildingAction](
 		ctx,
 		tx,
-		getBuildingActionQuery,
-		id,
+		listBuildingActionForPlanetQuery,
+		planet,
 	)
 	if err != nil {
-		return models.BuildingAction{}, parseDbError(err)
+		return nil, err
 	}
 
-	action := dbAction.ToDomain()
+	queue := make([]models.BuildingAction, 0, len(dbActions))
+	for _, dbAction := range dbActions {
+		action := dbAction.ToDomain()
 
-	action.Costs, err = db.QueryAllTx[models.BuildingActionCost](
-		ctx,
-		tx,
-		listBuildingActionCostForActionQuery,
-		dbAction.Id,
-	)
-	if err != nil {
-		return action, err
-	}
+		action.Costs, err = db.QueryAllTx[models.BuildingActionCost](
+			ctx,
+			tx,
+			listBuildingActionCostForActionQuery,
+			dbAction.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-	action.Storages, err = db.QueryAllTx[models.BuildingActionResourceStorage](
-		ctx,
-		tx,
-		listBuildingActionResourceStorageForActionQuery,
-		dbAction.Id,
-	)
-	if err != nil {
-		return action, err
-	}
+		action.Storages, err = db.QueryAllTx[models.BuildingActionResourceStorage](
+			ctx,
+			tx,
+			listBuildingActionResourceStorageForActionQuery,
+			dbAction.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-	action.Productions, err = db.QueryAllTx[models.BuildingActionResourceProduction](
-		ctx,
-		tx,
-		listBuildingActionResourceProductionForActionQuery,
-		dbAction.Id,
-	)
-	if err != nil {
-		return action, err
+		action.Productions, err = db.QueryAllTx[models.BuildingActionResourceProduction](
+			ctx,
+			tx,
+			listBuildingActionResourceProductionForActionQuery,
+			dbAction.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		queue = append(queue, action)
 	}
 
-	return action, nil
+	return queue, nil
 }
 
 func deleteBuildingActionAndDetailsForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) error {
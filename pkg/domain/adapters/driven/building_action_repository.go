@@ -0,0 +1,54 @@
+package drivenadapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/google/uuid"
+)
+
+const (
+	getNextBuildingActionCompletionQuery = `
+SELECT
+	completed_at
+FROM
+	building_action
+ORDER BY
+	completed_at
+LIMIT 1`
+
+	listPlanetWithDueBuildingActionQuery = `
+SELECT DISTINCT
+	planet
+FROM
+	building_action
+WHERE
+	completed_at <= $1`
+)
+
+type BuildingActionRepository struct {
+	conn db.Connection
+}
+
+func NewBuildingActionRepository(conn db.Connection) *BuildingActionRepository {
+	return &BuildingActionRepository{
+		conn: conn,
+	}
+}
+
+func (r *BuildingActionRepository) NextCompletion(ctx context.Context) (time.Time, error) {
+	completedAt, err := db.QueryOne[time.Time](ctx, r.conn, getNextBuildingActionCompletionQuery)
+	if err != nil {
+		return time.Time{}, parseDbError(err)
+	}
+
+	return completedAt, nil
+}
+
+func (r *BuildingActionRepository) ListDuePlanets(
+	ctx context.Context,
+	until time.Time,
+) ([]uuid.UUID, error) {
+	return db.QueryAll[uuid.UUID](ctx, r.conn, listPlanetWithDueBuildingActionQuery, until)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,6 +16,59 @@ var (
 	metalMineId = uuid.MustParse("d176e82d-f2ca-4611-996b-c4804096caef")
 )
 
+func TestIT_BuildingActionRepository_NextCompletion(t *testing.T) {
+	repo, conn := newTestBuildingActionRepository(t)
+
+	t.Run("returns earliest completion time", func(t *testing.T) {
+		// Other tests insert actions completing around the test time: this
+		// one has to complete way before to be the earliest.
+		earliest := time.Date(1990, time.March, 7, 10, 11, 12, 0, time.UTC)
+
+		action, _ := insertTestBuildingAction(t, conn)
+		updateBuildingActionCompletion(t, conn, action.Id, earliest)
+		insertTestBuildingAction(t, conn)
+
+		actual, err := repo.NextCompletion(t.Context())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, earliest.Equal(actual), "Expected %v, got %v", earliest, actual)
+	})
+}
+
+func TestIT_BuildingActionRepository_ListDuePlanets(t *testing.T) {
+	repo, conn := newTestBuildingActionRepository(t)
+
+	t.Run("lists planets with an action completed before the time", func(t *testing.T) {
+		due := time.Date(1991, time.April, 9, 14, 15, 16, 0, time.UTC)
+
+		dueAction, duePlanet := insertTestBuildingAction(t, conn)
+		updateBuildingActionCompletion(t, conn, dueAction.Id, due)
+		// Multiple due actions should not list the planet twice.
+		other := insertTestBuildingActionForPlanet(t, conn, duePlanet.Id)
+		updateBuildingActionCompletion(t, conn, other.Id, due.Add(-1*time.Hour))
+		_, notDuePlanet := insertTestBuildingAction(t, conn)
+
+		actual, err := repo.ListDuePlanets(t.Context(), due)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Contains(t, actual, duePlanet.Id)
+		assert.NotContains(t, actual, notDuePlanet.Id)
+		count := 0
+		for _, id := range actual {
+			if id == duePlanet.Id {
+				count++
+			}
+		}
+		assert.Equal(t, 1, count)
+	})
+}
+
+func newTestBuildingActionRepository(t *testing.T) (*BuildingActionRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewBuildingActionRepository(conn), conn
+}
+
 func insertTestBuildingActionForPlanet(
 	t *testing.T,
 	conn db.Connection,
@@ -25,6 +79,7 @@ func insertTestBuildingActionForPlanet(
 
 	action := models.BuildingAction{
 		Id:           uuid.New(),
+		Kind:         models.UpgradeAction,
 		Building:     metalMineId,
 		DesiredLevel: 5,
 		CreatedAt:    someTime,
@@ -36,9 +91,10 @@ func insertTestBuildingActionForPlanet(
 		Productions: []models.BuildingActionResourceProduction{},
 	}
 
+	// Actions are appended at the end of the queue of the planet.
 	sqlQuery := `INSERT INTO building_action
-		(id, planet, building, desired_level, created_at, completed_at)
-		VALUES ($1, $2, $3, $4, $5, $6)`
+		(id, planet, building, desired_level, position, created_at, completed_at)
+		VALUES ($1, $2, $3, $4, (SELECT COUNT(*) FROM building_action WHERE planet = $2), $5, $6)`
 	_, err := conn.Exec(
 		t.Context(),
 		sqlQuery,
@@ -67,7 +123,7 @@ func insertTestBuildingAction(
 
 	planet, _, _ := insertTestPlanetForPlayer(t, conn)
 	action := insertTestBuildingActionForPlanet(t, conn, planet.Id, modifiers...)
-	planet.BuildingAction = &action
+	planet.BuildingQueue = append(planet.BuildingQueue, action)
 	return action, planet
 }
 
@@ -141,6 +197,14 @@ func addBuildingActionProduction(t *testing.T, conn db.Connection, a *models.Bui
 	a.Productions = append(a.Productions, production)
 }
 
+func updateBuildingActionCompletion(t *testing.T, conn db.Connection, action uuid.UUID, completedAt time.Time) {
+	t.Helper()
+
+	sqlQuery := `UPDATE building_action SET completed_at = $1 WHERE id = $2`
+	_, err := conn.Exec(t.Context(), sqlQuery, completedAt, action)
+	require.NoError(t, err, "Actual err: %v", err)
+}
+
 func assertBuildingActionExists(t *testing.T, conn db.Connection, id uuid.UUID) {
 	t.Helper()
 
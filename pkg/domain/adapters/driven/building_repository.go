@@ -53,6 +53,16 @@ FROM
 WHERE
 	building = $1`
 
+	listBuildingResourceConsumptionForBuildingQuery = `
+SELECT
+	resource,
+	base,
+	progress
+FROM
+	building_resource_consumption
+WHERE
+	building = $1`
+
 	listBuildingResourceStorageForBuildingQuery = `
 SELECT
 	resource,
@@ -61,6 +71,15 @@ SELECT
 	progress
 FROM
 	building_resource_storage
+WHERE
+	building = $1`
+
+	listBuildingRequirementForBuildingQuery = `
+SELECT
+	requirement AS building,
+	level
+FROM
+	building_requirement
 WHERE
 	building = $1`
 )
@@ -90,6 +109,16 @@ func (r *BuildingRepository) Get(ctx context.Context, id uuid.UUID) (models.Buil
 	return loadBuildingDetails(ctx, tx, dbBuilding)
 }
 
+func (r *BuildingRepository) List(ctx context.Context) ([]models.Building, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	return loadBuildings(ctx, tx)
+}
+
 func loadBuildings(ctx context.Context, tx db.Transaction) ([]models.Building, error) {
 	dbBuildings, err := db.QueryAllTx[mappers.DbBuilding](ctx, tx, listBuildingQuery)
 	if err != nil {
@@ -133,6 +162,16 @@ func loadBuildingDetails(ctx context.Context, tx db.Transaction, dbBuilding mapp
 		return building, err
 	}
 
+	building.Consumptions, err = db.QueryAllTx[models.BuildingResourceConsumption](
+		ctx,
+		tx,
+		listBuildingResourceConsumptionForBuildingQuery,
+		dbBuilding.Id,
+	)
+	if err != nil {
+		return building, err
+	}
+
 	building.Storages, err = db.QueryAllTx[models.BuildingResourceStorage](
 		ctx,
 		tx,
@@ -143,5 +182,15 @@ func loadBuildingDetails(ctx context.Context, tx db.Transaction, dbBuilding mapp
 		return building, err
 	}
 
+	building.Requirements, err = db.QueryAllTx[models.BuildingRequirement](
+		ctx,
+		tx,
+		listBuildingRequirementForBuildingQuery,
+		dbBuilding.Id,
+	)
+	if err != nil {
+		return building, err
+	}
+
 	return building, nil
 }
@@ -14,7 +14,8 @@ import (
 )
 
 var (
-	metalResourceId = uuid.MustParse("b4419b6b-b3bf-4576-aa92-055283addbc8")
+	metalResourceId  = uuid.MustParse("b4419b6b-b3bf-4576-aa92-055283addbc8")
+	energyResourceId = uuid.MustParse("3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4")
 )
 
 func TestIT_BuildingRepository_Get(t *testing.T) {
@@ -47,6 +48,15 @@ func TestIT_BuildingRepository_Get(t *testing.T) {
 		assert.Equal(t, building, actual)
 	})
 
+	t.Run("gets a building with consumption", func(t *testing.T) {
+		building := insertTestBuilding(t, conn, addBuildingConsumption)
+
+		actual, err := repo.Get(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, building, actual)
+	})
+
 	t.Run("gets a building with storage", func(t *testing.T) {
 		building := insertTestBuilding(t, conn, addBuildingStorage)
 
@@ -65,6 +75,15 @@ func TestIT_BuildingRepository_Get(t *testing.T) {
 		assert.Equal(t, building, actual)
 	})
 
+	t.Run("gets a mine consuming energy", func(t *testing.T) {
+		building := insertTestBuilding(t, conn, addBuildingCost, addBuildingProduction, addBuildingConsumption)
+
+		actual, err := repo.Get(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, building, actual)
+	})
+
 	t.Run("gets a hangar-like building", func(t *testing.T) {
 		building := insertTestBuilding(t, conn, addBuildingCost, addBuildingStorage)
 
@@ -74,6 +93,15 @@ func TestIT_BuildingRepository_Get(t *testing.T) {
 		assert.Equal(t, building, actual)
 	})
 
+	t.Run("gets a building with requirements", func(t *testing.T) {
+		building := insertTestBuilding(t, conn, addBuildingRequirement)
+
+		actual, err := repo.Get(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, building, actual)
+	})
+
 	t.Run("returns error when building does not exist", func(t *testing.T) {
 		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
 		_, err := repo.Get(t.Context(), id)
@@ -82,6 +110,19 @@ func TestIT_BuildingRepository_Get(t *testing.T) {
 	})
 }
 
+func TestIT_BuildingRepository_List(t *testing.T) {
+	repo, conn := newTestBuildingRepository(t)
+
+	t.Run("lists buildings with their details", func(t *testing.T) {
+		building := insertTestBuilding(t, conn, addBuildingCost, addBuildingRequirement)
+
+		actual, err := repo.List(t.Context())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Contains(t, actual, building)
+	})
+}
+
 func newTestBuildingRepository(t *testing.T) (*BuildingRepository, db.Connection) {
 	t.Helper()
 	conn := newTestConnection(t)
@@ -101,9 +142,11 @@ func insertTestBuilding(
 		CreatedAt: someTime,
 		// This is intentional: the details (e.g. costs, productions, etc.) are returned as empty
 		// slices by the adapter
-		Costs:       []models.BuildingCost{},
-		Productions: []models.BuildingResourceProduction{},
-		Storages:    []models.BuildingResourceStorage{},
+		Costs:        []models.BuildingCost{},
+		Productions:  []models.BuildingResourceProduction{},
+		Consumptions: []models.BuildingResourceConsumption{},
+		Storages:     []models.BuildingResourceStorage{},
+		Requirements: []models.BuildingRequirement{},
 	}
 
 	sqlQuery := `INSERT INTO building (id, name, created_at) VALUES ($1, $2, $3)`
@@ -174,6 +217,31 @@ func addBuildingProduction(t *testing.T, conn db.Connection, b *models.Building)
 	b.Productions = append(b.Productions, production)
 }
 
+func addBuildingConsumption(t *testing.T, conn db.Connection, b *models.Building) {
+	t.Helper()
+
+	consumption := models.BuildingResourceConsumption{
+		Resource: energyResourceId,
+		Base:     rand.Intn(148),
+		// Progress is stored with 5 decimals in the DB
+		Progress: randFloat(t, 11, 500, 5),
+	}
+
+	sqlQuery := `INSERT INTO building_resource_consumption (building, resource, base, progress)
+		VALUES ($1, $2, $3, $4)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		b.Id,
+		consumption.Resource,
+		consumption.Base,
+		consumption.Progress,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	b.Consumptions = append(b.Consumptions, consumption)
+}
+
 func addBuildingStorage(t *testing.T, conn db.Connection, b *models.Building) {
 	t.Helper()
 
@@ -202,3 +270,26 @@ func addBuildingStorage(t *testing.T, conn db.Connection, b *models.Building) {
 
 	b.Storages = append(b.Storages, storage)
 }
+
+func addBuildingRequirement(t *testing.T, conn db.Connection, b *models.Building) {
+	t.Helper()
+
+	required := insertTestBuilding(t, conn)
+	requirement := models.BuildingRequirement{
+		Building: required.Id,
+		Level:    1 + rand.Intn(12),
+	}
+
+	sqlQuery := `INSERT INTO building_requirement (building, requirement, level)
+		VALUES ($1, $2, $3)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		b.Id,
+		requirement.Building,
+		requirement.Level,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	b.Requirements = append(b.Requirements, requirement)
+}
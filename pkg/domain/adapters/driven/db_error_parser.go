@@ -36,6 +36,18 @@ func parseForeignKeyConstraintViolation(err *db.DatabaseError) error {
 	switch err.Constraint {
 	case "player_universe_fkey":
 		return domainerrors.ErrUniverseNotFound
+	case "message_player_fkey":
+		return domainerrors.ErrPlayerNotFound
+	case "alliance_member_player_fkey":
+		return domainerrors.ErrPlayerNotFound
+	case "alliance_application_alliance_fkey":
+		return domainerrors.ErrAllianceNotFound
+	case "building_cost_resource_fkey":
+		return domainerrors.ErrInvalidBuilding
+	case "building_resource_production_resource_fkey":
+		return domainerrors.ErrInvalidBuilding
+	case "building_resource_storage_resource_fkey":
+		return domainerrors.ErrInvalidBuilding
 	default:
 		return err
 	}
@@ -45,12 +57,24 @@ func parseUniqueConstraintViolation(err *db.DatabaseError) error {
 	switch err.Constraint {
 	case "universe_name_key":
 		return domainerrors.ErrNameAlreadyTaken
+	case "resource_name_key":
+		return domainerrors.ErrNameAlreadyTaken
 	case "player_universe_name_key":
 		return domainerrors.ErrNameAlreadyTaken
-	case "building_action_planet_key":
+	case "building_action_planet_position_key":
+		return domainerrors.ErrInvalidBuildingQueue
+	case "research_action_player_key":
 		return domainerrors.ErrActionAlreadyInProgress
 	case "planet_coordinate_universe_galaxy_solar_system_position_key":
 		return domainerrors.ErrCoordinateAlreadyUsed
+	case "alliance_universe_name_key":
+		return domainerrors.ErrNameAlreadyTaken
+	case "alliance_universe_tag_key":
+		return domainerrors.ErrTagAlreadyTaken
+	case "alliance_member_pkey":
+		return domainerrors.ErrAlreadyInAlliance
+	case "alliance_application_alliance_player_key":
+		return domainerrors.ErrAlreadyApplied
 	default:
 		return err
 	}
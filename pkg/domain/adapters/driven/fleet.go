@@ -0,0 +1,337 @@
+package drivenadapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	upsertFleetQuery = `
+INSERT INTO
+	fleet (id, player, mission, origin, target, departed_at, arrives_at, returns_at, created_at, version)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (id) DO UPDATE
+SET
+	target = excluded.target,
+	returns_at = excluded.returns_at,
+	version = excluded.version`
+
+	createFleetShipQuery = `
+INSERT INTO
+	fleet_ship (fleet, ship, count)
+	VALUES ($1, $2, $3)`
+
+	createFleetCargoQuery = `
+INSERT INTO
+	fleet_cargo (fleet, resource, amount)
+	VALUES ($1, $2, $3)`
+
+	selectFleetQuery = `
+SELECT
+	f.id,
+	f.player,
+	f.mission,
+	f.origin,
+	oc.galaxy AS origin_galaxy,
+	oc.solar_system AS origin_solar_system,
+	oc.position AS origin_position,
+	f.target,
+	tc.galaxy AS target_galaxy,
+	tc.solar_system AS target_solar_system,
+	tc.position AS target_position,
+	f.departed_at,
+	f.arrives_at,
+	f.returns_at,
+	f.created_at,
+	f.version
+FROM
+	fleet AS f
+	INNER JOIN planet_coordinate AS oc ON oc.planet = f.origin
+	INNER JOIN planet_coordinate AS tc ON tc.planet = f.target`
+
+	getFleetQuery = selectFleetQuery + `
+WHERE
+	f.id = $1`
+
+	listFleetForPlayerQuery = selectFleetQuery + `
+WHERE
+	f.player = $1
+ORDER BY
+	f.departed_at,
+	f.id`
+
+	listFleetForPlanetOwnerQuery = selectFleetQuery + `
+WHERE
+	f.player = (SELECT player FROM planet WHERE id = $1)
+ORDER BY
+	f.departed_at,
+	f.id`
+
+	listFleetForPlanetQuery = selectFleetQuery + `
+WHERE
+	f.origin = $1
+	OR f.target = $1
+ORDER BY
+	f.departed_at,
+	f.id`
+
+	listFleetShipForFleetQuery = `
+SELECT
+	ship,
+	count
+FROM
+	fleet_ship
+WHERE
+	fleet = $1`
+
+	listFleetCargoForFleetQuery = `
+SELECT
+	resource,
+	amount
+FROM
+	fleet_cargo
+WHERE
+	fleet = $1`
+
+	// The fleets are locked in a consistent order to prevent dead locks
+	// when both ends of their path are mutated concurrently.
+	lockFleetsForPlanetQuery = `
+SELECT
+	id
+FROM
+	fleet
+WHERE
+	origin = $1
+	OR target = $1
+ORDER BY
+	id
+FOR UPDATE`
+
+	deleteFleetShipQuery  = `DELETE FROM fleet_ship WHERE fleet = $1`
+	deleteFleetCargoQuery = `DELETE FROM fleet_cargo WHERE fleet = $1`
+	deleteFleetQuery      = `DELETE FROM fleet WHERE id = $1`
+)
+
+func lockFleetsForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) ([]uuid.UUID, error) {
+	return db.QueryAllTx[uuid.UUID](ctx, tx, lockFleetsForPlanetQuery, planet)
+}
+
+func loadFleetAndDetails(ctx context.Context, tx db.Transaction, id uuid.UUID) (models.Fleet, error) {
+	dbFleet, err := db.QueryOneTx[mappers.DbFleet](ctx, tx, getFleetQuery, id)
+	if err != nil {
+		return models.Fleet{}, parseDbError(err)
+	}
+
+	return loadFleetDetails(ctx, tx, dbFleet)
+}
+
+func loadFleetsAndDetails(
+	ctx context.Context,
+	tx db.Transaction,
+	query string,
+	args ...any,
+) ([]models.Fleet, error) {
+	dbFleets, err := db.QueryAllTx[mappers.DbFleet](ctx, tx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	fleets := make([]models.Fleet, 0, len(dbFleets))
+	for _, dbFleet := range dbFleets {
+		fleet, err := loadFleetDetails(ctx, tx, dbFleet)
+		if err != nil {
+			return nil, err
+		}
+
+		fleets = append(fleets, fleet)
+	}
+
+	return fleets, nil
+}
+
+func loadFleetDetails(ctx context.Context, tx db.Transaction, dbFleet mappers.DbFleet) (models.Fleet, error) {
+	fleet := dbFleet.ToDomain()
+
+	var err error
+	fleet.Ships, err = db.QueryAllTx[models.FleetShip](
+		ctx,
+		tx,
+		listFleetShipForFleetQuery,
+		dbFleet.Id,
+	)
+	if err != nil {
+		return fleet, err
+	}
+
+	fleet.Cargo, err = db.QueryAllTx[models.FleetCargo](
+		ctx,
+		tx,
+		listFleetCargoForFleetQuery,
+		dbFleet.Id,
+	)
+	if err != nil {
+		return fleet, err
+	}
+
+	return fleet, nil
+}
+
+func loadFleetsForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) ([]models.Fleet, error) {
+	return loadFleetsAndDetails(ctx, tx, listFleetForPlanetQuery, planet)
+}
+
+// syncFleetsForPlanet persists the fleets attached to the planet. Unlike
+// the shipyard queue, fleets are shared with the planet at the other end
+// of their path: they are upserted rather than recreated so that their
+// identity is preserved, and only the ones which disappeared from the
+// planet are deleted.
+func syncFleetsForPlanet(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+	existing, err := lockFleetsForPlanet(ctx, tx, planet.Id)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[uuid.UUID]bool)
+	for _, fleet := range planet.Fleets {
+		err := upsertFleetWithDetails(ctx, tx, fleet)
+		if err != nil {
+			return err
+		}
+
+		kept[fleet.Id] = true
+	}
+
+	for _, id := range existing {
+		if kept[id] {
+			continue
+		}
+
+		err := deleteFleetAndDetails(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertFleetWithDetails(ctx context.Context, tx db.Transaction, fleet models.Fleet) error {
+	_, err := tx.Exec(
+		ctx,
+		upsertFleetQuery,
+		fleet.Id,
+		fleet.Player,
+		string(fleet.Mission),
+		fleet.Origin,
+		fleet.Target,
+		fleet.DepartedAt,
+		fleet.ArrivesAt,
+		fleet.ReturnsAt,
+		fleet.CreatedAt,
+		fleet.Version,
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	err = deleteFleetContent(ctx, tx, fleet.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range fleet.Ships {
+		_, err := tx.Exec(
+			ctx,
+			createFleetShipQuery,
+			fleet.Id,
+			s.Ship,
+			s.Count,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, c := range fleet.Cargo {
+		_, err := tx.Exec(
+			ctx,
+			createFleetCargoQuery,
+			fleet.Id,
+			c.Resource,
+			c.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteFleetsForPlanet removes the fleets sent from the planet. The
+// fleets flying towards it from other planets belong to those planets:
+// they are sent back to their origin as of the input time instead.
+func deleteFleetsForPlanet(
+	ctx context.Context,
+	tx db.Transaction,
+	planet uuid.UUID,
+	moment time.Time,
+) error {
+	_, err := lockFleetsForPlanet(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	fleets, err := loadFleetsForPlanet(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	for _, fleet := range fleets {
+		if fleet.Origin == planet {
+			err = deleteFleetAndDetails(ctx, tx, fleet.Id)
+		} else {
+			fleet.ReturnToOrigin(moment)
+			err = upsertFleetWithDetails(ctx, tx, fleet)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteFleetAndDetails(ctx context.Context, tx db.Transaction, id uuid.UUID) error {
+	err := deleteFleetContent(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, deleteFleetQuery, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deleteFleetContent(ctx context.Context, tx db.Transaction, id uuid.UUID) error {
+	_, err := tx.Exec(ctx, deleteFleetShipQuery, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, deleteFleetCargoQuery, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type FleetRepository struct {
+	conn db.Connection
+}
+
+func NewFleetRepository(conn db.Connection) *FleetRepository {
+	return &FleetRepository{
+		conn: conn,
+	}
+}
+
+func (r *FleetRepository) Get(ctx context.Context, id uuid.UUID) (models.Fleet, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Fleet{}, err
+	}
+	defer tx.Close(ctx)
+
+	return loadFleetAndDetails(ctx, tx, id)
+}
+
+func (r *FleetRepository) ListForPlayer(ctx context.Context, player uuid.UUID) ([]models.Fleet, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	return loadFleetsAndDetails(ctx, tx, listFleetForPlayerQuery, player)
+}
+
+func (r *FleetRepository) ListForPlanetOwner(ctx context.Context, planet uuid.UUID) ([]models.Fleet, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	return loadFleetsAndDetails(ctx, tx, listFleetForPlanetOwnerQuery, planet)
+}
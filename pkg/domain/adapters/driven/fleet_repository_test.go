@@ -0,0 +1,135 @@
+package drivenadapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_FleetRepository_Get(t *testing.T) {
+	repo, conn := newTestFleetRepository(t)
+
+	t.Run("gets a fleet with ships and cargo", func(t *testing.T) {
+		origin, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+		fleet := insertTestFleet(t, conn, origin, target)
+
+		actual, err := repo.Get(t.Context(), fleet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, fleet, actual)
+	})
+
+	t.Run("returns error when fleet does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.Get(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_FleetRepository_ListForPlayer(t *testing.T) {
+	repo, conn := newTestFleetRepository(t)
+
+	t.Run("lists fleets of the player", func(t *testing.T) {
+		origin, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+		f1 := insertTestFleet(t, conn, origin, target)
+		f2 := insertTestFleet(t, conn, target, origin)
+
+		other, _, _ := insertTestPlanetForPlayer(t, conn)
+		insertTestFleet(t, conn, other, other)
+
+		actual, err := repo.ListForPlayer(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		// Both fleets departed at the same time so their order is not
+		// deterministic.
+		assert.Len(t, actual, 2)
+		assert.Contains(t, actual, f1)
+		assert.Contains(t, actual, f2)
+	})
+
+	t.Run("returns empty slice when player has no fleet", func(t *testing.T) {
+		_, player, _ := insertTestPlanetForPlayer(t, conn)
+
+		actual, err := repo.ListForPlayer(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.Fleet{}, actual)
+	})
+}
+
+func newTestFleetRepository(t *testing.T) (*FleetRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewFleetRepository(conn), conn
+}
+
+func insertTestFleet(t *testing.T, conn db.Connection, origin models.Planet, target models.Planet) models.Fleet {
+	t.Helper()
+
+	ship := insertTestShip(t, conn)
+
+	fleet := models.Fleet{
+		Id:               uuid.New(),
+		Player:           origin.Player,
+		Mission:          models.TransportMission,
+		Origin:           origin.Id,
+		OriginCoordinate: origin.Coordinate,
+		Target:           target.Id,
+		TargetCoordinate: target.Coordinate,
+		Ships: []models.FleetShip{
+			{Ship: ship.Id, Count: 4},
+		},
+		Cargo: []models.FleetCargo{
+			{Resource: metalResourceId, Amount: 1500},
+		},
+		DepartedAt: someTime,
+		ArrivesAt:  someTime.Add(time.Hour),
+		CreatedAt:  someTime,
+		Version:    3,
+	}
+
+	sqlQuery := `INSERT INTO fleet (id, player, mission, origin, target, departed_at, arrives_at, created_at, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		fleet.Id,
+		fleet.Player,
+		string(fleet.Mission),
+		fleet.Origin,
+		fleet.Target,
+		fleet.DepartedAt,
+		fleet.ArrivesAt,
+		fleet.CreatedAt,
+		fleet.Version,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	sqlQuery = `INSERT INTO fleet_ship (fleet, ship, count) VALUES ($1, $2, $3)`
+	_, err = conn.Exec(t.Context(), sqlQuery, fleet.Id, ship.Id, fleet.Ships[0].Count)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	sqlQuery = `INSERT INTO fleet_cargo (fleet, resource, amount) VALUES ($1, $2, $3)`
+	_, err = conn.Exec(t.Context(), sqlQuery, fleet.Id, metalResourceId, fleet.Cargo[0].Amount)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return fleet
+}
+
+func assertFleetDoesNotExist(t *testing.T, conn db.Connection, id uuid.UUID) {
+	t.Helper()
+
+	sqlQuery := `SELECT COUNT(id) FROM fleet WHERE id = $1`
+	value, err := db.QueryOne[int](t.Context(), conn, sqlQuery, id)
+	require.NoError(t, err, "Actual err: %v", err)
+	require.Zero(t, value)
+}
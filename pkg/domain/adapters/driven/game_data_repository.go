@@ -0,0 +1,481 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	getResourceQuery = `
+SELECT
+	id,
+	name,
+	start_amount,
+	start_production,
+	start_storage,
+	build_time_hours_per_unit,
+	created_at
+FROM
+	resource
+WHERE
+	id = $1`
+
+	createResourceQuery = `
+INSERT INTO
+	resource (id, name, start_amount, start_production, start_storage, build_time_hours_per_unit, created_at)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7)`
+
+	updateResourceQuery = `
+UPDATE
+	resource
+SET
+	name = $1,
+	start_amount = $2,
+	start_production = $3,
+	start_storage = $4,
+	build_time_hours_per_unit = $5
+WHERE
+	id = $6`
+
+	upsertResourceQuery = `
+INSERT INTO
+	resource (id, name, start_amount, start_production, start_storage, build_time_hours_per_unit, created_at)
+VALUES
+	($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE
+SET
+	name = excluded.name,
+	start_amount = excluded.start_amount,
+	start_production = excluded.start_production,
+	start_storage = excluded.start_storage,
+	build_time_hours_per_unit = excluded.build_time_hours_per_unit`
+
+	createBuildingQuery = `
+INSERT INTO
+	building (id, name, created_at)
+VALUES
+	($1, $2, $3)`
+
+	updateBuildingQuery = `
+UPDATE
+	building
+SET
+	name = $1
+WHERE
+	id = $2`
+
+	upsertBuildingQuery = `
+INSERT INTO
+	building (id, name, created_at)
+VALUES
+	($1, $2, $3)
+ON CONFLICT (id) DO UPDATE
+SET
+	name = excluded.name`
+
+	createBuildingCostQuery = `
+INSERT INTO
+	building_cost (building, resource, cost, progress)
+VALUES
+	($1, $2, $3, $4)`
+
+	deleteBuildingCostQuery = `
+DELETE FROM
+	building_cost
+WHERE
+	building = $1`
+
+	createBuildingResourceProductionQuery = `
+INSERT INTO
+	building_resource_production (building, resource, base, progress)
+VALUES
+	($1, $2, $3, $4)`
+
+	deleteBuildingResourceProductionQuery = `
+DELETE FROM
+	building_resource_production
+WHERE
+	building = $1`
+
+	createBuildingResourceStorageQuery = `
+INSERT INTO
+	building_resource_storage (building, resource, base, scale, progress)
+VALUES
+	($1, $2, $3, $4, $5)`
+
+	deleteBuildingResourceStorageQuery = `
+DELETE FROM
+	building_resource_storage
+WHERE
+	building = $1`
+
+	// Planets created before a resource or a building need their own
+	// rows for it: they get the same values as new planets.
+	addResourceToPlanetsQuery = `
+INSERT INTO
+	planet_resource (planet, resource, amount)
+SELECT
+	id,
+	$1,
+	$2
+FROM
+	planet
+ON CONFLICT DO NOTHING`
+
+	addResourceStorageToPlanetsQuery = `
+INSERT INTO
+	planet_resource_storage (planet, resource, storage)
+SELECT
+	id,
+	$1,
+	$2
+FROM
+	planet
+ON CONFLICT DO NOTHING`
+
+	addResourceProductionToPlanetsQuery = `
+INSERT INTO
+	planet_resource_production (planet, building, resource, production)
+SELECT
+	id,
+	NULL,
+	$1,
+	$2
+FROM
+	planet
+ON CONFLICT DO NOTHING`
+
+	addBuildingToPlanetsQuery = `
+INSERT INTO
+	planet_building (planet, building, level)
+SELECT
+	id,
+	$1,
+	0
+FROM
+	planet
+ON CONFLICT DO NOTHING`
+
+	getGameDataRolesQuery = `
+SELECT
+	COALESCE((SELECT id FROM building WHERE name = $1), '00000000-0000-0000-0000-000000000000') AS shipyard_building,
+	COALESCE((SELECT id FROM technology WHERE name = $2), '00000000-0000-0000-0000-000000000000') AS energy_technology,
+	COALESCE((SELECT id FROM technology WHERE name = $3), '00000000-0000-0000-0000-000000000000') AS computer_technology`
+
+	upsertPlanetFieldRangeQuery = `
+INSERT INTO
+	planet_field_range (position, min_fields, max_fields)
+VALUES
+	($1, $2, $3)
+ON CONFLICT (position) DO UPDATE
+SET
+	min_fields = excluded.min_fields,
+	max_fields = excluded.max_fields`
+
+	updateMaxPlanetsPerPlayerQuery = `
+UPDATE
+	game_setting
+SET
+	max_planets_per_player = $1`
+)
+
+type GameDataRepository struct {
+	conn db.Connection
+}
+
+func NewGameDataRepository(conn db.Connection) *GameDataRepository {
+	return &GameDataRepository{
+		conn: conn,
+	}
+}
+
+func (r *GameDataRepository) ListResources(ctx context.Context) ([]models.Resource, error) {
+	return db.QueryAll[models.Resource](ctx, r.conn, listResourceQuery)
+}
+
+func (r *GameDataRepository) GetResource(ctx context.Context, id uuid.UUID) (models.Resource, error) {
+	resource, err := db.QueryOne[models.Resource](ctx, r.conn, getResourceQuery, id)
+	return resource, parseDbError(err)
+}
+
+func (r *GameDataRepository) CreateResource(ctx context.Context, resource models.Resource) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	_, err = tx.Exec(
+		ctx,
+		createResourceQuery,
+		resource.Id,
+		resource.Name,
+		resource.StartAmount,
+		resource.StartProduction,
+		resource.StartStorage,
+		resource.BuildTimeHoursPerUnit,
+		resource.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	return addResourceToPlanets(ctx, tx, resource)
+}
+
+func (r *GameDataRepository) UpdateResource(ctx context.Context, resource models.Resource) error {
+	affected, err := r.conn.Exec(
+		ctx,
+		updateResourceQuery,
+		resource.Name,
+		resource.StartAmount,
+		resource.StartProduction,
+		resource.StartStorage,
+		resource.BuildTimeHoursPerUnit,
+		resource.Id,
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *GameDataRepository) ListBuildings(ctx context.Context) ([]models.Building, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	return loadBuildings(ctx, tx)
+}
+
+func (r *GameDataRepository) GetBuilding(ctx context.Context, id uuid.UUID) (models.Building, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Building{}, err
+	}
+	defer tx.Close(ctx)
+
+	dbBuilding, err := db.QueryOneTx[mappers.DbBuilding](ctx, tx, getBuildingQuery, id)
+	if err != nil {
+		return models.Building{}, parseDbError(err)
+	}
+
+	return loadBuildingDetails(ctx, tx, dbBuilding)
+}
+
+func (r *GameDataRepository) CreateBuilding(ctx context.Context, building models.Building) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	_, err = tx.Exec(ctx, createBuildingQuery, building.Id, building.Name, building.CreatedAt.UTC())
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	err = createBuildingDetails(ctx, tx, building)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, addBuildingToPlanetsQuery, building.Id)
+	return err
+}
+
+func (r *GameDataRepository) UpdateBuilding(ctx context.Context, building models.Building) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	affected, err := tx.Exec(ctx, updateBuildingQuery, building.Name, building.Id)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return replaceBuildingDetails(ctx, tx, building)
+}
+
+func (r *GameDataRepository) ImportRuleset(ctx context.Context, ruleset models.Ruleset) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	for _, resource := range ruleset.Resources {
+		_, err = tx.Exec(
+			ctx,
+			upsertResourceQuery,
+			resource.Id,
+			resource.Name,
+			resource.StartAmount,
+			resource.StartProduction,
+			resource.StartStorage,
+			resource.BuildTimeHoursPerUnit,
+			resource.CreatedAt.UTC(),
+		)
+		if err != nil {
+			return parseDbError(err)
+		}
+
+		err = addResourceToPlanets(ctx, tx, resource)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, building := range ruleset.Buildings {
+		_, err = tx.Exec(ctx, upsertBuildingQuery, building.Id, building.Name, building.CreatedAt.UTC())
+		if err != nil {
+			return parseDbError(err)
+		}
+
+		err = replaceBuildingDetails(ctx, tx, building)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, addBuildingToPlanetsQuery, building.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fieldRange := range ruleset.FieldRanges {
+		_, err = tx.Exec(ctx, upsertPlanetFieldRangeQuery, fieldRange.Position, fieldRange.Min, fieldRange.Max)
+		if err != nil {
+			return parseDbError(err)
+		}
+	}
+
+	if ruleset.MaxPlanetsPerPlayer > 0 {
+		_, err = tx.Exec(ctx, updateMaxPlanetsPerPlayerQuery, ruleset.MaxPlanetsPerPlayer)
+		if err != nil {
+			return parseDbError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *GameDataRepository) GetRoles(ctx context.Context) (models.GameDataRoles, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.GameDataRoles{}, err
+	}
+	defer tx.Close(ctx)
+
+	return loadGameDataRoles(ctx, tx)
+}
+
+func loadGameDataRoles(ctx context.Context, tx db.Transaction) (models.GameDataRoles, error) {
+	return db.QueryOneTx[models.GameDataRoles](
+		ctx,
+		tx,
+		getGameDataRolesQuery,
+		models.ShipyardBuildingName,
+		models.EnergyTechnologyName,
+		models.ComputerTechnologyName,
+	)
+}
+
+// addResourceToPlanets gives the existing planets the starting amount,
+// storage and production of the resource. Energy is not stored on the
+// planets: see Universe.CreatePlanet.
+func addResourceToPlanets(ctx context.Context, tx db.Transaction, resource models.Resource) error {
+	if resource.Name == models.EnergyResourceName {
+		return nil
+	}
+
+	for _, query := range []struct {
+		sql   string
+		value int
+	}{
+		{addResourceToPlanetsQuery, resource.StartAmount},
+		{addResourceStorageToPlanetsQuery, resource.StartStorage},
+		{addResourceProductionToPlanetsQuery, resource.StartProduction},
+	} {
+		_, err := tx.Exec(ctx, query.sql, resource.Id, query.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replaceBuildingDetails(ctx context.Context, tx db.Transaction, building models.Building) error {
+	// Consumptions and requirements are not part of the balancing values
+	// and are kept as is.
+	for _, query := range []string{
+		deleteBuildingCostQuery,
+		deleteBuildingResourceProductionQuery,
+		deleteBuildingResourceStorageQuery,
+	} {
+		_, err := tx.Exec(ctx, query, building.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return createBuildingDetails(ctx, tx, building)
+}
+
+func createBuildingDetails(ctx context.Context, tx db.Transaction, building models.Building) error {
+	for _, cost := range building.Costs {
+		_, err := tx.Exec(ctx, createBuildingCostQuery, building.Id, cost.Resource, cost.Cost, cost.Progress)
+		if err != nil {
+			return parseDbError(err)
+		}
+	}
+
+	for _, production := range building.Productions {
+		_, err := tx.Exec(
+			ctx,
+			createBuildingResourceProductionQuery,
+			building.Id,
+			production.Resource,
+			production.Base,
+			production.Progress,
+		)
+		if err != nil {
+			return parseDbError(err)
+		}
+	}
+
+	for _, storage := range building.Storages {
+		_, err := tx.Exec(
+			ctx,
+			createBuildingResourceStorageQuery,
+			building.Id,
+			storage.Resource,
+			storage.Base,
+			storage.Scale,
+			storage.Progress,
+		)
+		if err != nil {
+			return parseDbError(err)
+		}
+	}
+
+	return nil
+}
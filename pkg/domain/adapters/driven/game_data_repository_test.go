@@ -0,0 +1,370 @@
+package drivenadapters
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_GameDataRepository_ListResources(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+	resource := insertTestResource(t, conn)
+
+	actual, err := repo.ListResources(t.Context())
+	require.NoError(t, err, "Actual err: %v", err)
+
+	assert.Contains(t, actual, resource)
+}
+
+func TestIT_GameDataRepository_CreateResource(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+
+	t.Run("creates resource", func(t *testing.T) {
+		resource := models.Resource{
+			Id:                    uuid.New(),
+			Name:                  fmt.Sprintf("my-resource-%s", uuid.NewString()),
+			StartAmount:           12,
+			StartProduction:       34,
+			StartStorage:          5678,
+			BuildTimeHoursPerUnit: 0.25,
+			CreatedAt:             someTime,
+		}
+
+		err := repo.CreateResource(t.Context(), resource)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetResource(t.Context(), resource.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, resource, actual)
+	})
+
+	t.Run("adds resource to existing planets", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		resource := models.Resource{
+			Id:              uuid.New(),
+			Name:            fmt.Sprintf("my-resource-%s", uuid.NewString()),
+			StartAmount:     12,
+			StartProduction: 34,
+			StartStorage:    5678,
+			CreatedAt:       someTime,
+		}
+
+		err := repo.CreateResource(t.Context(), resource)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertPlanetResourceAmount(t, conn, planet.Id, resource.Id, 12)
+		assertPlanetResourceStorage(t, conn, planet.Id, resource.Id, 5678)
+		assertPlanetResourceProduction(t, conn, planet.Id, resource.Id, nil, 34)
+	})
+
+	t.Run("returns error when name is already taken", func(t *testing.T) {
+		existing := insertTestResource(t, conn)
+		resource := models.Resource{
+			Id:        uuid.New(),
+			Name:      existing.Name,
+			CreatedAt: someTime,
+		}
+
+		err := repo.CreateResource(t.Context(), resource)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNameAlreadyTaken, "Actual err: %v", err)
+	})
+}
+
+func TestIT_GameDataRepository_UpdateResource(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+
+	t.Run("updates resource", func(t *testing.T) {
+		resource := insertTestResource(t, conn)
+		resource.StartAmount = 987
+		resource.BuildTimeHoursPerUnit = 0.5
+
+		err := repo.UpdateResource(t.Context(), resource)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetResource(t.Context(), resource.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, resource, actual)
+	})
+
+	t.Run("returns error when resource does not exist", func(t *testing.T) {
+		resource := models.Resource{
+			Id:   uuid.MustParse("00000000-1111-2222-1111-000000000000"),
+			Name: fmt.Sprintf("my-resource-%s", uuid.NewString()),
+		}
+
+		err := repo.UpdateResource(t.Context(), resource)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_GameDataRepository_ListBuildings(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+	building := insertTestBuilding(t, conn, addBuildingCost, addBuildingStorage)
+
+	actual, err := repo.ListBuildings(t.Context())
+	require.NoError(t, err, "Actual err: %v", err)
+
+	assert.Contains(t, actual, building)
+}
+
+func TestIT_GameDataRepository_CreateBuilding(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+
+	t.Run("creates building with its details", func(t *testing.T) {
+		building := generateTestGameDataBuilding(metalResourceId)
+
+		err := repo.CreateBuilding(t.Context(), building)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetBuilding(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, building, actual)
+	})
+
+	t.Run("adds building to existing planets", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		building := generateTestGameDataBuilding(metalResourceId)
+
+		err := repo.CreateBuilding(t.Context(), building)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertPlanetBuildingLevel(t, conn, planet.Id, building.Id, 0)
+	})
+
+	t.Run("returns error and creates nothing when resource does not exist", func(t *testing.T) {
+		building := generateTestGameDataBuilding(uuid.MustParse("00000000-1111-2222-1111-000000000000"))
+
+		err := repo.CreateBuilding(t.Context(), building)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuilding, "Actual err: %v", err)
+
+		_, err = repo.GetBuilding(t.Context(), building.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_GameDataRepository_UpdateBuilding(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+
+	t.Run("replaces details of building", func(t *testing.T) {
+		existing := insertTestBuilding(t, conn, addBuildingCost, addBuildingProduction, addBuildingConsumption)
+		building := generateTestGameDataBuilding(metalResourceId)
+		building.Id = existing.Id
+		building.CreatedAt = existing.CreatedAt
+
+		err := repo.UpdateBuilding(t.Context(), building)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetBuilding(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		building.Consumptions = existing.Consumptions
+		assert.Equal(t, building, actual)
+	})
+
+	t.Run("keeps building when resource does not exist", func(t *testing.T) {
+		existing := insertTestBuilding(t, conn, addBuildingCost)
+		building := generateTestGameDataBuilding(uuid.MustParse("00000000-1111-2222-1111-000000000000"))
+		building.Id = existing.Id
+
+		err := repo.UpdateBuilding(t.Context(), building)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuilding, "Actual err: %v", err)
+
+		actual, err := repo.GetBuilding(t.Context(), existing.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, existing, actual)
+	})
+
+	t.Run("returns error when building does not exist", func(t *testing.T) {
+		building := generateTestGameDataBuilding(metalResourceId)
+
+		err := repo.UpdateBuilding(t.Context(), building)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_GameDataRepository_ImportRuleset(t *testing.T) {
+	repo, conn := newTestGameDataRepository(t)
+
+	t.Run("creates and updates game data", func(t *testing.T) {
+		existing := insertTestResource(t, conn)
+		existing.StartAmount = 987
+		resource := models.Resource{
+			Id:                    uuid.New(),
+			Name:                  fmt.Sprintf("my-resource-%s", uuid.NewString()),
+			StartStorage:          5678,
+			BuildTimeHoursPerUnit: 0.25,
+			CreatedAt:             someTime,
+		}
+		building := generateTestGameDataBuilding(metalResourceId)
+		fieldRange := models.PlanetFieldRange{Position: 1000 + rand.Intn(1000), Min: 12, Max: 34}
+		defer deleteTestPlanetFieldRange(t, conn, fieldRange.Position)
+		ruleset := models.Ruleset{
+			Resources:   []models.Resource{existing, resource},
+			Buildings:   []models.Building{building},
+			FieldRanges: []models.PlanetFieldRange{fieldRange},
+		}
+
+		err := repo.ImportRuleset(t.Context(), ruleset)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetResource(t.Context(), existing.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, existing, actual)
+		actual, err = repo.GetResource(t.Context(), resource.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, resource, actual)
+		actualBuilding, err := repo.GetBuilding(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, building, actualBuilding)
+		assertPlanetFieldRange(t, conn, fieldRange)
+	})
+
+	t.Run("updates the colony limit", func(t *testing.T) {
+		defer resetTestMaxPlanetsPerPlayer(t, conn)
+		ruleset := models.Ruleset{MaxPlanetsPerPlayer: 4}
+
+		err := repo.ImportRuleset(t.Context(), ruleset)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertMaxPlanetsPerPlayer(t, conn, 4)
+	})
+
+	t.Run("keeps the colony limit when it is not set", func(t *testing.T) {
+		err := repo.ImportRuleset(t.Context(), models.Ruleset{})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertMaxPlanetsPerPlayer(t, conn, defaultMaxPlanetsPerPlayer)
+	})
+
+	t.Run("keeps existing building details when they are not balancing values", func(t *testing.T) {
+		existing := insertTestBuilding(t, conn, addBuildingCost, addBuildingConsumption)
+		building := generateTestGameDataBuilding(metalResourceId)
+		building.Id = existing.Id
+		building.CreatedAt = existing.CreatedAt
+		ruleset := models.Ruleset{
+			Buildings: []models.Building{building},
+		}
+
+		err := repo.ImportRuleset(t.Context(), ruleset)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.GetBuilding(t.Context(), building.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		building.Consumptions = existing.Consumptions
+		assert.Equal(t, building, actual)
+	})
+
+	t.Run("returns error and imports nothing when resource does not exist", func(t *testing.T) {
+		resource := models.Resource{
+			Id:        uuid.New(),
+			Name:      fmt.Sprintf("my-resource-%s", uuid.NewString()),
+			CreatedAt: someTime,
+		}
+		building := generateTestGameDataBuilding(uuid.MustParse("00000000-1111-2222-1111-000000000000"))
+		ruleset := models.Ruleset{
+			Resources: []models.Resource{resource},
+			Buildings: []models.Building{building},
+		}
+
+		err := repo.ImportRuleset(t.Context(), ruleset)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuilding, "Actual err: %v", err)
+
+		_, err = repo.GetResource(t.Context(), resource.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+		_, err = repo.GetBuilding(t.Context(), building.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+// defaultMaxPlanetsPerPlayer is the colony limit set by the migrations.
+const defaultMaxPlanetsPerPlayer = 9
+
+// seededRoles are the game data roles of the seed data of the database.
+var seededRoles = models.GameDataRoles{
+	ShipyardBuilding:   uuid.MustParse("58d75842-6dc0-4ac0-b36d-55f91b8d060d"),
+	EnergyTechnology:   uuid.MustParse("48315497-51de-4e2a-afe0-9bf3c9f00278"),
+	ComputerTechnology: uuid.MustParse("78e99c8b-99f6-4e14-be92-cd275dc7c05b"),
+}
+
+func TestIT_GameDataRepository_GetRoles(t *testing.T) {
+	repo, _ := newTestGameDataRepository(t)
+
+	actual, err := repo.GetRoles(t.Context())
+	require.NoError(t, err, "Actual err: %v", err)
+
+	assert.Equal(t, seededRoles, actual)
+}
+
+func newTestGameDataRepository(t *testing.T) (*GameDataRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewGameDataRepository(conn), conn
+}
+
+func generateTestGameDataBuilding(resource uuid.UUID) models.Building {
+	return models.Building{
+		Id:        uuid.New(),
+		Name:      fmt.Sprintf("my-building-%s", uuid.NewString()),
+		CreatedAt: someTime,
+		Costs: []models.BuildingCost{
+			{
+				Resource: resource,
+				Cost:     60,
+				Progress: 1.5,
+				// Comes from the resource
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+		},
+		Productions: []models.BuildingResourceProduction{
+			{Resource: resource, Base: 30, Progress: 1.1},
+		},
+		Consumptions: []models.BuildingResourceConsumption{},
+		Storages: []models.BuildingResourceStorage{
+			{Resource: resource, Base: 5000, Scale: 2.5, Progress: 1.6},
+		},
+		Requirements: []models.BuildingRequirement{},
+	}
+}
+
+func assertPlanetFieldRange(t *testing.T, conn db.Connection, expected models.PlanetFieldRange) {
+	t.Helper()
+
+	sqlQuery := `SELECT position, min_fields AS min, max_fields AS max FROM planet_field_range WHERE position = $1`
+	value, err := db.QueryOne[models.PlanetFieldRange](t.Context(), conn, sqlQuery, expected.Position)
+	require.NoError(t, err, "Actual err: %v", err)
+	require.Equal(t, expected, value)
+}
+
+func deleteTestPlanetFieldRange(t *testing.T, conn db.Connection, position int) {
+	t.Helper()
+
+	sqlQuery := `DELETE FROM planet_field_range WHERE position = $1`
+	_, err := conn.Exec(t.Context(), sqlQuery, position)
+	require.NoError(t, err, "Actual err: %v", err)
+}
+
+func assertMaxPlanetsPerPlayer(t *testing.T, conn db.Connection, expected int) {
+	t.Helper()
+
+	sqlQuery := `SELECT max_planets_per_player FROM game_setting`
+	value, err := db.QueryOne[int](t.Context(), conn, sqlQuery)
+	require.NoError(t, err, "Actual err: %v", err)
+	require.Equal(t, expected, value)
+}
+
+func resetTestMaxPlanetsPerPlayer(t *testing.T, conn db.Connection) {
+	t.Helper()
+
+	sqlQuery := `UPDATE game_setting SET max_planets_per_player = $1`
+	_, err := conn.Exec(t.Context(), sqlQuery, defaultMaxPlanetsPerPlayer)
+	require.NoError(t, err, "Actual err: %v", err)
+}
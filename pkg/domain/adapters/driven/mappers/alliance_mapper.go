@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type DbAlliance struct {
+	Id        uuid.UUID
+	Universe  uuid.UUID
+	Name      string
+	Tag       string
+	CreatedAt time.Time
+}
+
+func (a DbAlliance) ToDomain() models.Alliance {
+	return models.Alliance{
+		Id:        a.Id,
+		Universe:  a.Universe,
+		Name:      a.Name,
+		Tag:       a.Tag,
+		CreatedAt: a.CreatedAt,
+	}
+}
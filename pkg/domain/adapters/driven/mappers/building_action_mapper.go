@@ -10,6 +10,7 @@ import (
 type DbBuildingAction struct {
 	Id           uuid.UUID
 	Building     uuid.UUID
+	Kind         string
 	DesiredLevel int
 
 	CreatedAt   time.Time
@@ -19,6 +20,7 @@ type DbBuildingAction struct {
 func (a DbBuildingAction) ToDomain() models.BuildingAction {
 	return models.BuildingAction{
 		Id:           a.Id,
+		Kind:         models.BuildingActionKind(a.Kind),
 		Building:     a.Building,
 		DesiredLevel: a.DesiredLevel,
 
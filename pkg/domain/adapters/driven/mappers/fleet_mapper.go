@@ -0,0 +1,82 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type DbFleet struct {
+	Id      uuid.UUID
+	Player  uuid.UUID
+	Mission string
+
+	Origin            uuid.UUID
+	OriginGalaxy      int
+	OriginSolarSystem int
+	OriginPosition    int
+
+	Target            uuid.UUID
+	TargetGalaxy      int
+	TargetSolarSystem int
+	TargetPosition    int
+
+	DepartedAt time.Time
+	ArrivesAt  time.Time
+	ReturnsAt  *time.Time
+
+	CreatedAt time.Time
+
+	Version int
+}
+
+func (f DbFleet) ToDomain() models.Fleet {
+	return models.Fleet{
+		Id:      f.Id,
+		Player:  f.Player,
+		Mission: models.FleetMission(f.Mission),
+
+		Origin: f.Origin,
+		OriginCoordinate: models.Coordinate{
+			Galaxy:      f.OriginGalaxy,
+			SolarSystem: f.OriginSolarSystem,
+			Position:    f.OriginPosition,
+		},
+		Target: f.Target,
+		TargetCoordinate: models.Coordinate{
+			Galaxy:      f.TargetGalaxy,
+			SolarSystem: f.TargetSolarSystem,
+			Position:    f.TargetPosition,
+		},
+
+		DepartedAt: f.DepartedAt,
+		ArrivesAt:  f.ArrivesAt,
+		ReturnsAt:  f.ReturnsAt,
+
+		CreatedAt: f.CreatedAt,
+
+		Version: f.Version,
+	}
+}
+
+type DbFleetTarget struct {
+	Planet uuid.UUID
+	Player uuid.UUID
+
+	Galaxy      int
+	SolarSystem int
+	Position    int
+}
+
+func (t DbFleetTarget) ToDomain() models.FleetTarget {
+	return models.FleetTarget{
+		Planet: t.Planet,
+		Player: t.Player,
+		Coordinate: models.Coordinate{
+			Galaxy:      t.Galaxy,
+			SolarSystem: t.SolarSystem,
+			Position:    t.Position,
+		},
+	}
+}
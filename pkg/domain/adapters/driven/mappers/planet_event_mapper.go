@@ -0,0 +1,33 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+// DbPlanetEvent keeps the state of the action as stored: it is decoded by
+// the repository.
+type DbPlanetEvent struct {
+	Id            uuid.UUID
+	Planet        uuid.UUID
+	Kind          string
+	Action        *uuid.UUID
+	ActionState   []byte
+	VersionBefore int
+	VersionAfter  int
+	CreatedAt     time.Time
+}
+
+func (e DbPlanetEvent) ToDomain() models.PlanetEvent {
+	return models.PlanetEvent{
+		Id:            e.Id,
+		Planet:        e.Planet,
+		Kind:          models.PlanetEventKind(e.Kind),
+		Action:        e.Action,
+		VersionBefore: e.VersionBefore,
+		VersionAfter:  e.VersionAfter,
+		CreatedAt:     e.CreatedAt,
+	}
+}
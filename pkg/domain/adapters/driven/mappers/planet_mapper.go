@@ -23,8 +23,6 @@ type DbPlanet struct {
 	UpdatedAt time.Time
 
 	Version int
-
-	BuildingAction *uuid.UUID
 }
 
 func (p DbPlanet) ToDomain() models.Planet {
@@ -18,10 +18,18 @@ type DbPlayer struct {
 	Version int
 
 	Homeworld uuid.UUID
+
+	Rank   int
+	Points int
+
+	AllianceId   *uuid.UUID
+	AllianceName *string
+	AllianceTag  *string
+	AllianceRank *string
 }
 
 func (p DbPlayer) ToDomain() models.Player {
-	return models.Player{
+	player := models.Player{
 		Id:        p.Id,
 		ApiUser:   p.ApiUser,
 		Universe:  p.Universe,
@@ -29,5 +37,18 @@ func (p DbPlayer) ToDomain() models.Player {
 		CreatedAt: p.CreatedAt,
 		Version:   p.Version,
 		Homeworld: p.Homeworld,
+		Rank:      p.Rank,
+		Points:    p.Points,
 	}
+
+	if p.AllianceId != nil {
+		player.Alliance = &models.PlayerAlliance{
+			Id:   *p.AllianceId,
+			Name: *p.AllianceName,
+			Tag:  *p.AllianceTag,
+			Rank: models.AllianceRank(*p.AllianceRank),
+		}
+	}
+
+	return player
 }
@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type DbResearchAction struct {
+	Id           uuid.UUID
+	Player       uuid.UUID
+	Planet       uuid.UUID
+	Technology   uuid.UUID
+	DesiredLevel int
+
+	CreatedAt   time.Time
+	CompletedAt time.Time
+}
+
+func (a DbResearchAction) ToDomain() models.ResearchAction {
+	return models.ResearchAction{
+		Id:           a.Id,
+		Player:       a.Player,
+		Planet:       a.Planet,
+		Technology:   a.Technology,
+		DesiredLevel: a.DesiredLevel,
+
+		CreatedAt:   a.CreatedAt,
+		CompletedAt: a.CompletedAt,
+	}
+}
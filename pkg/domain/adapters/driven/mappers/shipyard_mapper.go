@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type DbShip struct {
+	Id            uuid.UUID
+	Name          string
+	Speed         int
+	CargoCapacity int
+	CreatedAt     time.Time
+}
+
+func (s DbShip) ToDomain() models.Ship {
+	return models.Ship{
+		Id:            s.Id,
+		Name:          s.Name,
+		CreatedAt:     s.CreatedAt,
+		Speed:         s.Speed,
+		CargoCapacity: s.CargoCapacity,
+	}
+}
+
+type DbDefense struct {
+	Id        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+func (d DbDefense) ToDomain() models.Defense {
+	return models.Defense{
+		Id:        d.Id,
+		Name:      d.Name,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+type DbShipyardAction struct {
+	Id      uuid.UUID
+	Ship    *uuid.UUID
+	Defense *uuid.UUID
+
+	Count           int
+	UnitBuildTimeMs int64
+
+	CreatedAt   time.Time
+	CompletedAt time.Time
+}
+
+func (a DbShipyardAction) ToDomain() models.ShipyardAction {
+	out := models.ShipyardAction{
+		Id:            a.Id,
+		Count:         a.Count,
+		UnitBuildTime: time.Duration(a.UnitBuildTimeMs) * time.Millisecond,
+		CreatedAt:     a.CreatedAt,
+		CompletedAt:   a.CompletedAt,
+	}
+
+	if a.Ship != nil {
+		out.Kind = models.ShipUnit
+		out.Unit = *a.Ship
+	}
+	if a.Defense != nil {
+		out.Kind = models.DefenseUnit
+		out.Unit = *a.Defense
+	}
+
+	return out
+}
@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type DbTechnology struct {
+	Id        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+func (t DbTechnology) ToDomain() models.Technology {
+	return models.Technology{
+		Id:        t.Id,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+	}
+}
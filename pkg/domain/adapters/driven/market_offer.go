@@ -0,0 +1,197 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	upsertMarketOfferQuery = `
+INSERT INTO
+	market_offer (
+		id,
+		universe,
+		player,
+		planet,
+		offered_resource,
+		offered_amount,
+		requested_resource,
+		requested_amount,
+		status,
+		buyer,
+		buyer_planet,
+		accepted_at,
+		expires_at,
+		created_at,
+		version
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (id) DO UPDATE
+SET
+	status = excluded.status,
+	buyer = excluded.buyer,
+	buyer_planet = excluded.buyer_planet,
+	accepted_at = excluded.accepted_at,
+	version = excluded.version`
+
+	// The version check guarantees that an offer is only accepted once
+	// when several players try to accept it concurrently.
+	acceptMarketOfferQuery = `
+UPDATE
+	market_offer
+SET
+	status = $1,
+	buyer = $2,
+	buyer_planet = $3,
+	accepted_at = $4,
+	version = $5
+WHERE
+	id = $6
+	AND version = $7`
+
+	selectMarketOfferQuery = `
+SELECT
+	id,
+	universe,
+	player,
+	planet,
+	offered_resource,
+	offered_amount,
+	requested_resource,
+	requested_amount,
+	status,
+	buyer,
+	buyer_planet,
+	accepted_at,
+	expires_at,
+	created_at,
+	version
+FROM
+	market_offer`
+
+	getMarketOfferQuery = selectMarketOfferQuery + `
+WHERE
+	id = $1`
+
+	listOpenMarketOfferForUniverseQuery = selectMarketOfferQuery + `
+WHERE
+	universe = $1
+	AND status = 'open'
+ORDER BY
+	created_at DESC,
+	id
+LIMIT $2
+OFFSET $3`
+
+	listPendingMarketOfferForPlanetQuery = selectMarketOfferQuery + `
+WHERE
+	planet = $1
+	AND status IN ('open', 'accepted')
+ORDER BY
+	created_at,
+	id`
+
+	// Pending offers are locked along with the planet: they can also be
+	// modified by the planet of the buyer when they are accepted.
+	lockPendingMarketOffersForPlanetQuery = `
+SELECT
+	id
+FROM
+	market_offer
+WHERE
+	planet = $1
+	AND status IN ('open', 'accepted')
+ORDER BY
+	id
+FOR UPDATE`
+
+	deleteMarketOffersForPlanetQuery = `DELETE FROM market_offer WHERE planet = $1`
+)
+
+func lockPendingMarketOffersForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) ([]uuid.UUID, error) {
+	return db.QueryAllTx[uuid.UUID](ctx, tx, lockPendingMarketOffersForPlanetQuery, planet)
+}
+
+func loadPendingMarketOffersForPlanet(
+	ctx context.Context,
+	tx db.Transaction,
+	planet uuid.UUID,
+) ([]models.MarketOffer, error) {
+	return db.QueryAllTx[models.MarketOffer](ctx, tx, listPendingMarketOfferForPlanetQuery, planet)
+}
+
+// syncMarketOffersForPlanet persists the offers attached to the planet.
+// The offers posted from the planet are locked with it and can safely be
+// upserted. An offer accepted from the planet belongs to another planet:
+// it is only updated if nobody modified it since it was fetched, which
+// prevents paying twice for the same escrow.
+func syncMarketOffersForPlanet(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+	for _, offer := range planet.MarketOffers {
+		var err error
+		if offer.Planet == planet.Id {
+			err = upsertMarketOffer(ctx, tx, offer)
+		} else {
+			err = acceptMarketOffer(ctx, tx, offer)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertMarketOffer(ctx context.Context, tx db.Transaction, offer models.MarketOffer) error {
+	_, err := tx.Exec(
+		ctx,
+		upsertMarketOfferQuery,
+		offer.Id,
+		offer.Universe,
+		offer.Player,
+		offer.Planet,
+		offer.OfferedResource,
+		offer.OfferedAmount,
+		offer.RequestedResource,
+		offer.RequestedAmount,
+		string(offer.Status),
+		offer.Buyer,
+		offer.BuyerPlanet,
+		offer.AcceptedAt,
+		offer.ExpiresAt,
+		offer.CreatedAt,
+		offer.Version,
+	)
+	return parseDbError(err)
+}
+
+func acceptMarketOffer(ctx context.Context, tx db.Transaction, offer models.MarketOffer) error {
+	affected, err := tx.Exec(
+		ctx,
+		acceptMarketOfferQuery,
+		string(offer.Status),
+		offer.Buyer,
+		offer.BuyerPlanet,
+		offer.AcceptedAt,
+		offer.Version,
+		offer.Id,
+		offer.Version-1,
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrMarketOfferNotAvailable
+	}
+
+	return nil
+}
+
+func deleteMarketOffersForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) error {
+	_, err := tx.Exec(ctx, deleteMarketOffersForPlanetQuery, planet)
+	return err
+}
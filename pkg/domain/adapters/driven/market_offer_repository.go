@@ -0,0 +1,37 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type MarketOfferRepository struct {
+	conn db.Connection
+}
+
+func NewMarketOfferRepository(conn db.Connection) *MarketOfferRepository {
+	return &MarketOfferRepository{
+		conn: conn,
+	}
+}
+
+func (r *MarketOfferRepository) Get(ctx context.Context, id uuid.UUID) (models.MarketOffer, error) {
+	offer, err := db.QueryOne[models.MarketOffer](ctx, r.conn, getMarketOfferQuery, id)
+	if err != nil {
+		return models.MarketOffer{}, parseDbError(err)
+	}
+
+	return offer, nil
+}
+
+func (r *MarketOfferRepository) ListForUniverse(
+	ctx context.Context,
+	universe uuid.UUID,
+	offset int,
+	limit int,
+) ([]models.MarketOffer, error) {
+	return db.QueryAll[models.MarketOffer](ctx, r.conn, listOpenMarketOfferForUniverseQuery, universe, limit, offset)
+}
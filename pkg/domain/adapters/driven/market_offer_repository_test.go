@@ -0,0 +1,158 @@
+package drivenadapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_MarketOfferRepository_Get(t *testing.T) {
+	repo, conn := newTestMarketOfferRepository(t)
+
+	t.Run("gets a market offer", func(t *testing.T) {
+		planet, _, universe := insertTestPlanetForPlayer(t, conn)
+		offer := insertTestMarketOffer(t, conn, planet, universe.Id)
+
+		actual, err := repo.Get(t.Context(), offer.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, offer, actual)
+	})
+
+	t.Run("returns error when offer does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.Get(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_MarketOfferRepository_ListForUniverse(t *testing.T) {
+	repo, conn := newTestMarketOfferRepository(t)
+
+	t.Run("lists open offers of the universe most recent first", func(t *testing.T) {
+		planet, _, universe := insertTestPlanetForPlayer(t, conn)
+		o1 := insertTestMarketOffer(t, conn, planet, universe.Id)
+		o2 := insertTestMarketOffer(t, conn, planet, universe.Id, func(o *models.MarketOffer) {
+			o.CreatedAt = someOtherTime
+		})
+		insertTestMarketOffer(t, conn, planet, universe.Id, func(o *models.MarketOffer) {
+			o.Status = models.CancelledOffer
+		})
+
+		other, _, otherUniverse := insertTestPlanetForPlayer(t, conn)
+		insertTestMarketOffer(t, conn, other, otherUniverse.Id)
+
+		actual, err := repo.ListForUniverse(t.Context(), universe.Id, 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.MarketOffer{o2, o1}, actual)
+	})
+
+	t.Run("returns requested page", func(t *testing.T) {
+		planet, _, universe := insertTestPlanetForPlayer(t, conn)
+		o1 := insertTestMarketOffer(t, conn, planet, universe.Id)
+		insertTestMarketOffer(t, conn, planet, universe.Id, func(o *models.MarketOffer) {
+			o.CreatedAt = someOtherTime
+		})
+
+		actual, err := repo.ListForUniverse(t.Context(), universe.Id, 1, 1)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.MarketOffer{o1}, actual)
+	})
+
+	t.Run("returns empty slice when universe has no offer", func(t *testing.T) {
+		universe := insertTestUniverse(t, conn)
+
+		actual, err := repo.ListForUniverse(t.Context(), universe.Id, 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.MarketOffer{}, actual)
+	})
+}
+
+func newTestMarketOfferRepository(t *testing.T) (*MarketOfferRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewMarketOfferRepository(conn), conn
+}
+
+func insertTestMarketOffer(
+	t *testing.T,
+	conn db.Connection,
+	planet models.Planet,
+	universe uuid.UUID,
+	modifiers ...func(*models.MarketOffer),
+) models.MarketOffer {
+	t.Helper()
+
+	offer := models.MarketOffer{
+		Id:                uuid.New(),
+		Universe:          universe,
+		Player:            planet.Player,
+		Planet:            planet.Id,
+		OfferedResource:   metalResourceId,
+		OfferedAmount:     500,
+		RequestedResource: crystalResourceId,
+		RequestedAmount:   250,
+		Status:            models.OpenOffer,
+		ExpiresAt:         someTime.Add(12 * time.Hour),
+		CreatedAt:         someTime,
+		Version:           2,
+	}
+
+	for _, modifier := range modifiers {
+		modifier(&offer)
+	}
+
+	sqlQuery := `INSERT INTO market_offer (
+			id,
+			universe,
+			player,
+			planet,
+			offered_resource,
+			offered_amount,
+			requested_resource,
+			requested_amount,
+			status,
+			expires_at,
+			created_at,
+			version
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		offer.Id,
+		offer.Universe,
+		offer.Player,
+		offer.Planet,
+		offer.OfferedResource,
+		offer.OfferedAmount,
+		offer.RequestedResource,
+		offer.RequestedAmount,
+		string(offer.Status),
+		offer.ExpiresAt,
+		offer.CreatedAt,
+		offer.Version,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return offer
+}
+
+func assertMarketOfferStatus(t *testing.T, conn db.Connection, id uuid.UUID, status models.MarketOfferStatus) {
+	t.Helper()
+
+	sqlQuery := `SELECT status FROM market_offer WHERE id = $1`
+	value, err := db.QueryOne[string](t.Context(), conn, sqlQuery, id)
+	require.NoError(t, err, "Actual err: %v", err)
+	require.Equal(t, string(status), value)
+}
@@ -0,0 +1,148 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	createMessageQuery = `
+INSERT INTO
+	message (id, kind, player, sender, subject, content, read, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	getMessageQuery = `
+SELECT
+	id,
+	kind,
+	player,
+	sender,
+	subject,
+	content,
+	read,
+	created_at
+FROM
+	message
+WHERE
+	id = $1`
+
+	listMessagesForPlayerQuery = `
+SELECT
+	id,
+	kind,
+	player,
+	sender,
+	subject,
+	content,
+	read,
+	created_at
+FROM
+	message
+WHERE
+	player = $1
+ORDER BY
+	created_at DESC,
+	id
+LIMIT $2
+OFFSET $3`
+
+	markMessageAsReadQuery = `UPDATE message SET read = true WHERE id = $1`
+
+	deleteMessageQuery = `DELETE FROM message WHERE id = $1`
+)
+
+type MessageRepository struct {
+	conn db.Connection
+}
+
+func NewMessageRepository(conn db.Connection) *MessageRepository {
+	return &MessageRepository{
+		conn: conn,
+	}
+}
+
+func (r *MessageRepository) Create(ctx context.Context, message models.Message) error {
+	_, err := r.conn.Exec(
+		ctx,
+		createMessageQuery,
+		message.Id,
+		message.Kind,
+		message.Player,
+		message.Sender,
+		message.Subject,
+		message.Content,
+		message.Read,
+		message.CreatedAt.UTC(),
+	)
+	return parseDbError(err)
+}
+
+func (r *MessageRepository) Get(ctx context.Context, id uuid.UUID) (models.Message, error) {
+	message, err := db.QueryOne[models.Message](ctx, r.conn, getMessageQuery, id)
+	if err != nil {
+		return models.Message{}, parseDbError(err)
+	}
+
+	return message, nil
+}
+
+func (r *MessageRepository) ListForPlayer(
+	ctx context.Context,
+	player uuid.UUID,
+	offset int,
+	limit int,
+) ([]models.Message, error) {
+	return db.QueryAll[models.Message](ctx, r.conn, listMessagesForPlayerQuery, player, limit, offset)
+}
+
+func (r *MessageRepository) MarkAsRead(ctx context.Context, id uuid.UUID) error {
+	affected, err := r.conn.Exec(ctx, markMessageAsReadQuery, id)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MessageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	affected, err := r.conn.Exec(ctx, deleteMessageQuery, id)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+// createMessages persists the messages generated while mutating a planet:
+// they are created in the same transaction as the planet modifications.
+func createMessages(ctx context.Context, tx db.Transaction, messages []models.Message) error {
+	for _, message := range messages {
+		_, err := tx.Exec(
+			ctx,
+			createMessageQuery,
+			message.Id,
+			message.Kind,
+			message.Player,
+			message.Sender,
+			message.Subject,
+			message.Content,
+			message.Read,
+			message.CreatedAt.UTC(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
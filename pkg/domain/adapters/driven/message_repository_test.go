@@ -0,0 +1,186 @@
+package drivenadapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_MessageRepository_Create(t *testing.T) {
+	repo, conn := newTestMessageRepository(t)
+
+	t.Run("creates a message", func(t *testing.T) {
+		sender, universe := insertTestPlayerInUniverse(t, conn)
+		recipient := insertTestPlayer(t, conn, universe.Id)
+
+		message := models.Message{
+			Id:        uuid.New(),
+			Kind:      models.PlayerMessage,
+			Player:    recipient.Id,
+			Sender:    &sender.Id,
+			Subject:   "hello",
+			Content:   "how are you?",
+			CreatedAt: someTime,
+		}
+		err := repo.Create(t.Context(), message)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), message.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, message, actual)
+	})
+
+	t.Run("creates a system message", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+
+		message := models.Message{
+			Id:        uuid.New(),
+			Kind:      models.SystemMessage,
+			Player:    player.Id,
+			Subject:   "Building upgraded",
+			Content:   "Building metal mine reached level 1.",
+			CreatedAt: someTime,
+		}
+		err := repo.Create(t.Context(), message)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), message.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, message, actual)
+	})
+
+	t.Run("returns error when player does not exist", func(t *testing.T) {
+		message := models.Message{
+			Id:        uuid.New(),
+			Kind:      models.SystemMessage,
+			Player:    uuid.New(),
+			Subject:   "subject",
+			Content:   "content",
+			CreatedAt: someTime,
+		}
+		err := repo.Create(t.Context(), message)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayerNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_MessageRepository_Get(t *testing.T) {
+	repo, _ := newTestMessageRepository(t)
+
+	t.Run("returns error when message does not exist", func(t *testing.T) {
+		_, err := repo.Get(t.Context(), uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_MessageRepository_ListForPlayer(t *testing.T) {
+	repo, conn := newTestMessageRepository(t)
+
+	t.Run("returns most recent messages first", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		older := insertTestMessage(t, conn, player.Id, someTime)
+		newer := insertTestMessage(t, conn, player.Id, someTime.Add(time.Hour))
+		other, _ := insertTestPlayerInUniverse(t, conn)
+		insertTestMessage(t, conn, other.Id, someTime)
+
+		actual, err := repo.ListForPlayer(t.Context(), player.Id, 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.Message{newer, older}, actual)
+	})
+
+	t.Run("returns requested page", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		insertTestMessage(t, conn, player.Id, someTime.Add(2*time.Hour))
+		expected := insertTestMessage(t, conn, player.Id, someTime.Add(time.Hour))
+		insertTestMessage(t, conn, player.Id, someTime)
+
+		actual, err := repo.ListForPlayer(t.Context(), player.Id, 1, 1)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.Message{expected}, actual)
+	})
+}
+
+func TestIT_MessageRepository_MarkAsRead(t *testing.T) {
+	repo, conn := newTestMessageRepository(t)
+
+	t.Run("marks message as read", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		message := insertTestMessage(t, conn, player.Id, someTime)
+
+		err := repo.MarkAsRead(t.Context(), message.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), message.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, actual.Read)
+	})
+
+	t.Run("returns error when message does not exist", func(t *testing.T) {
+		err := repo.MarkAsRead(t.Context(), uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_MessageRepository_Delete(t *testing.T) {
+	repo, conn := newTestMessageRepository(t)
+
+	t.Run("deletes message", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		message := insertTestMessage(t, conn, player.Id, someTime)
+
+		err := repo.Delete(t.Context(), message.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		_, err = repo.Get(t.Context(), message.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when message does not exist", func(t *testing.T) {
+		err := repo.Delete(t.Context(), uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func newTestMessageRepository(t *testing.T) (*MessageRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewMessageRepository(conn), conn
+}
+
+func insertTestMessage(
+	t *testing.T,
+	conn db.Connection,
+	player uuid.UUID,
+	createdAt time.Time,
+) models.Message {
+	t.Helper()
+
+	message := models.Message{
+		Id:        uuid.New(),
+		Kind:      models.SystemMessage,
+		Player:    player,
+		Subject:   "subject",
+		Content:   "content",
+		CreatedAt: createdAt,
+	}
+
+	sqlQuery := `INSERT INTO message (id, kind, player, subject, content, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := conn.Exec(t.Context(), sqlQuery, message.Id, message.Kind, message.Player, message.Subject, message.Content, message.CreatedAt)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return message
+}
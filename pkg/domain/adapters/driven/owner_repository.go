@@ -0,0 +1,80 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/google/uuid"
+)
+
+const (
+	getPlayerOwnerQuery = `
+SELECT
+	api_user
+FROM
+	player
+WHERE
+	id = $1`
+
+	getPlanetOwnerQuery = `
+SELECT
+	pl.api_user
+FROM
+	planet AS p
+	INNER JOIN player AS pl ON pl.id = p.player
+WHERE
+	p.id = $1`
+
+	getFleetOwnerQuery = `
+SELECT
+	pl.api_user
+FROM
+	fleet AS f
+	INNER JOIN player AS pl ON pl.id = f.player
+WHERE
+	f.id = $1`
+
+	getMarketOfferOwnerQuery = `
+SELECT
+	pl.api_user
+FROM
+	market_offer AS mo
+	INNER JOIN player AS pl ON pl.id = mo.player
+WHERE
+	mo.id = $1`
+)
+
+type OwnerRepository struct {
+	conn db.Connection
+}
+
+func NewOwnerRepository(conn db.Connection) *OwnerRepository {
+	return &OwnerRepository{
+		conn: conn,
+	}
+}
+
+func (r *OwnerRepository) GetPlayerOwner(ctx context.Context, player uuid.UUID) (uuid.UUID, error) {
+	return r.fetchOwner(ctx, getPlayerOwnerQuery, player)
+}
+
+func (r *OwnerRepository) GetPlanetOwner(ctx context.Context, planet uuid.UUID) (uuid.UUID, error) {
+	return r.fetchOwner(ctx, getPlanetOwnerQuery, planet)
+}
+
+func (r *OwnerRepository) GetFleetOwner(ctx context.Context, fleet uuid.UUID) (uuid.UUID, error) {
+	return r.fetchOwner(ctx, getFleetOwnerQuery, fleet)
+}
+
+func (r *OwnerRepository) GetMarketOfferOwner(ctx context.Context, offer uuid.UUID) (uuid.UUID, error) {
+	return r.fetchOwner(ctx, getMarketOfferOwnerQuery, offer)
+}
+
+func (r *OwnerRepository) fetchOwner(ctx context.Context, query string, id uuid.UUID) (uuid.UUID, error) {
+	owner, err := db.QueryOne[uuid.UUID](ctx, r.conn, query, id)
+	if err != nil {
+		return uuid.Nil, parseDbError(err)
+	}
+
+	return owner, nil
+}
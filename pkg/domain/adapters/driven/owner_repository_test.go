@@ -0,0 +1,100 @@
+package drivenadapters
+
+import (
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_OwnerRepository_GetPlayerOwner(t *testing.T) {
+	repo, conn := newTestOwnerRepository(t)
+
+	t.Run("returns api user of the player", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+
+		actual, err := repo.GetPlayerOwner(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, player.ApiUser, actual)
+	})
+
+	t.Run("returns error when player does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetPlayerOwner(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_OwnerRepository_GetPlanetOwner(t *testing.T) {
+	repo, conn := newTestOwnerRepository(t)
+
+	t.Run("returns api user of the player owning the planet", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+
+		actual, err := repo.GetPlanetOwner(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, player.ApiUser, actual)
+	})
+
+	t.Run("returns error when planet does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetPlanetOwner(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_OwnerRepository_GetFleetOwner(t *testing.T) {
+	repo, conn := newTestOwnerRepository(t)
+
+	t.Run("returns api user of the player owning the fleet", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+		fleet := insertTestFleet(t, conn, planet, target)
+
+		actual, err := repo.GetFleetOwner(t.Context(), fleet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, player.ApiUser, actual)
+	})
+
+	t.Run("returns error when fleet does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetFleetOwner(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_OwnerRepository_GetMarketOfferOwner(t *testing.T) {
+	repo, conn := newTestOwnerRepository(t)
+
+	t.Run("returns api user of the player posting the offer", func(t *testing.T) {
+		planet, player, universe := insertTestPlanetForPlayer(t, conn)
+		offer := insertTestMarketOffer(t, conn, planet, universe.Id)
+
+		actual, err := repo.GetMarketOfferOwner(t.Context(), offer.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, player.ApiUser, actual)
+	})
+
+	t.Run("returns error when offer does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetMarketOfferOwner(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func newTestOwnerRepository(t *testing.T) (*OwnerRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewOwnerRepository(conn), conn
+}
@@ -0,0 +1,85 @@
+package drivenadapters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	planetChangesChannel = "planet_changes"
+
+	notifyPlanetChangeQuery = `SELECT pg_notify($1, $2)`
+)
+
+// planetChangePayload is the content of the notifications sent on the
+// planet changes channel. It is kept short as the payload of a notification
+// is limited to a few kilobytes.
+type planetChangePayload struct {
+	Planet   uuid.UUID `json:"planet"`
+	Player   uuid.UUID `json:"player"`
+	Universe uuid.UUID `json:"universe"`
+	Version  int       `json:"version"`
+	Kind     string    `json:"kind"`
+	Deleted  bool      `json:"deleted"`
+}
+
+// notifyPlanetChange sends the change on the planet changes channel as part
+// of the transaction: the notification is only delivered to the listeners
+// when the transaction is committed.
+func notifyPlanetChange(ctx context.Context, tx db.Transaction, change models.PlanetChange) error {
+	payload, err := marshalPlanetChange(change)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, notifyPlanetChangeQuery, planetChangesChannel, payload)
+	return err
+}
+
+// notifyPlanetCreation publishes the creation of a planet: the planet has
+// not been through a mutation yet.
+func notifyPlanetCreation(ctx context.Context, tx db.Transaction, planet models.Planet, universe uuid.UUID) error {
+	change := models.PlanetChange{
+		Planet:   planet.Id,
+		Player:   planet.Player,
+		Universe: universe,
+		Version:  planet.Version,
+		Kind:     models.PlanetCreated,
+	}
+	return notifyPlanetChange(ctx, tx, change)
+}
+
+func marshalPlanetChange(change models.PlanetChange) (string, error) {
+	payload := planetChangePayload{
+		Planet:   change.Planet,
+		Player:   change.Player,
+		Universe: change.Universe,
+		Version:  change.Version,
+		Kind:     string(change.Kind),
+		Deleted:  change.Deleted,
+	}
+
+	out, err := json.Marshal(payload)
+	return string(out), err
+}
+
+func unmarshalPlanetChange(data string) (models.PlanetChange, error) {
+	var payload planetChangePayload
+	if err := json.Unmarshal([]byte(data), &payload); err != nil {
+		return models.PlanetChange{}, err
+	}
+
+	out := models.PlanetChange{
+		Planet:   payload.Planet,
+		Player:   payload.Player,
+		Universe: payload.Universe,
+		Version:  payload.Version,
+		Kind:     models.PlanetEventKind(payload.Kind),
+		Deleted:  payload.Deleted,
+	}
+	return out, nil
+}
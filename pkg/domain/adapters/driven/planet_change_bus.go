@@ -0,0 +1,75 @@
+package drivenadapters
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+// planetChangeBufferSize is the number of changes kept for a subscriber
+// which does not consume them fast enough. Further changes are dropped.
+const planetChangeBufferSize = 16
+
+// PlanetChangeBus dispatches the planet changes to the subscribers of this
+// process. It is fed by the PlanetChangeListener so that the changes
+// committed by any replica of the service reach all the subscribers.
+type PlanetChangeBus struct {
+	lock        sync.Mutex
+	subscribers map[models.PlanetChangeTopic]map[chan models.PlanetChange]struct{}
+}
+
+func NewPlanetChangeBus() *PlanetChangeBus {
+	return &PlanetChangeBus{
+		subscribers: make(map[models.PlanetChangeTopic]map[chan models.PlanetChange]struct{}),
+	}
+}
+
+func (b *PlanetChangeBus) Subscribe(ctx context.Context, topic models.PlanetChangeTopic) <-chan models.PlanetChange {
+	out := make(chan models.PlanetChange, planetChangeBufferSize)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	subscribers, ok := b.subscribers[topic]
+	if !ok {
+		subscribers = make(map[chan models.PlanetChange]struct{})
+		b.subscribers[topic] = subscribers
+	}
+	subscribers[out] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(topic, out)
+	}()
+
+	return out
+}
+
+// Publish never blocks: a subscriber with a full buffer misses the change.
+func (b *PlanetChangeBus) Publish(change models.PlanetChange) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for _, topic := range change.Topics() {
+		for subscriber := range b.subscribers[topic] {
+			select {
+			case subscriber <- change:
+			default:
+			}
+		}
+	}
+}
+
+func (b *PlanetChangeBus) unsubscribe(topic models.PlanetChangeTopic, subscriber chan models.PlanetChange) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	subscribers := b.subscribers[topic]
+	delete(subscribers, subscriber)
+	if len(subscribers) == 0 {
+		delete(b.subscribers, topic)
+	}
+
+	close(subscriber)
+}
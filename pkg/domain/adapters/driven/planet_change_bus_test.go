@@ -0,0 +1,76 @@
+package drivenadapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_PlanetChangeBus(t *testing.T) {
+	player := uuid.New()
+	playerTopic := models.PlanetChangeTopic{Scope: models.PlayerScope, Id: player}
+
+	t.Run("delivers changes to subscribers of topic", func(t *testing.T) {
+		bus := NewPlanetChangeBus()
+		change := models.PlanetChange{Planet: uuid.New(), Player: player, Universe: uuid.New(), Version: 2}
+
+		first := bus.Subscribe(t.Context(), playerTopic)
+		second := bus.Subscribe(t.Context(), playerTopic)
+		planet := bus.Subscribe(t.Context(), models.PlanetChangeTopic{Scope: models.PlanetScope, Id: change.Planet})
+		universe := bus.Subscribe(t.Context(), models.PlanetChangeTopic{Scope: models.UniverseScope, Id: change.Universe})
+		other := bus.Subscribe(t.Context(), models.PlanetChangeTopic{Scope: models.PlayerScope, Id: uuid.New()})
+
+		bus.Publish(change)
+
+		assert.Equal(t, change, <-first)
+		assert.Equal(t, change, <-second)
+		assert.Equal(t, change, <-planet)
+		assert.Equal(t, change, <-universe)
+		assert.Empty(t, other)
+	})
+
+	t.Run("drops changes when subscriber is too slow", func(t *testing.T) {
+		bus := NewPlanetChangeBus()
+		changes := bus.Subscribe(t.Context(), playerTopic)
+
+		for version := range planetChangeBufferSize + 1 {
+			bus.Publish(models.PlanetChange{Player: player, Version: version})
+		}
+
+		assert.Len(t, changes, planetChangeBufferSize)
+		assert.Equal(t, 0, (<-changes).Version)
+	})
+
+	t.Run("closes channel when context is done", func(t *testing.T) {
+		bus := NewPlanetChangeBus()
+		ctx, cancel := context.WithCancel(t.Context())
+		changes := bus.Subscribe(ctx, playerTopic)
+
+		cancel()
+
+		_, ok := <-changes
+		assert.False(t, ok)
+	})
+}
+
+func TestUnit_PlanetChange_Marshal(t *testing.T) {
+	change := models.PlanetChange{
+		Planet:   uuid.New(),
+		Player:   uuid.New(),
+		Universe: uuid.New(),
+		Version:  12,
+		Kind:     models.PlanetDeleted,
+		Deleted:  true,
+	}
+
+	payload, err := marshalPlanetChange(change)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	actual, err := unmarshalPlanetChange(payload)
+	require.NoError(t, err, "Actual err: %v", err)
+	assert.Equal(t, change, actual)
+}
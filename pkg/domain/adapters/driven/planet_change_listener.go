@@ -0,0 +1,102 @@
+package drivenadapters
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// planetChangeReconnectDelay is the time waited before listening again
+// after the connection to the database was lost.
+const planetChangeReconnectDelay = 5 * time.Second
+
+// PlanetChangeListener listens to the changes notified by the planet
+// mutators of all the replicas of the service and publishes them on the
+// bus. It is meant to be started with the process package. It uses its
+// own connection as listening requires a session which is not shared with
+// the connection pool.
+type PlanetChangeListener struct {
+	conf     db.Config
+	bus      *PlanetChangeBus
+	log      *slog.Logger
+	stopChan chan struct{}
+}
+
+func NewPlanetChangeListener(conf db.Config, bus *PlanetChangeBus, log *slog.Logger) *PlanetChangeListener {
+	return &PlanetChangeListener{
+		conf:     conf,
+		bus:      bus,
+		log:      log,
+		stopChan: make(chan struct{}, 1),
+	}
+}
+
+func (l *PlanetChangeListener) Start() error {
+	l.log.Info("Starting planet change listener", slog.String("channel", planetChangesChannel))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-l.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		err := l.listen(ctx)
+		if err != nil && ctx.Err() == nil {
+			l.log.Error("Failed to listen to planet changes", slog.Any("error", err))
+		}
+
+		// Changes committed while not listening are lost: subscribers
+		// are expected to fetch the planets again when they reconnect.
+		timer := time.NewTimer(planetChangeReconnectDelay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.log.Info("Planet change listener gracefully shutdown")
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+func (l *PlanetChangeListener) Stop() error {
+	l.stopChan <- struct{}{}
+	return nil
+}
+
+func (l *PlanetChangeListener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.conf.ToConnectionString())
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+planetChangesChannel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		change, err := unmarshalPlanetChange(notification.Payload)
+		if err != nil {
+			l.log.Warn("Ignoring invalid planet change", slog.String("payload", notification.Payload), slog.Any("error", err))
+			continue
+		}
+
+		l.bus.Publish(change)
+	}
+}
@@ -0,0 +1,260 @@
+package drivenadapters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	createPlanetEventQuery = `
+INSERT INTO
+	planet_event (id, planet, kind, action, action_state, version_before, version_after, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	createPlanetEventResourceQuery = `
+INSERT INTO
+	planet_event_resource (event, resource, delta, produced)
+	VALUES ($1, $2, $3, $4)`
+
+	listPlanetEventForPlanetQuery = `
+SELECT
+	id,
+	planet,
+	kind,
+	action,
+	action_state,
+	version_before,
+	version_after,
+	created_at
+FROM
+	planet_event
+WHERE
+	planet = $1
+ORDER BY
+	created_at DESC,
+	version_before DESC,
+	version_after DESC
+LIMIT $2
+OFFSET $3`
+
+	listPlanetEventForPlanetUntilQuery = `
+SELECT
+	id,
+	planet,
+	kind,
+	action,
+	action_state,
+	version_before,
+	version_after,
+	created_at
+FROM
+	planet_event
+WHERE
+	planet = $1
+	AND created_at <= $2
+ORDER BY
+	created_at,
+	version_before,
+	version_after`
+
+	listPlanetEventResourceForEventQuery = `
+SELECT
+	resource,
+	delta,
+	produced
+FROM
+	planet_event_resource
+WHERE
+	event = $1`
+)
+
+type PlanetEventRepository struct {
+	conn db.Connection
+}
+
+func NewPlanetEventRepository(conn db.Connection) *PlanetEventRepository {
+	return &PlanetEventRepository{
+		conn: conn,
+	}
+}
+
+func (r *PlanetEventRepository) ListForPlanet(
+	ctx context.Context,
+	planet uuid.UUID,
+	offset int,
+	limit int,
+) ([]models.PlanetEvent, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	dbEvents, err := db.QueryAllTx[mappers.DbPlanetEvent](ctx, tx, listPlanetEventForPlanetQuery, planet, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadPlanetEventResources(ctx, tx, dbEvents)
+}
+
+func (r *PlanetEventRepository) ListForPlanetUntil(
+	ctx context.Context,
+	planet uuid.UUID,
+	until time.Time,
+) ([]models.PlanetEvent, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Close(ctx)
+
+	dbEvents, err := db.QueryAllTx[mappers.DbPlanetEvent](ctx, tx, listPlanetEventForPlanetUntilQuery, planet, until.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return loadPlanetEventResources(ctx, tx, dbEvents)
+}
+
+func loadPlanetEventResources(
+	ctx context.Context,
+	tx db.Transaction,
+	dbEvents []mappers.DbPlanetEvent,
+) ([]models.PlanetEvent, error) {
+	events := make([]models.PlanetEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		event := dbEvent.ToDomain()
+
+		var err error
+		event.ActionState, err = unmarshalPlanetEventAction(dbEvent.ActionState)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Resources, err = db.QueryAllTx[models.PlanetEventResource](
+			ctx,
+			tx,
+			listPlanetEventResourceForEventQuery,
+			event.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// createPlanetEvents records the events of a mutation of a planet in the
+// same transaction as the planet modifications. It returns the kind of the
+// last event, which is the one reaching the new version of the planet.
+func createPlanetEvents(
+	ctx context.Context,
+	tx db.Transaction,
+	events []models.PlanetEvent,
+) (models.PlanetEventKind, error) {
+	var kind models.PlanetEventKind
+	for _, event := range events {
+		err := createPlanetEvent(ctx, tx, event)
+		if err != nil {
+			return kind, err
+		}
+		kind = event.Kind
+	}
+
+	return kind, nil
+}
+
+func createPlanetEvent(ctx context.Context, tx db.Transaction, event models.PlanetEvent) error {
+	state, err := marshalPlanetEventAction(event.ActionState)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		createPlanetEventQuery,
+		event.Id,
+		event.Planet,
+		event.Kind,
+		event.Action,
+		state,
+		event.VersionBefore,
+		event.VersionAfter,
+		event.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range event.Resources {
+		_, err = tx.Exec(
+			ctx,
+			createPlanetEventResourceQuery,
+			event.Id,
+			resource.Resource,
+			resource.Delta,
+			resource.Produced,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// planetEventActionPayload is how the action of an event is stored. The
+// actions are only read back to replay the events: they keep the names of
+// the fields of the domain.
+type planetEventActionPayload struct {
+	Building *models.BuildingAction `json:"building,omitempty"`
+	Shipyard *models.ShipyardAction `json:"shipyard,omitempty"`
+	Research *models.ResearchAction `json:"research,omitempty"`
+}
+
+func marshalPlanetEventAction(state *models.PlanetEventAction) (*string, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	payload := planetEventActionPayload{
+		Building: state.Building,
+		Shipyard: state.Shipyard,
+		Research: state.Research,
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	out := string(data)
+	return &out, nil
+}
+
+func unmarshalPlanetEventAction(data []byte) (*models.PlanetEventAction, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var payload planetEventActionPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	return &models.PlanetEventAction{
+		Building: payload.Building,
+		Shipyard: payload.Shipyard,
+		Research: payload.Research,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package drivenadapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_PlanetEventRepository_ListForPlanet(t *testing.T) {
+	repo, conn := newTestPlanetEventRepository(t)
+
+	t.Run("returns events of planet with most recent first", func(t *testing.T) {
+		planet := uuid.New()
+		older := insertTestPlanetEvent(t, conn, planet, 3, someTime)
+		newer := insertTestPlanetEvent(t, conn, planet, 4, someOtherTime)
+		insertTestPlanetEvent(t, conn, uuid.New(), 2, someOtherTime)
+
+		actual, err := repo.ListForPlanet(t.Context(), planet, 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.PlanetEvent{newer, older}, actual)
+	})
+
+	t.Run("returns requested page", func(t *testing.T) {
+		planet := uuid.New()
+		older := insertTestPlanetEvent(t, conn, planet, 3, someTime)
+		insertTestPlanetEvent(t, conn, planet, 4, someOtherTime)
+
+		actual, err := repo.ListForPlanet(t.Context(), planet, 1, 1)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.PlanetEvent{older}, actual)
+	})
+
+	t.Run("returns events of deleted planet", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		mutator := NewPlanetMutator(conn)
+
+		_, err := mutator.Mutate(t.Context(), planet.Id, generateDeletingMutator())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.ListForPlanet(t.Context(), planet.Id, 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, models.PlanetDeleted, actual[0].Kind)
+	})
+
+	t.Run("returns empty list when planet has no event", func(t *testing.T) {
+		actual, err := repo.ListForPlanet(t.Context(), uuid.New(), 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+}
+
+func TestIT_PlanetEventRepository_ListForPlanetUntil(t *testing.T) {
+	repo, conn := newTestPlanetEventRepository(t)
+
+	t.Run("returns events of planet with oldest first", func(t *testing.T) {
+		planet := uuid.New()
+		newer := insertTestPlanetEvent(t, conn, planet, 4, someOtherTime)
+		older := insertTestPlanetEvent(t, conn, planet, 3, someTime)
+		insertTestPlanetEvent(t, conn, uuid.New(), 2, someTime)
+
+		actual, err := repo.ListForPlanetUntil(t.Context(), planet, someOtherTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.PlanetEvent{older, newer}, actual)
+	})
+
+	t.Run("does not return events after the moment", func(t *testing.T) {
+		planet := uuid.New()
+		older := insertTestPlanetEvent(t, conn, planet, 3, someTime)
+		insertTestPlanetEvent(t, conn, planet, 4, someOtherTime)
+
+		actual, err := repo.ListForPlanetUntil(t.Context(), planet, someOtherTime.Add(-time.Second))
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.PlanetEvent{older}, actual)
+	})
+}
+
+func newTestPlanetEventRepository(t *testing.T) (*PlanetEventRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewPlanetEventRepository(conn), conn
+}
+
+func insertTestPlanetEvent(
+	t *testing.T,
+	conn db.Connection,
+	planet uuid.UUID,
+	version int,
+	createdAt time.Time,
+) models.PlanetEvent {
+	t.Helper()
+
+	event := models.PlanetEvent{
+		Id:            uuid.New(),
+		Planet:        planet,
+		Kind:          models.PlanetActionCreated,
+		VersionBefore: version,
+		VersionAfter:  version + 1,
+		Resources: []models.PlanetEventResource{
+			{Resource: crystalResourceId, Delta: -125.5},
+		},
+		CreatedAt: createdAt,
+	}
+
+	tx, err := conn.BeginTx(t.Context())
+	require.NoError(t, err, "Actual err: %v", err)
+	defer tx.Close(t.Context())
+
+	err = createPlanetEvent(t.Context(), tx, event)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return event
+}
@@ -51,12 +51,33 @@ func (m *PlanetMutator) Mutate(
 		return models.PlanetMutationResult{}, domainerrors.ErrNotFound
 	}
 
+	// Fleets are shared between the planets at both ends of their path
+	// and market offers can be accepted from other planets: they also
+	// need to be locked to avoid concurrent modifications.
+	_, err = lockFleetsForPlanet(ctx, tx, id)
+	if err != nil {
+		return models.PlanetMutationResult{}, err
+	}
+
+	_, err = lockPendingMarketOffersForPlanet(ctx, tx, id)
+	if err != nil {
+		return models.PlanetMutationResult{}, err
+	}
+
 	planet, err := loadPlanetAndDetails(ctx, tx, id)
 	if err != nil {
 		return models.PlanetMutationResult{}, err
 	}
 
+	// The coordinate of the planet is removed along with it: the universe
+	// is fetched beforehand to notify the change.
+	universe, err := db.QueryOneTx[uuid.UUID](ctx, tx, getPlanetUniverseQuery, id)
+	if err != nil {
+		return models.PlanetMutationResult{}, parseDbError(err)
+	}
+
 	expectedVersion := planet.Version
+	before := planet.Snapshot()
 
 	deleted, err := mutator(&planet)
 	if err != nil {
@@ -71,8 +92,30 @@ func (m *PlanetMutator) Mutate(
 	out := models.PlanetMutationResult{Deleted: deleted}
 
 	if deleted {
-		err = deletePlanetAndDetails(ctx, tx, id)
-		return out, err
+		err = deletePlanetAndDetails(ctx, tx, id, planet.UpdatedAt)
+		if err != nil {
+			return out, err
+		}
+
+		events, err := models.NewPlanetEvents(before, planet, true)
+		if err != nil {
+			return out, err
+		}
+
+		kind, err := createPlanetEvents(ctx, tx, events)
+		if err != nil {
+			return out, err
+		}
+
+		change := models.PlanetChange{
+			Planet:   id,
+			Player:   planet.Player,
+			Universe: universe,
+			Version:  expectedVersion,
+			Kind:     kind,
+			Deleted:  true,
+		}
+		return out, notifyPlanetChange(ctx, tx, change)
 	}
 
 	out.Planet, err = saveAndReloadPlanet(ctx, tx, planet, expectedVersion)
@@ -80,7 +123,24 @@ func (m *PlanetMutator) Mutate(
 		return out, err
 	}
 
-	return out, nil
+	events, err := models.NewPlanetEvents(before, out.Planet, false)
+	if err != nil {
+		return out, err
+	}
+
+	kind, err := createPlanetEvents(ctx, tx, events)
+	if err != nil {
+		return out, err
+	}
+
+	change := models.PlanetChange{
+		Planet:   id,
+		Player:   out.Planet.Player,
+		Universe: universe,
+		Version:  out.Planet.Version,
+		Kind:     kind,
+	}
+	return out, notifyPlanetChange(ctx, tx, change)
 }
 
 func saveAndReloadPlanet(
@@ -314,16 +314,323 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		assert.False(t, returned.Deleted)
 		expected := []models.PlanetBuilding{
-			{Building: metalStorageId, Level: 6},
+			{Building: metalStorageId, Name: "metal storage", Level: 6},
 		}
 		assert.Equal(t, expected, returned.Planet.Buildings)
 		assertPlanetBuildingLevel(t, conn, planet.Id, metalStorageId, 6)
 	})
 
+	t.Run("persists mutated planet shipyard queue", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		ship := insertTestShip(t, conn)
+
+		action := models.ShipyardAction{
+			Id:            uuid.New(),
+			Kind:          models.ShipUnit,
+			Unit:          ship.Id,
+			Count:         4,
+			UnitBuildTime: 1500 * time.Millisecond,
+			CreatedAt:     someTime,
+			CompletedAt:   someOtherTime,
+			Costs: []models.ShipyardActionCost{
+				{Resource: metalResourceId, Amount: 1200},
+			},
+		}
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.ShipyardQueue = append(p.ShipyardQueue, action)
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.False(t, returned.Deleted)
+		assert.Equal(t, []models.ShipyardAction{action}, returned.Planet.ShipyardQueue)
+	})
+
+	t.Run("persists mutated planet units", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		ship := insertTestShip(t, conn)
+		defense := insertTestDefense(t, conn)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Ships = append(p.Ships, models.PlanetShip{Ship: ship.Id, Count: 12})
+			p.Defenses = append(p.Defenses, models.PlanetDefense{Defense: defense.Id, Count: 3})
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.False(t, returned.Deleted)
+		assert.Equal(t, []models.PlanetShip{{Ship: ship.Id, Count: 12}}, returned.Planet.Ships)
+		assert.Equal(t, []models.PlanetDefense{{Defense: defense.Id, Count: 3}}, returned.Planet.Defenses)
+	})
+
+	t.Run("persists fleet sent from planet", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+		ship := insertTestShip(t, conn)
+
+		fleet := models.Fleet{
+			Id:               uuid.New(),
+			Player:           player.Id,
+			Mission:          models.TransportMission,
+			Origin:           planet.Id,
+			OriginCoordinate: planet.Coordinate,
+			Target:           target.Id,
+			TargetCoordinate: target.Coordinate,
+			Ships: []models.FleetShip{
+				{Ship: ship.Id, Count: 2},
+			},
+			Cargo: []models.FleetCargo{
+				{Resource: metalResourceId, Amount: 800},
+			},
+			DepartedAt: someTime,
+			ArrivesAt:  someOtherTime,
+			CreatedAt:  someTime,
+		}
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Fleets = append(p.Fleets, fleet)
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.False(t, returned.Deleted)
+		assert.Equal(t, []models.Fleet{fleet}, returned.Planet.Fleets)
+	})
+
+	t.Run("persists recalled fleet", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+		fleet := insertTestFleet(t, conn, planet, target)
+
+		returnsAt := fleet.ArrivesAt
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Fleets[0].ReturnsAt = &returnsAt
+			p.Fleets[0].Version++
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		fleet.ReturnsAt = &returnsAt
+		fleet.Version++
+		assert.Equal(t, []models.Fleet{fleet}, returned.Planet.Fleets)
+	})
+
+	t.Run("removes fleet which is not attached to the planet anymore", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		origin := insertTestPlanet(t, conn, player.Id)
+		fleet := insertTestFleet(t, conn, origin, planet)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Fleets = []models.Fleet{}
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, returned.Planet.Fleets)
+		assertFleetDoesNotExist(t, conn, fleet.Id)
+	})
+
+	t.Run("persists market offer posted from planet", func(t *testing.T) {
+		planet, player, universe := insertTestPlanetForPlayer(t, conn)
+
+		offer := models.MarketOffer{
+			Id:                uuid.New(),
+			Universe:          universe.Id,
+			Player:            player.Id,
+			Planet:            planet.Id,
+			OfferedResource:   metalResourceId,
+			OfferedAmount:     300,
+			RequestedResource: crystalResourceId,
+			RequestedAmount:   100,
+			Status:            models.OpenOffer,
+			ExpiresAt:         someOtherTime,
+			CreatedAt:         someTime,
+			Version:           1,
+		}
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.MarketOffers = append(p.MarketOffers, offer)
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.MarketOffer{offer}, returned.Planet.MarketOffers)
+	})
+
+	t.Run("persists market offer accepted from another planet", func(t *testing.T) {
+		seller, _, universe := insertTestPlanetForPlayer(t, conn)
+		offer := insertTestMarketOffer(t, conn, seller, universe.Id)
+		buyer, player, _ := insertTestPlanetForPlayer(t, conn)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			accepted := offer
+			accepted.Status = models.AcceptedOffer
+			accepted.Buyer = &player.Id
+			accepted.BuyerPlanet = &buyer.Id
+			accepted.AcceptedAt = &someOtherTime
+			accepted.Version++
+			p.MarketOffers = append(p.MarketOffers, accepted)
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), buyer.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertMarketOfferStatus(t, conn, offer.Id, models.AcceptedOffer)
+	})
+
+	t.Run("returns error when accepted market offer was modified concurrently", func(t *testing.T) {
+		seller, _, universe := insertTestPlanetForPlayer(t, conn)
+		offer := insertTestMarketOffer(t, conn, seller, universe.Id)
+		buyer, player, _ := insertTestPlanetForPlayer(t, conn)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			accepted := offer
+			accepted.Status = models.AcceptedOffer
+			accepted.Buyer = &player.Id
+			accepted.BuyerPlanet = &buyer.Id
+			accepted.AcceptedAt = &someOtherTime
+			accepted.Version += 2
+			p.MarketOffers = append(p.MarketOffers, accepted)
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), buyer.Id, mutator)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotAvailable, "Actual err: %v", err)
+		assertMarketOfferStatus(t, conn, offer.Id, models.OpenOffer)
+	})
+
+	t.Run("persists research action", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		technology := insertTestTechnology(t, conn)
+
+		action := models.ResearchAction{
+			Id:           uuid.New(),
+			Player:       player.Id,
+			Planet:       planet.Id,
+			Technology:   technology.Id,
+			DesiredLevel: 1,
+			CreatedAt:    someTime,
+			CompletedAt:  yetAnotherTime,
+			Costs:        []models.ResearchActionCost{},
+		}
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.ResearchAction = &action
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, &action, returned.Planet.ResearchAction)
+	})
+
+	t.Run("returns error when player is already researching on another planet", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		technology := insertTestTechnology(t, conn)
+		insertTestResearchAction(t, conn, player.Id, player.Homeworld, technology.Id)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.ResearchAction = &models.ResearchAction{
+				Id:           uuid.New(),
+				Player:       player.Id,
+				Planet:       planet.Id,
+				Technology:   technology.Id,
+				DesiredLevel: 1,
+				CreatedAt:    someTime,
+				CompletedAt:  yetAnotherTime,
+			}
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionAlreadyInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("persists technology level", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		technology := insertTestTechnology(t, conn)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Technologies = append(p.Technologies, models.PlayerTechnology{
+				Technology: technology.Id,
+				Level:      3,
+				ReachedAt:  someTime,
+			})
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlayerTechnology{
+			{Technology: technology.Id, Level: 3, ReachedAt: someTime},
+		}
+		assert.Equal(t, expected, returned.Planet.Technologies)
+		assertPlayerTechnologyLevel(t, conn, player.Id, technology.Id, 3)
+	})
+
+	t.Run("does not lower technology level", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		technology := insertTestTechnology(t, conn)
+		pt := insertTestPlayerTechnology(t, conn, player.Id, technology.Id)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Technologies[0].Level = 0
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertPlayerTechnologyLevel(t, conn, player.Id, technology.Id, pt.Level)
+	})
+
+	t.Run("removes completed shipyard batch", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		ship := insertTestShip(t, conn)
+
+		insert := generateModifyingMutator(func(p *models.Planet) {
+			p.ShipyardQueue = append(p.ShipyardQueue, models.ShipyardAction{
+				Id:            uuid.New(),
+				Kind:          models.ShipUnit,
+				Unit:          ship.Id,
+				Count:         1,
+				UnitBuildTime: time.Second,
+				CreatedAt:     someTime,
+				CompletedAt:   someOtherTime,
+			})
+			p.Version++
+		})
+		_, err := adapter.Mutate(t.Context(), planet.Id, insert)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		remove := generateModifyingMutator(func(p *models.Planet) {
+			p.ShipyardQueue = []models.ShipyardAction{}
+			p.Version++
+		})
+		returned, err := adapter.Mutate(t.Context(), planet.Id, remove)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, returned.Planet.ShipyardQueue)
+		assertShipyardQueueIsEmpty(t, conn, planet.Id)
+	})
+
 	t.Run("does not delete existing planet building", func(t *testing.T) {
 		planet, _, _ := insertTestPlanetForPlayer(t, conn, addPlanetBuilding)
-		building := planet.Buildings[0].Building
-		level := planet.Buildings[0].Level
+		building := planet.Buildings[0]
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
 			p.Buildings = []models.PlanetBuilding{}
@@ -334,19 +641,18 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.False(t, returned.Deleted)
-		expected := []models.PlanetBuilding{
-			{Building: building, Level: level},
-		}
+		expected := []models.PlanetBuilding{building}
 		assert.Equal(t, expected, returned.Planet.Buildings)
-		assertPlanetBuildingLevel(t, conn, planet.Id, building, level)
+		assertPlanetBuildingLevel(t, conn, planet.Id, building.Building, building.Level)
 	})
 
 	t.Run("persists mutated planet with action", func(t *testing.T) {
 		planet, _, _ := insertTestPlanetForPlayer(t, conn)
-		require.Nil(t, planet.BuildingAction)
+		require.Empty(t, planet.BuildingQueue)
 
 		action := models.BuildingAction{
 			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
 			Building:     metalMineId,
 			DesiredLevel: 3,
 			CreatedAt:    someTime,
@@ -357,7 +663,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = &action
+			p.BuildingQueue = append(p.BuildingQueue, action)
 			p.Version++
 		})
 
@@ -367,18 +673,45 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, action, *returned.Planet.BuildingAction)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, action, *actual.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{action}, returned.Planet.BuildingQueue)
+		assert.Equal(t, []models.BuildingAction{action}, actual.BuildingQueue)
+	})
+
+	t.Run("persists kind of demolition action", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		require.Empty(t, planet.BuildingQueue)
+
+		action := models.BuildingAction{
+			Id:           uuid.New(),
+			Kind:         models.DemolishAction,
+			Building:     metalMineId,
+			DesiredLevel: 1,
+			CreatedAt:    someTime,
+			CompletedAt:  someTime.Add(1 * time.Hour),
+			Costs:        []models.BuildingActionCost{},
+			Storages:     []models.BuildingActionResourceStorage{},
+			Productions:  []models.BuildingActionResourceProduction{},
+		}
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.BuildingQueue = append(p.BuildingQueue, action)
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual := loadPlanetFromDb(t, conn, planet.Id)
+		assert.Equal(t, []models.BuildingAction{action}, actual.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with action and costs", func(t *testing.T) {
 		planet, _, _ := insertTestPlanetForPlayer(t, conn)
-		require.Nil(t, planet.BuildingAction)
+		require.Empty(t, planet.BuildingQueue)
 
 		action := models.BuildingAction{
 			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
 			Building:     metalMineId,
 			DesiredLevel: 3,
 			CreatedAt:    someTime,
@@ -398,7 +731,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = &action
+			p.BuildingQueue = append(p.BuildingQueue, action)
 			p.Version++
 		})
 
@@ -408,18 +741,17 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, action, *returned.Planet.BuildingAction)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, action, *actual.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{action}, returned.Planet.BuildingQueue)
+		assert.Equal(t, []models.BuildingAction{action}, actual.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with action and storages", func(t *testing.T) {
 		planet, _, _ := insertTestPlanetForPlayer(t, conn)
-		require.Nil(t, planet.BuildingAction)
+		require.Empty(t, planet.BuildingQueue)
 
 		action := models.BuildingAction{
 			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
 			Building:     metalMineId,
 			DesiredLevel: 3,
 			CreatedAt:    someTime,
@@ -439,7 +771,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = &action
+			p.BuildingQueue = append(p.BuildingQueue, action)
 			p.Version++
 		})
 
@@ -449,18 +781,17 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, action, *returned.Planet.BuildingAction)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, action, *actual.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{action}, returned.Planet.BuildingQueue)
+		assert.Equal(t, []models.BuildingAction{action}, actual.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with action and productions", func(t *testing.T) {
 		planet, _, _ := insertTestPlanetForPlayer(t, conn)
-		require.Nil(t, planet.BuildingAction)
+		require.Empty(t, planet.BuildingQueue)
 
 		action := models.BuildingAction{
 			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
 			Building:     metalMineId,
 			DesiredLevel: 3,
 			CreatedAt:    someTime,
@@ -480,7 +811,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = &action
+			p.BuildingQueue = append(p.BuildingQueue, action)
 			p.Version++
 		})
 
@@ -490,10 +821,8 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, action, *returned.Planet.BuildingAction)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, action, *actual.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{action}, returned.Planet.BuildingQueue)
+		assert.Equal(t, []models.BuildingAction{action}, actual.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with updated completion time", func(t *testing.T) {
@@ -502,7 +831,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		require.NotEqual(t, yetAnotherTime, action.CompletedAt)
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.CompletedAt = yetAnotherTime
+			p.BuildingQueue[0].CompletedAt = yetAnotherTime
 			p.Version++
 		})
 
@@ -512,10 +841,10 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, yetAnotherTime, returned.Planet.BuildingAction.CompletedAt)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, yetAnotherTime, actual.BuildingAction.CompletedAt)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, yetAnotherTime, returned.Planet.BuildingQueue[0].CompletedAt)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, yetAnotherTime, actual.BuildingQueue[0].CompletedAt)
 	})
 
 	t.Run("persists mutated planet with update to existing action costs", func(t *testing.T) {
@@ -527,7 +856,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		costs := []models.BuildingActionCost{{Resource: metalResourceId, Amount: 32}}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.Costs = costs
+			p.BuildingQueue[0].Costs = costs
 			p.Version++
 		})
 
@@ -536,10 +865,10 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, costs, returned.Planet.BuildingAction.Costs)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, costs, actual.BuildingAction.Costs)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, costs, returned.Planet.BuildingQueue[0].Costs)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, costs, actual.BuildingQueue[0].Costs)
 	})
 
 	t.Run("persists mutated planet with updated action and costs", func(t *testing.T) {
@@ -558,7 +887,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.Costs = costs
+			p.BuildingQueue[0].Costs = costs
 			p.Version++
 		})
 
@@ -568,10 +897,10 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, costs, returned.Planet.BuildingAction.Costs)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, costs, actual.BuildingAction.Costs)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, costs, returned.Planet.BuildingQueue[0].Costs)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, costs, actual.BuildingQueue[0].Costs)
 	})
 
 	t.Run("persists mutated planet with update to existing action storages", func(t *testing.T) {
@@ -583,7 +912,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		storages := []models.BuildingActionResourceStorage{{Resource: crystalResourceId, Storage: 32}}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.Storages = storages
+			p.BuildingQueue[0].Storages = storages
 			p.Version++
 		})
 
@@ -592,10 +921,10 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, storages, returned.Planet.BuildingAction.Storages)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, storages, actual.BuildingAction.Storages)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, storages, returned.Planet.BuildingQueue[0].Storages)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, storages, actual.BuildingQueue[0].Storages)
 	})
 
 	t.Run("persists mutated planet with updated action and storages", func(t *testing.T) {
@@ -614,7 +943,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.Storages = storages
+			p.BuildingQueue[0].Storages = storages
 			p.Version++
 		})
 
@@ -624,10 +953,10 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, storages, returned.Planet.BuildingAction.Storages)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, storages, actual.BuildingAction.Storages)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, storages, returned.Planet.BuildingQueue[0].Storages)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, storages, actual.BuildingQueue[0].Storages)
 	})
 
 	t.Run("persists mutated planet with update to existing action productions", func(t *testing.T) {
@@ -639,7 +968,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		productions := []models.BuildingActionResourceProduction{{Resource: crystalResourceId, Production: 32}}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.Productions = productions
+			p.BuildingQueue[0].Productions = productions
 			p.Version++
 		})
 
@@ -648,10 +977,10 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, productions, returned.Planet.BuildingAction.Productions)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, productions, actual.BuildingAction.Productions)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, productions, returned.Planet.BuildingQueue[0].Productions)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, productions, actual.BuildingQueue[0].Productions)
 	})
 
 	t.Run("persists mutated planet with updated action and productions", func(t *testing.T) {
@@ -670,7 +999,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction.Productions = productions
+			p.BuildingQueue[0].Productions = productions
 			p.Version++
 		})
 
@@ -680,17 +1009,17 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, productions, returned.Planet.BuildingAction.Productions)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, productions, actual.BuildingAction.Productions)
+		require.Len(t, returned.Planet.BuildingQueue, 1)
+		assert.Equal(t, productions, returned.Planet.BuildingQueue[0].Productions)
+		require.Len(t, actual.BuildingQueue, 1)
+		assert.Equal(t, productions, actual.BuildingQueue[0].Productions)
 	})
 
 	t.Run("persists mutated planet with deleted action", func(t *testing.T) {
 		action, planet := insertTestBuildingAction(t, conn)
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = nil
+			p.BuildingQueue = []models.BuildingAction{}
 			p.Version++
 		})
 
@@ -699,14 +1028,14 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		assert.False(t, returned.Deleted)
 		assertBuildingActionDoesNotExist(t, conn, action.Id)
-		assert.Nil(t, returned.Planet.BuildingAction)
+		assert.Empty(t, returned.Planet.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with deleted action with costs", func(t *testing.T) {
 		action, planet := insertTestBuildingAction(t, conn, addBuildingActionCost)
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = nil
+			p.BuildingQueue = []models.BuildingAction{}
 			p.Version++
 		})
 
@@ -716,14 +1045,14 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		assertBuildingActionDoesNotExist(t, conn, action.Id)
 		assertBuildingActionCostDoesNotExist(t, conn, action.Id)
-		assert.Nil(t, returned.Planet.BuildingAction)
+		assert.Empty(t, returned.Planet.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with deleted action with storages", func(t *testing.T) {
 		action, planet := insertTestBuildingAction(t, conn, addBuildingActionStorage)
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = nil
+			p.BuildingQueue = []models.BuildingAction{}
 			p.Version++
 		})
 
@@ -733,14 +1062,14 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		assertBuildingActionDoesNotExist(t, conn, action.Id)
 		assertBuildingActionStorageDoesNotExist(t, conn, action.Id)
-		assert.Nil(t, returned.Planet.BuildingAction)
+		assert.Empty(t, returned.Planet.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with deleted action with productions", func(t *testing.T) {
 		action, planet := insertTestBuildingAction(t, conn, addBuildingActionProduction)
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = nil
+			p.BuildingQueue = []models.BuildingAction{}
 			p.Version++
 		})
 
@@ -750,7 +1079,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		assertBuildingActionDoesNotExist(t, conn, action.Id)
 		assertBuildingActionProductionDoesNotExist(t, conn, action.Id)
-		assert.Nil(t, returned.Planet.BuildingAction)
+		assert.Empty(t, returned.Planet.BuildingQueue)
 	})
 
 	t.Run("persists mutated planet with new action", func(t *testing.T) {
@@ -759,6 +1088,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		newAction := models.BuildingAction{
 			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
 			Building:     crystalMineId,
 			DesiredLevel: 4,
 			CreatedAt:    someTime,
@@ -769,7 +1099,7 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		}
 
 		mutator := generateModifyingMutator(func(p *models.Planet) {
-			p.BuildingAction = &newAction
+			p.BuildingQueue = []models.BuildingAction{newAction}
 			p.Version++
 		})
 
@@ -779,10 +1109,114 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 		assert.False(t, returned.Deleted)
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 		assert.Equal(t, returned.Planet, actual)
-		require.NotNil(t, returned.Planet.BuildingAction)
-		assert.Equal(t, newAction, *returned.Planet.BuildingAction)
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, newAction, *actual.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{newAction}, returned.Planet.BuildingQueue)
+		assert.Equal(t, []models.BuildingAction{newAction}, actual.BuildingQueue)
+	})
+
+	t.Run("persists order of the building queue", func(t *testing.T) {
+		first, planet := insertTestBuildingAction(t, conn)
+		second := insertTestBuildingActionForPlanet(t, conn, planet.Id)
+		third := insertTestBuildingActionForPlanet(t, conn, planet.Id)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.BuildingQueue = []models.BuildingAction{first, third, second}
+			p.Version++
+		})
+
+		returned, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.False(t, returned.Deleted)
+		actual := loadPlanetFromDb(t, conn, planet.Id)
+		expected := []models.BuildingAction{first, third, second}
+		assert.Equal(t, expected, returned.Planet.BuildingQueue)
+		assert.Equal(t, expected, actual.BuildingQueue)
+	})
+
+	t.Run("records event of mutation", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn, addPlanetResource)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.Resources[0].Amount += 12.5
+			p.UpdatedAt = yetAnotherTime
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		events := listTestPlanetEvents(t, conn, planet.Id)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.PlanetUpdated, events[0].Kind)
+		assert.Equal(t, planet.Version, events[0].VersionBefore)
+		assert.Equal(t, planet.Version+1, events[0].VersionAfter)
+		assert.Equal(t, yetAnotherTime, events[0].CreatedAt)
+		expected := []models.PlanetEventResource{{Resource: crystalResourceId, Delta: 12.5}}
+		assert.Equal(t, expected, events[0].Resources)
+	})
+
+	t.Run("records one event per action", func(t *testing.T) {
+		action, planet := insertTestBuildingAction(t, conn)
+
+		newAction := models.BuildingAction{
+			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
+			Building:     crystalMineId,
+			DesiredLevel: 4,
+			CreatedAt:    yetAnotherTime,
+			CompletedAt:  yetAnotherTime.Add(1 * time.Hour),
+			Costs:        []models.BuildingActionCost{},
+			Storages:     []models.BuildingActionResourceStorage{},
+			Productions:  []models.BuildingActionResourceProduction{},
+		}
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {
+			p.BuildingQueue = []models.BuildingAction{newAction}
+			p.UpdatedAt = yetAnotherTime
+			p.Version++
+		})
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		events := listTestPlanetEvents(t, conn, planet.Id)
+		require.Len(t, events, 2)
+		assert.Equal(t, models.PlanetActionCreated, events[0].Kind)
+		assert.Equal(t, &newAction.Id, events[0].Action)
+		require.NotNil(t, events[0].ActionState)
+		require.NotNil(t, events[0].ActionState.Building)
+		assert.Equal(t, newAction.Id, events[0].ActionState.Building.Id)
+		assert.Equal(t, newAction.DesiredLevel, events[0].ActionState.Building.DesiredLevel)
+		assert.Equal(t, planet.Version+1, events[0].VersionAfter)
+		assert.Equal(t, &action.Id, events[1].Action)
+		assert.Equal(t, planet.Version, events[1].VersionAfter)
+	})
+
+	t.Run("does not record event of failed mutation", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+
+		mutator := generateModifyingMutator(func(p *models.Planet) {})
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, mutator)
+		assert.ErrorIs(t, err, domainerrors.ErrMutationWithoutVersionBump, "Actual err: %v", err)
+
+		events := listTestPlanetEvents(t, conn, planet.Id)
+		assert.Empty(t, events)
+	})
+
+	t.Run("records event of deleted planet", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn, addPlanetResource)
+
+		_, err := adapter.Mutate(t.Context(), planet.Id, generateDeletingMutator())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		events := listTestPlanetEvents(t, conn, planet.Id)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.PlanetDeleted, events[0].Kind)
+		expected := []models.PlanetEventResource{
+			{Resource: crystalResourceId, Delta: -planet.Resources[0].Amount},
+		}
+		assert.Equal(t, expected, events[0].Resources)
 	})
 
 	t.Run("returns error when planet does not exist", func(t *testing.T) {
@@ -913,8 +1347,8 @@ func TestIT_PlanetMutator_Mutate(t *testing.T) {
 
 		assert.True(t, returned.Deleted)
 		assertPlanetDoesNotExist(t, conn, planet.Id)
-		require.NotNil(t, planet.BuildingAction)
-		assertBuildingActionDoesNotExist(t, conn, planet.BuildingAction.Id)
+		require.Len(t, planet.BuildingQueue, 1)
+		assertBuildingActionDoesNotExist(t, conn, planet.BuildingQueue[0].Id)
 	})
 }
 
@@ -1115,6 +1549,7 @@ func TestIT_PlanetMutator_ActionCreationDeletionWorkflow(t *testing.T) {
 
 	action := models.BuildingAction{
 		Id:           uuid.New(),
+		Kind:         models.UpgradeAction,
 		Building:     metalMineId,
 		DesiredLevel: 27,
 		CreatedAt:    time.Date(2024, time.December, 7, 20, 26, 47, 0, time.UTC),
@@ -1127,33 +1562,31 @@ func TestIT_PlanetMutator_ActionCreationDeletionWorkflow(t *testing.T) {
 	planet, _, _ := insertTestPlanetForPlayer(t, conn)
 
 	mutation := func(p *models.Planet) (bool, error) {
-		p.BuildingAction = &action
+		p.BuildingQueue = append(p.BuildingQueue, action)
 		p.Version++
 		return false, nil
 	}
 	result, err := planetMutator.Mutate(t.Context(), planet.Id, mutation)
 	require.NoError(t, err, "Actual err: %v", err)
 	assert.False(t, result.Deleted)
-	require.NotNil(t, result.Planet.BuildingAction)
-	assert.Equal(t, action, *result.Planet.BuildingAction)
+	assert.Equal(t, []models.BuildingAction{action}, result.Planet.BuildingQueue)
 	assertBuildingActionExists(t, conn, action.Id)
 
 	func() {
 		actual := loadPlanetFromDb(t, conn, planet.Id)
 
-		require.NotNil(t, actual.BuildingAction)
-		assert.Equal(t, action, *actual.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{action}, actual.BuildingQueue)
 	}()
 
 	mutation = func(p *models.Planet) (bool, error) {
-		p.BuildingAction = nil
+		p.BuildingQueue = []models.BuildingAction{}
 		p.Version++
 		return false, nil
 	}
 	result, err = planetMutator.Mutate(t.Context(), planet.Id, mutation)
 	require.NoError(t, err, "Actual err: %v", err)
 	assert.False(t, result.Deleted)
-	assert.Nil(t, result.Planet.BuildingAction)
+	assert.Empty(t, result.Planet.BuildingQueue)
 	assertBuildingActionDoesNotExist(t, conn, action.Id)
 }
 
@@ -1170,6 +1603,16 @@ func generateModifyingMutator(modifier func(p *models.Planet)) drivenports.Plane
 	}
 }
 
+func listTestPlanetEvents(t *testing.T, conn db.Connection, planet uuid.UUID) []models.PlanetEvent {
+	t.Helper()
+
+	repo := NewPlanetEventRepository(conn)
+	events, err := repo.ListForPlanet(t.Context(), planet, 0, 10)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return events
+}
+
 func generateDeletingMutator() drivenports.PlanetMutator {
 	return func(*models.Planet) (bool, error) {
 		return true, nil
@@ -2,6 +2,7 @@ package drivenadapters
 
 import (
 	"context"
+	"time"
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
@@ -50,6 +51,16 @@ INSERT INTO
 	planet_building (planet, building, level)
 	VALUES ($1, $2, $3)`
 
+	createPlanetShipQuery = `
+INSERT INTO
+	planet_ship (planet, ship, count)
+	VALUES ($1, $2, $3)`
+
+	createPlanetDefenseQuery = `
+INSERT INTO
+	planet_defense (planet, defense, count)
+	VALUES ($1, $2, $3)`
+
 	getPlanetQuery = `
 SELECT
 	p.id,
@@ -65,13 +76,11 @@ SELECT
 	p.fields,
 	p.created_at,
 	p.updated_at,
-	p.version,
-	ba.id AS building_action
+	p.version
 FROM
 	planet AS p
 	LEFT JOIN homeworld AS h ON h.planet = p.id
 	INNER JOIN planet_coordinate AS pc ON pc.planet = p.id
-	LEFT JOIN building_action AS ba ON ba.planet = p.id
 WHERE
 	p.id = $1`
 
@@ -105,10 +114,55 @@ WHERE
 
 	listPlanetBuildingForPlanetQuery = `
 SELECT
-	building,
-	level
+	pb.building,
+	b.name,
+	pb.level
 FROM
-	planet_building
+	planet_building AS pb
+	INNER JOIN building AS b ON b.id = pb.building
+WHERE
+	pb.planet = $1`
+
+	listPlanetShipForPlanetQuery = `
+SELECT
+	ship,
+	count
+FROM
+	planet_ship
+WHERE
+	planet = $1`
+
+	listPlanetDefenseForPlanetQuery = `
+SELECT
+	defense,
+	count
+FROM
+	planet_defense
+WHERE
+	planet = $1`
+
+	getPlanetAtCoordinateQuery = `
+SELECT
+	p.id AS planet,
+	p.player,
+	pc.galaxy,
+	pc.solar_system,
+	pc.position
+FROM
+	planet_coordinate AS pc
+	INNER JOIN planet AS p ON p.id = pc.planet
+	INNER JOIN planet_coordinate AS oc ON oc.universe = pc.universe
+WHERE
+	oc.planet = $1
+	AND pc.galaxy = $2
+	AND pc.solar_system = $3
+	AND pc.position = $4`
+
+	getPlanetUniverseQuery = `
+SELECT
+	universe
+FROM
+	planet_coordinate
 WHERE
 	planet = $1`
 
@@ -170,6 +224,8 @@ WHERE
 	planet = $2
 	AND building = $3`
 
+	deletePlanetShipsQuery               = `DELETE FROM planet_ship WHERE planet = $1`
+	deletePlanetDefensesQuery            = `DELETE FROM planet_defense WHERE planet = $1`
 	deletePlanetBuildingsQuery           = `DELETE FROM planet_building WHERE planet = $1`
 	deletePlanetResourceProductionsQuery = `DELETE FROM planet_resource_production WHERE planet = $1`
 	deletePlanetResourceStoragesQuery    = `DELETE FROM planet_resource_storage WHERE planet = $1`
@@ -193,6 +249,48 @@ func (r *PlanetRepository) ListForPlayer(ctx context.Context, player uuid.UUID)
 	return db.QueryAll[uuid.UUID](ctx, r.conn, listPlanetForPlayerQuery, player)
 }
 
+func (r *PlanetRepository) GetAtCoordinate(
+	ctx context.Context,
+	origin uuid.UUID,
+	coordinate models.Coordinate,
+) (models.FleetTarget, error) {
+	dbTarget, err := db.QueryOne[mappers.DbFleetTarget](
+		ctx,
+		r.conn,
+		getPlanetAtCoordinateQuery,
+		origin,
+		coordinate.Galaxy,
+		coordinate.SolarSystem,
+		coordinate.Position,
+	)
+	if err != nil {
+		return models.FleetTarget{}, parseDbError(err)
+	}
+
+	return dbTarget.ToDomain(), nil
+}
+
+// Get loads the planet as stored in the database: contrary to the mutator,
+// it is neither brought up to date nor modified.
+func (r *PlanetRepository) Get(ctx context.Context, id uuid.UUID) (models.Planet, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Planet{}, err
+	}
+	defer tx.Close(ctx)
+
+	return loadPlanetAndDetails(ctx, tx, id)
+}
+
+func (r *PlanetRepository) GetUniverse(ctx context.Context, planet uuid.UUID) (uuid.UUID, error) {
+	universe, err := db.QueryOne[uuid.UUID](ctx, r.conn, getPlanetUniverseQuery, planet)
+	if err != nil {
+		return uuid.Nil, parseDbError(err)
+	}
+
+	return universe, nil
+}
+
 func (r *PlanetRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	tx, err := r.conn.BeginTx(ctx)
 	if err != nil {
@@ -200,7 +298,7 @@ func (r *PlanetRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	defer tx.Close(ctx)
 
-	return deletePlanetAndDetails(ctx, tx, id)
+	return deletePlanetAndDetails(ctx, tx, id, time.Now())
 }
 
 func createPlanetWithDetails(ctx context.Context, tx db.Transaction, planet models.Planet) error {
@@ -292,6 +390,11 @@ func createPlanetWithDetails(ctx context.Context, tx db.Transaction, planet mode
 		}
 	}
 
+	err = createPlanetUnits(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -352,13 +455,59 @@ func loadPlanetDetails(ctx context.Context, tx db.Transaction, dbPlanet mappers.
 		return planet, err
 	}
 
-	if dbPlanet.BuildingAction != nil {
-		action, err := loadBuildingActionAndDetails(ctx, tx, *dbPlanet.BuildingAction)
-		if err != nil {
-			return planet, err
-		}
+	planet.Ships, err = db.QueryAllTx[models.PlanetShip](
+		ctx,
+		tx,
+		listPlanetShipForPlanetQuery,
+		dbPlanet.Id,
+	)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.Defenses, err = db.QueryAllTx[models.PlanetDefense](
+		ctx,
+		tx,
+		listPlanetDefenseForPlanetQuery,
+		dbPlanet.Id,
+	)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.BuildingQueue, err = loadBuildingQueueForPlanet(ctx, tx, dbPlanet.Id)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.ShipyardQueue, err = loadShipyardQueueForPlanet(ctx, tx, dbPlanet.Id)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.Fleets, err = loadFleetsForPlanet(ctx, tx, dbPlanet.Id)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.MarketOffers, err = loadPendingMarketOffersForPlanet(ctx, tx, dbPlanet.Id)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.Technologies, planet.TechnologyUpgrades, err = loadPlayerTechnologiesForPlanet(ctx, tx, dbPlanet)
+	if err != nil {
+		return planet, err
+	}
+
+	planet.Roles, err = loadGameDataRoles(ctx, tx)
+	if err != nil {
+		return planet, err
+	}
 
-		planet.BuildingAction = &action
+	planet.ResearchAction, err = loadResearchAction(ctx, tx, listResearchActionForPlanetQuery, dbPlanet.Id)
+	if err != nil {
+		return planet, err
 	}
 
 	return planet, nil
@@ -423,7 +572,42 @@ func updatePlanetDetails(
 		}
 	}
 
-	err = recreateBuildingAction(ctx, tx, planet)
+	err = recreateBuildingQueue(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	err = recreateUnits(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	err = recreateShipyardQueue(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	err = syncFleetsForPlanet(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	err = syncMarketOffersForPlanet(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	err = upsertPlayerTechnologies(ctx, tx, planet.Player, planet.Technologies)
+	if err != nil {
+		return err
+	}
+
+	err = recreateResearchAction(ctx, tx, planet)
+	if err != nil {
+		return err
+	}
+
+	err = createMessages(ctx, tx, planet.Messages)
 	if err != nil {
 		return err
 	}
@@ -450,8 +634,41 @@ func updatePlanetDetails(
 	return nil
 }
 
-func deletePlanetAndDetails(ctx context.Context, tx db.Transaction, id uuid.UUID) error {
-	err := deleteBuildingActionAndDetailsForPlanet(ctx, tx, id)
+// deletePlanetAndDetails removes the planet along with everything attached
+// to it. The moment is used to send back the fleets of other planets which
+// were flying towards it.
+func deletePlanetAndDetails(
+	ctx context.Context,
+	tx db.Transaction,
+	id uuid.UUID,
+	moment time.Time,
+) error {
+	err := deleteFleetsForPlanet(ctx, tx, id, moment)
+	if err != nil {
+		return err
+	}
+
+	err = deleteMarketOffersForPlanet(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	err = deleteBuildingActionAndDetailsForPlanet(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	err = deleteShipyardQueueForPlanet(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	err = deleteResearchActionAndDetailsForPlanet(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	err = deletePlanetUnits(ctx, tx, id)
 	if err != nil {
 		return err
 	}
@@ -523,16 +740,17 @@ func recreateResourceProductions(ctx context.Context, tx db.Transaction, planet
 	return nil
 }
 
-// recreateBuildingAction deletes the action first and recreate it completely: this allows to tackle
-// situations where the mutator completed an existing action and recreated a new one.
-func recreateBuildingAction(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+// recreateBuildingQueue deletes the queued actions first and recreate them completely: this allows
+// to tackle situations where the mutator completed, cancelled or reordered some actions. The position
+// of each action in the queue is persisted alongside it.
+func recreateBuildingQueue(ctx context.Context, tx db.Transaction, planet models.Planet) error {
 	err := deleteBuildingActionAndDetailsForPlanet(ctx, tx, planet.Id)
 	if err != nil {
 		return err
 	}
 
-	if planet.BuildingAction != nil {
-		err = upsertBuildingActionWithDetails(ctx, tx, planet.Id, *planet.BuildingAction)
+	for position, action := range planet.BuildingQueue {
+		err = upsertBuildingActionWithDetails(ctx, tx, planet.Id, position, action)
 		if err != nil {
 			return err
 		}
@@ -540,3 +758,96 @@ func recreateBuildingAction(ctx context.Context, tx db.Transaction, planet model
 
 	return nil
 }
+
+// recreateResearchAction behaves like recreateBuildingQueue for the research
+// running on the planet.
+func recreateResearchAction(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+	err := deleteResearchActionAndDetailsForPlanet(ctx, tx, planet.Id)
+	if err != nil {
+		return err
+	}
+
+	if planet.ResearchAction != nil {
+		err = createResearchActionWithDetails(ctx, tx, *planet.ResearchAction)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recreateUnits deletes the ships and defenses stationed on a planet and recreate them
+// completely. This allows to handle units being added by the shipyard as well as units
+// disappearing from the planet.
+func recreateUnits(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+	err := deletePlanetUnits(ctx, tx, planet.Id)
+	if err != nil {
+		return err
+	}
+
+	return createPlanetUnits(ctx, tx, planet)
+}
+
+// recreateShipyardQueue deletes the shipyard queue and recreate it completely: this is
+// similar to what happens for the building queue and allows to handle batches being
+// partially or totally completed by the mutator.
+func recreateShipyardQueue(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+	err := deleteShipyardQueueForPlanet(ctx, tx, planet.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range planet.ShipyardQueue {
+		err = createShipyardActionWithDetails(ctx, tx, planet.Id, action)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createPlanetUnits(ctx context.Context, tx db.Transaction, planet models.Planet) error {
+	for _, s := range planet.Ships {
+		_, err := tx.Exec(
+			ctx,
+			createPlanetShipQuery,
+			planet.Id,
+			s.Ship,
+			s.Count,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, d := range planet.Defenses {
+		_, err := tx.Exec(
+			ctx,
+			createPlanetDefenseQuery,
+			planet.Id,
+			d.Defense,
+			d.Count,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deletePlanetUnits(ctx context.Context, tx db.Transaction, planet uuid.UUID) error {
+	_, err := tx.Exec(ctx, deletePlanetShipsQuery, planet)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, deletePlanetDefensesQuery, planet)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
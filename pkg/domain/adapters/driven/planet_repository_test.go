@@ -7,6 +7,7 @@ import (
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,6 +45,116 @@ func TestIT_PlanetRepository_ListForPlayer(t *testing.T) {
 	assert.NotContains(t, actual, p1)
 }
 
+func TestIT_PlanetRepository_GetAtCoordinate(t *testing.T) {
+	repo, conn := newTestPlanetRepository(t)
+
+	t.Run("gets planet at coordinate in the universe of the origin", func(t *testing.T) {
+		origin, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+
+		actual, err := repo.GetAtCoordinate(t.Context(), origin.Id, target.Coordinate)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.FleetTarget{
+			Planet:     target.Id,
+			Player:     player.Id,
+			Coordinate: target.Coordinate,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when planet is in another universe", func(t *testing.T) {
+		origin, _, _ := insertTestPlanetForPlayer(t, conn)
+		other, _, _ := insertTestPlanetForPlayer(t, conn)
+
+		_, err := repo.GetAtCoordinate(t.Context(), origin.Id, other.Coordinate)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_PlanetRepository_Get(t *testing.T) {
+	repo, conn := newTestPlanetRepository(t)
+
+	t.Run("gets planet without updating it", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn, addPlanetResource, addPlanetBuildingAction)
+
+		actual, err := repo.Get(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := loadPlanetFromDb(t, conn, planet.Id)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, planet.Version, actual.Version)
+	})
+
+	t.Run("gets technology levels reached after the last update of the planet", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		technology := insertTestTechnology(t, conn)
+		pt := insertTestPlayerTechnology(t, conn, player.Id, technology.Id)
+
+		upgrade := models.PlayerTechnology{
+			Technology: technology.Id,
+			Level:      pt.Level + 1,
+			ReachedAt:  yetAnotherTime,
+		}
+		sqlQuery := `INSERT INTO player_technology_level (player, technology, level, reached_at) VALUES ($1, $2, $3, $4)`
+		_, err := conn.Exec(t.Context(), sqlQuery, player.Id, upgrade.Technology, upgrade.Level, upgrade.ReachedAt)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.PlayerTechnology{pt}, actual.Technologies)
+		assert.Equal(t, []models.PlayerTechnology{upgrade}, actual.TechnologyUpgrades)
+	})
+
+	t.Run("gets research running on another planet as a technology upgrade", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		technology := insertTestTechnology(t, conn)
+		action := insertTestResearchAction(t, conn, player.Id, player.Homeworld, technology.Id)
+
+		sqlQuery := `UPDATE research_action SET completed_at = $1 WHERE id = $2`
+		_, err := conn.Exec(t.Context(), sqlQuery, yetAnotherTime, action.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.Get(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlayerTechnology{
+			{Technology: technology.Id, Level: action.DesiredLevel, ReachedAt: yetAnotherTime},
+		}
+		assert.Equal(t, expected, actual.TechnologyUpgrades)
+		assert.Nil(t, actual.ResearchAction)
+	})
+
+	t.Run("returns error when planet does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.Get(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_PlanetRepository_GetUniverse(t *testing.T) {
+	repo, conn := newTestPlanetRepository(t)
+
+	t.Run("gets universe of the planet", func(t *testing.T) {
+		planet, _, universe := insertTestPlanetForPlayer(t, conn)
+
+		actual, err := repo.GetUniverse(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, universe.Id, actual)
+	})
+
+	t.Run("returns error when planet does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetUniverse(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
 func TestIT_PlanetRepository_Delete(t *testing.T) {
 	repo, conn := newTestPlanetRepository(t)
 
@@ -178,7 +289,7 @@ func TestIT_PlanetRepository_Delete(t *testing.T) {
 		require.NoError(t, err, "Actual err: %v", err)
 
 		assertPlanetDoesNotExist(t, conn, planet.Id)
-		assertBuildingActionDoesNotExist(t, conn, planet.BuildingAction.Id)
+		assertBuildingActionDoesNotExist(t, conn, planet.BuildingQueue[0].Id)
 	})
 
 	t.Run("deletes homeworld with building actions", func(t *testing.T) {
@@ -189,7 +300,42 @@ func TestIT_PlanetRepository_Delete(t *testing.T) {
 
 		assertPlanetDoesNotExist(t, conn, planet.Id)
 		assertPlanetIsNotHomeworld(t, conn, planet.Id)
-		assertBuildingActionDoesNotExist(t, conn, planet.BuildingAction.Id)
+		assertBuildingActionDoesNotExist(t, conn, planet.BuildingQueue[0].Id)
+	})
+
+	t.Run("deletes planet with fleets", func(t *testing.T) {
+		planet, player, _ := insertTestPlanetForPlayer(t, conn)
+		target := insertTestPlanet(t, conn, player.Id)
+		fleet := insertTestFleet(t, conn, planet, target)
+
+		err := repo.Delete(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertPlanetDoesNotExist(t, conn, planet.Id)
+		assertFleetDoesNotExist(t, conn, fleet.Id)
+	})
+
+	t.Run("sends back fleets of other players flying towards the planet", func(t *testing.T) {
+		planet, _, _ := insertTestPlanetForPlayer(t, conn)
+		origin, _, _ := insertTestPlanetForPlayer(t, conn)
+		fleet := insertTestFleet(t, conn, origin, planet)
+
+		err := repo.Delete(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assertPlanetDoesNotExist(t, conn, planet.Id)
+
+		actual, err := NewFleetRepository(conn).Get(t.Context(), fleet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		// The fleet reached the planet before it was deleted: it flies
+		// back from there with its cargo.
+		returnsAt := fleet.ArrivesAt.Add(fleet.FlightDuration())
+		fleet.Target = origin.Id
+		fleet.TargetCoordinate = origin.Coordinate
+		fleet.ReturnsAt = &returnsAt
+		fleet.Version++
+		assert.Equal(t, fleet, actual)
 	})
 
 	t.Run("succeeds when the planet does not exist", func(t *testing.T) {
@@ -230,10 +376,20 @@ func insertTestPlanet(
 		Version:   7,
 		// This is intentional: the details (e.g. resources, etc.) are returned as empty
 		// slices by the adapter
-		Resources:   []models.PlanetResource{},
-		Storages:    []models.PlanetResourceStorage{},
-		Productions: []models.PlanetResourceProduction{},
-		Buildings:   []models.PlanetBuilding{},
+		Resources:     []models.PlanetResource{},
+		Storages:      []models.PlanetResourceStorage{},
+		Productions:   []models.PlanetResourceProduction{},
+		Buildings:     []models.PlanetBuilding{},
+		Ships:         []models.PlanetShip{},
+		Defenses:      []models.PlanetDefense{},
+		Fleets:        []models.Fleet{},
+		MarketOffers:  []models.MarketOffer{},
+		BuildingQueue: []models.BuildingAction{},
+		ShipyardQueue: []models.ShipyardAction{},
+		Technologies:  []models.PlayerTechnology{},
+		Roles:         seededRoles,
+
+		TechnologyUpgrades: []models.PlayerTechnology{},
 	}
 
 	sqlQuery := `INSERT INTO planet (id, player, name, fields, created_at, updated_at, version)
@@ -381,6 +537,7 @@ func addPlanetBuilding(t *testing.T, conn db.Connection, p *models.Planet) {
 
 	building := models.PlanetBuilding{
 		Building: metalStorageId,
+		Name:     "metal storage",
 		Level:    0,
 	}
 
@@ -403,6 +560,7 @@ func addPlanetBuildingAction(t *testing.T, conn db.Connection, p *models.Planet)
 
 	action := models.BuildingAction{
 		Id:           uuid.New(),
+		Kind:         models.UpgradeAction,
 		Building:     metalStorageId,
 		DesiredLevel: 1,
 		CreatedAt:    someTime,
@@ -415,8 +573,8 @@ func addPlanetBuildingAction(t *testing.T, conn db.Connection, p *models.Planet)
 	}
 
 	sqlQuery := `INSERT INTO building_action
-		(id, planet, building, desired_level, created_at, completed_at)
-		VALUES ($1, $2, $3, $4, $5, $6)`
+		(id, planet, building, desired_level, position, created_at, completed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
 	_, err := conn.Exec(
 		t.Context(),
 		sqlQuery,
@@ -424,12 +582,13 @@ func addPlanetBuildingAction(t *testing.T, conn db.Connection, p *models.Planet)
 		p.Id,
 		action.Building,
 		action.DesiredLevel,
+		len(p.BuildingQueue),
 		action.CreatedAt,
 		action.CompletedAt,
 	)
 	require.NoError(t, err, "Actual err: %v", err)
 
-	p.BuildingAction = &action
+	p.BuildingQueue = append(p.BuildingQueue, action)
 }
 
 // insertTestPlanetForPlayer creates a test planet. The returned planet belongs
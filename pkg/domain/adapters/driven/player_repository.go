@@ -6,6 +6,7 @@ import (
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 )
 
@@ -23,10 +24,19 @@ SELECT
 	p.name,
 	p.created_at,
 	p.version,
-	h.planet AS homeworld
+	h.planet AS homeworld,
+	COALESCE(r.rank, 0) AS rank,
+	COALESCE(r.points, 0) AS points,
+	a.id AS alliance_id,
+	a.name AS alliance_name,
+	a.tag AS alliance_tag,
+	am.rank AS alliance_rank
 FROM
 	player AS p
 	INNER JOIN homeworld AS h ON h.player = p.id
+	LEFT JOIN player_ranking AS r ON r.player = p.id
+	LEFT JOIN alliance_member AS am ON am.player = p.id
+	LEFT JOIN alliance AS a ON a.id = am.alliance
 WHERE
 	p.id = $1`
 
@@ -51,16 +61,50 @@ SELECT
 	p.name,
 	p.created_at,
 	p.version,
-	h.planet AS homeworld
+	h.planet AS homeworld,
+	COALESCE(r.rank, 0) AS rank,
+	COALESCE(r.points, 0) AS points,
+	a.id AS alliance_id,
+	a.name AS alliance_name,
+	a.tag AS alliance_tag,
+	am.rank AS alliance_rank
 FROM
 	player AS p
 	INNER JOIN homeworld AS h ON h.player = p.id
+	LEFT JOIN player_ranking AS r ON r.player = p.id
+	LEFT JOIN alliance_member AS am ON am.player = p.id
+	LEFT JOIN alliance AS a ON a.id = am.alliance
 WHERE
 	p.api_user = $1
 ORDER BY
 	p.created_at,
 	p.name`
 
+	updatePlayerVersionQuery = `
+UPDATE
+	player
+SET
+	version = $1
+WHERE
+	id = $2
+	AND version = $3`
+
+	// The founder can not leave its alliance: when it is deleted, the alliance
+	// is disbanded.
+	deleteAlliancesFoundedByPlayerQuery = `
+DELETE FROM
+	alliance
+WHERE
+	id IN (
+		SELECT
+			alliance
+		FROM
+			alliance_member
+		WHERE
+			player = $1
+			AND rank = 'founder'
+	)`
+
 	deletePlayerQuery = `DELETE FROM player WHERE id = $1`
 )
 
@@ -103,7 +147,7 @@ func (r *PlayerRepository) Create(
 		return parseDbError(err)
 	}
 
-	return nil
+	return notifyPlanetCreation(ctx, tx, homeworld, player.Universe)
 }
 
 func (r *PlayerRepository) Get(ctx context.Context, id uuid.UUID) (models.Player, error) {
@@ -121,6 +165,45 @@ func (r *PlayerRepository) Get(ctx context.Context, id uuid.UUID) (models.Player
 	return loadPlayerDetails(ctx, tx, dbPlayer)
 }
 
+// Colonize persists the planet and bumps the version of the player in a single
+// transaction. The version of the player is expected to have been incremented by
+// exactly one compared to what is stored in the database.
+func (r *PlayerRepository) Colonize(
+	ctx context.Context,
+	player models.Player,
+	planet models.Planet,
+) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	affected, err := tx.Exec(
+		ctx,
+		updatePlayerVersionQuery,
+		player.Version,
+		player.Id,
+		player.Version-1,
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+	if affected != 1 {
+		if err := tx.Rollback(); err != nil {
+			return err
+		}
+		return domainerrors.ErrOptimisticLocking
+	}
+
+	err = createPlanetWithDetails(ctx, tx, planet)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	return notifyPlanetCreation(ctx, tx, planet, player.Universe)
+}
+
 func (r *PlayerRepository) ListForApiUser(ctx context.Context, apiUser uuid.UUID) ([]models.Player, error) {
 	tx, err := r.conn.BeginTx(ctx)
 	if err != nil {
@@ -146,6 +229,8 @@ func (r *PlayerRepository) ListForApiUser(ctx context.Context, apiUser uuid.UUID
 	return players, nil
 }
 
+// Delete removes the player along with its technologies and the alliances
+// it founded. Its planets are expected to be deleted beforehand.
 func (r *PlayerRepository) Delete(ctx context.Context, player models.Player) error {
 	tx, err := r.conn.BeginTx(ctx)
 	if err != nil {
@@ -153,11 +238,14 @@ func (r *PlayerRepository) Delete(ctx context.Context, player models.Player) err
 	}
 	defer tx.Close(ctx)
 
-	for _, p := range player.Planets {
-		err = deletePlanetAndDetails(ctx, tx, p)
-		if err != nil {
-			return parseDbError(err)
-		}
+	_, err = tx.Exec(ctx, deletePlayerTechnologiesQuery, player.Id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, deleteAlliancesFoundedByPlayerQuery, player.Id)
+	if err != nil {
+		return err
 	}
 
 	_, err = tx.Exec(ctx, deletePlayerQuery, player.Id)
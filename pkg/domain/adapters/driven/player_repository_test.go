@@ -65,9 +65,20 @@ func TestIT_PlayerRepository_Create(t *testing.T) {
 			Buildings: []models.PlanetBuilding{
 				{
 					Building: metalMineId,
+					Name:     "metal mine",
 					Level:    2,
 				},
 			},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
+			Roles:         seededRoles,
+
+			TechnologyUpgrades: []models.PlayerTechnology{},
 		}
 
 		err := repo.Create(t.Context(), player, planet)
@@ -107,13 +118,20 @@ func TestIT_PlayerRepository_Create(t *testing.T) {
 				SolarSystem: 147,
 				Position:    17,
 			},
-			CreatedAt:   someTime,
-			UpdatedAt:   someOtherTime,
-			Version:     0,
-			Resources:   []models.PlanetResource{},
-			Storages:    []models.PlanetResourceStorage{},
-			Productions: []models.PlanetResourceProduction{},
-			Buildings:   []models.PlanetBuilding{},
+			CreatedAt:     someTime,
+			UpdatedAt:     someOtherTime,
+			Version:       0,
+			Resources:     []models.PlanetResource{},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
 		}
 
 		err := repo.Create(t.Context(), player, planet)
@@ -134,17 +152,24 @@ func TestIT_PlayerRepository_Create(t *testing.T) {
 			CreatedAt: someTime,
 		}
 		planet := models.Planet{
-			Id:          uuid.New(),
-			Player:      player.Id,
-			Name:        fmt.Sprintf("planet-%s", uuid.NewString()),
-			Homeworld:   true,
-			CreatedAt:   someTime,
-			UpdatedAt:   someOtherTime,
-			Version:     0,
-			Resources:   []models.PlanetResource{},
-			Storages:    []models.PlanetResourceStorage{},
-			Productions: []models.PlanetResourceProduction{},
-			Buildings:   []models.PlanetBuilding{},
+			Id:            uuid.New(),
+			Player:        player.Id,
+			Name:          fmt.Sprintf("planet-%s", uuid.NewString()),
+			Homeworld:     true,
+			CreatedAt:     someTime,
+			UpdatedAt:     someOtherTime,
+			Version:       0,
+			Resources:     []models.PlanetResource{},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
 		}
 
 		err := repo.Create(t.Context(), newPlayer, planet)
@@ -164,18 +189,25 @@ func TestIT_PlayerRepository_Create(t *testing.T) {
 			CreatedAt: someTime,
 		}
 		homeworld := models.Planet{
-			Id:          uuid.New(),
-			Player:      newPlayer.Id,
-			Name:        fmt.Sprintf("planet-%s", uuid.NewString()),
-			Homeworld:   true,
-			Coordinate:  planet.Coordinate,
-			CreatedAt:   someTime,
-			UpdatedAt:   someOtherTime,
-			Version:     0,
-			Resources:   []models.PlanetResource{},
-			Storages:    []models.PlanetResourceStorage{},
-			Productions: []models.PlanetResourceProduction{},
-			Buildings:   []models.PlanetBuilding{},
+			Id:            uuid.New(),
+			Player:        newPlayer.Id,
+			Name:          fmt.Sprintf("planet-%s", uuid.NewString()),
+			Homeworld:     true,
+			Coordinate:    planet.Coordinate,
+			CreatedAt:     someTime,
+			UpdatedAt:     someOtherTime,
+			Version:       0,
+			Resources:     []models.PlanetResource{},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
 		}
 
 		err := repo.Create(t.Context(), newPlayer, homeworld)
@@ -207,6 +239,22 @@ func TestIT_PlayerRepository_Get(t *testing.T) {
 		assert.Equal(t, player, actual)
 	})
 
+	t.Run("gets a player with its alliance", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		alliance := insertTestAlliance(t, conn, player)
+
+		actual, err := repo.Get(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := &models.PlayerAlliance{
+			Id:   alliance.Id,
+			Name: alliance.Name,
+			Tag:  alliance.Tag,
+			Rank: models.FounderRank,
+		}
+		assert.Equal(t, expected, actual.Alliance)
+	})
+
 	t.Run("returns error when player does not exist", func(t *testing.T) {
 		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
 		_, err := repo.Get(t.Context(), id)
@@ -215,6 +263,122 @@ func TestIT_PlayerRepository_Get(t *testing.T) {
 	})
 }
 
+func TestIT_PlayerRepository_Colonize(t *testing.T) {
+	repo, conn := newTestPlayerRepository(t)
+
+	t.Run("creates a colony for the player", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+
+		planet := models.Planet{
+			Id:        uuid.New(),
+			Player:    player.Id,
+			Name:      fmt.Sprintf("planet-%s", uuid.NewString()),
+			Homeworld: false,
+			Coordinate: models.Coordinate{
+				Galaxy:      29,
+				SolarSystem: 401,
+				Position:    12,
+			},
+			Fields:    163,
+			CreatedAt: someTime,
+			UpdatedAt: someOtherTime,
+			Version:   0,
+			Resources: []models.PlanetResource{
+				{
+					Resource: metalResourceId,
+					Amount:   500,
+				},
+			},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
+			Roles:         seededRoles,
+
+			TechnologyUpgrades: []models.PlayerTechnology{},
+		}
+		player.Planets = append(player.Planets, planet.Id)
+		player.Version++
+
+		err := repo.Colonize(t.Context(), player, planet)
+		require.NoError(t, err, "Actual err: %v", err)
+		assertPlanetExists(t, conn, planet.Id)
+		assertPlanetIsNotHomeworld(t, conn, planet.Id)
+
+		actual, err := repo.Get(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+		assert.Equal(t, player, actual)
+
+		actualPlanet := loadPlanetFromDb(t, conn, planet.Id)
+		assert.Equal(t, planet, actualPlanet)
+	})
+
+	t.Run("returns error when player version does not match", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+
+		planet := models.Planet{
+			Id:            uuid.New(),
+			Player:        player.Id,
+			Name:          fmt.Sprintf("planet-%s", uuid.NewString()),
+			CreatedAt:     someTime,
+			UpdatedAt:     someOtherTime,
+			Resources:     []models.PlanetResource{},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
+		}
+		player.Version += 2
+
+		err := repo.Colonize(t.Context(), player, planet)
+
+		assert.Equal(t, domainerrors.ErrOptimisticLocking, err, "Actual err: %v", err)
+		assertPlanetDoesNotExist(t, conn, planet.Id)
+	})
+
+	t.Run("returns error when planet with same coordinates already exist", func(t *testing.T) {
+		existing, player, _ := insertTestPlanetForPlayer(t, conn)
+
+		planet := models.Planet{
+			Id:            uuid.New(),
+			Player:        player.Id,
+			Name:          fmt.Sprintf("planet-%s", uuid.NewString()),
+			Coordinate:    existing.Coordinate,
+			CreatedAt:     someTime,
+			UpdatedAt:     someOtherTime,
+			Resources:     []models.PlanetResource{},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
+		}
+		player.Version++
+
+		err := repo.Colonize(t.Context(), player, planet)
+
+		assert.Equal(t, domainerrors.ErrCoordinateAlreadyUsed, err, "Actual err: %v", err)
+		assertPlanetDoesNotExist(t, conn, planet.Id)
+	})
+}
+
 func TestIT_PlayerRepository_ListForApiUser(t *testing.T) {
 	repo, conn := newTestPlayerRepository(t)
 
@@ -252,29 +416,14 @@ func TestIT_PlayerRepository_Delete(t *testing.T) {
 		assertPlayerDoesNotExist(t, conn, player.Id)
 	})
 
-	t.Run("deletes a player with planet", func(t *testing.T) {
+	t.Run("deletes a player whose planets were deleted", func(t *testing.T) {
 		player, _ := insertTestPlayerInUniverse(t, conn, addPlayerPlanet)
+		deleteTestPlayerPlanets(t, conn, player)
 
 		err := repo.Delete(t.Context(), player)
 		require.NoError(t, err, "Actual err: %v", err)
 
 		assertPlayerDoesNotExist(t, conn, player.Id)
-		for _, planetId := range player.Planets {
-			assertPlanetDoesNotExist(t, conn, planetId)
-		}
-	})
-
-	t.Run("deletes a player with a building action", func(t *testing.T) {
-		player, _ := insertTestPlayerInUniverse(t, conn)
-		planet := insertTestPlanet(t, conn, player.Id, addPlanetBuildingAction)
-		player.Planets = append(player.Planets, planet.Id)
-
-		err := repo.Delete(t.Context(), player)
-		require.NoError(t, err, "Actual err: %v", err)
-
-		assertPlayerDoesNotExist(t, conn, player.Id)
-		assertPlanetDoesNotExist(t, conn, planet.Id)
-		assertBuildingActionDoesNotExist(t, conn, planet.BuildingAction.Id)
 	})
 
 	t.Run("succeeds when the player does not exist", func(t *testing.T) {
@@ -301,14 +450,24 @@ func TestIT_PlayerRepository_CreationDeletionWorkflow(t *testing.T) {
 			Planets:   []uuid.UUID{planetId},
 		}
 		planet := models.Planet{
-			Id:          planetId,
-			Player:      player.Id,
-			Name:        "homeworld",
-			Homeworld:   true,
-			Resources:   []models.PlanetResource{},
-			Storages:    []models.PlanetResourceStorage{},
-			Productions: []models.PlanetResourceProduction{},
-			Buildings:   []models.PlanetBuilding{},
+			Id:            planetId,
+			Player:        player.Id,
+			Name:          "homeworld",
+			Homeworld:     true,
+			Resources:     []models.PlanetResource{},
+			Storages:      []models.PlanetResourceStorage{},
+			Productions:   []models.PlanetResourceProduction{},
+			Buildings:     []models.PlanetBuilding{},
+			Ships:         []models.PlanetShip{},
+			Defenses:      []models.PlanetDefense{},
+			Fleets:        []models.Fleet{},
+			MarketOffers:  []models.MarketOffer{},
+			BuildingQueue: []models.BuildingAction{},
+			ShipyardQueue: []models.ShipyardAction{},
+			Technologies:  []models.PlayerTechnology{},
+			Roles:         seededRoles,
+
+			TechnologyUpgrades: []models.PlayerTechnology{},
 		}
 
 		func() {
@@ -322,6 +481,8 @@ func TestIT_PlayerRepository_CreationDeletionWorkflow(t *testing.T) {
 		}()
 
 		func() {
+			deleteTestPlayerPlanets(t, conn, player)
+
 			err := repo.Delete(t.Context(), player)
 			require.NoError(t, err, "Actual err: %v", err)
 		}()
@@ -331,6 +492,18 @@ func TestIT_PlayerRepository_CreationDeletionWorkflow(t *testing.T) {
 	})
 }
 
+// deleteTestPlayerPlanets deletes the planets of the player through the
+// mutator as it is done before deleting the player.
+func deleteTestPlayerPlanets(t *testing.T, conn db.Connection, player models.Player) {
+	t.Helper()
+
+	mutator := NewPlanetMutator(conn)
+	for _, planet := range player.Planets {
+		_, err := mutator.Mutate(t.Context(), planet, generateDeletingMutator())
+		require.NoError(t, err, "Actual err: %v", err)
+	}
+}
+
 func newTestPlayerRepository(t *testing.T) (*PlayerRepository, db.Connection) {
 	t.Helper()
 	conn := newTestConnection(t)
@@ -0,0 +1,141 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	listPlayerIdsInUniverseQuery = `
+SELECT
+	id
+FROM
+	player
+WHERE
+	universe = $1`
+
+	listPlayerBuildingLevelsQuery = `
+SELECT
+	p.player,
+	pb.building,
+	pb.level,
+	COUNT(*) AS count
+FROM
+	planet_building AS pb
+	INNER JOIN planet AS p ON p.id = pb.planet
+	INNER JOIN player AS pl ON pl.id = p.player
+WHERE
+	pl.universe = $1
+	AND pb.level > 0
+GROUP BY
+	p.player,
+	pb.building,
+	pb.level`
+
+	deleteRankingsForUniverseQuery = `DELETE FROM player_ranking WHERE universe = $1`
+
+	// https://www.postgresql.org/docs/current/functions-array.html
+	createRankingsQuery = `
+INSERT INTO
+	player_ranking (player, universe, points, rank)
+SELECT
+	r.player,
+	$1,
+	r.points,
+	r.rank
+FROM
+	unnest($2::uuid[], $3::bigint[], $4::integer[]) AS r(player, points, rank)`
+
+	listRankingsQuery = `
+SELECT
+	r.player,
+	p.name AS player_name,
+	r.points,
+	r.rank
+FROM
+	player_ranking AS r
+	INNER JOIN player AS p ON p.id = r.player
+WHERE
+	r.universe = $1
+ORDER BY
+	r.rank,
+	r.player
+LIMIT $2
+OFFSET $3`
+)
+
+type RankingRepository struct {
+	conn db.Connection
+}
+
+func NewRankingRepository(conn db.Connection) *RankingRepository {
+	return &RankingRepository{
+		conn: conn,
+	}
+}
+
+func (r *RankingRepository) ListPlayers(
+	ctx context.Context,
+	universe uuid.UUID,
+) ([]uuid.UUID, error) {
+	return db.QueryAll[uuid.UUID](ctx, r.conn, listPlayerIdsInUniverseQuery, universe)
+}
+
+func (r *RankingRepository) ListBuildingLevels(
+	ctx context.Context,
+	universe uuid.UUID,
+) ([]models.PlayerBuildingLevel, error) {
+	return db.QueryAll[models.PlayerBuildingLevel](
+		ctx,
+		r.conn,
+		listPlayerBuildingLevelsQuery,
+		universe,
+	)
+}
+
+func (r *RankingRepository) Replace(
+	ctx context.Context,
+	universe uuid.UUID,
+	rankings []models.Ranking,
+) error {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Close(ctx)
+
+	_, err = tx.Exec(ctx, deleteRankingsForUniverseQuery, universe)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	// The rankings are inserted in a single statement: this keeps the
+	// refresh fast even for large universes.
+	players := make([]uuid.UUID, 0, len(rankings))
+	points := make([]int, 0, len(rankings))
+	ranks := make([]int, 0, len(rankings))
+	for _, ranking := range rankings {
+		players = append(players, ranking.Player)
+		points = append(points, ranking.Points)
+		ranks = append(ranks, ranking.Rank)
+	}
+
+	_, err = tx.Exec(ctx, createRankingsQuery, universe, players, points, ranks)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	return nil
+}
+
+func (r *RankingRepository) List(
+	ctx context.Context,
+	universe uuid.UUID,
+	offset int,
+	limit int,
+) ([]models.Ranking, error) {
+	return db.QueryAll[models.Ranking](ctx, r.conn, listRankingsQuery, universe, limit, offset)
+}
@@ -0,0 +1,148 @@
+package drivenadapters
+
+import (
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_RankingRepository_ListPlayers(t *testing.T) {
+	repo, conn := newTestRankingRepository(t)
+
+	t.Run("lists players of the universe", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+		insertTestPlayerInUniverse(t, conn)
+
+		actual, err := repo.ListPlayers(t.Context(), universe.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []uuid.UUID{player.Id}, actual)
+	})
+}
+
+func TestIT_RankingRepository_ListBuildingLevels(t *testing.T) {
+	repo, conn := newTestRankingRepository(t)
+
+	t.Run("counts planets with the same building level", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+		colony := insertTestPlanet(t, conn, player.Id)
+		insertTestPlanetBuilding(t, conn, player.Homeworld, metalMineId, 3)
+		insertTestPlanetBuilding(t, conn, colony.Id, metalMineId, 3)
+
+		actual, err := repo.ListBuildingLevels(t.Context(), universe.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlayerBuildingLevel{
+			{Player: player.Id, Building: metalMineId, Level: 3, Count: 2},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("ignores buildings which are not built", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+		insertTestPlanetBuilding(t, conn, player.Homeworld, metalMineId, 0)
+
+		actual, err := repo.ListBuildingLevels(t.Context(), universe.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+}
+
+func TestIT_RankingRepository_Replace(t *testing.T) {
+	repo, conn := newTestRankingRepository(t)
+
+	t.Run("replaces previous rankings", func(t *testing.T) {
+		player1, universe := insertTestPlayerInUniverse(t, conn)
+		player2 := insertTestPlayer(t, conn, universe.Id)
+
+		rankings := []models.Ranking{
+			{Player: player1.Id, Points: 12, Rank: 1},
+			{Player: player2.Id, Points: 5, Rank: 2},
+		}
+		err := repo.Replace(t.Context(), universe.Id, rankings)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		rankings = []models.Ranking{
+			{Player: player2.Id, Points: 26, Rank: 1},
+			{Player: player1.Id, Points: 12, Rank: 2},
+		}
+		err = repo.Replace(t.Context(), universe.Id, rankings)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.List(t.Context(), universe.Id, 0, 10)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.Ranking{
+			{Player: player2.Id, PlayerName: player2.Name, Points: 26, Rank: 1},
+			{Player: player1.Id, PlayerName: player1.Name, Points: 12, Rank: 2},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("exposes rank of player", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+
+		rankings := []models.Ranking{
+			{Player: player.Id, Points: 47, Rank: 1},
+		}
+		err := repo.Replace(t.Context(), universe.Id, rankings)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := NewPlayerRepository(conn).Get(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 1, actual.Rank)
+		assert.Equal(t, 47, actual.Points)
+	})
+}
+
+func TestIT_RankingRepository_List(t *testing.T) {
+	repo, conn := newTestRankingRepository(t)
+
+	t.Run("returns requested page", func(t *testing.T) {
+		player1, universe := insertTestPlayerInUniverse(t, conn)
+		player2 := insertTestPlayer(t, conn, universe.Id)
+		player3 := insertTestPlayer(t, conn, universe.Id)
+
+		rankings := []models.Ranking{
+			{Player: player1.Id, Points: 30, Rank: 1},
+			{Player: player2.Id, Points: 20, Rank: 2},
+			{Player: player3.Id, Points: 10, Rank: 3},
+		}
+		err := repo.Replace(t.Context(), universe.Id, rankings)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.List(t.Context(), universe.Id, 1, 1)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.Ranking{
+			{Player: player2.Id, PlayerName: player2.Name, Points: 20, Rank: 2},
+		}
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func newTestRankingRepository(t *testing.T) (*RankingRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewRankingRepository(conn), conn
+}
+
+func insertTestPlanetBuilding(
+	t *testing.T,
+	conn db.Connection,
+	planet uuid.UUID,
+	building uuid.UUID,
+	level int,
+) {
+	t.Helper()
+
+	sqlQuery := `INSERT INTO planet_building (planet, building, level) VALUES ($1, $2, $3)`
+	_, err := conn.Exec(t.Context(), sqlQuery, planet, building, level)
+	require.NoError(t, err, "Actual err: %v", err)
+}
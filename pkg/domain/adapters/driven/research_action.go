@@ -0,0 +1,143 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	createResearchActionQuery = `
+INSERT INTO
+	research_action (id, player, planet, technology, desired_level, created_at, completed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	createResearchActionCostQuery = `
+INSERT INTO
+	research_action_cost (action, resource, amount)
+	VALUES ($1, $2, $3)`
+
+	selectResearchActionQuery = `
+SELECT
+	id,
+	player,
+	planet,
+	technology,
+	desired_level,
+	created_at,
+	completed_at
+FROM
+	research_action`
+
+	listResearchActionForPlanetQuery = selectResearchActionQuery + `
+WHERE
+	planet = $1`
+
+	listResearchActionForPlayerQuery = selectResearchActionQuery + `
+WHERE
+	player = $1`
+
+	listResearchActionCostForActionQuery = `
+SELECT
+	resource,
+	amount
+FROM
+	research_action_cost
+WHERE
+	action = $1`
+
+	deleteResearchActionCostForPlanetQuery = `
+DELETE FROM
+	research_action_cost AS racd
+USING
+	research_action_cost AS rac
+	INNER JOIN research_action AS ra ON ra.id = rac.action
+WHERE
+	racd.action = rac.action
+	AND ra.planet = $1`
+	deleteResearchActionForPlanetQuery = `DELETE FROM research_action WHERE planet = $1`
+)
+
+func createResearchActionWithDetails(
+	ctx context.Context,
+	tx db.Transaction,
+	action models.ResearchAction,
+) error {
+	_, err := tx.Exec(
+		ctx,
+		createResearchActionQuery,
+		action.Id,
+		action.Player,
+		action.Planet,
+		action.Technology,
+		action.DesiredLevel,
+		action.CreatedAt,
+		action.CompletedAt,
+	)
+	if err != nil {
+		return parseDbError(err)
+	}
+
+	for _, c := range action.Costs {
+		_, err = tx.Exec(
+			ctx,
+			createResearchActionCostQuery,
+			action.Id,
+			c.Resource,
+			c.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadResearchAction returns the research action matching the query if
+// any: there's at most one action per planet and per player.
+func loadResearchAction(
+	ctx context.Context,
+	tx db.Transaction,
+	query string,
+	id uuid.UUID,
+) (*models.ResearchAction, error) {
+	dbActions, err := db.QueryAllTx[mappers.DbResearchAction](ctx, tx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(dbActions) == 0 {
+		return nil, nil
+	}
+
+	action := dbActions[0].ToDomain()
+
+	action.Costs, err = db.QueryAllTx[models.ResearchActionCost](
+		ctx,
+		tx,
+		listResearchActionCostForActionQuery,
+		action.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+func deleteResearchActionAndDetailsForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) error {
+	_, err := tx.Exec(ctx, deleteResearchActionCostForPlanetQuery, planet)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, deleteResearchActionForPlanetQuery, planet)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	createShipyardActionQuery = `
+INSERT INTO
+	shipyard_action (id, planet, ship, defense, count, unit_build_time_ms, created_at, completed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	createShipyardActionCostQuery = `
+INSERT INTO
+	shipyard_action_cost (action, resource, amount)
+	VALUES ($1, $2, $3)`
+
+	listShipyardActionForPlanetQuery = `
+SELECT
+	id,
+	ship,
+	defense,
+	count,
+	unit_build_time_ms,
+	created_at,
+	completed_at
+FROM
+	shipyard_action
+WHERE
+	planet = $1
+ORDER BY
+	completed_at,
+	created_at`
+
+	listShipyardActionCostForActionQuery = `
+SELECT
+	resource,
+	amount
+FROM
+	shipyard_action_cost
+WHERE
+	action = $1`
+
+	deleteShipyardActionCostForPlanetQuery = `
+DELETE FROM
+	shipyard_action_cost AS sacd
+USING
+	shipyard_action_cost AS sac
+	INNER JOIN shipyard_action AS sa ON sa.id = sac.action
+WHERE
+	sacd.action = sac.action
+	AND sa.planet = $1`
+	deleteShipyardActionForPlanetQuery = `DELETE FROM shipyard_action WHERE planet = $1`
+)
+
+func createShipyardActionWithDetails(
+	ctx context.Context,
+	tx db.Transaction,
+	planet uuid.UUID,
+	action models.ShipyardAction,
+) error {
+	var ship, defense *uuid.UUID
+	switch action.Kind {
+	case models.ShipUnit:
+		ship = &action.Unit
+	case models.DefenseUnit:
+		defense = &action.Unit
+	}
+
+	_, err := tx.Exec(
+		ctx,
+		createShipyardActionQuery,
+		action.Id,
+		planet,
+		ship,
+		defense,
+		action.Count,
+		action.UnitBuildTime.Milliseconds(),
+		action.CreatedAt,
+		action.CompletedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range action.Costs {
+		_, err = tx.Exec(
+			ctx,
+			createShipyardActionCostQuery,
+			action.Id,
+			c.Resource,
+			c.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func loadShipyardQueueForPlanet(
+	ctx context.Context,
+	tx db.Transaction,
+	planet uuid.UUID,
+) ([]models.ShipyardAction, error) {
+	dbActions, err := db.QueryAllTx[mappers.DbShipyardAction](
+		ctx,
+		tx,
+		listShipyardActionForPlanetQuery,
+		planet,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]models.ShipyardAction, 0, len(dbActions))
+	for _, dbAction := range dbActions {
+		action := dbAction.ToDomain()
+
+		action.Costs, err = db.QueryAllTx[models.ShipyardActionCost](
+			ctx,
+			tx,
+			listShipyardActionCostForActionQuery,
+			dbAction.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		queue = append(queue, action)
+	}
+
+	return queue, nil
+}
+
+func deleteShipyardQueueForPlanet(ctx context.Context, tx db.Transaction, planet uuid.UUID) error {
+	_, err := tx.Exec(ctx, deleteShipyardActionCostForPlanetQuery, planet)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, deleteShipyardActionForPlanetQuery, planet)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	getShipQuery = `
+SELECT
+	id,
+	name,
+	speed,
+	cargo_capacity,
+	created_at
+FROM
+	ship
+WHERE
+	id = $1`
+
+	listShipQuery = `
+SELECT
+	id,
+	name,
+	speed,
+	cargo_capacity,
+	created_at
+FROM
+	ship
+ORDER BY
+	created_at,
+	name`
+
+	listShipCostForShipQuery = `
+SELECT
+	sc.resource,
+	sc.cost,
+	r.build_time_hours_per_unit
+FROM
+	ship_cost AS sc
+	INNER JOIN resource AS r ON r.id = sc.resource
+WHERE
+	sc.ship = $1`
+
+	getDefenseQuery = `
+SELECT
+	id,
+	name,
+	created_at
+FROM
+	defense
+WHERE
+	id = $1`
+
+	listDefenseQuery = `
+SELECT
+	id,
+	name,
+	created_at
+FROM
+	defense
+ORDER BY
+	created_at,
+	name`
+
+	listDefenseCostForDefenseQuery = `
+SELECT
+	dc.resource,
+	dc.cost,
+	r.build_time_hours_per_unit
+FROM
+	defense_cost AS dc
+	INNER JOIN resource AS r ON r.id = dc.resource
+WHERE
+	dc.defense = $1`
+)
+
+type ShipyardRepository struct {
+	conn db.Connection
+}
+
+func NewShipyardRepository(conn db.Connection) *ShipyardRepository {
+	return &ShipyardRepository{
+		conn: conn,
+	}
+}
+
+func (r *ShipyardRepository) GetShip(ctx context.Context, id uuid.UUID) (models.Ship, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Ship{}, err
+	}
+	defer tx.Close(ctx)
+
+	dbShip, err := db.QueryOneTx[mappers.DbShip](ctx, tx, getShipQuery, id)
+	if err != nil {
+		return models.Ship{}, parseDbError(err)
+	}
+
+	return loadShipDetails(ctx, tx, dbShip)
+}
+
+func (r *ShipyardRepository) GetDefense(ctx context.Context, id uuid.UUID) (models.Defense, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Defense{}, err
+	}
+	defer tx.Close(ctx)
+
+	dbDefense, err := db.QueryOneTx[mappers.DbDefense](ctx, tx, getDefenseQuery, id)
+	if err != nil {
+		return models.Defense{}, parseDbError(err)
+	}
+
+	return loadDefenseDetails(ctx, tx, dbDefense)
+}
+
+func loadShips(ctx context.Context, tx db.Transaction) ([]models.Ship, error) {
+	dbShips, err := db.QueryAllTx[mappers.DbShip](ctx, tx, listShipQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	ships := make([]models.Ship, 0, len(dbShips))
+	for id := range dbShips {
+		ship, err := loadShipDetails(ctx, tx, dbShips[id])
+		if err != nil {
+			return nil, err
+		}
+
+		ships = append(ships, ship)
+	}
+
+	return ships, nil
+}
+
+func loadShipDetails(ctx context.Context, tx db.Transaction, dbShip mappers.DbShip) (models.Ship, error) {
+	ship := dbShip.ToDomain()
+
+	var err error
+	ship.Costs, err = db.QueryAllTx[models.UnitCost](
+		ctx,
+		tx,
+		listShipCostForShipQuery,
+		dbShip.Id,
+	)
+	if err != nil {
+		return ship, err
+	}
+
+	return ship, nil
+}
+
+func loadDefenses(ctx context.Context, tx db.Transaction) ([]models.Defense, error) {
+	dbDefenses, err := db.QueryAllTx[mappers.DbDefense](ctx, tx, listDefenseQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	defenses := make([]models.Defense, 0, len(dbDefenses))
+	for id := range dbDefenses {
+		defense, err := loadDefenseDetails(ctx, tx, dbDefenses[id])
+		if err != nil {
+			return nil, err
+		}
+
+		defenses = append(defenses, defense)
+	}
+
+	return defenses, nil
+}
+
+func loadDefenseDetails(ctx context.Context, tx db.Transaction, dbDefense mappers.DbDefense) (models.Defense, error) {
+	defense := dbDefense.ToDomain()
+
+	var err error
+	defense.Costs, err = db.QueryAllTx[models.UnitCost](
+		ctx,
+		tx,
+		listDefenseCostForDefenseQuery,
+		dbDefense.Id,
+	)
+	if err != nil {
+		return defense, err
+	}
+
+	return defense, nil
+}
@@ -0,0 +1,163 @@
+package drivenadapters
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_ShipyardRepository_GetShip(t *testing.T) {
+	repo, conn := newTestShipyardRepository(t)
+
+	t.Run("gets a ship", func(t *testing.T) {
+		ship := insertTestShip(t, conn)
+
+		actual, err := repo.GetShip(t.Context(), ship.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, ship, actual)
+	})
+
+	t.Run("gets a ship with costs", func(t *testing.T) {
+		ship := insertTestShip(t, conn)
+		ship.Costs = append(ship.Costs, insertTestUnitCost(t, conn, "ship_cost", "ship", ship.Id))
+
+		actual, err := repo.GetShip(t.Context(), ship.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, ship, actual)
+	})
+
+	t.Run("returns error when ship does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetShip(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_ShipyardRepository_GetDefense(t *testing.T) {
+	repo, conn := newTestShipyardRepository(t)
+
+	t.Run("gets a defense with costs", func(t *testing.T) {
+		defense := insertTestDefense(t, conn)
+		defense.Costs = append(defense.Costs, insertTestUnitCost(t, conn, "defense_cost", "defense", defense.Id))
+
+		actual, err := repo.GetDefense(t.Context(), defense.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, defense, actual)
+	})
+
+	t.Run("returns error when defense does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetDefense(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func newTestShipyardRepository(t *testing.T) (*ShipyardRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewShipyardRepository(conn), conn
+}
+
+func insertTestShip(t *testing.T, conn db.Connection) models.Ship {
+	t.Helper()
+
+	ship := models.Ship{
+		Id:            uuid.New(),
+		Name:          fmt.Sprintf("my-ship-%s", uuid.NewString()),
+		Speed:         5000,
+		CargoCapacity: 5000,
+		CreatedAt:     someTime,
+		// This is intentional: the costs are returned as empty slices by the adapter
+		Costs: []models.UnitCost{},
+	}
+
+	sqlQuery := `INSERT INTO ship (id, name, speed, cargo_capacity, created_at) VALUES ($1, $2, $3, $4, $5)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		ship.Id,
+		ship.Name,
+		ship.Speed,
+		ship.CargoCapacity,
+		ship.CreatedAt,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return ship
+}
+
+func insertTestDefense(t *testing.T, conn db.Connection) models.Defense {
+	t.Helper()
+
+	defense := models.Defense{
+		Id:        uuid.New(),
+		Name:      fmt.Sprintf("my-defense-%s", uuid.NewString()),
+		CreatedAt: someTime,
+		Costs:     []models.UnitCost{},
+	}
+
+	sqlQuery := `INSERT INTO defense (id, name, created_at) VALUES ($1, $2, $3)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		defense.Id,
+		defense.Name,
+		defense.CreatedAt,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return defense
+}
+
+func insertTestUnitCost(
+	t *testing.T,
+	conn db.Connection,
+	table string,
+	column string,
+	unit uuid.UUID,
+) models.UnitCost {
+	t.Helper()
+
+	cost := models.UnitCost{
+		Resource:              metalResourceId,
+		Cost:                  rand.Intn(5000),
+		BuildTimeHoursPerUnit: 0.0004,
+	}
+
+	sqlQuery := fmt.Sprintf(
+		`INSERT INTO %s (%s, resource, cost) VALUES ($1, $2, $3)`,
+		table,
+		column,
+	)
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		unit,
+		cost.Resource,
+		cost.Cost,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return cost
+}
+
+func assertShipyardQueueIsEmpty(t *testing.T, conn db.Connection, planet uuid.UUID) {
+	t.Helper()
+
+	sqlQuery := `SELECT COUNT(id) FROM shipyard_action WHERE planet = $1`
+	value, err := db.QueryOne[int](t.Context(), conn, sqlQuery, planet)
+	require.NoError(t, err, "Actual err: %v", err)
+	require.Zero(t, value)
+}
@@ -0,0 +1,300 @@
+package drivenadapters
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driven/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+const (
+	getTechnologyQuery = `
+SELECT
+	id,
+	name,
+	created_at
+FROM
+	technology
+WHERE
+	id = $1`
+
+	listTechnologyQuery = `
+SELECT
+	id,
+	name,
+	created_at
+FROM
+	technology
+ORDER BY
+	created_at,
+	name`
+
+	listTechnologyCostForTechnologyQuery = `
+SELECT
+	tc.resource,
+	tc.cost,
+	tc.progress,
+	r.build_time_hours_per_unit
+FROM
+	technology_cost AS tc
+	INNER JOIN resource AS r ON r.id = tc.resource
+WHERE
+	tc.technology = $1`
+
+	getPlayerIdQuery = `SELECT id FROM player WHERE id = $1`
+
+	// The levels of the technologies are only ever added: the current one
+	// is the highest.
+	listPlayerTechnologyForPlayerQuery = `
+SELECT DISTINCT ON (t.created_at, t.name)
+	ptl.technology,
+	ptl.level,
+	ptl.reached_at
+FROM
+	player_technology_level AS ptl
+	INNER JOIN technology AS t ON t.id = ptl.technology
+WHERE
+	ptl.player = $1
+ORDER BY
+	t.created_at,
+	t.name,
+	ptl.level DESC`
+
+	listPlayerTechnologyForPlanetQuery = `
+SELECT DISTINCT ON (t.created_at, t.name)
+	ptl.technology,
+	ptl.level,
+	ptl.reached_at
+FROM
+	player_technology_level AS ptl
+	INNER JOIN technology AS t ON t.id = ptl.technology
+WHERE
+	ptl.player = $1
+	AND ptl.reached_at <= $2
+ORDER BY
+	t.created_at,
+	t.name,
+	ptl.level DESC`
+
+	listPlayerTechnologyLevelForPlayerQuery = `
+SELECT
+	technology,
+	level,
+	reached_at
+FROM
+	player_technology_level
+WHERE
+	player = $1
+ORDER BY
+	reached_at,
+	level`
+
+	// The research running on another planet of the player is included:
+	// the planet may be advanced past its completion before the level is
+	// persisted.
+	listTechnologyUpgradeForPlanetQuery = `
+SELECT
+	technology,
+	level,
+	reached_at
+FROM
+	player_technology_level
+WHERE
+	player = $1
+	AND reached_at > $2
+UNION
+SELECT
+	technology,
+	desired_level AS level,
+	completed_at AS reached_at
+FROM
+	research_action
+WHERE
+	player = $1
+	AND planet <> $3
+	AND completed_at > $2
+ORDER BY
+	reached_at,
+	level`
+
+	// Technologies are shared by all the planets of a player: levels are
+	// only ever added so that a planet saved with stale data can't revert
+	// a research completed on another planet.
+	createPlayerTechnologyLevelQuery = `
+INSERT INTO
+	player_technology_level (player, technology, level, reached_at)
+	VALUES ($1, $2, $3, $4)
+ON CONFLICT (player, technology, level) DO NOTHING`
+
+	deletePlayerTechnologiesQuery = `DELETE FROM player_technology_level WHERE player = $1`
+)
+
+type TechnologyRepository struct {
+	conn db.Connection
+}
+
+func NewTechnologyRepository(conn db.Connection) *TechnologyRepository {
+	return &TechnologyRepository{
+		conn: conn,
+	}
+}
+
+func (r *TechnologyRepository) Get(ctx context.Context, id uuid.UUID) (models.Technology, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Technology{}, err
+	}
+	defer tx.Close(ctx)
+
+	dbTechnology, err := db.QueryOneTx[mappers.DbTechnology](ctx, tx, getTechnologyQuery, id)
+	if err != nil {
+		return models.Technology{}, parseDbError(err)
+	}
+
+	return loadTechnologyDetails(ctx, tx, dbTechnology)
+}
+
+func (r *TechnologyRepository) GetResearchForPlayer(ctx context.Context, player uuid.UUID) (models.Research, error) {
+	tx, err := r.conn.BeginTx(ctx)
+	if err != nil {
+		return models.Research{}, err
+	}
+	defer tx.Close(ctx)
+
+	_, err = db.QueryOneTx[uuid.UUID](ctx, tx, getPlayerIdQuery, player)
+	if err != nil {
+		return models.Research{}, parseDbError(err)
+	}
+
+	research := models.Research{
+		Player: player,
+	}
+
+	research.Technologies, err = loadPlayerTechnologies(ctx, tx, player)
+	if err != nil {
+		return models.Research{}, err
+	}
+
+	research.Action, err = loadResearchAction(ctx, tx, listResearchActionForPlayerQuery, player)
+	if err != nil {
+		return models.Research{}, err
+	}
+
+	return research, nil
+}
+
+func (r *TechnologyRepository) ListLevelsForPlayer(ctx context.Context, player uuid.UUID) ([]models.PlayerTechnology, error) {
+	return db.QueryAll[models.PlayerTechnology](ctx, r.conn, listPlayerTechnologyLevelForPlayerQuery, player)
+}
+
+func loadTechnologies(ctx context.Context, tx db.Transaction) ([]models.Technology, error) {
+	dbTechnologies, err := db.QueryAllTx[mappers.DbTechnology](ctx, tx, listTechnologyQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	technologies := make([]models.Technology, 0, len(dbTechnologies))
+	for id := range dbTechnologies {
+		technology, err := loadTechnologyDetails(ctx, tx, dbTechnologies[id])
+		if err != nil {
+			return nil, err
+		}
+
+		technologies = append(technologies, technology)
+	}
+
+	return technologies, nil
+}
+
+func loadTechnologyDetails(
+	ctx context.Context,
+	tx db.Transaction,
+	dbTechnology mappers.DbTechnology,
+) (models.Technology, error) {
+	technology := dbTechnology.ToDomain()
+
+	var err error
+	technology.Costs, err = db.QueryAllTx[models.TechnologyCost](
+		ctx,
+		tx,
+		listTechnologyCostForTechnologyQuery,
+		dbTechnology.Id,
+	)
+	if err != nil {
+		return technology, err
+	}
+
+	return technology, nil
+}
+
+func loadPlayerTechnologies(
+	ctx context.Context,
+	tx db.Transaction,
+	player uuid.UUID,
+) ([]models.PlayerTechnology, error) {
+	return db.QueryAllTx[models.PlayerTechnology](
+		ctx,
+		tx,
+		listPlayerTechnologyForPlayerQuery,
+		player,
+	)
+}
+
+// loadPlayerTechnologiesForPlanet returns the levels of the technologies
+// of the player at the time of the last update of the planet and the ones
+// reached afterwards.
+func loadPlayerTechnologiesForPlanet(
+	ctx context.Context,
+	tx db.Transaction,
+	planet mappers.DbPlanet,
+) ([]models.PlayerTechnology, []models.PlayerTechnology, error) {
+	technologies, err := db.QueryAllTx[models.PlayerTechnology](
+		ctx,
+		tx,
+		listPlayerTechnologyForPlanetQuery,
+		planet.Player,
+		planet.UpdatedAt,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upgrades, err := db.QueryAllTx[models.PlayerTechnology](
+		ctx,
+		tx,
+		listTechnologyUpgradeForPlanetQuery,
+		planet.Player,
+		planet.UpdatedAt,
+		planet.Id,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return technologies, upgrades, nil
+}
+
+func upsertPlayerTechnologies(
+	ctx context.Context,
+	tx db.Transaction,
+	player uuid.UUID,
+	technologies []models.PlayerTechnology,
+) error {
+	for _, t := range technologies {
+		_, err := tx.Exec(
+			ctx,
+			createPlayerTechnologyLevelQuery,
+			player,
+			t.Technology,
+			t.Level,
+			t.ReachedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
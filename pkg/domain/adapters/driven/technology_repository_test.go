@@ -0,0 +1,264 @@
+package drivenadapters
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/db"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIT_TechnologyRepository_Get(t *testing.T) {
+	repo, conn := newTestTechnologyRepository(t)
+
+	t.Run("gets a technology", func(t *testing.T) {
+		technology := insertTestTechnology(t, conn)
+
+		actual, err := repo.Get(t.Context(), technology.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, technology, actual)
+	})
+
+	t.Run("gets a technology with costs", func(t *testing.T) {
+		technology := insertTestTechnology(t, conn, addTechnologyCost)
+
+		actual, err := repo.Get(t.Context(), technology.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, technology, actual)
+	})
+
+	t.Run("returns error when technology does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.Get(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestIT_TechnologyRepository_GetResearchForPlayer(t *testing.T) {
+	repo, conn := newTestTechnologyRepository(t)
+
+	t.Run("returns empty research for new player", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+
+		actual, err := repo.GetResearchForPlayer(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.Research{
+			Player:       player.Id,
+			Technologies: []models.PlayerTechnology{},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns technologies and action in progress", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		technology := insertTestTechnology(t, conn)
+		pt := insertTestPlayerTechnology(t, conn, player.Id, technology.Id)
+		action := insertTestResearchAction(t, conn, player.Id, player.Homeworld, technology.Id)
+
+		actual, err := repo.GetResearchForPlayer(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.Research{
+			Player:       player.Id,
+			Technologies: []models.PlayerTechnology{pt},
+			Action:       &action,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when player does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetResearchForPlayer(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func newTestTechnologyRepository(t *testing.T) (*TechnologyRepository, db.Connection) {
+	t.Helper()
+	conn := newTestConnection(t)
+	return NewTechnologyRepository(conn), conn
+}
+
+func insertTestTechnology(
+	t *testing.T,
+	conn db.Connection,
+	modifiers ...func(*testing.T, db.Connection, *models.Technology),
+) models.Technology {
+	t.Helper()
+
+	technology := models.Technology{
+		Id:        uuid.New(),
+		Name:      fmt.Sprintf("my-technology-%s", uuid.NewString()),
+		CreatedAt: someTime,
+		// This is intentional: the costs are returned as empty slices by the adapter
+		Costs: []models.TechnologyCost{},
+	}
+
+	sqlQuery := `INSERT INTO technology (id, name, created_at) VALUES ($1, $2, $3)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		technology.Id,
+		technology.Name,
+		technology.CreatedAt,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	for _, modifier := range modifiers {
+		modifier(t, conn, &technology)
+	}
+
+	return technology
+}
+
+func addTechnologyCost(t *testing.T, conn db.Connection, tech *models.Technology) {
+	t.Helper()
+
+	cost := models.TechnologyCost{
+		Resource: metalResourceId,
+		Cost:     rand.Intn(897),
+		// Progress is stored with 5 decimals in the DB
+		Progress:              randFloat(t, 1, 3, 5),
+		BuildTimeHoursPerUnit: 0.0004,
+	}
+
+	sqlQuery := `INSERT INTO technology_cost (technology, resource, cost, progress)
+		VALUES ($1, $2, $3, $4)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		tech.Id,
+		cost.Resource,
+		cost.Cost,
+		cost.Progress,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	tech.Costs = append(tech.Costs, cost)
+}
+
+func TestIT_TechnologyRepository_ListLevelsForPlayer(t *testing.T) {
+	repo, conn := newTestTechnologyRepository(t)
+
+	t.Run("returns levels ordered by the time they were reached", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		technology := insertTestTechnology(t, conn)
+		pt := insertTestPlayerTechnology(t, conn, player.Id, technology.Id)
+
+		next := models.PlayerTechnology{
+			Technology: technology.Id,
+			Level:      pt.Level + 1,
+			ReachedAt:  someOtherTime,
+		}
+		sqlQuery := `INSERT INTO player_technology_level (player, technology, level, reached_at) VALUES ($1, $2, $3, $4)`
+		_, err := conn.Exec(t.Context(), sqlQuery, player.Id, next.Technology, next.Level, next.ReachedAt)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual, err := repo.ListLevelsForPlayer(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.PlayerTechnology{pt, next}, actual)
+	})
+
+	t.Run("returns no level for new player", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+
+		actual, err := repo.ListLevelsForPlayer(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+}
+
+func insertTestPlayerTechnology(
+	t *testing.T,
+	conn db.Connection,
+	player uuid.UUID,
+	technology uuid.UUID,
+) models.PlayerTechnology {
+	t.Helper()
+
+	pt := models.PlayerTechnology{
+		Technology: technology,
+		Level:      1 + rand.Intn(10),
+		ReachedAt:  someTime,
+	}
+
+	sqlQuery := `INSERT INTO player_technology_level (player, technology, level, reached_at) VALUES ($1, $2, $3, $4)`
+	_, err := conn.Exec(t.Context(), sqlQuery, player, pt.Technology, pt.Level, pt.ReachedAt)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return pt
+}
+
+func insertTestResearchAction(
+	t *testing.T,
+	conn db.Connection,
+	player uuid.UUID,
+	planet uuid.UUID,
+	technology uuid.UUID,
+) models.ResearchAction {
+	t.Helper()
+
+	action := models.ResearchAction{
+		Id:           uuid.New(),
+		Player:       player,
+		Planet:       planet,
+		Technology:   technology,
+		DesiredLevel: 2,
+		CreatedAt:    someTime,
+		CompletedAt:  someOtherTime,
+		Costs: []models.ResearchActionCost{
+			{
+				Resource: metalResourceId,
+				Amount:   1600,
+			},
+		},
+	}
+
+	sqlQuery := `INSERT INTO research_action (id, player, planet, technology, desired_level, created_at, completed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := conn.Exec(
+		t.Context(),
+		sqlQuery,
+		action.Id,
+		action.Player,
+		action.Planet,
+		action.Technology,
+		action.DesiredLevel,
+		action.CreatedAt,
+		action.CompletedAt,
+	)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	sqlQuery = `INSERT INTO research_action_cost (action, resource, amount) VALUES ($1, $2, $3)`
+	_, err = conn.Exec(t.Context(), sqlQuery, action.Id, metalResourceId, 1600)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return action
+}
+
+func assertPlayerTechnologyLevel(
+	t *testing.T,
+	conn db.Connection,
+	player uuid.UUID,
+	technology uuid.UUID,
+	level int,
+) {
+	t.Helper()
+
+	sqlQuery := `SELECT MAX(level) FROM player_technology_level WHERE player = $1 AND technology = $2`
+	value, err := db.QueryOne[int](t.Context(), conn, sqlQuery, player, technology)
+	require.NoError(t, err, "Actual err: %v", err)
+	require.Equal(t, level, value)
+}
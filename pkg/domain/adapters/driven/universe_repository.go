@@ -36,6 +36,16 @@ FROM
 WHERE
 	u.id = $1`
 
+	getUniverseTopologyQuery = `
+SELECT
+	galaxies,
+	solar_systems,
+	orbits
+FROM
+	universe_topology
+WHERE
+	universe = $1`
+
 	listResourceQuery = `
 SELECT
 	id,
@@ -51,6 +61,45 @@ ORDER BY
 	created_at,
 	resource`
 
+	listExchangeRateQuery = `
+SELECT
+	uer.resource,
+	uer.rate
+FROM
+	universe_exchange_rate AS uer
+	INNER JOIN resource AS r ON r.id = uer.resource
+WHERE
+	uer.universe = $1
+ORDER BY
+	r.created_at,
+	r.name`
+
+	listPlanetFieldRangeQuery = `
+SELECT
+	position,
+	min_fields AS min,
+	max_fields AS max
+FROM
+	planet_field_range
+ORDER BY
+	position`
+
+	getMaxPlanetsPerPlayerQuery = `
+SELECT
+	max_planets_per_player
+FROM
+	game_setting`
+
+	listExchangeRateForPlanetQuery = `
+SELECT
+	uer.resource,
+	uer.rate
+FROM
+	universe_exchange_rate AS uer
+	INNER JOIN planet_coordinate AS pc ON pc.universe = uer.universe
+WHERE
+	pc.planet = $1`
+
 	listUsedCoordinateQuery = `
 SELECT
 	galaxy,
@@ -77,8 +126,34 @@ ORDER BY
 	u.created_at,
 	u.name`
 
-	deleteUniverseTopologyQuery = `DELETE FROM universe_topology WHERE universe = $1`
-	deleteUniverseQuery         = `DELETE FROM universe WHERE id = $1`
+	listPlanetInSolarSystemQuery = `
+SELECT
+	p.id,
+	p.name,
+	pl.name AS player_name,
+	COALESCE(a.tag, '') AS alliance_tag,
+	CASE
+		WHEN h.planet IS NOT NULL THEN true
+		ELSE false
+	END AS homeworld,
+	pc.position
+FROM
+	planet_coordinate AS pc
+	INNER JOIN planet AS p ON p.id = pc.planet
+	INNER JOIN player AS pl ON pl.id = p.player
+	LEFT JOIN homeworld AS h ON h.planet = p.id
+	LEFT JOIN alliance_member AS am ON am.player = pl.id
+	LEFT JOIN alliance AS a ON a.id = am.alliance
+WHERE
+	pc.universe = $1
+	AND pc.galaxy = $2
+	AND pc.solar_system = $3
+ORDER BY
+	pc.position`
+
+	deleteUniverseExchangeRateQuery = `DELETE FROM universe_exchange_rate WHERE universe = $1`
+	deleteUniverseTopologyQuery     = `DELETE FROM universe_topology WHERE universe = $1`
+	deleteUniverseQuery             = `DELETE FROM universe WHERE id = $1`
 )
 
 type UniverseRepository struct {
@@ -133,6 +208,15 @@ func (r *UniverseRepository) Get(ctx context.Context, id uuid.UUID) (models.Univ
 	return loadUniverseDetails(ctx, tx, dbUniverse)
 }
 
+func (r *UniverseRepository) GetTopology(ctx context.Context, id uuid.UUID) (models.UniverseTopology, error) {
+	topology, err := db.QueryOne[models.UniverseTopology](ctx, r.conn, getUniverseTopologyQuery, id)
+	if err != nil {
+		return models.UniverseTopology{}, parseDbError(err)
+	}
+
+	return topology, nil
+}
+
 func (r *UniverseRepository) List(ctx context.Context) ([]models.Universe, error) {
 	tx, err := r.conn.BeginTx(ctx)
 	if err != nil {
@@ -158,6 +242,29 @@ func (r *UniverseRepository) List(ctx context.Context) ([]models.Universe, error
 	return universes, nil
 }
 
+func (r *UniverseRepository) ListPlanetsInSolarSystem(
+	ctx context.Context,
+	universe uuid.UUID,
+	galaxy int,
+	solarSystem int,
+) ([]models.SolarSystemPlanet, error) {
+	return db.QueryAll[models.SolarSystemPlanet](
+		ctx,
+		r.conn,
+		listPlanetInSolarSystemQuery,
+		universe,
+		galaxy,
+		solarSystem,
+	)
+}
+
+func (r *UniverseRepository) ListExchangeRatesForPlanet(
+	ctx context.Context,
+	planet uuid.UUID,
+) ([]models.ExchangeRate, error) {
+	return db.QueryAll[models.ExchangeRate](ctx, r.conn, listExchangeRateForPlanetQuery, planet)
+}
+
 func (r *UniverseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	tx, err := r.conn.BeginTx(ctx)
 	if err != nil {
@@ -165,6 +272,11 @@ func (r *UniverseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	defer tx.Close(ctx)
 
+	_, err = tx.Exec(ctx, deleteUniverseExchangeRateQuery, id)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(ctx, deleteUniverseTopologyQuery, id)
 	if err != nil {
 		return err
@@ -204,6 +316,45 @@ func loadUniverseDetails(ctx context.Context, tx db.Transaction, dbUniverse mapp
 		return universe, err
 	}
 
+	universe.Ships, err = loadShips(ctx, tx)
+	if err != nil {
+		return universe, err
+	}
+
+	universe.Defenses, err = loadDefenses(ctx, tx)
+	if err != nil {
+		return universe, err
+	}
+
+	universe.Technologies, err = loadTechnologies(ctx, tx)
+	if err != nil {
+		return universe, err
+	}
+
+	universe.ExchangeRates, err = db.QueryAllTx[models.ExchangeRate](
+		ctx,
+		tx,
+		listExchangeRateQuery,
+		universe.Id,
+	)
+	if err != nil {
+		return universe, err
+	}
+
+	universe.FieldRanges, err = db.QueryAllTx[models.PlanetFieldRange](
+		ctx,
+		tx,
+		listPlanetFieldRangeQuery,
+	)
+	if err != nil {
+		return universe, err
+	}
+
+	universe.MaxPlanetsPerPlayer, err = db.QueryOneTx[int](ctx, tx, getMaxPlanetsPerPlayerQuery)
+	if err != nil {
+		return universe, err
+	}
+
 	universe.OccupancyMap, err = loadOccupancyMap(ctx, tx, universe.Id, universe.Topology)
 	if err != nil {
 		return universe, err
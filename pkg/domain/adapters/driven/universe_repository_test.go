@@ -25,7 +25,8 @@ func TestIT_UniverseRepository_Create(t *testing.T) {
 				SolarSystems: 487,
 				Orbits:       14,
 			},
-			CreatedAt: someTime,
+			CreatedAt:           someTime,
+			MaxPlanetsPerPlayer: defaultMaxPlanetsPerPlayer,
 		}
 
 		err := repo.Create(t.Context(), universe)
@@ -40,7 +41,7 @@ func TestIT_UniverseRepository_Create(t *testing.T) {
 			Topology:  universe.Topology,
 			UsedSlots: make(map[models.Coordinate]struct{}),
 		}
-		assertEqualIgnoringFields(t, actual, expected, "Buildings", "Resources")
+		assertEqualIgnoringFields(t, actual, expected, "Buildings", "Resources", "Ships", "Defenses", "Technologies", "ExchangeRates", "FieldRanges")
 	})
 
 	t.Run("returns error when universe with same name already exists", func(t *testing.T) {
@@ -69,7 +70,7 @@ func TestIT_UniverseRepository_Get(t *testing.T) {
 		actual, err := repo.Get(t.Context(), universe.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assertEqualIgnoringFields(t, actual, universe, "Buildings", "Resources")
+		assertEqualIgnoringFields(t, actual, universe, "Buildings", "Resources", "Ships", "Defenses", "Technologies", "ExchangeRates", "FieldRanges")
 	})
 
 	t.Run("gets a universe with resources", func(t *testing.T) {
@@ -92,6 +93,16 @@ func TestIT_UniverseRepository_Get(t *testing.T) {
 		assert.Contains(t, actual.Buildings, building)
 	})
 
+	t.Run("gets a universe with exchange rates", func(t *testing.T) {
+		universe := insertTestUniverse(t, conn)
+		rate := insertTestExchangeRate(t, conn, universe.Id)
+
+		actual, err := repo.Get(t.Context(), universe.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.ExchangeRate{rate}, actual.ExchangeRates)
+	})
+
 	t.Run("gets a universe with occupied slots", func(t *testing.T) {
 		u1 := insertTestUniverse(t, conn)
 		p1 := insertTestPlayer(t, conn, u1.Id)
@@ -113,7 +124,7 @@ func TestIT_UniverseRepository_Get(t *testing.T) {
 			},
 		}
 
-		assertEqualIgnoringFields(t, actual, expected, "Buildings", "Resources")
+		assertEqualIgnoringFields(t, actual, expected, "Buildings", "Resources", "Ships", "Defenses", "Technologies", "ExchangeRates", "FieldRanges")
 	})
 
 	t.Run("returns error when universe does not exist", func(t *testing.T) {
@@ -124,6 +135,26 @@ func TestIT_UniverseRepository_Get(t *testing.T) {
 	})
 }
 
+func TestIT_UniverseRepository_GetTopology(t *testing.T) {
+	repo, conn := newTestUniverseRepository(t)
+
+	t.Run("gets the topology of a universe", func(t *testing.T) {
+		universe := insertTestUniverse(t, conn)
+
+		actual, err := repo.GetTopology(t.Context(), universe.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, universe.Topology, actual)
+	})
+
+	t.Run("returns error when universe does not exist", func(t *testing.T) {
+		id := uuid.MustParse("00000000-1111-2222-1111-000000000000")
+		_, err := repo.GetTopology(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
 func TestIT_UniverseRepository_List(t *testing.T) {
 	repo, conn := newTestUniverseRepository(t)
 	u1 := insertTestUniverse(t, conn)
@@ -135,8 +166,8 @@ func TestIT_UniverseRepository_List(t *testing.T) {
 	require.NoError(t, err, "Actual err: %v", err)
 
 	// The additional resources are the universes from the seed data
-	assertContainsIgnoringFields(t, actual, u1, "Buildings", "Resources")
-	assertContainsIgnoringFields(t, actual, u2, "Buildings", "Resources")
+	assertContainsIgnoringFields(t, actual, u1, "Buildings", "Resources", "Ships", "Defenses", "Technologies", "ExchangeRates", "FieldRanges")
+	assertContainsIgnoringFields(t, actual, u2, "Buildings", "Resources", "Ships", "Defenses", "Technologies", "ExchangeRates", "FieldRanges")
 
 	for _, u := range actual {
 		assert.Contains(t, u.Resources, resource)
@@ -144,6 +175,67 @@ func TestIT_UniverseRepository_List(t *testing.T) {
 	}
 }
 
+func TestIT_UniverseRepository_ListPlanetsInSolarSystem(t *testing.T) {
+	repo, conn := newTestUniverseRepository(t)
+
+	t.Run("lists planets with their owner", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+		homeworld := loadPlanetFromDb(t, conn, player.Homeworld)
+
+		actual, err := repo.ListPlanetsInSolarSystem(
+			t.Context(),
+			universe.Id,
+			homeworld.Coordinate.Galaxy,
+			homeworld.Coordinate.SolarSystem,
+		)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.SolarSystemPlanet{
+			{
+				Id:         homeworld.Id,
+				Name:       homeworld.Name,
+				PlayerName: player.Name,
+				Homeworld:  true,
+				Position:   homeworld.Coordinate.Position,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("includes alliance tag of owner", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+		homeworld := loadPlanetFromDb(t, conn, player.Homeworld)
+		alliance := insertTestAlliance(t, conn, player)
+
+		actual, err := repo.ListPlanetsInSolarSystem(
+			t.Context(),
+			universe.Id,
+			homeworld.Coordinate.Galaxy,
+			homeworld.Coordinate.SolarSystem,
+		)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, alliance.Tag, actual[0].AllianceTag)
+	})
+
+	t.Run("does not list planets of other universes", func(t *testing.T) {
+		player, _ := insertTestPlayerInUniverse(t, conn)
+		homeworld := loadPlanetFromDb(t, conn, player.Homeworld)
+		other := insertTestUniverse(t, conn)
+
+		actual, err := repo.ListPlanetsInSolarSystem(
+			t.Context(),
+			other.Id,
+			homeworld.Coordinate.Galaxy,
+			homeworld.Coordinate.SolarSystem,
+		)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+}
+
 func TestIT_UniverseRepository_Delete(t *testing.T) {
 	repo, conn := newTestUniverseRepository(t)
 
@@ -172,6 +264,29 @@ func TestIT_UniverseRepository_Delete(t *testing.T) {
 	})
 }
 
+func TestIT_UniverseRepository_ListExchangeRatesForPlanet(t *testing.T) {
+	repo, conn := newTestUniverseRepository(t)
+
+	t.Run("lists exchange rates of the universe of the planet", func(t *testing.T) {
+		player, universe := insertTestPlayerInUniverse(t, conn)
+		rate := insertTestExchangeRate(t, conn, universe.Id)
+		other := insertTestUniverse(t, conn)
+		insertTestExchangeRate(t, conn, other.Id)
+
+		actual, err := repo.ListExchangeRatesForPlanet(t.Context(), player.Homeworld)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.ExchangeRate{rate}, actual)
+	})
+
+	t.Run("returns no rates when planet does not exist", func(t *testing.T) {
+		actual, err := repo.ListExchangeRatesForPlanet(t.Context(), uuid.New())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+}
+
 func newTestUniverseRepository(t *testing.T) (*UniverseRepository, db.Connection) {
 	t.Helper()
 	conn := newTestConnection(t)
@@ -192,6 +307,8 @@ func insertTestUniverse(t *testing.T, conn db.Connection) models.Universe {
 		Name:      fmt.Sprintf("my-universe-%s", uuid.NewString()),
 		Topology:  topology,
 		CreatedAt: someTime,
+		// The setting is shared by all the universes.
+		MaxPlanetsPerPlayer: defaultMaxPlanetsPerPlayer,
 		OccupancyMap: models.OccupancyMap{
 			Topology:  topology,
 			UsedSlots: make(map[models.Coordinate]struct{}),
@@ -223,6 +340,22 @@ func insertTestUniverse(t *testing.T, conn db.Connection) models.Universe {
 	return universe
 }
 
+func insertTestExchangeRate(t *testing.T, conn db.Connection, universe uuid.UUID) models.ExchangeRate {
+	t.Helper()
+
+	resource := insertTestResource(t, conn)
+	rate := models.ExchangeRate{
+		Resource: resource.Id,
+		Rate:     3,
+	}
+
+	sqlQuery := `INSERT INTO universe_exchange_rate (universe, resource, rate) VALUES ($1, $2, $3)`
+	_, err := conn.Exec(t.Context(), sqlQuery, universe, rate.Resource, rate.Rate)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return rate
+}
+
 func insertTestResource(t *testing.T, conn db.Connection) models.Resource {
 	t.Helper()
 
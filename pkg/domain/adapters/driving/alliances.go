@@ -0,0 +1,492 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	applicationAccepted = "accepted"
+	applicationRejected = "rejected"
+)
+
+func AllianceEndpoints(usecase drivingports.ForManagingAlliance) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(createAlliance, usecase)
+	post := rest.NewRoute(http.MethodPost, "/alliances", handler)
+	out = append(out, post)
+
+	handler = generateHandler(getAlliance, usecase)
+	get := rest.NewRoute(http.MethodGet, "/alliances/:id", handler)
+	out = append(out, get)
+
+	handler = generateHandler(disbandAlliance, usecase)
+	delete := rest.NewRoute(http.MethodDelete, "/alliances/:id", handler)
+	out = append(out, delete)
+
+	handler = generateHandler(applyToAlliance, usecase)
+	apply := rest.NewRoute(http.MethodPost, "/alliances/:id/applications", handler)
+	out = append(out, apply)
+
+	handler = generateHandler(listAllianceApplications, usecase)
+	list := rest.NewRoute(http.MethodGet, "/alliances/:id/applications", handler)
+	out = append(out, list)
+
+	handler = generateHandler(decideAllianceApplication, usecase)
+	decide := rest.NewRoute(http.MethodPatch, "/alliances/:id/applications/:application", handler)
+	out = append(out, decide)
+
+	handler = generateHandler(setAllianceRank, usecase)
+	rank := rest.NewRoute(http.MethodPatch, "/alliances/:id/members/:player", handler)
+	out = append(out, rank)
+
+	handler = generateHandler(leaveAlliance, usecase)
+	leave := rest.NewRoute(http.MethodDelete, "/alliances/:id/members/:player", handler)
+	out = append(out, leave)
+
+	return out
+}
+
+// createAlliance godoc
+//
+//	@Summary		Create alliance
+//	@Description	Creates an alliance in the universe of the founder. The name and the tag are unique within a universe.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			request	body		dtos.AllianceDtoRequest	true	"Alliance payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.AllianceDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances [post]
+func createAlliance(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	var inputDto dtos.AllianceDtoRequest
+	err := c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid alliance syntax")
+	}
+
+	request := mappers.ToAllianceCreationRequest(inputDto)
+	alliance, err := usecase.Create(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrPlayerNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		if err == domainerrors.ErrInvalidAlliance {
+			return c.JSON(http.StatusBadRequest, "invalid alliance")
+		}
+
+		if err == domainerrors.ErrNameAlreadyTaken {
+			return c.JSON(http.StatusConflict, "name already used")
+		}
+
+		if err == domainerrors.ErrTagAlreadyTaken {
+			return c.JSON(http.StatusConflict, "tag already used")
+		}
+
+		if err == domainerrors.ErrAlreadyInAlliance {
+			return c.JSON(http.StatusConflict, "player already in an alliance")
+		}
+
+		c.Logger().Error("Failed to create alliance", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to create alliance")
+	}
+
+	out := mappers.ToAllianceResponse(alliance)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// getAlliance godoc
+//
+//	@Summary		Get alliance
+//	@Description	Returns an alliance and its members.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id	path		string	true	"Alliance id (UUID)"	Format(uuid)
+//	@Success		200	{object}	rest.ResponseEnvelope[dtos.AllianceDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id} [get]
+func getAlliance(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	alliance, err := usecase.Get(c.Request().Context(), id)
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		c.Logger().Error("Failed to get alliance", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to get alliance")
+	}
+
+	out := mappers.ToAllianceResponse(alliance)
+	return c.JSON(http.StatusOK, out)
+}
+
+// disbandAlliance godoc
+//
+//	@Summary		Disband alliance
+//	@Description	Deletes an alliance along with its memberships and applications. Only the founder can disband the alliance.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id		path		string	true	"Alliance id (UUID)"					Format(uuid)
+//	@Param			player	query		string	true	"Id of the player disbanding (UUID)"	Format(uuid)
+//	@Success		204		{string}	string
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id} [delete]
+func disbandAlliance(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	exists, player, err := fetchIdFromQueryParam("player", c)
+	if err != nil || !exists {
+		return c.JSON(http.StatusBadRequest, "invalid player id syntax")
+	}
+
+	err = usecase.Disband(c.Request().Context(), id, player)
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		if err == domainerrors.ErrNotAllianceMember {
+			return c.JSON(http.StatusForbidden, "not a member of the alliance")
+		}
+
+		if err == domainerrors.ErrMissingAlliancePermission {
+			return c.JSON(http.StatusForbidden, "missing permission")
+		}
+
+		c.Logger().Error("Failed to disband alliance", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to disband alliance")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// applyToAlliance godoc
+//
+//	@Summary		Apply to alliance
+//	@Description	Submits an application for the player to join the alliance. The player needs to be part of the same universe and not to be in an alliance already.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id		path		string								true	"Alliance id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.AllianceApplicationDtoRequest	true	"Application payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.AllianceApplicationDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id}/applications [post]
+func applyToAlliance(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.AllianceApplicationDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid application syntax")
+	}
+
+	application, err := usecase.Apply(c.Request().Context(), id, inputDto.Player)
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		if err == domainerrors.ErrPlayerNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		if err == domainerrors.ErrAlreadyInAlliance {
+			return c.JSON(http.StatusConflict, "player already in an alliance")
+		}
+
+		if err == domainerrors.ErrAlreadyApplied {
+			return c.JSON(http.StatusConflict, "application already submitted")
+		}
+
+		c.Logger().Error("Failed to apply to alliance", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to apply to alliance")
+	}
+
+	out := mappers.ToAllianceApplicationResponse(application)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// listAllianceApplications godoc
+//
+//	@Summary		List alliance applications
+//	@Description	Returns the pending applications of an alliance. The player needs to be allowed to manage applications.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id		path		string	true	"Alliance id (UUID)"					Format(uuid)
+//	@Param			player	query		string	true	"Id of the player requesting (UUID)"	Format(uuid)
+//	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.AllianceApplicationDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id}/applications [get]
+func listAllianceApplications(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	exists, player, err := fetchIdFromQueryParam("player", c)
+	if err != nil || !exists {
+		return c.JSON(http.StatusBadRequest, "invalid player id syntax")
+	}
+
+	applications, err := usecase.ListApplications(c.Request().Context(), id, player)
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		if err == domainerrors.ErrNotAllianceMember {
+			return c.JSON(http.StatusForbidden, "not a member of the alliance")
+		}
+
+		if err == domainerrors.ErrMissingAlliancePermission {
+			return c.JSON(http.StatusForbidden, "missing permission")
+		}
+
+		c.Logger().Error("Failed to list alliance applications", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list alliance applications")
+	}
+
+	out := mappers.ToAllianceApplicationsResponse(applications)
+	return c.JSON(http.StatusOK, out)
+}
+
+// decideAllianceApplication godoc
+//
+//	@Summary		Accept or reject alliance application
+//	@Description	Accepting an application makes the applicant a member of the alliance and removes its other applications. The player deciding needs to be allowed to manage applications.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id			path		string										true	"Alliance id (UUID)"	Format(uuid)
+//	@Param			application	path		string										true	"Application id (UUID)"	Format(uuid)
+//	@Param			request		body		dtos.AllianceApplicationDecisionDtoRequest	true	"Decision payload"
+//	@Success		204			{string}	string
+//	@Failure		400			{object}	rest.ResponseEnvelope[string]
+//	@Failure		401			{object}	rest.ResponseEnvelope[string]
+//	@Failure		403			{object}	rest.ResponseEnvelope[string]
+//	@Failure		404			{object}	rest.ResponseEnvelope[string]
+//	@Failure		409			{object}	rest.ResponseEnvelope[string]
+//	@Failure		500			{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id}/applications/{application} [patch]
+func decideAllianceApplication(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybeApplication := c.Param("application")
+	application, err := uuid.Parse(maybeApplication)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid application id syntax")
+	}
+
+	var inputDto dtos.AllianceApplicationDecisionDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid decision syntax")
+	}
+
+	switch inputDto.Status {
+	case applicationAccepted:
+		err = usecase.AcceptApplication(c.Request().Context(), id, application, inputDto.Player)
+	case applicationRejected:
+		err = usecase.RejectApplication(c.Request().Context(), id, application, inputDto.Player)
+	default:
+		return c.JSON(http.StatusBadRequest, "invalid decision")
+	}
+
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		if err == domainerrors.ErrApplicationNotFound {
+			return c.JSON(http.StatusNotFound, "no such application")
+		}
+
+		if err == domainerrors.ErrPlayerNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		if err == domainerrors.ErrNotAllianceMember {
+			return c.JSON(http.StatusForbidden, "not a member of the alliance")
+		}
+
+		if err == domainerrors.ErrMissingAlliancePermission {
+			return c.JSON(http.StatusForbidden, "missing permission")
+		}
+
+		if err == domainerrors.ErrAlreadyInAlliance {
+			return c.JSON(http.StatusConflict, "player already in an alliance")
+		}
+
+		c.Logger().Error("Failed to decide on alliance application", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to decide on alliance application")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// setAllianceRank godoc
+//
+//	@Summary		Change rank of alliance member
+//	@Description	Promotes or demotes a member of the alliance. The player changing the rank needs to be allowed to manage ranks.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id		path		string						true	"Alliance id (UUID)"	Format(uuid)
+//	@Param			player	path		string						true	"Member id (UUID)"		Format(uuid)
+//	@Param			request	body		dtos.AllianceRankDtoRequest	true	"Rank payload"
+//	@Success		204		{string}	string
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id}/members/{player} [patch]
+func setAllianceRank(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybePlayer := c.Param("player")
+	player, err := uuid.Parse(maybePlayer)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid player id syntax")
+	}
+
+	var inputDto dtos.AllianceRankDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid rank syntax")
+	}
+
+	request := mappers.ToAllianceRankRequest(id, player, inputDto)
+	err = usecase.SetRank(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		if err == domainerrors.ErrNotAllianceMember {
+			return c.JSON(http.StatusNotFound, "no such member")
+		}
+
+		if err == domainerrors.ErrMissingAlliancePermission {
+			return c.JSON(http.StatusForbidden, "missing permission")
+		}
+
+		if err == domainerrors.ErrInvalidAllianceRank {
+			return c.JSON(http.StatusBadRequest, "invalid rank")
+		}
+
+		c.Logger().Error("Failed to change alliance rank", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to change alliance rank")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// leaveAlliance godoc
+//
+//	@Summary		Leave alliance
+//	@Description	Removes the player from the alliance. The founder can not leave and needs to disband the alliance instead.
+//	@Tags			alliances
+//	@Produce		json
+//	@Param			id		path		string	true	"Alliance id (UUID)"	Format(uuid)
+//	@Param			player	path		string	true	"Member id (UUID)"		Format(uuid)
+//	@Success		204		{string}	string
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/alliances/{id}/members/{player} [delete]
+func leaveAlliance(c *echo.Context, usecase drivingports.ForManagingAlliance) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybePlayer := c.Param("player")
+	player, err := uuid.Parse(maybePlayer)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid player id syntax")
+	}
+
+	err = usecase.Leave(c.Request().Context(), id, player)
+	if err != nil {
+		if err == domainerrors.ErrAllianceNotFound {
+			return c.JSON(http.StatusNotFound, "no such alliance")
+		}
+
+		if err == domainerrors.ErrNotAllianceMember || err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such member")
+		}
+
+		if err == domainerrors.ErrFounderCannotLeave {
+			return c.JSON(http.StatusConflict, "founder cannot leave")
+		}
+
+		c.Logger().Error("Failed to leave alliance", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to leave alliance")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,689 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Alliances_CreateAlliance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+
+	dto := dtos.AllianceDtoRequest{
+		Founder: uuid.New(),
+		Name:    "my alliance",
+		Tag:     "ALLY",
+	}
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := createAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid alliance syntax", actual)
+	})
+
+	t.Run("forwards creation to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		expectedRequest := request.AllianceCreationRequest{
+			Founder: dto.Founder,
+			Name:    dto.Name,
+			Tag:     dto.Tag,
+		}
+		universe := uuid.New()
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(models.Alliance{
+				Id:        sampleUuid,
+				Universe:  universe,
+				Name:      dto.Name,
+				Tag:       dto.Tag,
+				CreatedAt: someTime,
+				Members: []models.AllianceMember{
+					{Player: dto.Founder, Name: "founder", Rank: models.FounderRank, JoinedAt: someTime},
+				},
+			}, nil)
+
+		err := createAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.AllianceDtoResponse](t, rw)
+		expected := dtos.AllianceDtoResponse{
+			Id:        sampleUuid,
+			Universe:  universe,
+			Name:      dto.Name,
+			Tag:       dto.Tag,
+			CreatedAt: someTime,
+			Members: []dtos.AllianceMemberDtoResponse{
+				{
+					Player:      dto.Founder,
+					Name:        "founder",
+					Rank:        "founder",
+					Permissions: []string{"manage_applications", "manage_ranks", "disband"},
+					JoinedAt:    someTime,
+				},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 400 when alliance is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, domainerrors.ErrInvalidAlliance)
+
+		err := createAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid alliance", actual)
+	})
+
+	t.Run("returns 409 when tag is already used", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, domainerrors.ErrTagAlreadyTaken)
+
+		err := createAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "tag already used", actual)
+	})
+
+	t.Run("returns 409 when founder is already in an alliance", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, domainerrors.ErrAlreadyInAlliance)
+
+		err := createAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "player already in an alliance", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, errors.New("stubbed error"))
+
+		err := createAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to create alliance", actual)
+	})
+}
+
+func TestUnit_Alliances_GetAlliance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := getAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 404 when alliance does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(models.Alliance{}, domainerrors.ErrAllianceNotFound)
+
+		err := getAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such alliance", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, errors.New("stubbed error"))
+
+		err := getAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to get alliance", actual)
+	})
+}
+
+func TestUnit_Alliances_DisbandAlliance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+	player := uuid.New()
+
+	t.Run("returns 400 when player is missing", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := disbandAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid player id syntax", actual)
+	})
+
+	t.Run("forwards disbanding to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		addQueryParam(t, req, "player", player.String())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Disband(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(player)).
+			Times(1).
+			Return(nil)
+
+		err := disbandAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 403 when player is not allowed", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		addQueryParam(t, req, "player", player.String())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Disband(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrMissingAlliancePermission)
+
+		err := disbandAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusForbidden, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "missing permission", actual)
+	})
+}
+
+func TestUnit_Alliances_ApplyToAlliance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+
+	dto := dtos.AllianceApplicationDtoRequest{Player: uuid.New()}
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := applyToAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid application syntax", actual)
+	})
+
+	t.Run("forwards application to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		application := models.AllianceApplication{
+			Id:        uuid.New(),
+			Alliance:  sampleUuid,
+			Player:    dto.Player,
+			CreatedAt: someTime,
+		}
+		mockUsecase.EXPECT().
+			Apply(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(dto.Player)).
+			Times(1).
+			Return(application, nil)
+
+		err := applyToAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.AllianceApplicationDtoResponse](t, rw)
+		expected := dtos.AllianceApplicationDtoResponse{
+			Id:        application.Id,
+			Alliance:  sampleUuid,
+			Player:    dto.Player,
+			CreatedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 409 when player already applied", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Apply(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.AllianceApplication{}, domainerrors.ErrAlreadyApplied)
+
+		err := applyToAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "application already submitted", actual)
+	})
+
+	t.Run("returns 404 when alliance does not exist", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Apply(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.AllianceApplication{}, domainerrors.ErrAllianceNotFound)
+
+		err := applyToAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such alliance", actual)
+	})
+}
+
+func TestUnit_Alliances_ListAllianceApplications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+	player := uuid.New()
+
+	t.Run("returns 400 when player is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "player", "not-a-uuid")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := listAllianceApplications(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid player id syntax", actual)
+	})
+
+	t.Run("forwards listing to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "player", player.String())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		application := models.AllianceApplication{
+			Id:        uuid.New(),
+			Alliance:  sampleUuid,
+			Player:    uuid.New(),
+			CreatedAt: someTime,
+		}
+		mockUsecase.EXPECT().
+			ListApplications(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(player)).
+			Times(1).
+			Return([]models.AllianceApplication{application}, nil)
+
+		err := listAllianceApplications(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.AllianceApplicationDtoResponse](t, rw)
+		expected := []dtos.AllianceApplicationDtoResponse{
+			{
+				Id:        application.Id,
+				Alliance:  sampleUuid,
+				Player:    application.Player,
+				CreatedAt: someTime,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 403 when player is not a member", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "player", player.String())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListApplications(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrNotAllianceMember)
+
+		err := listAllianceApplications(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusForbidden, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not a member of the alliance", actual)
+	})
+}
+
+func TestUnit_Alliances_DecideAllianceApplication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+
+	applicationId := uuid.New()
+	player := uuid.New()
+	addApplicationPathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "application", Value: applicationId.String()},
+		})
+	}
+
+	t.Run("returns 400 when application id is invalid", func(t *testing.T) {
+		dto := dtos.AllianceApplicationDecisionDtoRequest{Player: player, Status: "accepted"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "application", Value: "not-a-uuid"},
+		})
+
+		err := decideAllianceApplication(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid application id syntax", actual)
+	})
+
+	t.Run("returns 400 when status is invalid", func(t *testing.T) {
+		dto := dtos.AllianceApplicationDecisionDtoRequest{Player: player, Status: "pending"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addApplicationPathParams)
+
+		err := decideAllianceApplication(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid decision", actual)
+	})
+
+	t.Run("forwards acceptance to use case", func(t *testing.T) {
+		dto := dtos.AllianceApplicationDecisionDtoRequest{Player: player, Status: "accepted"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addApplicationPathParams)
+
+		mockUsecase.EXPECT().
+			AcceptApplication(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(applicationId), gomock.Eq(player)).
+			Times(1).
+			Return(nil)
+
+		err := decideAllianceApplication(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("forwards rejection to use case", func(t *testing.T) {
+		dto := dtos.AllianceApplicationDecisionDtoRequest{Player: player, Status: "rejected"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addApplicationPathParams)
+
+		mockUsecase.EXPECT().
+			RejectApplication(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(applicationId), gomock.Eq(player)).
+			Times(1).
+			Return(nil)
+
+		err := decideAllianceApplication(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 404 when application does not exist", func(t *testing.T) {
+		dto := dtos.AllianceApplicationDecisionDtoRequest{Player: player, Status: "accepted"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addApplicationPathParams)
+
+		mockUsecase.EXPECT().
+			AcceptApplication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrApplicationNotFound)
+
+		err := decideAllianceApplication(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such application", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		dto := dtos.AllianceApplicationDecisionDtoRequest{Player: player, Status: "rejected"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addApplicationPathParams)
+
+		mockUsecase.EXPECT().
+			RejectApplication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(errors.New("stubbed error"))
+
+		err := decideAllianceApplication(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to decide on alliance application", actual)
+	})
+}
+
+func TestUnit_Alliances_SetAllianceRank(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+
+	member := uuid.New()
+	dto := dtos.AllianceRankDtoRequest{Player: uuid.New(), Rank: "officer"}
+	addMemberPathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "player", Value: member.String()},
+		})
+	}
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		err := setAllianceRank(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid rank syntax", actual)
+	})
+
+	t.Run("forwards rank change to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		expectedRequest := request.AllianceRankRequest{
+			Alliance:  sampleUuid,
+			Requester: dto.Player,
+			Player:    member,
+			Rank:      models.OfficerRank,
+		}
+		mockUsecase.EXPECT().
+			SetRank(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(nil)
+
+		err := setAllianceRank(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 400 when rank is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		mockUsecase.EXPECT().
+			SetRank(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrInvalidAllianceRank)
+
+		err := setAllianceRank(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid rank", actual)
+	})
+
+	t.Run("returns 403 when player is not allowed", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		mockUsecase.EXPECT().
+			SetRank(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrMissingAlliancePermission)
+
+		err := setAllianceRank(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusForbidden, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "missing permission", actual)
+	})
+}
+
+func TestUnit_Alliances_LeaveAlliance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingAlliance(ctrl)
+
+	member := uuid.New()
+	addMemberPathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "player", Value: member.String()},
+		})
+	}
+
+	t.Run("returns 400 when player id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "player", Value: "not-a-uuid"},
+		})
+
+		err := leaveAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid player id syntax", actual)
+	})
+
+	t.Run("forwards leaving to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		mockUsecase.EXPECT().
+			Leave(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(member)).
+			Times(1).
+			Return(nil)
+
+		err := leaveAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 409 when founder leaves", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		mockUsecase.EXPECT().
+			Leave(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrFounderCannotLeave)
+
+		err := leaveAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "founder cannot leave", actual)
+	})
+
+	t.Run("returns 404 when player is not a member", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addMemberPathParams)
+
+		mockUsecase.EXPECT().
+			Leave(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotAllianceMember)
+
+		err := leaveAlliance(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such member", actual)
+	})
+}
@@ -0,0 +1,290 @@
+package drivingadapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	bearerPrefix = "bearer "
+	// apiUserKey stores in the context the api user of the token.
+	apiUserKey = "apiUser"
+	// accessTokenKey is the name of the query parameter and of the cookie
+	// holding the token on the streaming routes.
+	accessTokenKey = "access_token"
+)
+
+var errMissingId = errors.New("missing id")
+
+type idFetcher func(c *echo.Context) (uuid.UUID, error)
+
+// ownershipCheck describes which resource a route acts on and where to find
+// its id in the request.
+type ownershipCheck struct {
+	resource request.OwnedResource
+	fetchId  idFetcher
+}
+
+// publicRoutes can be called without a token.
+var publicRoutes = map[string]bool{
+	routeKey(http.MethodGet, "/healthcheck"): true,
+}
+
+// streamingRoutes are opened by browsers through EventSource or WebSocket,
+// which can't set the Authorization header. The token is also accepted in
+// the query or in a cookie for these routes only, as it might otherwise end
+// up in the logs of the proxies.
+var streamingRoutes = map[string]bool{
+	routeKey(http.MethodGet, "/players/:id/events"): true,
+	routeKey(http.MethodGet, "/ws"):                 true,
+}
+
+// readOnlyMethods can be used on routes without ownership check: routes which
+// are not listed in ownershipChecks only require a valid token to read data,
+// and are forbidden for any other method.
+var readOnlyMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+}
+
+// ownershipChecks lists the routes acting on behalf of a player or reserved
+// to the administrators.
+var ownershipChecks = map[string]ownershipCheck{
+	routeKey(http.MethodPost, "/players"):              {request.ApiUserResource, idFromBody("api_user")},
+	routeKey(http.MethodGet, "/players/:id"):           {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/users/:id/players"):     {request.ApiUserResource, idFromPath("id")},
+	routeKey(http.MethodDelete, "/players/:id"):        {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/players/:id/planets"):  {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/players/:id/planets"):   {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/players/:id/fleets"):    {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/players/:id/research"):  {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/players/:id/research"): {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/players/:id/events"):    {request.PlayerResource, idFromPath("id")},
+
+	routeKey(http.MethodPost, "/players/:id/messages"):            {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/players/:id/messages"):             {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodPatch, "/players/:id/messages/:message"):  {request.PlayerResource, idFromPath("id")},
+	routeKey(http.MethodDelete, "/players/:id/messages/:message"): {request.PlayerResource, idFromPath("id")},
+
+	routeKey(http.MethodGet, "/planets/:id"):                             {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodDelete, "/planets/:id"):                          {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/planets/:id/actions"):                    {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodDelete, "/planets/:id/actions"):                  {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/planets/:id/actions"):                     {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodPatch, "/planets/:id/actions"):                   {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodDelete, "/planets/:id/actions/:action"):          {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/planets/:id/shipyard"):                   {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/planets/:id/fleets"):                     {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/planets/:id/trades"):                     {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodPost, "/planets/:id/offers"):                     {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/planets/:id/history"):                     {request.PlanetResource, idFromPath("id")},
+	routeKey(http.MethodGet, "/planets/:id/buildings/:building/preview"): {request.PlanetResource, idFromPath("id")},
+
+	routeKey(http.MethodPost, "/fleets/:id/recall"): {request.FleetResource, idFromPath("id")},
+
+	routeKey(http.MethodPost, "/offers/:id/accept"): {request.PlanetResource, idFromBody("planet")},
+	routeKey(http.MethodDelete, "/offers/:id"):      {request.MarketOfferResource, idFromPath("id")},
+
+	routeKey(http.MethodPost, "/alliances"):                                {request.PlayerResource, idFromBody("founder")},
+	routeKey(http.MethodDelete, "/alliances/:id"):                          {request.PlayerResource, idFromQuery("player")},
+	routeKey(http.MethodPost, "/alliances/:id/applications"):               {request.PlayerResource, idFromBody("player")},
+	routeKey(http.MethodGet, "/alliances/:id/applications"):                {request.PlayerResource, idFromQuery("player")},
+	routeKey(http.MethodPatch, "/alliances/:id/applications/:application"): {request.PlayerResource, idFromBody("player")},
+	routeKey(http.MethodPatch, "/alliances/:id/members/:player"):           {request.PlayerResource, idFromBody("player")},
+	routeKey(http.MethodDelete, "/alliances/:id/members/:player"):          {request.PlayerResource, idFromPath("player")},
+
+	routeKey(http.MethodGet, "/resources"):       {request.GameDataResource, noId},
+	routeKey(http.MethodPost, "/resources"):      {request.GameDataResource, noId},
+	routeKey(http.MethodPatch, "/resources/:id"): {request.GameDataResource, noId},
+	routeKey(http.MethodGet, "/buildings"):       {request.GameDataResource, noId},
+	routeKey(http.MethodPost, "/buildings"):      {request.GameDataResource, noId},
+	routeKey(http.MethodPatch, "/buildings/:id"): {request.GameDataResource, noId},
+
+	routeKey(http.MethodPost, "/universes"):       {request.GameDataResource, noId},
+	routeKey(http.MethodDelete, "/universes/:id"): {request.GameDataResource, noId},
+
+	// Auditing a planet is reserved to the administrators, like the game data.
+	routeKey(http.MethodGet, "/planets/:id/reconstruction"): {request.GameDataResource, noId},
+}
+
+// AuthorizedEndpoints requires a valid token for all the routes except the
+// public ones, and checks that the api user of the token owns the resource
+// targeted by the route. Routes modifying data without an ownership check
+// are rejected.
+func AuthorizedEndpoints(
+	routes rest.Routes,
+	verifier *TokenVerifier,
+	usecase drivingports.ForAuthorizingRequest,
+) rest.Routes {
+	out := make(rest.Routes, 0, len(routes))
+
+	for _, route := range routes {
+		key := routeKey(route.Method(), route.Path())
+		if publicRoutes[key] {
+			out = append(out, route)
+			continue
+		}
+
+		check, hasCheck := ownershipChecks[key]
+		denied := !hasCheck && !readOnlyMethods[route.Method()]
+		handler := generateAuthorizingHandler(
+			route.Handler(),
+			verifier,
+			usecase,
+			check,
+			hasCheck,
+			denied,
+			streamingRoutes[key],
+		)
+
+		if route.UseResponseEnvelope() {
+			out = append(out, rest.NewRoute(route.Method(), route.Path(), handler))
+		} else {
+			out = append(out, rest.NewRawRoute(route.Method(), route.Path(), handler))
+		}
+	}
+
+	return out
+}
+
+func generateAuthorizingHandler(
+	next echo.HandlerFunc,
+	verifier *TokenVerifier,
+	usecase drivingports.ForAuthorizingRequest,
+	check ownershipCheck,
+	hasCheck bool,
+	denied bool,
+	streaming bool,
+) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		token, ok := fetchBearerToken(c)
+		if !ok && streaming {
+			token, ok = fetchStreamingToken(c)
+		}
+		if !ok {
+			return c.JSON(http.StatusUnauthorized, "missing token")
+		}
+
+		apiUser, err := verifier.Verify(token, time.Now())
+		if err != nil {
+			return c.JSON(http.StatusUnauthorized, "invalid token")
+		}
+		c.Set(apiUserKey, apiUser)
+
+		if denied {
+			return c.JSON(http.StatusForbidden, "forbidden")
+		}
+		if !hasCheck {
+			return next(c)
+		}
+
+		id, err := check.fetchId(c)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, "invalid id syntax")
+		}
+
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: check.resource,
+			Id:       id,
+		}
+		err = usecase.CheckOwnership(c.Request().Context(), req)
+		if err == domainerrors.ErrNotResourceOwner {
+			return c.JSON(http.StatusForbidden, "forbidden")
+		}
+		// A missing resource is reported by the handler.
+		if err != nil && err != domainerrors.ErrNotFound {
+			return c.JSON(http.StatusInternalServerError, "failed to authorize request")
+		}
+
+		return next(c)
+	}
+}
+
+func routeKey(method string, path string) string {
+	return method + " " + path
+}
+
+func fetchBearerToken(c *echo.Context) (string, bool) {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+
+	return header[len(bearerPrefix):], true
+}
+
+// fetchStreamingToken looks for the token in the query first and then in
+// the cookies.
+func fetchStreamingToken(c *echo.Context) (string, bool) {
+	if token := c.QueryParam(accessTokenKey); token != "" {
+		return token, true
+	}
+
+	cookie, err := c.Cookie(accessTokenKey)
+	if err != nil || cookie.Value == "" {
+		return "", false
+	}
+
+	return cookie.Value, true
+}
+
+// noId is used for the resources which are not identified by the request,
+// such as the game data.
+func noId(c *echo.Context) (uuid.UUID, error) {
+	return uuid.Nil, nil
+}
+
+func idFromPath(key string) idFetcher {
+	return func(c *echo.Context) (uuid.UUID, error) {
+		return uuid.Parse(c.Param(key))
+	}
+}
+
+func idFromQuery(key string) idFetcher {
+	return func(c *echo.Context) (uuid.UUID, error) {
+		exists, id, err := fetchIdFromQueryParam(key, c)
+		if err == nil && !exists {
+			err = errMissingId
+		}
+		return id, err
+	}
+}
+
+func idFromBody(key string) idFetcher {
+	return func(c *echo.Context) (uuid.UUID, error) {
+		raw, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		// The handler decodes the body again.
+		c.Request().Body = io.NopCloser(bytes.NewReader(raw))
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return uuid.Nil, err
+		}
+
+		value, ok := fields[key]
+		if !ok {
+			return uuid.Nil, errMissingId
+		}
+
+		var id uuid.UUID
+		err = json.Unmarshal(value, &id)
+		return id, err
+	}
+}
@@ -0,0 +1,428 @@
+package drivingadapters
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_AuthorizedEndpoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForAuthorizingRequest(ctrl)
+	verifier := newTestTokenVerifier(t)
+
+	t.Run("does not require token for public route", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/healthcheck", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("returns 401 when token is missing", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/universes", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "missing token", actual)
+		assert.False(t, *called)
+	})
+
+	t.Run("returns 401 when token is invalid", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/universes", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer not-a-token")
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid token", actual)
+		assert.False(t, *called)
+	})
+
+	t.Run("calls handler with valid token when route has no owner", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/universes", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("stores api user of the token in context", func(t *testing.T) {
+		var actual any
+		handler := func(c *echo.Context) error {
+			actual = c.Get(apiUserKey)
+			return nil
+		}
+		routes := AuthorizedEndpoints(
+			rest.Routes{rest.NewRawRoute(http.MethodGet, "/ws", handler)},
+			verifier,
+			mockUsecase,
+		)
+		require.Len(t, routes, 1)
+
+		req := generateTestRequest(t, http.MethodGet)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		err := routes[0].Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, sampleApiUser, actual)
+	})
+
+	t.Run("checks ownership of the player in the path", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodDelete, "/players/:id", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodDelete)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedReq := request.OwnershipRequest{
+			ApiUser:  sampleApiUser,
+			Resource: request.PlayerResource,
+			Id:       sampleUuid,
+		}
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), expectedReq).
+			Times(1).
+			Return(nil)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("checks ownership of the api user in the body and keeps the body", func(t *testing.T) {
+		var body dtos.PlayerDtoRequest
+		route := AuthorizedEndpoints(
+			rest.Routes{rest.NewRoute(http.MethodPost, "/players", func(c *echo.Context) error {
+				return c.Bind(&body)
+			})},
+			verifier,
+			mockUsecase,
+		)[0]
+
+		requestDto := dtos.PlayerDtoRequest{
+			ApiUser:  sampleApiUser,
+			Universe: sampleUuid,
+			Name:     "my-player",
+		}
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, requestDto)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		expectedReq := request.OwnershipRequest{
+			ApiUser:  sampleApiUser,
+			Resource: request.ApiUserResource,
+			Id:       sampleApiUser,
+		}
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), expectedReq).
+			Times(1).
+			Return(nil)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, requestDto, body)
+	})
+
+	t.Run("checks game data routes are called by an admin", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodPatch, "/buildings/:id", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodPatch)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedReq := request.OwnershipRequest{
+			ApiUser:  sampleApiUser,
+			Resource: request.GameDataResource,
+		}
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), expectedReq).
+			Times(1).
+			Return(nil)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("checks universe routes are called by an admin", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodDelete, "/universes/:id", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodDelete)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedReq := request.OwnershipRequest{
+			ApiUser:  sampleApiUser,
+			Resource: request.GameDataResource,
+		}
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), expectedReq).
+			Times(1).
+			Return(nil)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("returns 403 when modifying route has no ownership check", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodPost, "/not-listed", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodPost)
+		addTestToken(t, req)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusForbidden, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "forbidden", actual)
+		assert.False(t, *called)
+	})
+
+	t.Run("accepts token in query on streaming route", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/players/:id/events", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		token := signTestToken(t, sampleApiUser, time.Now().Add(time.Hour))
+		req.URL.RawQuery = accessTokenKey + "=" + token
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().CheckOwnership(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("accepts token in cookie on streaming route", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/players/:id/events", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		token := signTestToken(t, sampleApiUser, time.Now().Add(time.Hour))
+		req.AddCookie(&http.Cookie{Name: accessTokenKey, Value: token})
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().CheckOwnership(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("returns 401 when token is in query of other route", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/universes", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		token := signTestToken(t, sampleApiUser, time.Now().Add(time.Hour))
+		req.URL.RawQuery = accessTokenKey + "=" + token
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "missing token", actual)
+		assert.False(t, *called)
+	})
+
+	t.Run("returns 400 when id of the owned resource is missing", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/alliances/:id/applications", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		addTestToken(t, req)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+		assert.False(t, *called)
+	})
+
+	t.Run("returns 403 when resource is owned by another api user", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/planets/:id", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		addTestToken(t, req)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotResourceOwner)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusForbidden, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "forbidden", actual)
+		assert.False(t, *called)
+	})
+
+	t.Run("calls handler when resource does not exist", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodGet, "/planets/:id", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodGet)
+		addTestToken(t, req)
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, *called)
+	})
+
+	t.Run("returns 500 when ownership can not be checked", func(t *testing.T) {
+		route, called := generateAuthorizedTestRoute(t, http.MethodPost, "/fleets/:id/recall", verifier, mockUsecase)
+
+		req := generateTestRequest(t, http.MethodPost)
+		addTestToken(t, req)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(errors.New("some error"))
+
+		err := route.Handler()(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to authorize request", actual)
+		assert.False(t, *called)
+	})
+}
+
+func generateAuthorizedTestRoute(
+	t *testing.T,
+	method string,
+	path string,
+	verifier *TokenVerifier,
+	usecase *drivingportstest.MockForAuthorizingRequest,
+) (rest.Route, *bool) {
+	t.Helper()
+
+	called := false
+	handler := func(c *echo.Context) error {
+		called = true
+		_, err := io.Copy(io.Discard, c.Request().Body)
+		return err
+	}
+
+	routes := AuthorizedEndpoints(rest.Routes{rest.NewRoute(method, path, handler)}, verifier, usecase)
+	require.Len(t, routes, 1)
+
+	return routes[0], &called
+}
+
+func addTestToken(t *testing.T, req *http.Request) {
+	t.Helper()
+
+	token := signTestToken(t, sampleApiUser, time.Now().Add(time.Hour))
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+}
+
+func TestUnit_OwnershipChecks_MatchRegisteredRoutes(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, route := range generateAllRegisteredRoutes() {
+		registered[routeKey(route.Method(), route.Path())] = true
+	}
+
+	for key := range ownershipChecks {
+		assert.True(t, registered[key], "Route %s is not registered", key)
+	}
+	for key := range streamingRoutes {
+		assert.True(t, registered[key], "Route %s is not registered", key)
+	}
+}
+
+func TestUnit_OwnershipChecks_CoverModifyingRoutes(t *testing.T) {
+	for _, route := range generateAllRegisteredRoutes() {
+		if readOnlyMethods[route.Method()] {
+			continue
+		}
+
+		key := routeKey(route.Method(), route.Path())
+		_, ok := ownershipChecks[key]
+		assert.True(t, ok, "Route %s has no ownership check", key)
+	}
+}
+
+func generateAllRegisteredRoutes() rest.Routes {
+	var routes rest.Routes
+	routes = append(routes, AllianceEndpoints(nil)...)
+	routes = append(routes, BuildingActionEndpoints(nil, nil, nil)...)
+	routes = append(routes, BuildingPreviewEndpoints(nil)...)
+	routes = append(routes, ColonizationEndpoints(nil)...)
+	routes = append(routes, FleetEndpoints(nil, nil)...)
+	routes = append(routes, GameDataEndpoints(nil)...)
+	routes = append(routes, GatewayEndpoints(nil, nil, nil, nil)...)
+	routes = append(routes, HealthcheckEndpoints(nil)...)
+	routes = append(routes, MarketOfferEndpoints(nil)...)
+	routes = append(routes, MessageEndpoints(nil)...)
+	routes = append(routes, PlanetEndpoints(nil)...)
+	routes = append(routes, PlanetEventEndpoints(nil)...)
+	routes = append(routes, PlanetHistoryEndpoints(nil)...)
+	routes = append(routes, PlanetReconstructionEndpoints(nil)...)
+	routes = append(routes, PlayerEndpoints(nil)...)
+	routes = append(routes, RankingEndpoints(nil)...)
+	routes = append(routes, ResearchEndpoints(nil)...)
+	routes = append(routes, ShipyardEndpoints(nil)...)
+	routes = append(routes, TradeEndpoints(nil)...)
+	routes = append(routes, UniverseEndpoints(nil)...)
+
+	return routes
+}
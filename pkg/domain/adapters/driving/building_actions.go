@@ -16,6 +16,7 @@ import (
 func BuildingActionEndpoints(
 	createUsecase drivingports.ForCreatingBuildingAction,
 	deleteUsecase drivingports.ForDeletingBuildingAction,
+	queueUsecase drivingports.ForManagingBuildingQueue,
 ) rest.Routes {
 	var out rest.Routes
 
@@ -27,22 +28,37 @@ func BuildingActionEndpoints(
 	delete := rest.NewRoute(http.MethodDelete, "/planets/:id/actions", handler)
 	out = append(out, delete)
 
+	handler = generateHandler(listBuildingQueue, queueUsecase)
+	list := rest.NewRoute(http.MethodGet, "/planets/:id/actions", handler)
+	out = append(out, list)
+
+	handler = generateHandler(reorderBuildingQueue, queueUsecase)
+	reorder := rest.NewRoute(http.MethodPatch, "/planets/:id/actions", handler)
+	out = append(out, reorder)
+
+	handler = generateHandler(cancelBuildingAction, queueUsecase)
+	cancel := rest.NewRoute(http.MethodDelete, "/planets/:id/actions/:action", handler)
+	out = append(out, cancel)
+
 	return out
 }
 
 // createBuildingAction godoc
 //
 //	@Summary		Create building action
-//	@Description	Creates a building action for the planet provided in path parameter. The planet field in the body is ignored and replaced with this path value.
+//	@Description	Appends a building action to the queue of the planet provided in path parameter. The action either upgrades or demolishes one level of the building depending on its kind, upgrading when it is not provided. Demolishing costs a fraction of the construction of the level. The action starts when the previous one in the queue completes and its costs are deducted immediately. The planet field in the body is ignored and replaced with this path value.
 //	@Tags			planets
 //	@Produce		json
 //	@Param			id		path		string					true	"Planet id (UUID)"	Format(uuid)
 //	@Param			request	body		dtos.BuildingActionDtoRequest	true	"Building action payload"
 //	@Success		201		{object}	rest.ResponseEnvelope[dtos.BuildingActionDtoResponse]
 //	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
 //	@Failure		404		{object}	rest.ResponseEnvelope[string]
 //	@Failure		409		{object}	rest.ResponseEnvelope[string]
 //	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/planets/{id}/actions [post]
 func createBuildingAction(c *echo.Context, usecase drivingports.ForCreatingBuildingAction) error {
 	maybeId := c.Param("id")
@@ -60,24 +76,8 @@ func createBuildingAction(c *echo.Context, usecase drivingports.ForCreatingBuild
 	request := mappers.ToBuildingActionCreationRequest(planetId, inputDto)
 	action, err := usecase.Create(c.Request().Context(), request)
 	if err != nil {
-		if err == domainerrors.ErrActionAlreadyInProgress {
-			return c.JSON(http.StatusConflict, "action already in progress")
-		}
-
-		if err == domainerrors.ErrNotFound {
-			return c.JSON(http.StatusNotFound, "no such planet")
-		}
-
-		if err == domainerrors.ErrBuildingNotFound {
-			return c.JSON(http.StatusBadRequest, "no such building")
-		}
-
-		if err == domainerrors.ErrNotEnoughResources {
-			return c.JSON(http.StatusBadRequest, "not enough resources")
-		}
-
-		if err == domainerrors.ErrAllFieldsUsed {
-			return c.JSON(http.StatusConflict, "all fields are used")
+		if status, message, ok := buildingActionCreationError(err); ok {
+			return c.JSON(status, message)
 		}
 
 		c.Logger().Error("Failed to create building action", slog.Any("error", err))
@@ -90,15 +90,18 @@ func createBuildingAction(c *echo.Context, usecase drivingports.ForCreatingBuild
 
 // deleteBuildingAction godoc
 //
-//	@Summary		Delete building action for a planet
-//	@Description	Deletes an existing building action for a planet.
+//	@Summary		Delete building queue for a planet
+//	@Description	Deletes all the building actions queued for a planet. The costs of the actions are not refunded.
 //	@Tags			planets
 //	@Produce		json
 //	@Param			id	path		string	true	"Planet id (UUID)"	Format(uuid)
 //	@Success		204	{string}	string
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
 //	@Failure		404	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/planets/{id}/actions [delete]
 func deleteBuildingAction(c *echo.Context, usecase drivingports.ForDeletingBuildingAction) error {
 	maybeId := c.Param("id")
@@ -119,3 +122,162 @@ func deleteBuildingAction(c *echo.Context, usecase drivingports.ForDeletingBuild
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+// listBuildingQueue godoc
+//
+//	@Summary		List building queue
+//	@Description	Returns the building actions queued for a planet in the order they will be processed. The first action is the one in progress.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id	path		string	true	"Planet id (UUID)"	Format(uuid)
+//	@Success		200	{object}	rest.ResponseEnvelope[[]dtos.BuildingActionDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/actions [get]
+func listBuildingQueue(c *echo.Context, usecase drivingports.ForManagingBuildingQueue) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	queue, err := usecase.List(c.Request().Context(), id)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		c.Logger().Error("Failed to list building queue", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list building queue")
+	}
+
+	out := mappers.ToBuildingActionsResponse(queue)
+	return c.JSON(http.StatusOK, out)
+}
+
+// reorderBuildingQueue godoc
+//
+//	@Summary		Reorder building queue
+//	@Description	Changes the order of the building actions queued for a planet. All the queued actions must be provided, the action in progress must stay first and upgrades of the same building must keep their relative order. An action can't be moved before the actions bringing its requirements.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id		path		string						true	"Planet id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.BuildingQueueDtoRequest	true	"Building queue payload"
+//	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.BuildingActionDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/actions [patch]
+func reorderBuildingQueue(c *echo.Context, usecase drivingports.ForManagingBuildingQueue) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.BuildingQueueDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid building queue syntax")
+	}
+
+	queue, err := usecase.Reorder(c.Request().Context(), id, inputDto.Actions)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrInvalidBuildingQueue {
+			return c.JSON(http.StatusBadRequest, "invalid building queue")
+		}
+
+		if err == domainerrors.ErrRequirementsNotMet {
+			return c.JSON(http.StatusConflict, "building requirements not met")
+		}
+
+		c.Logger().Error("Failed to reorder building queue", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to reorder building queue")
+	}
+
+	out := mappers.ToBuildingActionsResponse(queue)
+	return c.JSON(http.StatusOK, out)
+}
+
+// cancelBuildingAction godoc
+//
+//	@Summary		Cancel building action
+//	@Description	Removes a building action from the queue of a planet and refunds its costs. Actions queued later for the same building, or whose requirements are not met anymore, are cancelled and refunded as well.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id		path		string	true	"Planet id (UUID)"	Format(uuid)
+//	@Param			action	path		string	true	"Action id (UUID)"	Format(uuid)
+//	@Success		204		{string}	string
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/actions/{action} [delete]
+func cancelBuildingAction(c *echo.Context, usecase drivingports.ForManagingBuildingQueue) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybeAction := c.Param("action")
+	action, err := uuid.Parse(maybeAction)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid action id syntax")
+	}
+
+	err = usecase.Cancel(c.Request().Context(), id, action)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrNoActionInProgress {
+			return c.JSON(http.StatusNotFound, "no such action")
+		}
+
+		c.Logger().Error("Failed to cancel building action", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to cancel building action")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// buildingActionCreationError returns the status and message reported to the
+// client for the expected errors of the creation of a building action.
+func buildingActionCreationError(err error) (int, string, bool) {
+	switch err {
+	case domainerrors.ErrBuildingQueueFull:
+		return http.StatusConflict, "building queue is full", true
+	case domainerrors.ErrNotFound:
+		return http.StatusNotFound, "no such planet", true
+	case domainerrors.ErrBuildingNotFound:
+		return http.StatusBadRequest, "no such building", true
+	case domainerrors.ErrNotEnoughResources:
+		return http.StatusBadRequest, "not enough resources", true
+	case domainerrors.ErrAllFieldsUsed:
+		return http.StatusConflict, "all fields are used", true
+	case domainerrors.ErrRequirementsNotMet:
+		return http.StatusConflict, "building requirements not met", true
+	case domainerrors.ErrNothingToDemolish:
+		return http.StatusConflict, "nothing to demolish", true
+	case domainerrors.ErrInvalidBuildingActionKind:
+		return http.StatusBadRequest, "invalid building action kind", true
+	default:
+		return http.StatusInternalServerError, "", false
+	}
+}
@@ -3,6 +3,7 @@ package drivingadapters
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
@@ -52,9 +53,14 @@ func TestUnit_BuildingActions_CreateBuildingAction(t *testing.T) {
 		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
 		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
 
-		expectedRequest := request.BuildingActionCreationRequest{Planet: sampleUuid, Building: dto.Building}
+		expectedRequest := request.BuildingActionCreationRequest{
+			Planet:   sampleUuid,
+			Building: dto.Building,
+			Kind:     models.UpgradeAction,
+		}
 		action := models.BuildingAction{
 			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
 			Building:     dto.Building,
 			DesiredLevel: 6,
 			CreatedAt:    someTime,
@@ -95,6 +101,7 @@ func TestUnit_BuildingActions_CreateBuildingAction(t *testing.T) {
 		actual := decodeResponseBody[dtos.BuildingActionDtoResponse](t, rw)
 		expected := dtos.BuildingActionDtoResponse{
 			Id:           action.Id,
+			Kind:         "upgrade",
 			Building:     action.Building,
 			DesiredLevel: action.DesiredLevel,
 			CreatedAt:    action.CreatedAt,
@@ -119,7 +126,74 @@ func TestUnit_BuildingActions_CreateBuildingAction(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
-	t.Run("returns 409 when action already exists", func(t *testing.T) {
+	t.Run("forwards demolition kind to use case", func(t *testing.T) {
+		dto := dtos.BuildingActionDtoRequest{Building: uuid.New(), Kind: "demolish"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.BuildingActionCreationRequest{
+			Planet:   sampleUuid,
+			Building: dto.Building,
+			Kind:     models.DemolishAction,
+		}
+		action := models.BuildingAction{
+			Id:           uuid.New(),
+			Kind:         models.DemolishAction,
+			Building:     dto.Building,
+			DesiredLevel: 2,
+		}
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(action, nil)
+
+		err := createBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.BuildingActionDtoResponse](t, rw)
+		assert.Equal(t, "demolish", actual.Kind)
+		assert.Equal(t, 2, actual.DesiredLevel)
+	})
+
+	t.Run("returns 400 when action kind is invalid", func(t *testing.T) {
+		dto := dtos.BuildingActionDtoRequest{Building: uuid.New(), Kind: "not-a-kind"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.BuildingAction{}, domainerrors.ErrInvalidBuildingActionKind)
+
+		err := createBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid building action kind", actual)
+	})
+
+	t.Run("returns 409 when there is nothing to demolish", func(t *testing.T) {
+		dto := dtos.BuildingActionDtoRequest{Building: uuid.New(), Kind: "demolish"}
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.BuildingAction{}, domainerrors.ErrNothingToDemolish)
+
+		err := createBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "nothing to demolish", actual)
+	})
+
+	t.Run("returns 409 when building queue is full", func(t *testing.T) {
 		dto := dtos.BuildingActionDtoRequest{Building: uuid.New()}
 		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
 		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
@@ -127,14 +201,14 @@ func TestUnit_BuildingActions_CreateBuildingAction(t *testing.T) {
 		mockUsecase.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
 			Times(1).
-			Return(models.BuildingAction{}, domainerrors.ErrActionAlreadyInProgress)
+			Return(models.BuildingAction{}, domainerrors.ErrBuildingQueueFull)
 
 		err := createBuildingAction(ctx, mockUsecase)
 		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, http.StatusConflict, rw.Code)
 		actual := decodeResponseBody[string](t, rw)
-		assert.Equal(t, "action already in progress", actual)
+		assert.Equal(t, "building queue is full", actual)
 	})
 
 	t.Run("returns 409 when all planet fields are used", func(t *testing.T) {
@@ -155,6 +229,24 @@ func TestUnit_BuildingActions_CreateBuildingAction(t *testing.T) {
 		assert.Equal(t, "all fields are used", actual)
 	})
 
+	t.Run("returns 409 when building requirements are not met", func(t *testing.T) {
+		dto := dtos.BuildingActionDtoRequest{Building: uuid.New()}
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.BuildingAction{}, domainerrors.ErrRequirementsNotMet)
+
+		err := createBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "building requirements not met", actual)
+	})
+
 	t.Run("returns 404 when planet is not found", func(t *testing.T) {
 		dto := dtos.BuildingActionDtoRequest{Building: uuid.New()}
 		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
@@ -295,3 +387,329 @@ func TestUnit_BuildingActions_DeleteBuildingAction(t *testing.T) {
 		assert.Equal(t, "failed to delete building action", actual)
 	})
 }
+
+func TestUnit_BuildingActions_ListBuildingQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingBuildingQueue(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := listBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns queued actions in order", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		queue := []models.BuildingAction{
+			{
+				Id:           uuid.New(),
+				Building:     uuid.New(),
+				DesiredLevel: 3,
+				CreatedAt:    someTime,
+				CompletedAt:  someOtherTime,
+			},
+			{
+				Id:           uuid.New(),
+				Building:     uuid.New(),
+				DesiredLevel: 1,
+				CreatedAt:    someTime,
+				CompletedAt:  someOtherTime.Add(time.Hour),
+			},
+		}
+		mockUsecase.EXPECT().
+			List(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(queue, nil)
+
+		err := listBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.BuildingActionDtoResponse](t, rw)
+		require.Len(t, actual, 2)
+		assert.Equal(t, queue[0].Id, actual[0].Id)
+		assert.Equal(t, queue[1].Id, actual[1].Id)
+		assert.Equal(t, queue[1].CompletedAt, actual[1].CompletedAt)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			List(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(nil, domainerrors.ErrNotFound)
+
+		err := listBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list building queue", actual)
+	})
+}
+
+func TestUnit_BuildingActions_ReorderBuildingQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingBuildingQueue(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		dto := dtos.BuildingQueueDtoRequest{Actions: []uuid.UUID{uuid.New()}}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, "not-a-queue")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid building queue syntax", actual)
+	})
+
+	t.Run("forwards order to use case", func(t *testing.T) {
+		order := []uuid.UUID{uuid.New(), uuid.New()}
+		dto := dtos.BuildingQueueDtoRequest{Actions: order}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		queue := []models.BuildingAction{{Id: order[0]}, {Id: order[1]}}
+		mockUsecase.EXPECT().
+			Reorder(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(order)).
+			Times(1).
+			Return(queue, nil)
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.BuildingActionDtoResponse](t, rw)
+		require.Len(t, actual, 2)
+		assert.Equal(t, order[0], actual[0].Id)
+		assert.Equal(t, order[1], actual[1].Id)
+	})
+
+	t.Run("returns 400 when order is invalid", func(t *testing.T) {
+		dto := dtos.BuildingQueueDtoRequest{Actions: []uuid.UUID{uuid.New()}}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reorder(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrInvalidBuildingQueue)
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid building queue", actual)
+	})
+
+	t.Run("returns 409 when requirements are not met", func(t *testing.T) {
+		dto := dtos.BuildingQueueDtoRequest{Actions: []uuid.UUID{uuid.New()}}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reorder(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrRequirementsNotMet)
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "building requirements not met", actual)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		dto := dtos.BuildingQueueDtoRequest{Actions: []uuid.UUID{uuid.New()}}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reorder(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrNotFound)
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		dto := dtos.BuildingQueueDtoRequest{Actions: []uuid.UUID{uuid.New()}}
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reorder(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := reorderBuildingQueue(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to reorder building queue", actual)
+	})
+}
+
+func TestUnit_BuildingActions_CancelBuildingAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingBuildingQueue(ctrl)
+
+	actionId := uuid.New()
+	addActionPathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "action", Value: actionId.String()},
+		})
+	}
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: "not-a-uuid"},
+			{Name: "action", Value: actionId.String()},
+		})
+
+		err := cancelBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when action id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "action", Value: "not-a-uuid"},
+		})
+
+		err := cancelBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid action id syntax", actual)
+	})
+
+	t.Run("forwards cancellation to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addActionPathParams)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(actionId)).
+			Times(1).
+			Return(nil)
+
+		err := cancelBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addActionPathParams)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+
+		err := cancelBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 404 when action is not queued", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addActionPathParams)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNoActionInProgress)
+
+		err := cancelBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such action", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addActionPathParams)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(errors.New("stubbed error"))
+
+		err := cancelBuildingAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to cancel building action", actual)
+	})
+}
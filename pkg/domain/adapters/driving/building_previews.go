@@ -0,0 +1,85 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	defaultPreviewLevels = 1
+	maxPreviewLevels     = 20
+)
+
+func BuildingPreviewEndpoints(usecase drivingports.ForPreviewingBuildingUpgrades) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(previewBuildingUpgrades, usecase)
+	get := rest.NewRoute(http.MethodGet, "/planets/:id/buildings/:building/preview", handler)
+	out = append(out, get)
+
+	return out
+}
+
+// previewBuildingUpgrades godoc
+//
+//	@Summary		Preview building upgrades
+//	@Description	Describes the next levels of the building on the planet, starting after the level reached once the actions already queued for it are completed. For each level, returns the costs, the time needed to complete it, the production and storage of the building once it is reached (the bonus of the energy technology applies on top of the production, as for the planet) and whether the planet can currently afford it. Nothing is queued nor modified on the planet.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id			path		string	true	"Planet id (UUID)"		Format(uuid)
+//	@Param			building	path		string	true	"Building id (UUID)"	Format(uuid)
+//	@Param			levels		query		int		false	"Number of levels to preview"	minimum(1)	maximum(20)	default(1)
+//	@Success		200			{object}	rest.ResponseEnvelope[[]dtos.BuildingLevelPreviewDtoResponse]
+//	@Failure		400			{object}	rest.ResponseEnvelope[string]
+//	@Failure		401			{object}	rest.ResponseEnvelope[string]
+//	@Failure		403			{object}	rest.ResponseEnvelope[string]
+//	@Failure		404			{object}	rest.ResponseEnvelope[string]
+//	@Failure		500			{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/buildings/{building}/preview [get]
+func previewBuildingUpgrades(c *echo.Context, usecase drivingports.ForPreviewingBuildingUpgrades) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybeBuilding := c.Param("building")
+	building, err := uuid.Parse(maybeBuilding)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid building id syntax")
+	}
+
+	levels := defaultPreviewLevels
+	if maybeLevels := c.QueryParam("levels"); maybeLevels != "" {
+		levels, err = strconv.Atoi(maybeLevels)
+		if err != nil || levels < 1 || levels > maxPreviewLevels {
+			return c.JSON(http.StatusBadRequest, "invalid levels")
+		}
+	}
+
+	previews, err := usecase.Preview(c.Request().Context(), id, building, levels)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrBuildingNotFound {
+			return c.JSON(http.StatusNotFound, "no such building")
+		}
+
+		c.Logger().Error("Failed to preview building upgrades", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to preview building upgrades")
+	}
+
+	out := mappers.ToBuildingLevelPreviewsResponse(previews)
+	return c.JSON(http.StatusOK, out)
+}
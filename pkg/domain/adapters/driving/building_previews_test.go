@@ -0,0 +1,209 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_BuildingPreviews_PreviewBuildingUpgrades(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForPreviewingBuildingUpgrades(ctrl)
+
+	buildingId := uuid.New()
+	addPreviewPathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "building", Value: buildingId.String()},
+		})
+	}
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: "not-a-uuid"},
+			{Name: "building", Value: buildingId.String()},
+		})
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when building id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "building", Value: "not-a-uuid"},
+		})
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid building id syntax", actual)
+	})
+
+	t.Run("returns 400 when levels is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "levels", "abc")
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid levels", actual)
+	})
+
+	t.Run("returns 400 when levels is too large", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "levels", "21")
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid levels", actual)
+	})
+
+	t.Run("previews next level by default", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		mockUsecase.EXPECT().
+			Preview(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(buildingId), gomock.Eq(1)).
+			Times(1).
+			Return(nil, nil)
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.BuildingLevelPreviewDtoResponse](t, rw)
+		assert.Equal(t, []dtos.BuildingLevelPreviewDtoResponse{}, actual)
+	})
+
+	t.Run("forwards requested levels to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "levels", "5")
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		preview := models.BuildingLevelPreview{
+			Building: buildingId,
+			Level:    3,
+			Costs: []models.BuildingActionCost{
+				{Resource: sampleResourceId, Amount: 135},
+			},
+			Duration: 90 * time.Second,
+			Storages: []models.BuildingActionResourceStorage{
+				{Resource: sampleResourceId, Storage: 2000},
+			},
+			Productions: []models.BuildingActionResourceProduction{
+				{Resource: sampleResourceId, Production: 48},
+			},
+			Affordable: true,
+		}
+		mockUsecase.EXPECT().
+			Preview(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(buildingId), gomock.Eq(5)).
+			Times(1).
+			Return([]models.BuildingLevelPreview{preview}, nil)
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.BuildingLevelPreviewDtoResponse](t, rw)
+		expected := []dtos.BuildingLevelPreviewDtoResponse{
+			{
+				Building: buildingId,
+				Level:    3,
+				Costs: []dtos.BuildingActionCostDtoResponse{
+					{Resource: sampleResourceId, Amount: 135},
+				},
+				Duration: 90,
+				Storages: []dtos.BuildingActionStorageDtoResponse{
+					{Resource: sampleResourceId, Storage: 2000},
+				},
+				Productions: []dtos.BuildingActionProductionDtoResponse{
+					{Resource: sampleResourceId, Production: 48},
+				},
+				Affordable: true,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when planet does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		mockUsecase.EXPECT().
+			Preview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrNotFound)
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 404 when building does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		mockUsecase.EXPECT().
+			Preview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrBuildingNotFound)
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such building", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addPreviewPathParams)
+
+		mockUsecase.EXPECT().
+			Preview(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := previewBuildingUpgrades(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to preview building upgrades", actual)
+	})
+}
@@ -0,0 +1,82 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func ColonizationEndpoints(usecase drivingports.ForColonizingPlanet) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(colonizePlanet, usecase)
+	post := rest.NewRoute(http.MethodPost, "/players/:id/planets", handler)
+	out = append(out, post)
+
+	return out
+}
+
+// colonizePlanet godoc
+//
+//	@Summary		Colonize planet
+//	@Description	Creates a new colony for the player provided in path parameter at a free position of its universe.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id	path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Success		201	{object}	rest.ResponseEnvelope[dtos.PlanetDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		409	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/planets [post]
+func colonizePlanet(c *echo.Context, usecase drivingports.ForColonizingPlanet) error {
+	maybeId := c.Param("id")
+	playerId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	req := request.PlanetCreationRequest{Player: playerId}
+	planet, err := usecase.Colonize(c.Request().Context(), req)
+	if err != nil {
+		if err == domainerrors.ErrPlayerNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		if err == domainerrors.ErrUniverseNotFound {
+			return c.JSON(http.StatusNotFound, "no such universe")
+		}
+
+		if err == domainerrors.ErrCoordinateAlreadyUsed {
+			return c.JSON(http.StatusConflict, "coordinate already used")
+		}
+
+		if err == domainerrors.ErrColonyLimitReached {
+			return c.JSON(http.StatusConflict, "colony limit reached")
+		}
+
+		if err == domainerrors.ErrUniverseFull {
+			return c.JSON(http.StatusConflict, "universe is full")
+		}
+
+		if err == domainerrors.ErrOptimisticLocking {
+			return c.JSON(http.StatusConflict, "concurrent colonization")
+		}
+
+		c.Logger().Error("Failed to colonize planet", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to colonize planet")
+	}
+
+	out := mappers.ToPlanetResponse(planet)
+	return c.JSON(http.StatusCreated, out)
+}
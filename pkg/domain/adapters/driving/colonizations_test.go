@@ -0,0 +1,219 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Colonizations_ColonizePlanet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForColonizingPlanet(ctrl)
+
+	t.Run("returns 400 when player id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards colonization to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.PlanetCreationRequest{Player: sampleUuid}
+		planet := models.Planet{
+			Id:     uuid.New(),
+			Player: sampleUuid,
+			Name:   "colony",
+			Coordinate: models.Coordinate{
+				Galaxy:      2,
+				SolarSystem: 87,
+				Position:    4,
+			},
+			Fields:    163,
+			CreatedAt: someTime,
+			UpdatedAt: someTime,
+			Resources: []models.PlanetResource{
+				{
+					Resource: sampleResourceId,
+					Amount:   500,
+				},
+			},
+			Storages:    []models.PlanetResourceStorage{},
+			Productions: []models.PlanetResourceProduction{},
+			Buildings:   []models.PlanetBuilding{},
+		}
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(planet, nil)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.PlanetDtoResponse](t, rw)
+		expected := dtos.PlanetDtoResponse{
+			Id:     planet.Id,
+			Player: sampleUuid,
+			Name:   "colony",
+			Coordinate: dtos.CoordinateDtoResponse{
+				Galaxy:      2,
+				SolarSystem: 87,
+				Position:    4,
+			},
+			Fields:    163,
+			CreatedAt: someTime,
+			UpdatedAt: someTime,
+			Resources: []dtos.PlanetResourceDtoResponse{
+				{Resource: sampleResourceId, Amount: 500},
+			},
+			Storages:      []dtos.PlanetResourceStorageDtoResponse{},
+			Productions:   []dtos.PlanetResourceProductionDtoResponse{},
+			Buildings:     []dtos.PlanetBuildingDtoResponse{},
+			Ships:         []dtos.PlanetShipDtoResponse{},
+			Defenses:      []dtos.PlanetDefenseDtoResponse{},
+			BuildingQueue: []dtos.BuildingActionDtoResponse{},
+			ShipyardQueue: []dtos.ShipyardActionDtoResponse{},
+			Fleets:        []dtos.FleetDtoResponse{},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when player is not found", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrPlayerNotFound)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such player", actual)
+	})
+
+	t.Run("returns 404 when universe is not found", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrUniverseNotFound)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such universe", actual)
+	})
+
+	t.Run("returns 409 when coordinate is already used", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrCoordinateAlreadyUsed)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "coordinate already used", actual)
+	})
+
+	t.Run("returns 409 when colony limit is reached", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrColonyLimitReached)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "colony limit reached", actual)
+	})
+
+	t.Run("returns 409 when universe is full", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrUniverseFull)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "universe is full", actual)
+	})
+
+	t.Run("returns 409 when player was concurrently modified", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrOptimisticLocking)
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "concurrent colonization", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Colonize(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, errors.New("stubbed error"))
+
+		err := colonizePlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to colonize planet", actual)
+	})
+}
@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_managing_alliance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_managing_alliance.go -destination=drivingportstest/alliance_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingAlliance is a mock of ForManagingAlliance interface.
+type MockForManagingAlliance struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingAllianceMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingAllianceMockRecorder is the mock recorder for MockForManagingAlliance.
+type MockForManagingAllianceMockRecorder struct {
+	mock *MockForManagingAlliance
+}
+
+// NewMockForManagingAlliance creates a new mock instance.
+func NewMockForManagingAlliance(ctrl *gomock.Controller) *MockForManagingAlliance {
+	mock := &MockForManagingAlliance{ctrl: ctrl}
+	mock.recorder = &MockForManagingAllianceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingAlliance) EXPECT() *MockForManagingAllianceMockRecorder {
+	return m.recorder
+}
+
+// AcceptApplication mocks base method.
+func (m *MockForManagingAlliance) AcceptApplication(ctx context.Context, alliance, application, player uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptApplication", ctx, alliance, application, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptApplication indicates an expected call of AcceptApplication.
+func (mr *MockForManagingAllianceMockRecorder) AcceptApplication(ctx, alliance, application, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptApplication", reflect.TypeOf((*MockForManagingAlliance)(nil).AcceptApplication), ctx, alliance, application, player)
+}
+
+// Apply mocks base method.
+func (m *MockForManagingAlliance) Apply(ctx context.Context, alliance, player uuid.UUID) (models.AllianceApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, alliance, player)
+	ret0, _ := ret[0].(models.AllianceApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockForManagingAllianceMockRecorder) Apply(ctx, alliance, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockForManagingAlliance)(nil).Apply), ctx, alliance, player)
+}
+
+// Create mocks base method.
+func (m *MockForManagingAlliance) Create(ctx context.Context, req request.AllianceCreationRequest) (models.Alliance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(models.Alliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockForManagingAllianceMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockForManagingAlliance)(nil).Create), ctx, req)
+}
+
+// Disband mocks base method.
+func (m *MockForManagingAlliance) Disband(ctx context.Context, alliance, player uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disband", ctx, alliance, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disband indicates an expected call of Disband.
+func (mr *MockForManagingAllianceMockRecorder) Disband(ctx, alliance, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disband", reflect.TypeOf((*MockForManagingAlliance)(nil).Disband), ctx, alliance, player)
+}
+
+// Get mocks base method.
+func (m *MockForManagingAlliance) Get(ctx context.Context, id uuid.UUID) (models.Alliance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Alliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingAllianceMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingAlliance)(nil).Get), ctx, id)
+}
+
+// Leave mocks base method.
+func (m *MockForManagingAlliance) Leave(ctx context.Context, alliance, player uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leave", ctx, alliance, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Leave indicates an expected call of Leave.
+func (mr *MockForManagingAllianceMockRecorder) Leave(ctx, alliance, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockForManagingAlliance)(nil).Leave), ctx, alliance, player)
+}
+
+// ListApplications mocks base method.
+func (m *MockForManagingAlliance) ListApplications(ctx context.Context, alliance, player uuid.UUID) ([]models.AllianceApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications", ctx, alliance, player)
+	ret0, _ := ret[0].([]models.AllianceApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplications indicates an expected call of ListApplications.
+func (mr *MockForManagingAllianceMockRecorder) ListApplications(ctx, alliance, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockForManagingAlliance)(nil).ListApplications), ctx, alliance, player)
+}
+
+// RejectApplication mocks base method.
+func (m *MockForManagingAlliance) RejectApplication(ctx context.Context, alliance, application, player uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectApplication", ctx, alliance, application, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectApplication indicates an expected call of RejectApplication.
+func (mr *MockForManagingAllianceMockRecorder) RejectApplication(ctx, alliance, application, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectApplication", reflect.TypeOf((*MockForManagingAlliance)(nil).RejectApplication), ctx, alliance, application, player)
+}
+
+// SetRank mocks base method.
+func (m *MockForManagingAlliance) SetRank(ctx context.Context, req request.AllianceRankRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRank", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRank indicates an expected call of SetRank.
+func (mr *MockForManagingAllianceMockRecorder) SetRank(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRank", reflect.TypeOf((*MockForManagingAlliance)(nil).SetRank), ctx, req)
+}
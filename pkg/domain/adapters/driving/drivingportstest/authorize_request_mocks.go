@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_authorizing_request.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_authorizing_request.go -destination=drivingportstest/authorize_request_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForAuthorizingRequest is a mock of ForAuthorizingRequest interface.
+type MockForAuthorizingRequest struct {
+	ctrl     *gomock.Controller
+	recorder *MockForAuthorizingRequestMockRecorder
+	isgomock struct{}
+}
+
+// MockForAuthorizingRequestMockRecorder is the mock recorder for MockForAuthorizingRequest.
+type MockForAuthorizingRequestMockRecorder struct {
+	mock *MockForAuthorizingRequest
+}
+
+// NewMockForAuthorizingRequest creates a new mock instance.
+func NewMockForAuthorizingRequest(ctrl *gomock.Controller) *MockForAuthorizingRequest {
+	mock := &MockForAuthorizingRequest{ctrl: ctrl}
+	mock.recorder = &MockForAuthorizingRequestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForAuthorizingRequest) EXPECT() *MockForAuthorizingRequestMockRecorder {
+	return m.recorder
+}
+
+// CheckOwnership mocks base method.
+func (m *MockForAuthorizingRequest) CheckOwnership(ctx context.Context, req request.OwnershipRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOwnership", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOwnership indicates an expected call of CheckOwnership.
+func (mr *MockForAuthorizingRequestMockRecorder) CheckOwnership(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOwnership", reflect.TypeOf((*MockForAuthorizingRequest)(nil).CheckOwnership), ctx, req)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_previewing_building_upgrades.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_previewing_building_upgrades.go -destination=drivingportstest/building_preview_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForPreviewingBuildingUpgrades is a mock of ForPreviewingBuildingUpgrades interface.
+type MockForPreviewingBuildingUpgrades struct {
+	ctrl     *gomock.Controller
+	recorder *MockForPreviewingBuildingUpgradesMockRecorder
+	isgomock struct{}
+}
+
+// MockForPreviewingBuildingUpgradesMockRecorder is the mock recorder for MockForPreviewingBuildingUpgrades.
+type MockForPreviewingBuildingUpgradesMockRecorder struct {
+	mock *MockForPreviewingBuildingUpgrades
+}
+
+// NewMockForPreviewingBuildingUpgrades creates a new mock instance.
+func NewMockForPreviewingBuildingUpgrades(ctrl *gomock.Controller) *MockForPreviewingBuildingUpgrades {
+	mock := &MockForPreviewingBuildingUpgrades{ctrl: ctrl}
+	mock.recorder = &MockForPreviewingBuildingUpgradesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForPreviewingBuildingUpgrades) EXPECT() *MockForPreviewingBuildingUpgradesMockRecorder {
+	return m.recorder
+}
+
+// Preview mocks base method.
+func (m *MockForPreviewingBuildingUpgrades) Preview(ctx context.Context, planet, building uuid.UUID, levels int) ([]models.BuildingLevelPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", ctx, planet, building, levels)
+	ret0, _ := ret[0].([]models.BuildingLevelPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockForPreviewingBuildingUpgradesMockRecorder) Preview(ctx, planet, building, levels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockForPreviewingBuildingUpgrades)(nil).Preview), ctx, planet, building, levels)
+}
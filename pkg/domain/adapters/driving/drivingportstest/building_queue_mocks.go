@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_managing_building_queue.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_managing_building_queue.go -destination=drivingportstest/building_queue_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingBuildingQueue is a mock of ForManagingBuildingQueue interface.
+type MockForManagingBuildingQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingBuildingQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingBuildingQueueMockRecorder is the mock recorder for MockForManagingBuildingQueue.
+type MockForManagingBuildingQueueMockRecorder struct {
+	mock *MockForManagingBuildingQueue
+}
+
+// NewMockForManagingBuildingQueue creates a new mock instance.
+func NewMockForManagingBuildingQueue(ctrl *gomock.Controller) *MockForManagingBuildingQueue {
+	mock := &MockForManagingBuildingQueue{ctrl: ctrl}
+	mock.recorder = &MockForManagingBuildingQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingBuildingQueue) EXPECT() *MockForManagingBuildingQueueMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockForManagingBuildingQueue) Cancel(ctx context.Context, planet, action uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, planet, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockForManagingBuildingQueueMockRecorder) Cancel(ctx, planet, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockForManagingBuildingQueue)(nil).Cancel), ctx, planet, action)
+}
+
+// List mocks base method.
+func (m *MockForManagingBuildingQueue) List(ctx context.Context, planet uuid.UUID) ([]models.BuildingAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, planet)
+	ret0, _ := ret[0].([]models.BuildingAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockForManagingBuildingQueueMockRecorder) List(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockForManagingBuildingQueue)(nil).List), ctx, planet)
+}
+
+// Reorder mocks base method.
+func (m *MockForManagingBuildingQueue) Reorder(ctx context.Context, planet uuid.UUID, order []uuid.UUID) ([]models.BuildingAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, planet, order)
+	ret0, _ := ret[0].([]models.BuildingAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockForManagingBuildingQueueMockRecorder) Reorder(ctx, planet, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockForManagingBuildingQueue)(nil).Reorder), ctx, planet, order)
+}
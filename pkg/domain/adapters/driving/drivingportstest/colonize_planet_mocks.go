@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_colonizing_planet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_colonizing_planet.go -destination=drivingportstest/colonize_planet_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForColonizingPlanet is a mock of ForColonizingPlanet interface.
+type MockForColonizingPlanet struct {
+	ctrl     *gomock.Controller
+	recorder *MockForColonizingPlanetMockRecorder
+	isgomock struct{}
+}
+
+// MockForColonizingPlanetMockRecorder is the mock recorder for MockForColonizingPlanet.
+type MockForColonizingPlanetMockRecorder struct {
+	mock *MockForColonizingPlanet
+}
+
+// NewMockForColonizingPlanet creates a new mock instance.
+func NewMockForColonizingPlanet(ctrl *gomock.Controller) *MockForColonizingPlanet {
+	mock := &MockForColonizingPlanet{ctrl: ctrl}
+	mock.recorder = &MockForColonizingPlanetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForColonizingPlanet) EXPECT() *MockForColonizingPlanetMockRecorder {
+	return m.recorder
+}
+
+// Colonize mocks base method.
+func (m *MockForColonizingPlanet) Colonize(ctx context.Context, req request.PlanetCreationRequest) (models.Planet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Colonize", ctx, req)
+	ret0, _ := ret[0].(models.Planet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Colonize indicates an expected call of Colonize.
+func (mr *MockForColonizingPlanetMockRecorder) Colonize(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Colonize", reflect.TypeOf((*MockForColonizingPlanet)(nil).Colonize), ctx, req)
+}
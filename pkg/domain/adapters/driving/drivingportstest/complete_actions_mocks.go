@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_completing_actions.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_completing_actions.go -destination=drivingportstest/complete_actions_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForCompletingActions is a mock of ForCompletingActions interface.
+type MockForCompletingActions struct {
+	ctrl     *gomock.Controller
+	recorder *MockForCompletingActionsMockRecorder
+	isgomock struct{}
+}
+
+// MockForCompletingActionsMockRecorder is the mock recorder for MockForCompletingActions.
+type MockForCompletingActionsMockRecorder struct {
+	mock *MockForCompletingActions
+}
+
+// NewMockForCompletingActions creates a new mock instance.
+func NewMockForCompletingActions(ctrl *gomock.Controller) *MockForCompletingActions {
+	mock := &MockForCompletingActions{ctrl: ctrl}
+	mock.recorder = &MockForCompletingActionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForCompletingActions) EXPECT() *MockForCompletingActionsMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockForCompletingActions) Complete(ctx context.Context, planet uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, planet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockForCompletingActionsMockRecorder) Complete(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockForCompletingActions)(nil).Complete), ctx, planet)
+}
+
+// ListDuePlanets mocks base method.
+func (m *MockForCompletingActions) ListDuePlanets(ctx context.Context) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDuePlanets", ctx)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDuePlanets indicates an expected call of ListDuePlanets.
+func (mr *MockForCompletingActionsMockRecorder) ListDuePlanets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDuePlanets", reflect.TypeOf((*MockForCompletingActions)(nil).ListDuePlanets), ctx)
+}
+
+// NextCompletion mocks base method.
+func (m *MockForCompletingActions) NextCompletion(ctx context.Context) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextCompletion", ctx)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextCompletion indicates an expected call of NextCompletion.
+func (mr *MockForCompletingActionsMockRecorder) NextCompletion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextCompletion", reflect.TypeOf((*MockForCompletingActions)(nil).NextCompletion), ctx)
+}
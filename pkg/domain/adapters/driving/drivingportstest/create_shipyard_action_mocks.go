@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_creating_shipyard_action.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_creating_shipyard_action.go -destination=drivingportstest/create_shipyard_action_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForCreatingShipyardAction is a mock of ForCreatingShipyardAction interface.
+type MockForCreatingShipyardAction struct {
+	ctrl     *gomock.Controller
+	recorder *MockForCreatingShipyardActionMockRecorder
+	isgomock struct{}
+}
+
+// MockForCreatingShipyardActionMockRecorder is the mock recorder for MockForCreatingShipyardAction.
+type MockForCreatingShipyardActionMockRecorder struct {
+	mock *MockForCreatingShipyardAction
+}
+
+// NewMockForCreatingShipyardAction creates a new mock instance.
+func NewMockForCreatingShipyardAction(ctrl *gomock.Controller) *MockForCreatingShipyardAction {
+	mock := &MockForCreatingShipyardAction{ctrl: ctrl}
+	mock.recorder = &MockForCreatingShipyardActionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForCreatingShipyardAction) EXPECT() *MockForCreatingShipyardActionMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockForCreatingShipyardAction) Create(ctx context.Context, req request.ShipyardActionCreationRequest) (models.ShipyardAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(models.ShipyardAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockForCreatingShipyardActionMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockForCreatingShipyardAction)(nil).Create), ctx, req)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_managing_fleet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_managing_fleet.go -destination=drivingportstest/fleet_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingFleet is a mock of ForManagingFleet interface.
+type MockForManagingFleet struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingFleetMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingFleetMockRecorder is the mock recorder for MockForManagingFleet.
+type MockForManagingFleetMockRecorder struct {
+	mock *MockForManagingFleet
+}
+
+// NewMockForManagingFleet creates a new mock instance.
+func NewMockForManagingFleet(ctrl *gomock.Controller) *MockForManagingFleet {
+	mock := &MockForManagingFleet{ctrl: ctrl}
+	mock.recorder = &MockForManagingFleetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingFleet) EXPECT() *MockForManagingFleetMockRecorder {
+	return m.recorder
+}
+
+// ListForPlayer mocks base method.
+func (m *MockForManagingFleet) ListForPlayer(ctx context.Context, player uuid.UUID) ([]models.Fleet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlayer", ctx, player)
+	ret0, _ := ret[0].([]models.Fleet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlayer indicates an expected call of ListForPlayer.
+func (mr *MockForManagingFleetMockRecorder) ListForPlayer(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlayer", reflect.TypeOf((*MockForManagingFleet)(nil).ListForPlayer), ctx, player)
+}
+
+// Recall mocks base method.
+func (m *MockForManagingFleet) Recall(ctx context.Context, id uuid.UUID) (models.Fleet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recall", ctx, id)
+	ret0, _ := ret[0].(models.Fleet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recall indicates an expected call of Recall.
+func (mr *MockForManagingFleetMockRecorder) Recall(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recall", reflect.TypeOf((*MockForManagingFleet)(nil).Recall), ctx, id)
+}
@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_managing_game_data.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_managing_game_data.go -destination=drivingportstest/game_data_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingGameData is a mock of ForManagingGameData interface.
+type MockForManagingGameData struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingGameDataMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingGameDataMockRecorder is the mock recorder for MockForManagingGameData.
+type MockForManagingGameDataMockRecorder struct {
+	mock *MockForManagingGameData
+}
+
+// NewMockForManagingGameData creates a new mock instance.
+func NewMockForManagingGameData(ctrl *gomock.Controller) *MockForManagingGameData {
+	mock := &MockForManagingGameData{ctrl: ctrl}
+	mock.recorder = &MockForManagingGameDataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingGameData) EXPECT() *MockForManagingGameDataMockRecorder {
+	return m.recorder
+}
+
+// CreateBuilding mocks base method.
+func (m *MockForManagingGameData) CreateBuilding(ctx context.Context, req request.BuildingRequest) (models.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBuilding", ctx, req)
+	ret0, _ := ret[0].(models.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBuilding indicates an expected call of CreateBuilding.
+func (mr *MockForManagingGameDataMockRecorder) CreateBuilding(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBuilding", reflect.TypeOf((*MockForManagingGameData)(nil).CreateBuilding), ctx, req)
+}
+
+// CreateResource mocks base method.
+func (m *MockForManagingGameData) CreateResource(ctx context.Context, req request.ResourceRequest) (models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResource", ctx, req)
+	ret0, _ := ret[0].(models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResource indicates an expected call of CreateResource.
+func (mr *MockForManagingGameDataMockRecorder) CreateResource(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResource", reflect.TypeOf((*MockForManagingGameData)(nil).CreateResource), ctx, req)
+}
+
+// ImportRuleset mocks base method.
+func (m *MockForManagingGameData) ImportRuleset(ctx context.Context, req request.RulesetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRuleset", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportRuleset indicates an expected call of ImportRuleset.
+func (mr *MockForManagingGameDataMockRecorder) ImportRuleset(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRuleset", reflect.TypeOf((*MockForManagingGameData)(nil).ImportRuleset), ctx, req)
+}
+
+// ListBuildings mocks base method.
+func (m *MockForManagingGameData) ListBuildings(ctx context.Context) ([]models.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildings", ctx)
+	ret0, _ := ret[0].([]models.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuildings indicates an expected call of ListBuildings.
+func (mr *MockForManagingGameDataMockRecorder) ListBuildings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildings", reflect.TypeOf((*MockForManagingGameData)(nil).ListBuildings), ctx)
+}
+
+// ListResources mocks base method.
+func (m *MockForManagingGameData) ListResources(ctx context.Context) ([]models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", ctx)
+	ret0, _ := ret[0].([]models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockForManagingGameDataMockRecorder) ListResources(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockForManagingGameData)(nil).ListResources), ctx)
+}
+
+// UpdateBuilding mocks base method.
+func (m *MockForManagingGameData) UpdateBuilding(ctx context.Context, id uuid.UUID, req request.BuildingRequest) (models.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuilding", ctx, id, req)
+	ret0, _ := ret[0].(models.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuilding indicates an expected call of UpdateBuilding.
+func (mr *MockForManagingGameDataMockRecorder) UpdateBuilding(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuilding", reflect.TypeOf((*MockForManagingGameData)(nil).UpdateBuilding), ctx, id, req)
+}
+
+// UpdateResource mocks base method.
+func (m *MockForManagingGameData) UpdateResource(ctx context.Context, id uuid.UUID, req request.ResourceRequest) (models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResource", ctx, id, req)
+	ret0, _ := ret[0].(models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockForManagingGameDataMockRecorder) UpdateResource(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockForManagingGameData)(nil).UpdateResource), ctx, id, req)
+}
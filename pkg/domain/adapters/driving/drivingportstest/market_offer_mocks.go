@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_managing_market_offer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_managing_market_offer.go -destination=drivingportstest/market_offer_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingMarketOffer is a mock of ForManagingMarketOffer interface.
+type MockForManagingMarketOffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingMarketOfferMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingMarketOfferMockRecorder is the mock recorder for MockForManagingMarketOffer.
+type MockForManagingMarketOfferMockRecorder struct {
+	mock *MockForManagingMarketOffer
+}
+
+// NewMockForManagingMarketOffer creates a new mock instance.
+func NewMockForManagingMarketOffer(ctrl *gomock.Controller) *MockForManagingMarketOffer {
+	mock := &MockForManagingMarketOffer{ctrl: ctrl}
+	mock.recorder = &MockForManagingMarketOfferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingMarketOffer) EXPECT() *MockForManagingMarketOfferMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockForManagingMarketOffer) Accept(ctx context.Context, req request.MarketOfferAcceptanceRequest) (models.MarketOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, req)
+	ret0, _ := ret[0].(models.MarketOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockForManagingMarketOfferMockRecorder) Accept(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockForManagingMarketOffer)(nil).Accept), ctx, req)
+}
+
+// Cancel mocks base method.
+func (m *MockForManagingMarketOffer) Cancel(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockForManagingMarketOfferMockRecorder) Cancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockForManagingMarketOffer)(nil).Cancel), ctx, id)
+}
+
+// ListForUniverse mocks base method.
+func (m *MockForManagingMarketOffer) ListForUniverse(ctx context.Context, universe uuid.UUID, page request.PageRequest) ([]models.MarketOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUniverse", ctx, universe, page)
+	ret0, _ := ret[0].([]models.MarketOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUniverse indicates an expected call of ListForUniverse.
+func (mr *MockForManagingMarketOfferMockRecorder) ListForUniverse(ctx, universe, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUniverse", reflect.TypeOf((*MockForManagingMarketOffer)(nil).ListForUniverse), ctx, universe, page)
+}
+
+// Post mocks base method.
+func (m *MockForManagingMarketOffer) Post(ctx context.Context, req request.MarketOfferCreationRequest) (models.MarketOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, req)
+	ret0, _ := ret[0].(models.MarketOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockForManagingMarketOfferMockRecorder) Post(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockForManagingMarketOffer)(nil).Post), ctx, req)
+}
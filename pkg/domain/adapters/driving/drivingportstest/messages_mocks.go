@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_exchanging_messages.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_exchanging_messages.go -destination=drivingportstest/messages_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForExchangingMessages is a mock of ForExchangingMessages interface.
+type MockForExchangingMessages struct {
+	ctrl     *gomock.Controller
+	recorder *MockForExchangingMessagesMockRecorder
+	isgomock struct{}
+}
+
+// MockForExchangingMessagesMockRecorder is the mock recorder for MockForExchangingMessages.
+type MockForExchangingMessagesMockRecorder struct {
+	mock *MockForExchangingMessages
+}
+
+// NewMockForExchangingMessages creates a new mock instance.
+func NewMockForExchangingMessages(ctrl *gomock.Controller) *MockForExchangingMessages {
+	mock := &MockForExchangingMessages{ctrl: ctrl}
+	mock.recorder = &MockForExchangingMessagesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForExchangingMessages) EXPECT() *MockForExchangingMessagesMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockForExchangingMessages) Delete(ctx context.Context, player, message uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, player, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockForExchangingMessagesMockRecorder) Delete(ctx, player, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockForExchangingMessages)(nil).Delete), ctx, player, message)
+}
+
+// ListForPlayer mocks base method.
+func (m *MockForExchangingMessages) ListForPlayer(ctx context.Context, player uuid.UUID, page request.PageRequest) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlayer", ctx, player, page)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlayer indicates an expected call of ListForPlayer.
+func (mr *MockForExchangingMessagesMockRecorder) ListForPlayer(ctx, player, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlayer", reflect.TypeOf((*MockForExchangingMessages)(nil).ListForPlayer), ctx, player, page)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockForExchangingMessages) MarkAsRead(ctx context.Context, player, message uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, player, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockForExchangingMessagesMockRecorder) MarkAsRead(ctx, player, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockForExchangingMessages)(nil).MarkAsRead), ctx, player, message)
+}
+
+// Send mocks base method.
+func (m *MockForExchangingMessages) Send(ctx context.Context, req request.MessageCreationRequest) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, req)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockForExchangingMessagesMockRecorder) Send(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockForExchangingMessages)(nil).Send), ctx, req)
+}
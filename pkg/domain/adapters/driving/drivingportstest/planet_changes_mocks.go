@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_watching_planet_changes.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_watching_planet_changes.go -destination=drivingportstest/planet_changes_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForWatchingPlanetChanges is a mock of ForWatchingPlanetChanges interface.
+type MockForWatchingPlanetChanges struct {
+	ctrl     *gomock.Controller
+	recorder *MockForWatchingPlanetChangesMockRecorder
+	isgomock struct{}
+}
+
+// MockForWatchingPlanetChangesMockRecorder is the mock recorder for MockForWatchingPlanetChanges.
+type MockForWatchingPlanetChangesMockRecorder struct {
+	mock *MockForWatchingPlanetChanges
+}
+
+// NewMockForWatchingPlanetChanges creates a new mock instance.
+func NewMockForWatchingPlanetChanges(ctrl *gomock.Controller) *MockForWatchingPlanetChanges {
+	mock := &MockForWatchingPlanetChanges{ctrl: ctrl}
+	mock.recorder = &MockForWatchingPlanetChangesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForWatchingPlanetChanges) EXPECT() *MockForWatchingPlanetChangesMockRecorder {
+	return m.recorder
+}
+
+// WatchPlanet mocks base method.
+func (m *MockForWatchingPlanetChanges) WatchPlanet(ctx context.Context, planet uuid.UUID) (<-chan models.PlanetChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPlanet", ctx, planet)
+	ret0, _ := ret[0].(<-chan models.PlanetChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchPlanet indicates an expected call of WatchPlanet.
+func (mr *MockForWatchingPlanetChangesMockRecorder) WatchPlanet(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPlanet", reflect.TypeOf((*MockForWatchingPlanetChanges)(nil).WatchPlanet), ctx, planet)
+}
+
+// WatchPlayer mocks base method.
+func (m *MockForWatchingPlanetChanges) WatchPlayer(ctx context.Context, player uuid.UUID) (<-chan models.PlanetChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPlayer", ctx, player)
+	ret0, _ := ret[0].(<-chan models.PlanetChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchPlayer indicates an expected call of WatchPlayer.
+func (mr *MockForWatchingPlanetChangesMockRecorder) WatchPlayer(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPlayer", reflect.TypeOf((*MockForWatchingPlanetChanges)(nil).WatchPlayer), ctx, player)
+}
+
+// WatchUniverse mocks base method.
+func (m *MockForWatchingPlanetChanges) WatchUniverse(ctx context.Context, universe uuid.UUID) (<-chan models.PlanetChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUniverse", ctx, universe)
+	ret0, _ := ret[0].(<-chan models.PlanetChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUniverse indicates an expected call of WatchUniverse.
+func (mr *MockForWatchingPlanetChangesMockRecorder) WatchUniverse(ctx, universe any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUniverse", reflect.TypeOf((*MockForWatchingPlanetChanges)(nil).WatchUniverse), ctx, universe)
+}
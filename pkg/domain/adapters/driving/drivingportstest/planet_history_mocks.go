@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_listing_planet_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_listing_planet_history.go -destination=drivingportstest/planet_history_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForListingPlanetHistory is a mock of ForListingPlanetHistory interface.
+type MockForListingPlanetHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockForListingPlanetHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockForListingPlanetHistoryMockRecorder is the mock recorder for MockForListingPlanetHistory.
+type MockForListingPlanetHistoryMockRecorder struct {
+	mock *MockForListingPlanetHistory
+}
+
+// NewMockForListingPlanetHistory creates a new mock instance.
+func NewMockForListingPlanetHistory(ctrl *gomock.Controller) *MockForListingPlanetHistory {
+	mock := &MockForListingPlanetHistory{ctrl: ctrl}
+	mock.recorder = &MockForListingPlanetHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForListingPlanetHistory) EXPECT() *MockForListingPlanetHistoryMockRecorder {
+	return m.recorder
+}
+
+// ListHistory mocks base method.
+func (m *MockForListingPlanetHistory) ListHistory(ctx context.Context, planet uuid.UUID, page request.PageRequest) ([]models.PlanetEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", ctx, planet, page)
+	ret0, _ := ret[0].([]models.PlanetEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockForListingPlanetHistoryMockRecorder) ListHistory(ctx, planet, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockForListingPlanetHistory)(nil).ListHistory), ctx, planet, page)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_reconstructing_planet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_reconstructing_planet.go -destination=drivingportstest/planet_reconstruction_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForReconstructingPlanet is a mock of ForReconstructingPlanet interface.
+type MockForReconstructingPlanet struct {
+	ctrl     *gomock.Controller
+	recorder *MockForReconstructingPlanetMockRecorder
+	isgomock struct{}
+}
+
+// MockForReconstructingPlanetMockRecorder is the mock recorder for MockForReconstructingPlanet.
+type MockForReconstructingPlanetMockRecorder struct {
+	mock *MockForReconstructingPlanet
+}
+
+// NewMockForReconstructingPlanet creates a new mock instance.
+func NewMockForReconstructingPlanet(ctrl *gomock.Controller) *MockForReconstructingPlanet {
+	mock := &MockForReconstructingPlanet{ctrl: ctrl}
+	mock.recorder = &MockForReconstructingPlanetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForReconstructingPlanet) EXPECT() *MockForReconstructingPlanetMockRecorder {
+	return m.recorder
+}
+
+// Reconstruct mocks base method.
+func (m *MockForReconstructingPlanet) Reconstruct(ctx context.Context, planet uuid.UUID, moment time.Time) (models.PlanetReconstruction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconstruct", ctx, planet, moment)
+	ret0, _ := ret[0].(models.PlanetReconstruction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconstruct indicates an expected call of Reconstruct.
+func (mr *MockForReconstructingPlanetMockRecorder) Reconstruct(ctx, planet, moment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconstruct", reflect.TypeOf((*MockForReconstructingPlanet)(nil).Reconstruct), ctx, planet, moment)
+}
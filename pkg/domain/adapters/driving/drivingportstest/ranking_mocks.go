@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_ranking_players.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_ranking_players.go -destination=drivingportstest/ranking_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForRankingPlayers is a mock of ForRankingPlayers interface.
+type MockForRankingPlayers struct {
+	ctrl     *gomock.Controller
+	recorder *MockForRankingPlayersMockRecorder
+	isgomock struct{}
+}
+
+// MockForRankingPlayersMockRecorder is the mock recorder for MockForRankingPlayers.
+type MockForRankingPlayersMockRecorder struct {
+	mock *MockForRankingPlayers
+}
+
+// NewMockForRankingPlayers creates a new mock instance.
+func NewMockForRankingPlayers(ctrl *gomock.Controller) *MockForRankingPlayers {
+	mock := &MockForRankingPlayers{ctrl: ctrl}
+	mock.recorder = &MockForRankingPlayersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForRankingPlayers) EXPECT() *MockForRankingPlayersMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockForRankingPlayers) List(ctx context.Context, universe uuid.UUID, page request.PageRequest) ([]models.Ranking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, universe, page)
+	ret0, _ := ret[0].([]models.Ranking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockForRankingPlayersMockRecorder) List(ctx, universe, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockForRankingPlayers)(nil).List), ctx, universe, page)
+}
+
+// Refresh mocks base method.
+func (m *MockForRankingPlayers) Refresh(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockForRankingPlayersMockRecorder) Refresh(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockForRankingPlayers)(nil).Refresh), ctx)
+}
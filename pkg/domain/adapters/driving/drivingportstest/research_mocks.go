@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_managing_research.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_managing_research.go -destination=drivingportstest/research_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingResearch is a mock of ForManagingResearch interface.
+type MockForManagingResearch struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingResearchMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingResearchMockRecorder is the mock recorder for MockForManagingResearch.
+type MockForManagingResearchMockRecorder struct {
+	mock *MockForManagingResearch
+}
+
+// NewMockForManagingResearch creates a new mock instance.
+func NewMockForManagingResearch(ctrl *gomock.Controller) *MockForManagingResearch {
+	mock := &MockForManagingResearch{ctrl: ctrl}
+	mock.recorder = &MockForManagingResearchMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingResearch) EXPECT() *MockForManagingResearchMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockForManagingResearch) Create(ctx context.Context, req request.ResearchActionCreationRequest) (models.ResearchAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(models.ResearchAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockForManagingResearchMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockForManagingResearch)(nil).Create), ctx, req)
+}
+
+// Get mocks base method.
+func (m *MockForManagingResearch) Get(ctx context.Context, player uuid.UUID) (models.Research, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, player)
+	ret0, _ := ret[0].(models.Research)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingResearchMockRecorder) Get(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingResearch)(nil).Get), ctx, player)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_sending_fleet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_sending_fleet.go -destination=drivingportstest/send_fleet_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForSendingFleet is a mock of ForSendingFleet interface.
+type MockForSendingFleet struct {
+	ctrl     *gomock.Controller
+	recorder *MockForSendingFleetMockRecorder
+	isgomock struct{}
+}
+
+// MockForSendingFleetMockRecorder is the mock recorder for MockForSendingFleet.
+type MockForSendingFleetMockRecorder struct {
+	mock *MockForSendingFleet
+}
+
+// NewMockForSendingFleet creates a new mock instance.
+func NewMockForSendingFleet(ctrl *gomock.Controller) *MockForSendingFleet {
+	mock := &MockForSendingFleet{ctrl: ctrl}
+	mock.recorder = &MockForSendingFleetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForSendingFleet) EXPECT() *MockForSendingFleetMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockForSendingFleet) Send(ctx context.Context, req request.FleetCreationRequest) (models.Fleet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, req)
+	ret0, _ := ret[0].(models.Fleet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockForSendingFleetMockRecorder) Send(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockForSendingFleet)(nil).Send), ctx, req)
+}
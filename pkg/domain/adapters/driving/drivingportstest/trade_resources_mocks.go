@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../app/ports/driving/for_trading_resources.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../app/ports/driving/for_trading_resources.go -destination=drivingportstest/trade_resources_mocks.go -package=drivingportstest
+//
+
+// Package drivingportstest is a generated GoMock package.
+package drivingportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	request "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForTradingResources is a mock of ForTradingResources interface.
+type MockForTradingResources struct {
+	ctrl     *gomock.Controller
+	recorder *MockForTradingResourcesMockRecorder
+	isgomock struct{}
+}
+
+// MockForTradingResourcesMockRecorder is the mock recorder for MockForTradingResources.
+type MockForTradingResourcesMockRecorder struct {
+	mock *MockForTradingResources
+}
+
+// NewMockForTradingResources creates a new mock instance.
+func NewMockForTradingResources(ctrl *gomock.Controller) *MockForTradingResources {
+	mock := &MockForTradingResources{ctrl: ctrl}
+	mock.recorder = &MockForTradingResourcesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForTradingResources) EXPECT() *MockForTradingResourcesMockRecorder {
+	return m.recorder
+}
+
+// Trade mocks base method.
+func (m *MockForTradingResources) Trade(ctx context.Context, req request.TradeRequest) (models.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trade", ctx, req)
+	ret0, _ := ret[0].(models.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trade indicates an expected call of Trade.
+func (mr *MockForTradingResourcesMockRecorder) Trade(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trade", reflect.TypeOf((*MockForTradingResources)(nil).Trade), ctx, req)
+}
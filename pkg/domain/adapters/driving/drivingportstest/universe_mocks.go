@@ -87,6 +87,21 @@ func (mr *MockForManagingUniverseMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingUniverse)(nil).Get), ctx, id)
 }
 
+// GetSolarSystem mocks base method.
+func (m *MockForManagingUniverse) GetSolarSystem(ctx context.Context, universe uuid.UUID, galaxy, solarSystem int) (models.SolarSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSolarSystem", ctx, universe, galaxy, solarSystem)
+	ret0, _ := ret[0].(models.SolarSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSolarSystem indicates an expected call of GetSolarSystem.
+func (mr *MockForManagingUniverseMockRecorder) GetSolarSystem(ctx, universe, galaxy, solarSystem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolarSystem", reflect.TypeOf((*MockForManagingUniverse)(nil).GetSolarSystem), ctx, universe, galaxy, solarSystem)
+}
+
 // List mocks base method.
 func (m *MockForManagingUniverse) List(ctx context.Context) ([]models.Universe, error) {
 	m.ctrl.T.Helper()
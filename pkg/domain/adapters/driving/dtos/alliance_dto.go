@@ -0,0 +1,63 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AllianceDtoRequest struct {
+	Founder uuid.UUID `json:"founder" format:"uuid" binding:"required"`
+	Name    string    `json:"name" example:"galactic empire" binding:"required"`
+	Tag     string    `json:"tag" example:"EMP" binding:"required"`
+}
+
+type AllianceDtoResponse struct {
+	Id       uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Universe uuid.UUID `json:"universe" format:"uuid" binding:"required"`
+	Name     string    `json:"name" example:"galactic empire" binding:"required"`
+	Tag      string    `json:"tag" example:"EMP" binding:"required"`
+
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+
+	Members []AllianceMemberDtoResponse `json:"members" binding:"required"`
+}
+
+type AllianceMemberDtoResponse struct {
+	Player      uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	Name        string    `json:"name" example:"emperor palpatine" binding:"required"`
+	Rank        string    `json:"rank" enums:"founder,officer,member" binding:"required"`
+	Permissions []string  `json:"permissions" enums:"manage_applications,manage_ranks,disband" binding:"required"`
+	JoinedAt    time.Time `json:"joined_at" format:"date-time" binding:"required"`
+}
+
+type AllianceApplicationDtoRequest struct {
+	Player uuid.UUID `json:"player" format:"uuid" binding:"required"`
+}
+
+type AllianceApplicationDtoResponse struct {
+	Id        uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Alliance  uuid.UUID `json:"alliance" format:"uuid" binding:"required"`
+	Player    uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+}
+
+// AllianceApplicationDecisionDtoRequest is sent by the player deciding on
+// the application.
+type AllianceApplicationDecisionDtoRequest struct {
+	Player uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	Status string    `json:"status" enums:"accepted,rejected" binding:"required"`
+}
+
+// AllianceRankDtoRequest is sent by the player changing the rank.
+type AllianceRankDtoRequest struct {
+	Player uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	Rank   string    `json:"rank" enums:"officer,member" binding:"required"`
+}
+
+type PlayerAllianceDtoResponse struct {
+	Id   uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Name string    `json:"name" example:"galactic empire" binding:"required"`
+	Tag  string    `json:"tag" example:"EMP" binding:"required"`
+	Rank string    `json:"rank" enums:"founder,officer,member" binding:"required"`
+}
@@ -8,10 +8,16 @@ import (
 
 type BuildingActionDtoRequest struct {
 	Building uuid.UUID `json:"building" format:"uuid" binding:"required"`
+	Kind     string    `json:"kind,omitempty" enums:"upgrade,demolish" default:"upgrade"`
+}
+
+type BuildingQueueDtoRequest struct {
+	Actions []uuid.UUID `json:"actions" binding:"required"`
 }
 
 type BuildingActionDtoResponse struct {
 	Id           uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Kind         string    `json:"kind" enums:"upgrade,demolish" binding:"required"`
 	Building     uuid.UUID `json:"building" format:"uuid" binding:"required"`
 	DesiredLevel int       `json:"desired_level" binding:"required"`
 
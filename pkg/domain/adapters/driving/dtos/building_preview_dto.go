@@ -0,0 +1,19 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+)
+
+type BuildingLevelPreviewDtoResponse struct {
+	Building uuid.UUID `json:"building" format:"uuid" binding:"required"`
+	Level    int       `json:"level" binding:"required"`
+
+	Costs []BuildingActionCostDtoResponse `json:"costs" binding:"required"`
+	// Duration is expressed in seconds.
+	Duration float64 `json:"duration" binding:"required"`
+
+	Storages    []BuildingActionStorageDtoResponse    `json:"storages" binding:"required"`
+	Productions []BuildingActionProductionDtoResponse `json:"productions" binding:"required"`
+
+	Affordable bool `json:"affordable" binding:"required"`
+}
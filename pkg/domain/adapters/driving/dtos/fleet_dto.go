@@ -0,0 +1,49 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FleetDtoRequest struct {
+	Mission string               `json:"mission" enums:"transport,deploy" binding:"required"`
+	Target  CoordinateDtoRequest `json:"target" binding:"required"`
+	Speed   int                  `json:"speed" minimum:"10" maximum:"100" multipleOf:"10"`
+	Ships   []FleetShipDto       `json:"ships" binding:"required"`
+	Cargo   []FleetCargoDto      `json:"cargo"`
+}
+
+type CoordinateDtoRequest struct {
+	Galaxy      int `json:"galaxy" binding:"required"`
+	SolarSystem int `json:"solar_system" binding:"required"`
+	Position    int `json:"position" binding:"required"`
+}
+
+type FleetShipDto struct {
+	Ship  uuid.UUID `json:"ship" format:"uuid" binding:"required"`
+	Count int       `json:"count" binding:"required" minimum:"1"`
+}
+
+type FleetCargoDto struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Amount   int       `json:"amount" binding:"required" minimum:"1"`
+}
+
+type FleetDtoResponse struct {
+	Id      uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Player  uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	Mission string    `json:"mission" enums:"transport,deploy" binding:"required"`
+
+	Origin           uuid.UUID             `json:"origin" format:"uuid" binding:"required"`
+	OriginCoordinate CoordinateDtoResponse `json:"origin_coordinate" binding:"required"`
+	Target           uuid.UUID             `json:"target" format:"uuid" binding:"required"`
+	TargetCoordinate CoordinateDtoResponse `json:"target_coordinate" binding:"required"`
+
+	Ships []FleetShipDto  `json:"ships" binding:"required"`
+	Cargo []FleetCargoDto `json:"cargo" binding:"required"`
+
+	DepartedAt time.Time  `json:"departed_at" format:"date-time" binding:"required"`
+	ArrivesAt  time.Time  `json:"arrives_at" format:"date-time" binding:"required"`
+	ReturnsAt  *time.Time `json:"returns_at,omitempty" format:"date-time"`
+}
@@ -0,0 +1,39 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type ResourceDtoRequest struct {
+	Name            string `json:"name" example:"metal" binding:"required"`
+	StartAmount     int    `json:"start_amount" minimum:"0"`
+	StartProduction int    `json:"start_production" minimum:"0"`
+	StartStorage    int    `json:"start_storage" minimum:"0"`
+
+	BuildTimeHoursPerUnit float64 `json:"build_time_hours_per_unit" minimum:"0"`
+}
+
+type BuildingDtoRequest struct {
+	Name string `json:"name" example:"metal mine" binding:"required"`
+
+	Costs       []BuildingCostDtoRequest               `json:"costs"`
+	Productions []BuildingResourceProductionDtoRequest `json:"productions"`
+	Storages    []BuildingResourceStorageDtoRequest    `json:"storages"`
+}
+
+type BuildingCostDtoRequest struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Cost     int       `json:"cost" binding:"required" minimum:"1"`
+	Progress float64   `json:"progress" binding:"required"`
+}
+
+type BuildingResourceProductionDtoRequest struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Base     int       `json:"base" binding:"required" minimum:"0"`
+	Progress float64   `json:"progress" binding:"required"`
+}
+
+type BuildingResourceStorageDtoRequest struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Base     int       `json:"base" binding:"required" minimum:"1"`
+	Scale    float64   `json:"scale" binding:"required"`
+	Progress float64   `json:"progress" binding:"required"`
+}
@@ -0,0 +1,36 @@
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// GatewayFrameDto is the envelope of the messages exchanged over the
+// WebSocket gateway. The id is chosen by the client and is copied in the
+// frames answering its request.
+type GatewayFrameDto struct {
+	Id      string          `json:"id,omitempty"`
+	Type    string          `json:"type" binding:"required"`
+	Payload json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+}
+
+type GatewaySubscriptionDto struct {
+	Scope string    `json:"scope" enums:"player,planet,universe" binding:"required"`
+	Id    uuid.UUID `json:"id" format:"uuid" binding:"required"`
+}
+
+type GatewayBuildingActionDtoRequest struct {
+	Planet uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	BuildingActionDtoRequest
+}
+
+type GatewayBuildingActionCancellationDto struct {
+	Planet uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	Action uuid.UUID `json:"action" format:"uuid" binding:"required"`
+}
+
+type GatewayErrorDtoResponse struct {
+	Status  int    `json:"status" binding:"required"`
+	Message string `json:"message" binding:"required"`
+}
@@ -0,0 +1,41 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MarketOfferDtoRequest struct {
+	Offered   TradedResourceDtoRequest `json:"offered" binding:"required"`
+	Requested TradedResourceDtoRequest `json:"requested" binding:"required"`
+	// Duration is expressed in hours.
+	Duration int `json:"duration" binding:"required" minimum:"1" maximum:"72"`
+}
+
+type TradedResourceDtoRequest struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Amount   int       `json:"amount" binding:"required" minimum:"1"`
+}
+
+type MarketOfferAcceptanceDtoRequest struct {
+	Planet uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+}
+
+type MarketOfferDtoResponse struct {
+	Id       uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Universe uuid.UUID `json:"universe" format:"uuid" binding:"required"`
+	Player   uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	Planet   uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+
+	Offered   TradedResourceDtoResponse `json:"offered" binding:"required"`
+	Requested TradedResourceDtoResponse `json:"requested" binding:"required"`
+
+	Status string `json:"status" enums:"open,accepted,settled,cancelled,expired" binding:"required"`
+	// Buyer is only set once the offer has been accepted.
+	Buyer      *uuid.UUID `json:"buyer,omitempty" format:"uuid"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty" format:"date-time"`
+
+	ExpiresAt time.Time `json:"expires_at" format:"date-time" binding:"required"`
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+}
@@ -0,0 +1,27 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageDtoRequest struct {
+	Recipient uuid.UUID `json:"recipient" format:"uuid" binding:"required"`
+	Subject   string    `json:"subject" example:"trade proposal" binding:"required"`
+	Content   string    `json:"content" example:"would you exchange metal for crystal?" binding:"required"`
+}
+
+type MessageDtoResponse struct {
+	Id     uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Kind   string    `json:"kind" enums:"player,system" binding:"required"`
+	Player uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	// Sender is not set for system messages.
+	Sender *uuid.UUID `json:"sender,omitempty" format:"uuid"`
+
+	Subject string `json:"subject" example:"trade proposal" binding:"required"`
+	Content string `json:"content" example:"would you exchange metal for crystal?" binding:"required"`
+	Read    bool   `json:"read" binding:"required"`
+
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+}
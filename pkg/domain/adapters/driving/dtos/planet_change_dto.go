@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/google/uuid"
+
+type PlanetChangeDtoResponse struct {
+	Planet  uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	Version int       `json:"version" binding:"required"`
+	Kind    string    `json:"kind" enums:"creation,update,action_created,action_cancelled,action_applied,deletion" binding:"required"`
+	Deleted bool      `json:"deleted" binding:"required"`
+}
@@ -20,9 +20,16 @@ type PlanetDtoResponse struct {
 	Resources   []PlanetResourceDtoResponse           `json:"resources" binding:"required"`
 	Storages    []PlanetResourceStorageDtoResponse    `json:"storages" binding:"required"`
 	Productions []PlanetResourceProductionDtoResponse `json:"productions" binding:"required"`
+	Energy      PlanetEnergyDtoResponse               `json:"energy" binding:"required"`
 	Buildings   []PlanetBuildingDtoResponse           `json:"buildings" binding:"required"`
+	Ships       []PlanetShipDtoResponse               `json:"ships" binding:"required"`
+	Defenses    []PlanetDefenseDtoResponse            `json:"defenses" binding:"required"`
 
-	BuildingAction *BuildingActionDtoResponse `json:"building_action,omitempty"`
+	BuildingQueue  []BuildingActionDtoResponse `json:"building_queue" binding:"required"`
+	ShipyardQueue  []ShipyardActionDtoResponse `json:"shipyard_queue" binding:"required"`
+	ResearchAction *ResearchActionDtoResponse  `json:"research_action,omitempty"`
+
+	Fleets []FleetDtoResponse `json:"fleets" binding:"required"`
 }
 
 type CoordinateDtoResponse struct {
@@ -47,7 +54,22 @@ type PlanetResourceProductionDtoResponse struct {
 	Production int        `json:"production" binding:"required"`
 }
 
+type PlanetEnergyDtoResponse struct {
+	Produced int `json:"produced" binding:"required" minimum:"0"`
+	Consumed int `json:"consumed" binding:"required" minimum:"0"`
+}
+
 type PlanetBuildingDtoResponse struct {
 	Building uuid.UUID `json:"building" format:"uuid" binding:"required"`
 	Level    int       `json:"level" binding:"required"`
 }
+
+type PlanetShipDtoResponse struct {
+	Ship  uuid.UUID `json:"ship" format:"uuid" binding:"required"`
+	Count int       `json:"count" binding:"required"`
+}
+
+type PlanetDefenseDtoResponse struct {
+	Defense uuid.UUID `json:"defense" format:"uuid" binding:"required"`
+	Count   int       `json:"count" binding:"required"`
+}
@@ -0,0 +1,29 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlanetEventDtoResponse struct {
+	Id     uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Planet uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	Kind   string    `json:"kind" enums:"update,action_created,action_cancelled,action_applied,deletion" binding:"required"`
+	// Action is the action created, cancelled or applied by the event.
+	Action        *uuid.UUID `json:"action,omitempty" format:"uuid"`
+	VersionBefore int        `json:"version_before" binding:"required"`
+	VersionAfter  int        `json:"version_after" binding:"required"`
+
+	Resources []PlanetEventResourceDtoResponse `json:"resources" binding:"required"`
+
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+}
+
+type PlanetEventResourceDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid"`
+	// Delta is negative when the resource was spent.
+	Delta float64 `json:"delta"`
+	// Produced is the part of the delta produced by the planet.
+	Produced float64 `json:"produced"`
+}
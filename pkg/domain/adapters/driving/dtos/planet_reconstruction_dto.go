@@ -0,0 +1,30 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlanetReconstructionDtoResponse struct {
+	Planet  uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	At      time.Time `json:"at" format:"date-time" binding:"required"`
+	Version int       `json:"version" binding:"required"`
+	Events  int       `json:"events" binding:"required"`
+
+	Resources   []PlanetResourceDtoResponse   `json:"resources" binding:"required"`
+	Divergences []PlanetDivergenceDtoResponse `json:"divergences" binding:"required"`
+}
+
+type PlanetDivergenceDtoResponse struct {
+	Kind string `json:"kind" enums:"version_gap,version,resource,building,action" binding:"required"`
+	// Resource is only set for resource divergences.
+	Resource *uuid.UUID `json:"resource,omitempty" format:"uuid"`
+	// Building is only set for building divergences.
+	Building *uuid.UUID `json:"building,omitempty" format:"uuid"`
+	// Action is only set for action divergences.
+	Action  *uuid.UUID `json:"action,omitempty" format:"uuid"`
+	At      time.Time  `json:"at" format:"date-time" binding:"required"`
+	Rebuilt float64    `json:"rebuilt" binding:"required"`
+	Stored  float64    `json:"stored" binding:"required"`
+}
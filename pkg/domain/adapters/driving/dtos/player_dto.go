@@ -22,4 +22,10 @@ type PlayerDtoResponse struct {
 
 	Homeworld uuid.UUID   `json:"homeworld" format:"uuid" binding:"required"`
 	Planets   []uuid.UUID `json:"planets" format:"uuid" binding:"required"`
+
+	// Rank is 0 until the rankings of the universe are first computed.
+	Rank   int `json:"rank" binding:"required" minimum:"0"`
+	Points int `json:"points" binding:"required" minimum:"0"`
+
+	Alliance *PlayerAllianceDtoResponse `json:"alliance,omitempty"`
 }
@@ -0,0 +1,12 @@
+package dtos
+
+import (
+	"github.com/google/uuid"
+)
+
+type RankingDtoResponse struct {
+	Player uuid.UUID `json:"player" format:"uuid" binding:"required"`
+	Name   string    `json:"name" example:"emperor palpatine" binding:"required"`
+	Points int       `json:"points" binding:"required" minimum:"0"`
+	Rank   int       `json:"rank" binding:"required" minimum:"1"`
+}
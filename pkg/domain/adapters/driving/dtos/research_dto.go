@@ -0,0 +1,41 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ResearchDtoResponse struct {
+	Player       uuid.UUID                     `json:"player" format:"uuid" binding:"required"`
+	Technologies []PlayerTechnologyDtoResponse `json:"technologies" binding:"required"`
+
+	Action *ResearchActionDtoResponse `json:"action,omitempty"`
+}
+
+type PlayerTechnologyDtoResponse struct {
+	Technology uuid.UUID `json:"technology" format:"uuid" binding:"required"`
+	Level      int       `json:"level" binding:"required" minimum:"1"`
+}
+
+type ResearchActionDtoRequest struct {
+	Planet     uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	Technology uuid.UUID `json:"technology" format:"uuid" binding:"required"`
+}
+
+type ResearchActionDtoResponse struct {
+	Id           uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Planet       uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+	Technology   uuid.UUID `json:"technology" format:"uuid" binding:"required"`
+	DesiredLevel int       `json:"desired_level" binding:"required"`
+
+	CreatedAt   time.Time `json:"created_at" format:"date-time" binding:"required"`
+	CompletedAt time.Time `json:"completed_at" format:"date-time" binding:"required"`
+
+	Costs []ResearchActionCostDtoResponse `json:"costs" binding:"required"`
+}
+
+type ResearchActionCostDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid"`
+	Amount   int       `json:"amount"`
+}
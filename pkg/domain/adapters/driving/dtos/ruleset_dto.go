@@ -0,0 +1,30 @@
+package dtos
+
+import "github.com/google/uuid"
+
+// RulesetDto is the content of the game data file loaded at startup. It
+// can be written either in YAML or in JSON.
+type RulesetDto struct {
+	Resources   []RulesetResourceDto  `json:"resources"`
+	Buildings   []RulesetBuildingDto  `json:"buildings"`
+	FieldRanges []PlanetFieldRangeDto `json:"field_ranges"`
+	// MaxPlanetsPerPlayer includes the homeworld. The current limit is kept
+	// when it is not set.
+	MaxPlanetsPerPlayer int `json:"max_planets_per_player"`
+}
+
+type RulesetResourceDto struct {
+	Id uuid.UUID `json:"id"`
+	ResourceDtoRequest
+}
+
+type RulesetBuildingDto struct {
+	Id uuid.UUID `json:"id"`
+	BuildingDtoRequest
+}
+
+type PlanetFieldRangeDto struct {
+	Position int `json:"position"`
+	Min      int `json:"min"`
+	Max      int `json:"max"`
+}
@@ -0,0 +1,31 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShipyardActionDtoRequest struct {
+	Kind  string    `json:"kind" enums:"ship,defense" binding:"required"`
+	Unit  uuid.UUID `json:"unit" format:"uuid" binding:"required"`
+	Count int       `json:"count" binding:"required" minimum:"1" maximum:"10000"`
+}
+
+type ShipyardActionDtoResponse struct {
+	Id    uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Kind  string    `json:"kind" enums:"ship,defense" binding:"required"`
+	Unit  uuid.UUID `json:"unit" format:"uuid" binding:"required"`
+	Count int       `json:"count" binding:"required" minimum:"1"`
+
+	CreatedAt           time.Time `json:"created_at" format:"date-time" binding:"required"`
+	NextUnitCompletedAt time.Time `json:"next_unit_completed_at" format:"date-time" binding:"required"`
+	CompletedAt         time.Time `json:"completed_at" format:"date-time" binding:"required"`
+
+	Costs []ShipyardActionCostDtoResponse `json:"costs" binding:"required"`
+}
+
+type ShipyardActionCostDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid"`
+	Amount   int       `json:"amount"`
+}
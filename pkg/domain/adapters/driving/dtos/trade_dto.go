@@ -0,0 +1,27 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TradeDtoRequest struct {
+	Sold   uuid.UUID `json:"sold" format:"uuid" binding:"required"`
+	Bought uuid.UUID `json:"bought" format:"uuid" binding:"required"`
+	Amount int       `json:"amount" binding:"required" minimum:"1"`
+}
+
+type TradeDtoResponse struct {
+	Planet uuid.UUID `json:"planet" format:"uuid" binding:"required"`
+
+	Sold   TradedResourceDtoResponse `json:"sold" binding:"required"`
+	Bought TradedResourceDtoResponse `json:"bought" binding:"required"`
+
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+}
+
+type TradedResourceDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Amount   int       `json:"amount" binding:"required" minimum:"1"`
+}
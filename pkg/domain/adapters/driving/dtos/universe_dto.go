@@ -27,6 +27,12 @@ type UniverseDtoResponse struct {
 
 	Resources []ResourceDtoResponse `json:"resources" binding:"required"`
 	Buildings []BuildingDtoResponse `json:"buildings" binding:"required"`
+	Ships     []ShipDtoResponse     `json:"ships" binding:"required"`
+	Defenses  []DefenseDtoResponse  `json:"defenses" binding:"required"`
+
+	Technologies []TechnologyDtoResponse `json:"technologies" binding:"required"`
+
+	ExchangeRates []ExchangeRateDtoResponse `json:"exchange_rates" binding:"required"`
 }
 
 type TopologyDtoResponse struct {
@@ -53,9 +59,12 @@ type BuildingDtoResponse struct {
 	Name      string    `json:"name" example:"metal mine" binding:"required"`
 	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
 
-	Costs       []BuildingCostDtoResponse               `json:"costs" binding:"required"`
-	Productions []BuildingResourceProductionDtoResponse `json:"productions" binding:"required"`
-	Storages    []BuildingResourceStorageDtoResponse    `json:"storages" binding:"required"`
+	Costs        []BuildingCostDtoResponse                `json:"costs" binding:"required"`
+	Productions  []BuildingResourceProductionDtoResponse  `json:"productions" binding:"required"`
+	Consumptions []BuildingResourceConsumptionDtoResponse `json:"consumptions" binding:"required"`
+	Storages     []BuildingResourceStorageDtoResponse     `json:"storages" binding:"required"`
+
+	Requirements []BuildingRequirementDtoResponse `json:"requirements" binding:"required"`
 }
 
 type BuildingCostDtoResponse struct {
@@ -70,9 +79,85 @@ type BuildingResourceProductionDtoResponse struct {
 	Progress float64   `json:"progress" binding:"required"`
 }
 
+type BuildingResourceConsumptionDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Base     int       `json:"base" binding:"required"`
+	Progress float64   `json:"progress" binding:"required"`
+}
+
 type BuildingResourceStorageDtoResponse struct {
 	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
 	Base     int       `json:"base" binding:"required"`
 	Scale    float64   `json:"scale" binding:"required"`
 	Progress float64   `json:"progress" binding:"required"`
 }
+
+type BuildingRequirementDtoResponse struct {
+	Building uuid.UUID `json:"building" format:"uuid" binding:"required"`
+	Level    int       `json:"level" binding:"required" minimum:"1"`
+}
+
+type ShipDtoResponse struct {
+	Id        uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Name      string    `json:"name" example:"small cargo" binding:"required"`
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+
+	Speed         int `json:"speed" binding:"required"`
+	CargoCapacity int `json:"cargo_capacity" binding:"required"`
+
+	Costs []UnitCostDtoResponse `json:"costs" binding:"required"`
+}
+
+type DefenseDtoResponse struct {
+	Id        uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Name      string    `json:"name" example:"rocket launcher" binding:"required"`
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+
+	Costs []UnitCostDtoResponse `json:"costs" binding:"required"`
+}
+
+type UnitCostDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Cost     int       `json:"cost" binding:"required"`
+}
+
+type TechnologyDtoResponse struct {
+	Id        uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Name      string    `json:"name" example:"energy technology" binding:"required"`
+	CreatedAt time.Time `json:"created_at" format:"date-time" binding:"required"`
+
+	Costs []TechnologyCostDtoResponse `json:"costs" binding:"required"`
+}
+
+type TechnologyCostDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Cost     int       `json:"cost" binding:"required"`
+	Progress float64   `json:"progress" binding:"required"`
+}
+
+type ExchangeRateDtoResponse struct {
+	Resource uuid.UUID `json:"resource" format:"uuid" binding:"required"`
+	Rate     int       `json:"rate" binding:"required" minimum:"1"`
+}
+
+type SolarSystemDtoResponse struct {
+	Universe    uuid.UUID `json:"universe" format:"uuid" binding:"required"`
+	Galaxy      int       `json:"galaxy" binding:"required" minimum:"0"`
+	SolarSystem int       `json:"solar_system" binding:"required" minimum:"0"`
+
+	Slots []SolarSystemSlotDtoResponse `json:"slots" binding:"required"`
+}
+
+type SolarSystemSlotDtoResponse struct {
+	Position int                           `json:"position" binding:"required" minimum:"0"`
+	Planet   *SolarSystemPlanetDtoResponse `json:"planet,omitempty"`
+}
+
+type SolarSystemPlanetDtoResponse struct {
+	Id     uuid.UUID `json:"id" format:"uuid" binding:"required"`
+	Name   string    `json:"name" example:"my-planet" binding:"required"`
+	Player string    `json:"player" example:"my-player" binding:"required"`
+	// Alliance is the tag of the alliance of the player, if any.
+	Alliance  string `json:"alliance,omitempty" example:"EMP"`
+	Homeworld bool   `json:"homeworld" binding:"required"`
+}
@@ -0,0 +1,188 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func FleetEndpoints(
+	sendUsecase drivingports.ForSendingFleet,
+	usecase drivingports.ForManagingFleet,
+) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(sendFleet, sendUsecase)
+	post := rest.NewRoute(http.MethodPost, "/planets/:id/fleets", handler)
+	out = append(out, post)
+
+	handler = generateHandler(listFleetsForPlayer, usecase)
+	list := rest.NewRoute(http.MethodGet, "/players/:id/fleets", handler)
+	out = append(out, list)
+
+	handler = generateHandler(recallFleet, usecase)
+	recall := rest.NewRoute(http.MethodPost, "/fleets/:id/recall", handler)
+	out = append(out, recall)
+
+	return out
+}
+
+// sendFleet godoc
+//
+//	@Summary		Send fleet
+//	@Description	Sends ships and cargo from the planet provided in path parameter to another planet of the same player located at the target coordinate. A transport fleet unloads what fits in the storage of the target and brings the rest back. The flight duration depends on the distance and on the slowest ship of the fleet. The number of fleets in flight is limited by the computer technology of the player.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id		path		string					true	"Planet id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.FleetDtoRequest	true	"Fleet payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.FleetDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/fleets [post]
+func sendFleet(c *echo.Context, usecase drivingports.ForSendingFleet) error {
+	maybeId := c.Param("id")
+	planetId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.FleetDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid fleet syntax")
+	}
+
+	request := mappers.ToFleetCreationRequest(planetId, inputDto)
+	fleet, err := usecase.Send(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrFleetTargetNotFound {
+			return c.JSON(http.StatusNotFound, "no planet at target coordinate")
+		}
+
+		if err == domainerrors.ErrUnitNotFound {
+			return c.JSON(http.StatusBadRequest, "no such ship")
+		}
+
+		if err == domainerrors.ErrInvalidFleet {
+			return c.JSON(http.StatusBadRequest, "invalid fleet")
+		}
+
+		if err == domainerrors.ErrInvalidFleetTarget {
+			return c.JSON(http.StatusBadRequest, "invalid fleet target")
+		}
+
+		if err == domainerrors.ErrNotEnoughShips {
+			return c.JSON(http.StatusBadRequest, "not enough ships")
+		}
+
+		if err == domainerrors.ErrNotEnoughResources {
+			return c.JSON(http.StatusBadRequest, "not enough resources")
+		}
+
+		if err == domainerrors.ErrCargoCapacityExceeded {
+			return c.JSON(http.StatusBadRequest, "cargo capacity exceeded")
+		}
+
+		if err == domainerrors.ErrFleetLimitReached {
+			return c.JSON(http.StatusConflict, "fleet limit reached")
+		}
+
+		c.Logger().Error("Failed to send fleet", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to send fleet")
+	}
+
+	out := mappers.ToFleetResponse(fleet)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// listFleetsForPlayer godoc
+//
+//	@Summary		List fleets
+//	@Description	Returns the fleets sent by a player which did not come back yet.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id	path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Success		200	{object}	rest.ResponseEnvelope[[]dtos.FleetDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/fleets [get]
+func listFleetsForPlayer(c *echo.Context, usecase drivingports.ForManagingFleet) error {
+	maybeId := c.Param("id")
+	playerId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	fleets, err := usecase.ListForPlayer(c.Request().Context(), playerId)
+	if err != nil {
+		c.Logger().Error("Failed to list fleets", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list fleets")
+	}
+
+	out := mappers.ToFleetsResponse(fleets)
+	return c.JSON(http.StatusOK, out)
+}
+
+// recallFleet godoc
+//
+//	@Summary		Recall fleet
+//	@Description	Makes a fleet fly back to its origin before it reaches its target. The return flight takes as long as the fleet already spent flying.
+//	@Tags			fleets
+//	@Produce		json
+//	@Param			id	path		string	true	"Fleet id (UUID)"	Format(uuid)
+//	@Success		200	{object}	rest.ResponseEnvelope[dtos.FleetDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		409	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/fleets/{id}/recall [post]
+func recallFleet(c *echo.Context, usecase drivingports.ForManagingFleet) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	fleet, err := usecase.Recall(c.Request().Context(), id)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such fleet")
+		}
+
+		if err == domainerrors.ErrFleetAlreadyReturning {
+			return c.JSON(http.StatusConflict, "fleet already returning")
+		}
+
+		if err == domainerrors.ErrFleetAlreadyArrived {
+			return c.JSON(http.StatusConflict, "fleet already arrived")
+		}
+
+		c.Logger().Error("Failed to recall fleet", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to recall fleet")
+	}
+
+	out := mappers.ToFleetResponse(fleet)
+	return c.JSON(http.StatusOK, out)
+}
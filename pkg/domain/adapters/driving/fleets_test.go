@@ -0,0 +1,454 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Fleets_SendFleet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForSendingFleet(ctrl)
+
+	t.Run("returns 400 when planet id is invalid", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid fleet syntax", actual)
+	})
+
+	t.Run("forwards fleet to use case", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.FleetCreationRequest{
+			Planet:  sampleUuid,
+			Mission: models.TransportMission,
+			Target: models.Coordinate{
+				Galaxy:      1,
+				SolarSystem: 23,
+				Position:    4,
+			},
+			Speed: 50,
+			Ships: []request.FleetShipRequest{
+				{Ship: dto.Ships[0].Ship, Count: 3},
+			},
+			Cargo: []request.FleetCargoRequest{
+				{Resource: sampleResourceId, Amount: 1200},
+			},
+		}
+		fleet := sampleFleet()
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(fleet, nil)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.FleetDtoResponse](t, rw)
+		assert.Equal(t, sampleFleetDtoResponse(fleet), actual)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrNotFound)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 404 when target is not found", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrFleetTargetNotFound)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no planet at target coordinate", actual)
+	})
+
+	t.Run("returns 400 when fleet is invalid", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrInvalidFleet)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid fleet", actual)
+	})
+
+	t.Run("returns 400 when planet does not have enough ships", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrNotEnoughShips)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not enough ships", actual)
+	})
+
+	t.Run("returns 400 when cargo capacity is exceeded", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrCargoCapacityExceeded)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "cargo capacity exceeded", actual)
+	})
+
+	t.Run("returns 409 when fleet limit is reached", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrFleetLimitReached)
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "fleet limit reached", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		dto := sampleFleetDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Fleet{}, errors.New("stubbed error"))
+
+		err := sendFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to send fleet", actual)
+	})
+}
+
+func TestUnit_Fleets_ListFleetsForPlayer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingFleet(ctrl)
+
+	t.Run("returns 400 when player id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := listFleetsForPlayer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards listing to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		fleet := sampleFleet()
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return([]models.Fleet{fleet}, nil)
+
+		err := listFleetsForPlayer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.FleetDtoResponse](t, rw)
+		assert.Equal(t, []dtos.FleetDtoResponse{sampleFleetDtoResponse(fleet)}, actual)
+	})
+
+	t.Run("return empty slice when use case returns nil response", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(nil, nil)
+
+		err := listFleetsForPlayer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.FleetDtoResponse](t, rw)
+		assert.Equal(t, []dtos.FleetDtoResponse{}, actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listFleetsForPlayer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list fleets", actual)
+	})
+}
+
+func TestUnit_Fleets_RecallFleet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingFleet(ctrl)
+
+	t.Run("returns 400 when fleet id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := recallFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards recall to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		fleet := sampleFleet()
+		fleet.ReturnsAt = &someOtherTime
+		mockUsecase.EXPECT().
+			Recall(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(fleet, nil)
+
+		err := recallFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.FleetDtoResponse](t, rw)
+		assert.Equal(t, sampleFleetDtoResponse(fleet), actual)
+	})
+
+	t.Run("returns 404 when fleet is not found", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Recall(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrNotFound)
+
+		err := recallFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such fleet", actual)
+	})
+
+	t.Run("returns 409 when fleet is already returning", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Recall(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrFleetAlreadyReturning)
+
+		err := recallFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "fleet already returning", actual)
+	})
+
+	t.Run("returns 409 when fleet already arrived", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Recall(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrFleetAlreadyArrived)
+
+		err := recallFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "fleet already arrived", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPost)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Recall(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(models.Fleet{}, errors.New("stubbed error"))
+
+		err := recallFleet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to recall fleet", actual)
+	})
+}
+
+func sampleFleetDtoRequest() dtos.FleetDtoRequest {
+	return dtos.FleetDtoRequest{
+		Mission: "transport",
+		Target: dtos.CoordinateDtoRequest{
+			Galaxy:      1,
+			SolarSystem: 23,
+			Position:    4,
+		},
+		Speed: 50,
+		Ships: []dtos.FleetShipDto{
+			{Ship: uuid.New(), Count: 3},
+		},
+		Cargo: []dtos.FleetCargoDto{
+			{Resource: sampleResourceId, Amount: 1200},
+		},
+	}
+}
+
+func sampleFleet() models.Fleet {
+	return models.Fleet{
+		Id:               uuid.New(),
+		Player:           uuid.New(),
+		Mission:          models.TransportMission,
+		Origin:           uuid.New(),
+		OriginCoordinate: models.Coordinate{Galaxy: 1, SolarSystem: 23, Position: 7},
+		Target:           uuid.New(),
+		TargetCoordinate: models.Coordinate{Galaxy: 1, SolarSystem: 23, Position: 4},
+		Ships: []models.FleetShip{
+			{Ship: uuid.New(), Count: 3},
+		},
+		Cargo: []models.FleetCargo{
+			{Resource: sampleResourceId, Amount: 1200},
+		},
+		DepartedAt: someTime,
+		ArrivesAt:  someTime.Add(time.Hour),
+	}
+}
+
+func sampleFleetDtoResponse(fleet models.Fleet) dtos.FleetDtoResponse {
+	return dtos.FleetDtoResponse{
+		Id:      fleet.Id,
+		Player:  fleet.Player,
+		Mission: "transport",
+		Origin:  fleet.Origin,
+		OriginCoordinate: dtos.CoordinateDtoResponse{
+			Galaxy:      1,
+			SolarSystem: 23,
+			Position:    7,
+		},
+		Target: fleet.Target,
+		TargetCoordinate: dtos.CoordinateDtoResponse{
+			Galaxy:      1,
+			SolarSystem: 23,
+			Position:    4,
+		},
+		Ships: []dtos.FleetShipDto{
+			{Ship: fleet.Ships[0].Ship, Count: 3},
+		},
+		Cargo: []dtos.FleetCargoDto{
+			{Resource: sampleResourceId, Amount: 1200},
+		},
+		DepartedAt: fleet.DepartedAt,
+		ArrivesAt:  fleet.ArrivesAt,
+		ReturnsAt:  fleet.ReturnsAt,
+	}
+}
@@ -0,0 +1,277 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func GameDataEndpoints(usecase drivingports.ForManagingGameData) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(listResources, usecase)
+	getResources := rest.NewRoute(http.MethodGet, "/resources", handler)
+	out = append(out, getResources)
+
+	handler = generateHandler(createResource, usecase)
+	postResource := rest.NewRoute(http.MethodPost, "/resources", handler)
+	out = append(out, postResource)
+
+	handler = generateHandler(updateResource, usecase)
+	patchResource := rest.NewRoute(http.MethodPatch, "/resources/:id", handler)
+	out = append(out, patchResource)
+
+	handler = generateHandler(listBuildings, usecase)
+	getBuildings := rest.NewRoute(http.MethodGet, "/buildings", handler)
+	out = append(out, getBuildings)
+
+	handler = generateHandler(createBuilding, usecase)
+	postBuilding := rest.NewRoute(http.MethodPost, "/buildings", handler)
+	out = append(out, postBuilding)
+
+	handler = generateHandler(updateBuilding, usecase)
+	patchBuilding := rest.NewRoute(http.MethodPatch, "/buildings/:id", handler)
+	out = append(out, patchBuilding)
+
+	return out
+}
+
+// listResources godoc
+//
+//	@Summary		List resources
+//	@Description	Returns the resources shared by all universes. Restricted to administrators.
+//	@Tags			game data
+//	@Produce		json
+//	@Success		200	{object}	rest.ResponseEnvelope[[]dtos.ResourceDtoResponse]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/resources [get]
+func listResources(c *echo.Context, usecase drivingports.ForManagingGameData) error {
+	resources, err := usecase.ListResources(c.Request().Context())
+	if err != nil {
+		c.Logger().Error("Failed to list resources", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list resources")
+	}
+
+	out := mappers.ToResourcesResponse(resources)
+	return c.JSON(http.StatusOK, out)
+}
+
+// createResource godoc
+//
+//	@Summary		Create resource
+//	@Description	Creates a resource available in all universes. Restricted to administrators.
+//	@Tags			game data
+//	@Produce		json
+//	@Param			request	body		dtos.ResourceDtoRequest	true	"Resource payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.ResourceDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/resources [post]
+func createResource(c *echo.Context, usecase drivingports.ForManagingGameData) error {
+	var inputDto dtos.ResourceDtoRequest
+	err := c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid resource syntax")
+	}
+
+	request := mappers.ToResourceRequest(inputDto)
+	resource, err := usecase.CreateResource(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrInvalidResource {
+			return c.JSON(http.StatusBadRequest, "invalid resource")
+		}
+
+		if err == domainerrors.ErrNameAlreadyTaken {
+			return c.JSON(http.StatusConflict, "name already used")
+		}
+
+		c.Logger().Error("Failed to create resource", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to create resource")
+	}
+
+	out := mappers.ToResourceResponse(resource)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// updateResource godoc
+//
+//	@Summary		Update resource
+//	@Description	Replaces the starting values and build time of a resource. The resources some rules of the game depend on (e.g. energy) can not be renamed. Restricted to administrators.
+//	@Tags			game data
+//	@Produce		json
+//	@Param			id		path		string					true	"Resource id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.ResourceDtoRequest	true	"Resource payload"
+//	@Success		200		{object}	rest.ResponseEnvelope[dtos.ResourceDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/resources/{id} [patch]
+func updateResource(c *echo.Context, usecase drivingports.ForManagingGameData) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.ResourceDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid resource syntax")
+	}
+
+	request := mappers.ToResourceRequest(inputDto)
+	resource, err := usecase.UpdateResource(c.Request().Context(), id, request)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such resource")
+		}
+
+		if err == domainerrors.ErrInvalidResource {
+			return c.JSON(http.StatusBadRequest, "invalid resource")
+		}
+
+		if err == domainerrors.ErrNameAlreadyTaken {
+			return c.JSON(http.StatusConflict, "name already used")
+		}
+
+		if err == domainerrors.ErrGameDataRoleRenamed {
+			return c.JSON(http.StatusConflict, "resource can not be renamed")
+		}
+
+		c.Logger().Error("Failed to update resource", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to update resource")
+	}
+
+	out := mappers.ToResourceResponse(resource)
+	return c.JSON(http.StatusOK, out)
+}
+
+// listBuildings godoc
+//
+//	@Summary		List buildings
+//	@Description	Returns the buildings shared by all universes. Restricted to administrators.
+//	@Tags			game data
+//	@Produce		json
+//	@Success		200	{object}	rest.ResponseEnvelope[[]dtos.BuildingDtoResponse]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/buildings [get]
+func listBuildings(c *echo.Context, usecase drivingports.ForManagingGameData) error {
+	buildings, err := usecase.ListBuildings(c.Request().Context())
+	if err != nil {
+		c.Logger().Error("Failed to list buildings", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list buildings")
+	}
+
+	out := mappers.ToBuildingsResponse(buildings)
+	return c.JSON(http.StatusOK, out)
+}
+
+// createBuilding godoc
+//
+//	@Summary		Create building
+//	@Description	Creates a building with its costs, productions and storages. Restricted to administrators.
+//	@Tags			game data
+//	@Produce		json
+//	@Param			request	body		dtos.BuildingDtoRequest	true	"Building payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.BuildingDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/buildings [post]
+func createBuilding(c *echo.Context, usecase drivingports.ForManagingGameData) error {
+	var inputDto dtos.BuildingDtoRequest
+	err := c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid building syntax")
+	}
+
+	request := mappers.ToBuildingRequest(inputDto)
+	building, err := usecase.CreateBuilding(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrInvalidBuilding {
+			return c.JSON(http.StatusBadRequest, "invalid building")
+		}
+
+		c.Logger().Error("Failed to create building", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to create building")
+	}
+
+	out := mappers.ToBuildingResponse(building)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// updateBuilding godoc
+//
+//	@Summary		Update building
+//	@Description	Replaces the costs, productions and storages of a building. Its consumptions and requirements are kept. The buildings some rules of the game depend on (e.g. the shipyard) can not be renamed. Restricted to administrators.
+//	@Tags			game data
+//	@Produce		json
+//	@Param			id		path		string					true	"Building id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.BuildingDtoRequest	true	"Building payload"
+//	@Success		200		{object}	rest.ResponseEnvelope[dtos.BuildingDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/buildings/{id} [patch]
+func updateBuilding(c *echo.Context, usecase drivingports.ForManagingGameData) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.BuildingDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid building syntax")
+	}
+
+	request := mappers.ToBuildingRequest(inputDto)
+	building, err := usecase.UpdateBuilding(c.Request().Context(), id, request)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such building")
+		}
+
+		if err == domainerrors.ErrInvalidBuilding {
+			return c.JSON(http.StatusBadRequest, "invalid building")
+		}
+
+		if err == domainerrors.ErrGameDataRoleRenamed {
+			return c.JSON(http.StatusConflict, "building can not be renamed")
+		}
+
+		c.Logger().Error("Failed to update building", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to update building")
+	}
+
+	out := mappers.ToBuildingResponse(building)
+	return c.JSON(http.StatusOK, out)
+}
@@ -0,0 +1,464 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_GameData_ListResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("returns resources", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			ListResources(gomock.Any()).
+			Times(1).
+			Return([]models.Resource{sampleResource()}, nil)
+
+		err := listResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.ResourceDtoResponse](t, rw)
+		expected := []dtos.ResourceDtoResponse{
+			{
+				Id:                    sampleResourceId,
+				Name:                  "metal",
+				StartAmount:           500,
+				StartProduction:       30,
+				StartStorage:          10000,
+				BuildTimeHoursPerUnit: 0.0004,
+				CreatedAt:             someTime,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			ListResources(gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list resources", actual)
+	})
+}
+
+func TestUnit_GameData_CreateResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := createResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid resource syntax", actual)
+	})
+
+	t.Run("forwards resource to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		expectedRequest := request.ResourceRequest{
+			Name:                  "metal",
+			StartAmount:           500,
+			StartProduction:       30,
+			StartStorage:          10000,
+			BuildTimeHoursPerUnit: 0.0004,
+		}
+		mockUsecase.EXPECT().
+			CreateResource(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(sampleResource(), nil)
+
+		err := createResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.ResourceDtoResponse](t, rw)
+		assert.Equal(t, sampleResourceId, actual.Id)
+	})
+
+	t.Run("returns 400 when resource is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			CreateResource(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Resource{}, domainerrors.ErrInvalidResource)
+
+		err := createResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid resource", actual)
+	})
+
+	t.Run("returns 409 when name is already taken", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			CreateResource(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Resource{}, domainerrors.ErrNameAlreadyTaken)
+
+		err := createResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "name already used", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			CreateResource(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Resource{}, errors.New("stubbed error"))
+
+		err := createResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to create resource", actual)
+	})
+}
+
+func TestUnit_GameData_UpdateResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := updateResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards update to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateResource(gomock.Any(), sampleUuid, gomock.Any()).
+			Times(1).
+			Return(sampleResource(), nil)
+
+		err := updateResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.ResourceDtoResponse](t, rw)
+		assert.Equal(t, "metal", actual.Name)
+	})
+
+	t.Run("returns 404 when resource is not found", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateResource(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Resource{}, domainerrors.ErrNotFound)
+
+		err := updateResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such resource", actual)
+	})
+
+	t.Run("returns 409 when resource can not be renamed", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleResourceDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateResource(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Resource{}, domainerrors.ErrGameDataRoleRenamed)
+
+		err := updateResource(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "resource can not be renamed", actual)
+	})
+}
+
+func TestUnit_GameData_ListBuildings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("returns buildings", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			ListBuildings(gomock.Any()).
+			Times(1).
+			Return([]models.Building{sampleBuilding()}, nil)
+
+		err := listBuildings(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.BuildingDtoResponse](t, rw)
+		require.Len(t, actual, 1)
+		assert.Equal(t, sampleUuid, actual[0].Id)
+		expectedCosts := []dtos.BuildingCostDtoResponse{
+			{Resource: sampleResourceId, Cost: 60, Progress: 1.5},
+		}
+		assert.Equal(t, expectedCosts, actual[0].Costs)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			ListBuildings(gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listBuildings(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list buildings", actual)
+	})
+}
+
+func TestUnit_GameData_CreateBuilding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := createBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid building syntax", actual)
+	})
+
+	t.Run("forwards building to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		expectedRequest := request.BuildingRequest{
+			Name: "metal mine",
+			Costs: []request.BuildingCostRequest{
+				{Resource: sampleResourceId, Cost: 60, Progress: 1.5},
+			},
+			Productions: []request.BuildingProductionRequest{},
+			Storages: []request.BuildingStorageRequest{
+				{Resource: sampleResourceId, Base: 5000, Scale: 2.5, Progress: 1.6},
+			},
+		}
+		mockUsecase.EXPECT().
+			CreateBuilding(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(sampleBuilding(), nil)
+
+		err := createBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.BuildingDtoResponse](t, rw)
+		assert.Equal(t, sampleUuid, actual.Id)
+	})
+
+	t.Run("returns 400 when building is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			CreateBuilding(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Building{}, domainerrors.ErrInvalidBuilding)
+
+		err := createBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid building", actual)
+	})
+}
+
+func TestUnit_GameData_UpdateBuilding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := updateBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards update to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateBuilding(gomock.Any(), sampleUuid, gomock.Any()).
+			Times(1).
+			Return(sampleBuilding(), nil)
+
+		err := updateBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.BuildingDtoResponse](t, rw)
+		assert.Equal(t, "metal mine", actual.Name)
+	})
+
+	t.Run("returns 404 when building is not found", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateBuilding(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Building{}, domainerrors.ErrNotFound)
+
+		err := updateBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such building", actual)
+	})
+
+	t.Run("returns 409 when building can not be renamed", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateBuilding(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Building{}, domainerrors.ErrGameDataRoleRenamed)
+
+		err := updateBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "building can not be renamed", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPatch, sampleBuildingDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			UpdateBuilding(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Building{}, errors.New("stubbed error"))
+
+		err := updateBuilding(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to update building", actual)
+	})
+}
+
+func sampleResourceDtoRequest() dtos.ResourceDtoRequest {
+	return dtos.ResourceDtoRequest{
+		Name:                  "metal",
+		StartAmount:           500,
+		StartProduction:       30,
+		StartStorage:          10000,
+		BuildTimeHoursPerUnit: 0.0004,
+	}
+}
+
+func sampleResource() models.Resource {
+	return models.Resource{
+		Id:                    sampleResourceId,
+		Name:                  "metal",
+		StartAmount:           500,
+		StartProduction:       30,
+		StartStorage:          10000,
+		BuildTimeHoursPerUnit: 0.0004,
+		CreatedAt:             someTime,
+	}
+}
+
+func sampleBuildingDtoRequest() dtos.BuildingDtoRequest {
+	return dtos.BuildingDtoRequest{
+		Name: "metal mine",
+		Costs: []dtos.BuildingCostDtoRequest{
+			{Resource: sampleResourceId, Cost: 60, Progress: 1.5},
+		},
+		Storages: []dtos.BuildingResourceStorageDtoRequest{
+			{Resource: sampleResourceId, Base: 5000, Scale: 2.5, Progress: 1.6},
+		},
+	}
+}
+
+func sampleBuilding() models.Building {
+	return models.Building{
+		Id:        sampleUuid,
+		Name:      "metal mine",
+		CreatedAt: someTime,
+		Costs: []models.BuildingCost{
+			{Resource: sampleResourceId, Cost: 60, Progress: 1.5, BuildTimeHoursPerUnit: 0.0004},
+		},
+	}
+}
@@ -0,0 +1,408 @@
+package drivingadapters
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	// gatewayReadLimit is the maximum size in bytes of a frame sent by a
+	// client. Larger frames close the connection.
+	gatewayReadLimit = 4096
+	// gatewayQueueSize is the number of frames waiting to be written to a
+	// client. A client letting the queue fill up is disconnected.
+	gatewayQueueSize        = 32
+	gatewayMaxSubscriptions = 32
+	gatewayPingInterval     = 30 * time.Second
+	gatewayWriteTimeout     = 10 * time.Second
+)
+
+// Types of the frames sent by the clients.
+const (
+	subscribeFrame            = "subscribe"
+	unsubscribeFrame          = "unsubscribe"
+	createBuildingActionFrame = "create_building_action"
+	cancelBuildingActionFrame = "cancel_building_action"
+)
+
+// Types of the frames sent by the gateway.
+const (
+	subscribedFrame              = "subscribed"
+	unsubscribedFrame            = "unsubscribed"
+	buildingActionCreatedFrame   = "building_action_created"
+	buildingActionCancelledFrame = "building_action_cancelled"
+	planetChangeFrame            = "planet_change"
+	errorFrame                   = "error"
+)
+
+type gateway struct {
+	authorizer    drivingports.ForAuthorizingRequest
+	watcher       drivingports.ForWatchingPlanetChanges
+	createUsecase drivingports.ForCreatingBuildingAction
+	queueUsecase  drivingports.ForManagingBuildingQueue
+}
+
+type watchFunc func(ctx context.Context, id uuid.UUID) (<-chan models.PlanetChange, error)
+
+// gatewaySession holds the state of a single connection. The subscriptions
+// are only accessed by the goroutine reading the frames of the client.
+type gatewaySession struct {
+	gateway       gateway
+	apiUser       uuid.UUID
+	conn          *websocket.Conn
+	log           *slog.Logger
+	outgoing      chan dtos.GatewayFrameDto
+	subscriptions map[models.PlanetChangeTopic]context.CancelFunc
+}
+
+func GatewayEndpoints(
+	authorizer drivingports.ForAuthorizingRequest,
+	watcher drivingports.ForWatchingPlanetChanges,
+	createUsecase drivingports.ForCreatingBuildingAction,
+	queueUsecase drivingports.ForManagingBuildingQueue,
+) rest.Routes {
+	var out rest.Routes
+
+	gw := gateway{
+		authorizer:    authorizer,
+		watcher:       watcher,
+		createUsecase: createUsecase,
+		queueUsecase:  queueUsecase,
+	}
+
+	// The connection is upgraded: the frames can't be wrapped in the
+	// response envelope.
+	handler := generateHandler(serveGateway, gw)
+	ws := rest.NewRawRoute(http.MethodGet, "/ws", handler)
+	out = append(out, ws)
+
+	return out
+}
+
+// serveGateway godoc
+//
+//	@Summary		Open a WebSocket connection
+//	@Description	Upgrades the connection to a WebSocket exchanging JSON frames with an `id` chosen by the client, a `type` and a `payload`. Clients send `subscribe` and `unsubscribe` frames with a `{scope, id}` payload where the scope is a player, a planet or a universe, and `create_building_action` and `cancel_building_action` commands with the same payload as the matching routes plus the `planet` they act on, and the `action` to cancel. The gateway answers with `subscribed`, `unsubscribed`, `building_action_created`, `building_action_cancelled` or `error` frames carrying the id of the request, and pushes `planet_change` frames for the subscriptions. Universe subscriptions report the planets created and deleted in the universe. Browsers can't set the Authorization header on a WebSocket: the token can also be passed in the `access_token` query parameter or cookie.
+//	@Tags			gateway
+//	@Param			access_token	query		string	false	"Token, when it can't be passed in the Authorization header"
+//	@Success		101				{object}	dtos.GatewayFrameDto
+//	@Failure		401				{string}	string
+//	@Security		BearerAuth
+//	@Router			/ws [get]
+func serveGateway(c *echo.Context, gw gateway) error {
+	apiUser, ok := c.Get(apiUserKey).(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, "missing token")
+	}
+
+	conn, err := websocket.Accept(c.Response(), c.Request(), nil)
+	if err != nil {
+		// The error is already reported to the client.
+		c.Logger().Warn("Failed to accept connection", slog.Any("error", err))
+		return nil
+	}
+	defer conn.CloseNow()
+	conn.SetReadLimit(gatewayReadLimit)
+
+	s := &gatewaySession{
+		gateway:       gw,
+		apiUser:       apiUser,
+		conn:          conn,
+		log:           c.Logger(),
+		outgoing:      make(chan dtos.GatewayFrameDto, gatewayQueueSize),
+		subscriptions: make(map[models.PlanetChangeTopic]context.CancelFunc),
+	}
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	go s.writeFrames(ctx)
+	go s.sendHeartbeats(ctx)
+
+	for {
+		kind, data, err := conn.Read(ctx)
+		if err != nil {
+			// The client closed the connection or it was closed for being
+			// too slow or unresponsive.
+			return nil
+		}
+
+		s.handle(ctx, kind, data)
+	}
+}
+
+func (s *gatewaySession) handle(ctx context.Context, kind websocket.MessageType, data []byte) {
+	var frame dtos.GatewayFrameDto
+	if kind != websocket.MessageText || json.Unmarshal(data, &frame) != nil {
+		s.sendError("", http.StatusBadRequest, "invalid frame syntax")
+		return
+	}
+
+	switch frame.Type {
+	case subscribeFrame:
+		s.subscribe(ctx, frame)
+	case unsubscribeFrame:
+		s.unsubscribe(frame)
+	case createBuildingActionFrame:
+		s.createBuildingAction(ctx, frame)
+	case cancelBuildingActionFrame:
+		s.cancelBuildingAction(ctx, frame)
+	default:
+		s.sendError(frame.Id, http.StatusBadRequest, "unknown frame type")
+	}
+}
+
+func (s *gatewaySession) subscribe(ctx context.Context, frame dtos.GatewayFrameDto) {
+	var subscription dtos.GatewaySubscriptionDto
+	if err := json.Unmarshal(frame.Payload, &subscription); err != nil {
+		s.sendError(frame.Id, http.StatusBadRequest, "invalid subscription syntax")
+		return
+	}
+
+	topic := models.PlanetChangeTopic{
+		Scope: models.PlanetChangeScope(subscription.Scope),
+		Id:    subscription.Id,
+	}
+	if _, ok := s.subscriptions[topic]; ok {
+		s.send(frame.Id, subscribedFrame, subscription)
+		return
+	}
+	if len(s.subscriptions) >= gatewayMaxSubscriptions {
+		s.sendError(frame.Id, http.StatusConflict, "too many subscriptions")
+		return
+	}
+
+	var watch watchFunc
+	var notFound string
+	switch topic.Scope {
+	case models.PlayerScope:
+		if !s.authorize(ctx, frame.Id, request.PlayerResource, topic.Id) {
+			return
+		}
+		watch, notFound = s.gateway.watcher.WatchPlayer, "no such player"
+	case models.PlanetScope:
+		if !s.authorize(ctx, frame.Id, request.PlanetResource, topic.Id) {
+			return
+		}
+		watch, notFound = s.gateway.watcher.WatchPlanet, "no such planet"
+	case models.UniverseScope:
+		// Universes are public.
+		watch, notFound = s.gateway.watcher.WatchUniverse, "no such universe"
+	default:
+		s.sendError(frame.Id, http.StatusBadRequest, "invalid subscription scope")
+		return
+	}
+
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	changes, err := watch(subscriptionCtx, topic.Id)
+	if err != nil {
+		cancel()
+
+		if err == domainerrors.ErrNotFound {
+			s.sendError(frame.Id, http.StatusNotFound, notFound)
+			return
+		}
+
+		s.log.Error("Failed to subscribe", slog.Any("error", err))
+		s.sendError(frame.Id, http.StatusInternalServerError, "failed to subscribe")
+		return
+	}
+
+	s.subscriptions[topic] = cancel
+	s.send(frame.Id, subscribedFrame, subscription)
+
+	go func() {
+		for change := range changes {
+			s.send("", planetChangeFrame, mappers.ToPlanetChangeResponse(change))
+		}
+	}()
+}
+
+func (s *gatewaySession) unsubscribe(frame dtos.GatewayFrameDto) {
+	var subscription dtos.GatewaySubscriptionDto
+	if err := json.Unmarshal(frame.Payload, &subscription); err != nil {
+		s.sendError(frame.Id, http.StatusBadRequest, "invalid subscription syntax")
+		return
+	}
+
+	topic := models.PlanetChangeTopic{
+		Scope: models.PlanetChangeScope(subscription.Scope),
+		Id:    subscription.Id,
+	}
+	if cancel, ok := s.subscriptions[topic]; ok {
+		cancel()
+		delete(s.subscriptions, topic)
+	}
+
+	s.send(frame.Id, unsubscribedFrame, subscription)
+}
+
+func (s *gatewaySession) createBuildingAction(ctx context.Context, frame dtos.GatewayFrameDto) {
+	var inputDto dtos.GatewayBuildingActionDtoRequest
+	if err := json.Unmarshal(frame.Payload, &inputDto); err != nil {
+		s.sendError(frame.Id, http.StatusBadRequest, "invalid building action syntax")
+		return
+	}
+
+	if !s.authorize(ctx, frame.Id, request.PlanetResource, inputDto.Planet) {
+		return
+	}
+
+	actionRequest := mappers.ToBuildingActionCreationRequest(inputDto.Planet, inputDto.BuildingActionDtoRequest)
+	action, err := s.gateway.createUsecase.Create(ctx, actionRequest)
+	if err != nil {
+		if status, message, ok := buildingActionCreationError(err); ok {
+			s.sendError(frame.Id, status, message)
+			return
+		}
+
+		s.log.Error("Failed to create building action", slog.Any("error", err))
+		s.sendError(frame.Id, http.StatusInternalServerError, "failed to create building action")
+		return
+	}
+
+	s.send(frame.Id, buildingActionCreatedFrame, mappers.ToBuildingActionResponse(action))
+}
+
+func (s *gatewaySession) cancelBuildingAction(ctx context.Context, frame dtos.GatewayFrameDto) {
+	var inputDto dtos.GatewayBuildingActionCancellationDto
+	if err := json.Unmarshal(frame.Payload, &inputDto); err != nil {
+		s.sendError(frame.Id, http.StatusBadRequest, "invalid building action syntax")
+		return
+	}
+
+	if !s.authorize(ctx, frame.Id, request.PlanetResource, inputDto.Planet) {
+		return
+	}
+
+	err := s.gateway.queueUsecase.Cancel(ctx, inputDto.Planet, inputDto.Action)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			s.sendError(frame.Id, http.StatusNotFound, "no such planet")
+			return
+		}
+
+		if err == domainerrors.ErrNoActionInProgress {
+			s.sendError(frame.Id, http.StatusNotFound, "no such action")
+			return
+		}
+
+		s.log.Error("Failed to cancel building action", slog.Any("error", err))
+		s.sendError(frame.Id, http.StatusInternalServerError, "failed to cancel building action")
+		return
+	}
+
+	s.send(frame.Id, buildingActionCancelledFrame, inputDto)
+}
+
+// authorize checks that the api user of the connection owns the resource
+// and reports an error to the client otherwise.
+func (s *gatewaySession) authorize(
+	ctx context.Context,
+	frameId string,
+	resource request.OwnedResource,
+	id uuid.UUID,
+) bool {
+	req := request.OwnershipRequest{
+		ApiUser:  s.apiUser,
+		Resource: resource,
+		Id:       id,
+	}
+	err := s.gateway.authorizer.CheckOwnership(ctx, req)
+	if err == domainerrors.ErrNotResourceOwner {
+		s.sendError(frameId, http.StatusForbidden, "forbidden")
+		return false
+	}
+	// A missing resource is reported by the use case.
+	if err != nil && err != domainerrors.ErrNotFound {
+		s.log.Error("Failed to authorize request", slog.Any("error", err))
+		s.sendError(frameId, http.StatusInternalServerError, "failed to authorize request")
+		return false
+	}
+
+	return true
+}
+
+func (s *gatewaySession) sendError(frameId string, status int, message string) {
+	out := dtos.GatewayErrorDtoResponse{
+		Status:  status,
+		Message: message,
+	}
+	s.send(frameId, errorFrame, out)
+}
+
+// send never blocks: a client which does not read its frames fast enough is
+// disconnected rather than slowing down the gateway.
+func (s *gatewaySession) send(frameId string, kind string, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		s.log.Error("Failed to marshal frame", slog.String("type", kind), slog.Any("error", err))
+		return
+	}
+
+	frame := dtos.GatewayFrameDto{
+		Id:      frameId,
+		Type:    kind,
+		Payload: data,
+	}
+
+	select {
+	case s.outgoing <- frame:
+	default:
+		s.conn.Close(websocket.StatusTryAgainLater, "too slow")
+	}
+}
+
+func (s *gatewaySession) writeFrames(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case frame := <-s.outgoing:
+			writeCtx, cancel := context.WithTimeout(ctx, gatewayWriteTimeout)
+			err := wsjson.Write(writeCtx, s.conn, frame)
+			cancel()
+
+			if err != nil {
+				s.conn.CloseNow()
+				return
+			}
+		}
+	}
+}
+
+func (s *gatewaySession) sendHeartbeats(ctx context.Context) {
+	ticker := time.NewTicker(gatewayPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(ctx, gatewayWriteTimeout)
+			err := s.conn.Ping(pingCtx)
+			cancel()
+
+			if err != nil {
+				s.conn.CloseNow()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,451 @@
+package drivingadapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type gatewayTestSuite struct {
+	mockAuthorizer    *drivingportstest.MockForAuthorizingRequest
+	mockWatcher       *drivingportstest.MockForWatchingPlanetChanges
+	mockCreateUsecase *drivingportstest.MockForCreatingBuildingAction
+	mockQueueUsecase  *drivingportstest.MockForManagingBuildingQueue
+	gateway           gateway
+}
+
+func TestUnit_Gateway_ServeGateway(t *testing.T) {
+	t.Run("returns 401 when api user is missing", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		err := serveGateway(ctx, suite.gateway)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "missing token", actual)
+	})
+
+	t.Run("reports invalid frame", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		err := conn.Write(t.Context(), websocket.MessageText, []byte("not-json"))
+		require.NoError(t, err, "Actual err: %v", err)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "", http.StatusBadRequest, "invalid frame syntax")
+	})
+
+	t.Run("reports unknown frame type", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		writeTestFrame(t, conn, "1", "not-a-type", struct{}{})
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusBadRequest, "unknown frame type")
+	})
+}
+
+func TestUnit_Gateway_Subscribe(t *testing.T) {
+	planet := uuid.New()
+
+	t.Run("forwards changes of player", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		change := models.PlanetChange{Planet: planet, Player: sampleUuid, Version: 4}
+		expectedRequest := request.OwnershipRequest{
+			ApiUser:  sampleApiUser,
+			Resource: request.PlayerResource,
+			Id:       sampleUuid,
+		}
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), expectedRequest).
+			Times(1).
+			Return(nil)
+		suite.mockWatcher.EXPECT().
+			WatchPlayer(gomock.Any(), sampleUuid).
+			Times(1).
+			Return(generateTestPlanetChanges(change), nil)
+
+		subscription := dtos.GatewaySubscriptionDto{Scope: "player", Id: sampleUuid}
+		writeTestFrame(t, conn, "1", subscribeFrame, subscription)
+
+		actual := readTestFrame(t, conn)
+		assert.Equal(t, "1", actual.Id)
+		assert.Equal(t, subscribedFrame, actual.Type)
+		assert.Equal(t, subscription, decodeTestPayload[dtos.GatewaySubscriptionDto](t, actual))
+
+		actual = readTestFrame(t, conn)
+		assert.Equal(t, planetChangeFrame, actual.Type)
+		expected := mappers.ToPlanetChangeResponse(change)
+		assert.Equal(t, expected, decodeTestPayload[dtos.PlanetChangeDtoResponse](t, actual))
+	})
+
+	t.Run("does not check ownership of universe", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockWatcher.EXPECT().
+			WatchUniverse(gomock.Any(), sampleUuid).
+			Times(1).
+			Return(nil, domainerrors.ErrNotFound)
+
+		subscription := dtos.GatewaySubscriptionDto{Scope: "universe", Id: sampleUuid}
+		writeTestFrame(t, conn, "1", subscribeFrame, subscription)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusNotFound, "no such universe")
+	})
+
+	t.Run("reports planet not owned by api user", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotResourceOwner)
+
+		subscription := dtos.GatewaySubscriptionDto{Scope: "planet", Id: planet}
+		writeTestFrame(t, conn, "1", subscribeFrame, subscription)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusForbidden, "forbidden")
+	})
+
+	t.Run("reports invalid scope", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		subscription := dtos.GatewaySubscriptionDto{Scope: "fleet", Id: sampleUuid}
+		writeTestFrame(t, conn, "1", subscribeFrame, subscription)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusBadRequest, "invalid subscription scope")
+	})
+
+	t.Run("reports failure to subscribe", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockWatcher.EXPECT().
+			WatchUniverse(gomock.Any(), sampleUuid).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		subscription := dtos.GatewaySubscriptionDto{Scope: "universe", Id: sampleUuid}
+		writeTestFrame(t, conn, "1", subscribeFrame, subscription)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusInternalServerError, "failed to subscribe")
+	})
+}
+
+func TestUnit_Gateway_Unsubscribe(t *testing.T) {
+	t.Run("stops watching changes", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		var watchCtx context.Context
+		suite.mockWatcher.EXPECT().
+			WatchUniverse(gomock.Any(), sampleUuid).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, _ uuid.UUID) (<-chan models.PlanetChange, error) {
+				watchCtx = ctx
+				return make(chan models.PlanetChange), nil
+			})
+
+		subscription := dtos.GatewaySubscriptionDto{Scope: "universe", Id: sampleUuid}
+		writeTestFrame(t, conn, "1", subscribeFrame, subscription)
+		actual := readTestFrame(t, conn)
+		assert.Equal(t, subscribedFrame, actual.Type)
+
+		writeTestFrame(t, conn, "2", unsubscribeFrame, subscription)
+		actual = readTestFrame(t, conn)
+		assert.Equal(t, "2", actual.Id)
+		assert.Equal(t, unsubscribedFrame, actual.Type)
+
+		select {
+		case <-watchCtx.Done():
+		case <-time.After(time.Second):
+			assert.Fail(t, "subscription was not cancelled")
+		}
+	})
+}
+
+func TestUnit_Gateway_CreateBuildingAction(t *testing.T) {
+	planet := uuid.New()
+	dto := dtos.GatewayBuildingActionDtoRequest{
+		Planet: planet,
+		BuildingActionDtoRequest: dtos.BuildingActionDtoRequest{
+			Building: uuid.New(),
+		},
+	}
+
+	t.Run("creates action on owned planet", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		expectedOwnership := request.OwnershipRequest{
+			ApiUser:  sampleApiUser,
+			Resource: request.PlanetResource,
+			Id:       planet,
+		}
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), expectedOwnership).
+			Times(1).
+			Return(nil)
+		expectedRequest := mappers.ToBuildingActionCreationRequest(planet, dto.BuildingActionDtoRequest)
+		action := models.BuildingAction{
+			Id:           uuid.New(),
+			Kind:         models.UpgradeAction,
+			Building:     dto.Building,
+			DesiredLevel: 6,
+			CreatedAt:    someTime,
+			CompletedAt:  someOtherTime,
+		}
+		suite.mockCreateUsecase.EXPECT().
+			Create(gomock.Any(), expectedRequest).
+			Times(1).
+			Return(action, nil)
+
+		writeTestFrame(t, conn, "1", createBuildingActionFrame, dto)
+
+		actual := readTestFrame(t, conn)
+		assert.Equal(t, "1", actual.Id)
+		assert.Equal(t, buildingActionCreatedFrame, actual.Type)
+		expected := mappers.ToBuildingActionResponse(action)
+		assert.Equal(t, expected, decodeTestPayload[dtos.BuildingActionDtoResponse](t, actual))
+	})
+
+	t.Run("reports expected errors", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil)
+		suite.mockCreateUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.BuildingAction{}, domainerrors.ErrBuildingQueueFull)
+
+		writeTestFrame(t, conn, "1", createBuildingActionFrame, dto)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusConflict, "building queue is full")
+	})
+
+	t.Run("reports planet not owned by api user", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotResourceOwner)
+
+		writeTestFrame(t, conn, "1", createBuildingActionFrame, dto)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusForbidden, "forbidden")
+	})
+
+	t.Run("reports invalid payload", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		writeTestFrame(t, conn, "1", createBuildingActionFrame, "not-an-action")
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusBadRequest, "invalid building action syntax")
+	})
+}
+
+func TestUnit_Gateway_CancelBuildingAction(t *testing.T) {
+	planet := uuid.New()
+	dto := dtos.GatewayBuildingActionCancellationDto{Planet: planet, Action: uuid.New()}
+
+	t.Run("cancels action of owned planet", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil)
+		suite.mockQueueUsecase.EXPECT().
+			Cancel(gomock.Any(), planet, dto.Action).
+			Times(1).
+			Return(nil)
+
+		writeTestFrame(t, conn, "1", cancelBuildingActionFrame, dto)
+
+		actual := readTestFrame(t, conn)
+		assert.Equal(t, "1", actual.Id)
+		assert.Equal(t, buildingActionCancelledFrame, actual.Type)
+		assert.Equal(t, dto, decodeTestPayload[dtos.GatewayBuildingActionCancellationDto](t, actual))
+	})
+
+	t.Run("reports missing planet", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+		suite.mockQueueUsecase.EXPECT().
+			Cancel(gomock.Any(), planet, dto.Action).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+
+		writeTestFrame(t, conn, "1", cancelBuildingActionFrame, dto)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusNotFound, "no such planet")
+	})
+
+	t.Run("reports missing action", func(t *testing.T) {
+		suite := setupGatewayTestSuite(t)
+		conn := dialTestGateway(t, suite.gateway)
+
+		suite.mockAuthorizer.EXPECT().
+			CheckOwnership(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil)
+		suite.mockQueueUsecase.EXPECT().
+			Cancel(gomock.Any(), planet, dto.Action).
+			Times(1).
+			Return(domainerrors.ErrNoActionInProgress)
+
+		writeTestFrame(t, conn, "1", cancelBuildingActionFrame, dto)
+
+		actual := readTestFrame(t, conn)
+		assertErrorFrame(t, actual, "1", http.StatusNotFound, "no such action")
+	})
+}
+
+func setupGatewayTestSuite(t *testing.T) gatewayTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	suite := gatewayTestSuite{
+		mockAuthorizer:    drivingportstest.NewMockForAuthorizingRequest(ctrl),
+		mockWatcher:       drivingportstest.NewMockForWatchingPlanetChanges(ctrl),
+		mockCreateUsecase: drivingportstest.NewMockForCreatingBuildingAction(ctrl),
+		mockQueueUsecase:  drivingportstest.NewMockForManagingBuildingQueue(ctrl),
+	}
+	suite.gateway = gateway{
+		authorizer:    suite.mockAuthorizer,
+		watcher:       suite.mockWatcher,
+		createUsecase: suite.mockCreateUsecase,
+		queueUsecase:  suite.mockQueueUsecase,
+	}
+
+	return suite
+}
+
+// dialTestGateway serves the gateway as if the token of the request was
+// issued for the sample api user and connects to it.
+func dialTestGateway(t *testing.T, gw gateway) *websocket.Conn {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := echo.New().NewContext(r, w)
+		c.Set(apiUserKey, sampleApiUser)
+		serveGateway(c, gw)
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.Dial(t.Context(), server.URL, nil)
+	require.NoError(t, err, "Actual err: %v", err)
+	t.Cleanup(func() { conn.CloseNow() })
+
+	return conn
+}
+
+func writeTestFrame(t *testing.T, conn *websocket.Conn, id string, kind string, payload any) {
+	t.Helper()
+
+	data, err := json.Marshal(payload)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	frame := dtos.GatewayFrameDto{Id: id, Type: kind, Payload: data}
+	err = wsjson.Write(t.Context(), conn, frame)
+	require.NoError(t, err, "Actual err: %v", err)
+}
+
+func readTestFrame(t *testing.T, conn *websocket.Conn) dtos.GatewayFrameDto {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	var frame dtos.GatewayFrameDto
+	err := wsjson.Read(ctx, conn, &frame)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return frame
+}
+
+func decodeTestPayload[T any](t *testing.T, frame dtos.GatewayFrameDto) T {
+	t.Helper()
+
+	var out T
+	err := json.Unmarshal(frame.Payload, &out)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return out
+}
+
+func assertErrorFrame(
+	t *testing.T,
+	frame dtos.GatewayFrameDto,
+	id string,
+	status int,
+	message string,
+) {
+	t.Helper()
+
+	assert.Equal(t, id, frame.Id)
+	assert.Equal(t, errorFrame, frame.Type)
+	expected := dtos.GatewayErrorDtoResponse{Status: status, Message: message}
+	assert.Equal(t, expected, decodeTestPayload[dtos.GatewayErrorDtoResponse](t, frame))
+}
+
+// generateTestPlanetChanges returns a closed channel holding the changes.
+func generateTestPlanetChanges(changes ...models.PlanetChange) <-chan models.PlanetChange {
+	out := make(chan models.PlanetChange, len(changes))
+	for _, change := range changes {
+		out <- change
+	}
+	close(out)
+
+	return out
+}
@@ -1,8 +1,26 @@
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_authorizing_request.go -destination=drivingportstest/authorize_request_mocks.go -package=drivingportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_checking_service_health.go -destination=drivingportstest/health_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_colonizing_planet.go -destination=drivingportstest/colonize_planet_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_completing_actions.go -destination=drivingportstest/complete_actions_mocks.go -package=drivingportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_creating_building_action.go -destination=drivingportstest/create_building_action_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_creating_shipyard_action.go -destination=drivingportstest/create_shipyard_action_mocks.go -package=drivingportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_deleting_building_action.go -destination=drivingportstest/deleting_building_action_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_exchanging_messages.go -destination=drivingportstest/messages_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_listing_planet_history.go -destination=drivingportstest/planet_history_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_alliance.go -destination=drivingportstest/alliance_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_building_queue.go -destination=drivingportstest/building_queue_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_fleet.go -destination=drivingportstest/fleet_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_game_data.go -destination=drivingportstest/game_data_mocks.go -package=drivingportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_planet.go -destination=drivingportstest/planet_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_market_offer.go -destination=drivingportstest/market_offer_mocks.go -package=drivingportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_player.go -destination=drivingportstest/player_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_research.go -destination=drivingportstest/research_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_previewing_building_upgrades.go -destination=drivingportstest/building_preview_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_reconstructing_planet.go -destination=drivingportstest/planet_reconstruction_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_ranking_players.go -destination=drivingportstest/ranking_mocks.go -package=drivingportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_managing_universe.go -destination=drivingportstest/universe_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_sending_fleet.go -destination=drivingportstest/send_fleet_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_trading_resources.go -destination=drivingportstest/trade_resources_mocks.go -package=drivingportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../../app/ports/driving/for_watching_planet_changes.go -destination=drivingportstest/planet_changes_mocks.go -package=drivingportstest
 
 package drivingadapters
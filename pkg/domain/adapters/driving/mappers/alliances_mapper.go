@@ -0,0 +1,78 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToAllianceCreationRequest(dto dtos.AllianceDtoRequest) request.AllianceCreationRequest {
+	return request.AllianceCreationRequest{
+		Founder: dto.Founder,
+		Name:    dto.Name,
+		Tag:     dto.Tag,
+	}
+}
+
+func ToAllianceRankRequest(
+	alliance uuid.UUID,
+	player uuid.UUID,
+	dto dtos.AllianceRankDtoRequest,
+) request.AllianceRankRequest {
+	return request.AllianceRankRequest{
+		Alliance:  alliance,
+		Requester: dto.Player,
+		Player:    player,
+		Rank:      models.AllianceRank(dto.Rank),
+	}
+}
+
+func ToAllianceResponse(alliance models.Alliance) dtos.AllianceDtoResponse {
+	out := dtos.AllianceDtoResponse{
+		Id:        alliance.Id,
+		Universe:  alliance.Universe,
+		Name:      alliance.Name,
+		Tag:       alliance.Tag,
+		CreatedAt: alliance.CreatedAt,
+		Members:   make([]dtos.AllianceMemberDtoResponse, 0, len(alliance.Members)),
+	}
+
+	for _, member := range alliance.Members {
+		permissions := make([]string, 0)
+		for _, permission := range member.Rank.Permissions() {
+			permissions = append(permissions, string(permission))
+		}
+
+		dto := dtos.AllianceMemberDtoResponse{
+			Player:      member.Player,
+			Name:        member.Name,
+			Rank:        string(member.Rank),
+			Permissions: permissions,
+			JoinedAt:    member.JoinedAt,
+		}
+		out.Members = append(out.Members, dto)
+	}
+
+	return out
+}
+
+func ToAllianceApplicationResponse(application models.AllianceApplication) dtos.AllianceApplicationDtoResponse {
+	return dtos.AllianceApplicationDtoResponse{
+		Id:        application.Id,
+		Alliance:  application.Alliance,
+		Player:    application.Player,
+		CreatedAt: application.CreatedAt,
+	}
+}
+
+func ToAllianceApplicationsResponse(applications []models.AllianceApplication) []dtos.AllianceApplicationDtoResponse {
+	out := make([]dtos.AllianceApplicationDtoResponse, 0, len(applications))
+
+	for _, a := range applications {
+		dto := ToAllianceApplicationResponse(a)
+		out = append(out, dto)
+	}
+
+	return out
+}
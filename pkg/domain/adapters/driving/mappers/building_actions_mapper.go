@@ -11,15 +11,23 @@ func ToBuildingActionCreationRequest(
 	planetId uuid.UUID,
 	dto dtos.BuildingActionDtoRequest,
 ) request.BuildingActionCreationRequest {
+	// Upgrading is the historical behavior, kept when no kind is provided.
+	kind := models.UpgradeAction
+	if dto.Kind != "" {
+		kind = models.BuildingActionKind(dto.Kind)
+	}
+
 	return request.BuildingActionCreationRequest{
 		Planet:   planetId,
 		Building: dto.Building,
+		Kind:     kind,
 	}
 }
 
 func ToBuildingActionResponse(action models.BuildingAction) dtos.BuildingActionDtoResponse {
 	return dtos.BuildingActionDtoResponse{
 		Id:           action.Id,
+		Kind:         string(action.Kind),
 		Building:     action.Building,
 		DesiredLevel: action.DesiredLevel,
 		CreatedAt:    action.CreatedAt,
@@ -30,6 +38,19 @@ func ToBuildingActionResponse(action models.BuildingAction) dtos.BuildingActionD
 	}
 }
 
+func ToBuildingActionsResponse(
+	actions []models.BuildingAction,
+) []dtos.BuildingActionDtoResponse {
+	out := make([]dtos.BuildingActionDtoResponse, 0, len(actions))
+
+	for _, a := range actions {
+		dto := ToBuildingActionResponse(a)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
 func toBuildingActionCostResponse(
 	cost models.BuildingActionCost,
 ) dtos.BuildingActionCostDtoResponse {
@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+func ToBuildingLevelPreviewResponse(preview models.BuildingLevelPreview) dtos.BuildingLevelPreviewDtoResponse {
+	return dtos.BuildingLevelPreviewDtoResponse{
+		Building:    preview.Building,
+		Level:       preview.Level,
+		Costs:       toBuildingActionCostsResponse(preview.Costs),
+		Duration:    preview.Duration.Seconds(),
+		Storages:    toBuildingActionStoragesResponse(preview.Storages),
+		Productions: toBuildingActionProductionsResponse(preview.Productions),
+		Affordable:  preview.Affordable,
+	}
+}
+
+func ToBuildingLevelPreviewsResponse(
+	previews []models.BuildingLevelPreview,
+) []dtos.BuildingLevelPreviewDtoResponse {
+	out := make([]dtos.BuildingLevelPreviewDtoResponse, 0, len(previews))
+
+	for _, p := range previews {
+		dto := ToBuildingLevelPreviewResponse(p)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,106 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToFleetCreationRequest(
+	planetId uuid.UUID,
+	dto dtos.FleetDtoRequest,
+) request.FleetCreationRequest {
+	out := request.FleetCreationRequest{
+		Planet:  planetId,
+		Mission: models.FleetMission(dto.Mission),
+		Target: models.Coordinate{
+			Galaxy:      dto.Target.Galaxy,
+			SolarSystem: dto.Target.SolarSystem,
+			Position:    dto.Target.Position,
+		},
+		Speed: dto.Speed,
+	}
+
+	for _, s := range dto.Ships {
+		ship := request.FleetShipRequest{
+			Ship:  s.Ship,
+			Count: s.Count,
+		}
+		out.Ships = append(out.Ships, ship)
+	}
+
+	for _, c := range dto.Cargo {
+		cargo := request.FleetCargoRequest{
+			Resource: c.Resource,
+			Amount:   c.Amount,
+		}
+		out.Cargo = append(out.Cargo, cargo)
+	}
+
+	return out
+}
+
+func ToFleetResponse(fleet models.Fleet) dtos.FleetDtoResponse {
+	return dtos.FleetDtoResponse{
+		Id:               fleet.Id,
+		Player:           fleet.Player,
+		Mission:          string(fleet.Mission),
+		Origin:           fleet.Origin,
+		OriginCoordinate: toCoordinateResponse(fleet.OriginCoordinate),
+		Target:           fleet.Target,
+		TargetCoordinate: toCoordinateResponse(fleet.TargetCoordinate),
+		Ships:            toFleetShipsResponse(fleet.Ships),
+		Cargo:            toFleetCargoResponse(fleet.Cargo),
+		DepartedAt:       fleet.DepartedAt,
+		ArrivesAt:        fleet.ArrivesAt,
+		ReturnsAt:        fleet.ReturnsAt,
+	}
+}
+
+func ToFleetsResponse(fleets []models.Fleet) []dtos.FleetDtoResponse {
+	out := make([]dtos.FleetDtoResponse, 0, len(fleets))
+
+	for _, f := range fleets {
+		dto := ToFleetResponse(f)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toCoordinateResponse(coordinate models.Coordinate) dtos.CoordinateDtoResponse {
+	return dtos.CoordinateDtoResponse{
+		Galaxy:      coordinate.Galaxy,
+		SolarSystem: coordinate.SolarSystem,
+		Position:    coordinate.Position,
+	}
+}
+
+func toFleetShipsResponse(ships []models.FleetShip) []dtos.FleetShipDto {
+	out := make([]dtos.FleetShipDto, 0, len(ships))
+
+	for _, s := range ships {
+		dto := dtos.FleetShipDto{
+			Ship:  s.Ship,
+			Count: s.Count,
+		}
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toFleetCargoResponse(cargo []models.FleetCargo) []dtos.FleetCargoDto {
+	out := make([]dtos.FleetCargoDto, 0, len(cargo))
+
+	for _, c := range cargo {
+		dto := dtos.FleetCargoDto{
+			Resource: c.Resource,
+			Amount:   c.Amount,
+		}
+		out = append(out, dto)
+	}
+
+	return out
+}
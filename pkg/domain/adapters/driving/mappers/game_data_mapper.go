@@ -0,0 +1,99 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+)
+
+func ToResourceRequest(dto dtos.ResourceDtoRequest) request.ResourceRequest {
+	return request.ResourceRequest{
+		Name:                  dto.Name,
+		StartAmount:           dto.StartAmount,
+		StartProduction:       dto.StartProduction,
+		StartStorage:          dto.StartStorage,
+		BuildTimeHoursPerUnit: dto.BuildTimeHoursPerUnit,
+	}
+}
+
+func ToBuildingRequest(dto dtos.BuildingDtoRequest) request.BuildingRequest {
+	out := request.BuildingRequest{
+		Name:        dto.Name,
+		Costs:       make([]request.BuildingCostRequest, 0, len(dto.Costs)),
+		Productions: make([]request.BuildingProductionRequest, 0, len(dto.Productions)),
+		Storages:    make([]request.BuildingStorageRequest, 0, len(dto.Storages)),
+	}
+
+	for _, cost := range dto.Costs {
+		out.Costs = append(out.Costs, request.BuildingCostRequest{
+			Resource: cost.Resource,
+			Cost:     cost.Cost,
+			Progress: cost.Progress,
+		})
+	}
+	for _, production := range dto.Productions {
+		out.Productions = append(out.Productions, request.BuildingProductionRequest{
+			Resource: production.Resource,
+			Base:     production.Base,
+			Progress: production.Progress,
+		})
+	}
+	for _, storage := range dto.Storages {
+		out.Storages = append(out.Storages, request.BuildingStorageRequest{
+			Resource: storage.Resource,
+			Base:     storage.Base,
+			Scale:    storage.Scale,
+			Progress: storage.Progress,
+		})
+	}
+
+	return out
+}
+
+func ToRulesetRequest(dto dtos.RulesetDto) request.RulesetRequest {
+	out := request.RulesetRequest{
+		Resources:   make([]request.RulesetResourceRequest, 0, len(dto.Resources)),
+		Buildings:   make([]request.RulesetBuildingRequest, 0, len(dto.Buildings)),
+		FieldRanges: make([]request.PlanetFieldRangeRequest, 0, len(dto.FieldRanges)),
+
+		MaxPlanetsPerPlayer: dto.MaxPlanetsPerPlayer,
+	}
+
+	for _, resource := range dto.Resources {
+		out.Resources = append(out.Resources, request.RulesetResourceRequest{
+			Id:       resource.Id,
+			Resource: ToResourceRequest(resource.ResourceDtoRequest),
+		})
+	}
+	for _, building := range dto.Buildings {
+		out.Buildings = append(out.Buildings, request.RulesetBuildingRequest{
+			Id:       building.Id,
+			Building: ToBuildingRequest(building.BuildingDtoRequest),
+		})
+	}
+	for _, fieldRange := range dto.FieldRanges {
+		out.FieldRanges = append(out.FieldRanges, request.PlanetFieldRangeRequest{
+			Position: fieldRange.Position,
+			Min:      fieldRange.Min,
+			Max:      fieldRange.Max,
+		})
+	}
+
+	return out
+}
+
+func ToResourceResponse(resource models.Resource) dtos.ResourceDtoResponse {
+	return toResourceResponse(resource)
+}
+
+func ToResourcesResponse(resources []models.Resource) []dtos.ResourceDtoResponse {
+	return toResourcesResponse(resources)
+}
+
+func ToBuildingResponse(building models.Building) dtos.BuildingDtoResponse {
+	return toBuildingResponse(building)
+}
+
+func ToBuildingsResponse(buildings []models.Building) []dtos.BuildingDtoResponse {
+	return toBuildingsResponse(buildings)
+}
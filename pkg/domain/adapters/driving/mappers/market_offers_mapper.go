@@ -0,0 +1,65 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToMarketOfferCreationRequest(
+	planetId uuid.UUID,
+	dto dtos.MarketOfferDtoRequest,
+) request.MarketOfferCreationRequest {
+	return request.MarketOfferCreationRequest{
+		Planet:            planetId,
+		OfferedResource:   dto.Offered.Resource,
+		OfferedAmount:     dto.Offered.Amount,
+		RequestedResource: dto.Requested.Resource,
+		RequestedAmount:   dto.Requested.Amount,
+		DurationHours:     dto.Duration,
+	}
+}
+
+func ToMarketOfferAcceptanceRequest(
+	offerId uuid.UUID,
+	dto dtos.MarketOfferAcceptanceDtoRequest,
+) request.MarketOfferAcceptanceRequest {
+	return request.MarketOfferAcceptanceRequest{
+		Offer:  offerId,
+		Planet: dto.Planet,
+	}
+}
+
+func ToMarketOfferResponse(offer models.MarketOffer) dtos.MarketOfferDtoResponse {
+	return dtos.MarketOfferDtoResponse{
+		Id:       offer.Id,
+		Universe: offer.Universe,
+		Player:   offer.Player,
+		Planet:   offer.Planet,
+		Offered: dtos.TradedResourceDtoResponse{
+			Resource: offer.OfferedResource,
+			Amount:   offer.OfferedAmount,
+		},
+		Requested: dtos.TradedResourceDtoResponse{
+			Resource: offer.RequestedResource,
+			Amount:   offer.RequestedAmount,
+		},
+		Status:     string(offer.Status),
+		Buyer:      offer.Buyer,
+		AcceptedAt: offer.AcceptedAt,
+		ExpiresAt:  offer.ExpiresAt,
+		CreatedAt:  offer.CreatedAt,
+	}
+}
+
+func ToMarketOffersResponse(offers []models.MarketOffer) []dtos.MarketOfferDtoResponse {
+	out := make([]dtos.MarketOfferDtoResponse, 0, len(offers))
+
+	for _, o := range offers {
+		dto := ToMarketOfferResponse(o)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToMessageCreationRequest(sender uuid.UUID, dto dtos.MessageDtoRequest) request.MessageCreationRequest {
+	return request.MessageCreationRequest{
+		Sender:    sender,
+		Recipient: dto.Recipient,
+		Subject:   dto.Subject,
+		Content:   dto.Content,
+	}
+}
+
+func ToMessageResponse(message models.Message) dtos.MessageDtoResponse {
+	return dtos.MessageDtoResponse{
+		Id:        message.Id,
+		Kind:      string(message.Kind),
+		Player:    message.Player,
+		Sender:    message.Sender,
+		Subject:   message.Subject,
+		Content:   message.Content,
+		Read:      message.Read,
+		CreatedAt: message.CreatedAt,
+	}
+}
+
+func ToMessagesResponse(messages []models.Message) []dtos.MessageDtoResponse {
+	out := make([]dtos.MessageDtoResponse, 0, len(messages))
+
+	for _, m := range messages {
+		dto := ToMessageResponse(m)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,15 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+func ToPlanetChangeResponse(change models.PlanetChange) dtos.PlanetChangeDtoResponse {
+	return dtos.PlanetChangeDtoResponse{
+		Planet:  change.Planet,
+		Version: change.Version,
+		Kind:    string(change.Kind),
+		Deleted: change.Deleted,
+	}
+}
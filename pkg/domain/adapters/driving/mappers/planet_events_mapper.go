@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+func ToPlanetEventResponse(event models.PlanetEvent) dtos.PlanetEventDtoResponse {
+	out := dtos.PlanetEventDtoResponse{
+		Id:            event.Id,
+		Planet:        event.Planet,
+		Kind:          string(event.Kind),
+		Action:        event.Action,
+		VersionBefore: event.VersionBefore,
+		VersionAfter:  event.VersionAfter,
+		Resources:     make([]dtos.PlanetEventResourceDtoResponse, 0, len(event.Resources)),
+		CreatedAt:     event.CreatedAt,
+	}
+
+	for _, r := range event.Resources {
+		dto := dtos.PlanetEventResourceDtoResponse{
+			Resource: r.Resource,
+			Delta:    r.Delta,
+			Produced: r.Produced,
+		}
+		out.Resources = append(out.Resources, dto)
+	}
+
+	return out
+}
+
+func ToPlanetEventsResponse(events []models.PlanetEvent) []dtos.PlanetEventDtoResponse {
+	out := make([]dtos.PlanetEventDtoResponse, 0, len(events))
+
+	for _, e := range events {
+		dto := ToPlanetEventResponse(e)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,45 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+func ToPlanetReconstructionResponse(reconstruction models.PlanetReconstruction) dtos.PlanetReconstructionDtoResponse {
+	out := dtos.PlanetReconstructionDtoResponse{
+		Planet:      reconstruction.Planet,
+		At:          reconstruction.At,
+		Version:     reconstruction.Version,
+		Events:      reconstruction.Events,
+		Resources:   make([]dtos.PlanetResourceDtoResponse, 0, len(reconstruction.Resources)),
+		Divergences: make([]dtos.PlanetDivergenceDtoResponse, 0, len(reconstruction.Divergences)),
+	}
+
+	for _, r := range reconstruction.Resources {
+		dto := toPlanetResourceResponse(r)
+		out.Resources = append(out.Resources, dto)
+	}
+
+	for _, d := range reconstruction.Divergences {
+		dto := dtos.PlanetDivergenceDtoResponse{
+			Kind:    string(d.Kind),
+			At:      d.At,
+			Rebuilt: d.Rebuilt,
+			Stored:  d.Stored,
+		}
+		switch d.Kind {
+		case models.ResourceDivergence:
+			resource := d.Resource
+			dto.Resource = &resource
+		case models.BuildingDivergence:
+			building := d.Building
+			dto.Building = &building
+		case models.ActionDivergence:
+			action := d.Action
+			dto.Action = &action
+		}
+		out.Divergences = append(out.Divergences, dto)
+	}
+
+	return out
+}
@@ -22,12 +22,20 @@ func ToPlanetResponse(planet models.Planet) dtos.PlanetDtoResponse {
 		Resources:   toPlanetResourcesResponse(planet.Resources),
 		Storages:    toPlanetStoragesResponse(planet.Storages),
 		Productions: toPlanetProductionsResponse(planet.Productions),
+		Energy:      toPlanetEnergyResponse(planet.Energy()),
 		Buildings:   toPlanetBuildingsResponse(planet.Buildings),
+		Ships:       toPlanetShipsResponse(planet.Ships),
+		Defenses:    toPlanetDefensesResponse(planet.Defenses),
+
+		BuildingQueue: ToBuildingActionsResponse(planet.BuildingQueue),
+		ShipyardQueue: toShipyardActionsResponse(planet.ShipyardQueue),
+
+		Fleets: ToFleetsResponse(planet.Fleets),
 	}
 
-	if planet.BuildingAction != nil {
-		action := ToBuildingActionResponse(*planet.BuildingAction)
-		dto.BuildingAction = &action
+	if planet.ResearchAction != nil {
+		action := ToResearchActionResponse(*planet.ResearchAction)
+		dto.ResearchAction = &action
 	}
 
 	return dto
@@ -100,6 +108,15 @@ func toPlanetProductionsResponse(
 	return out
 }
 
+func toPlanetEnergyResponse(
+	energy models.PlanetEnergy,
+) dtos.PlanetEnergyDtoResponse {
+	return dtos.PlanetEnergyDtoResponse{
+		Produced: energy.Produced,
+		Consumed: energy.Consumed,
+	}
+}
+
 func toPlanetBuildingResponse(
 	building models.PlanetBuilding,
 ) dtos.PlanetBuildingDtoResponse {
@@ -122,6 +139,50 @@ func toPlanetBuildingsResponse(
 	return out
 }
 
+func toPlanetShipResponse(
+	ship models.PlanetShip,
+) dtos.PlanetShipDtoResponse {
+	return dtos.PlanetShipDtoResponse{
+		Ship:  ship.Ship,
+		Count: ship.Count,
+	}
+}
+
+func toPlanetShipsResponse(
+	ships []models.PlanetShip,
+) []dtos.PlanetShipDtoResponse {
+	out := make([]dtos.PlanetShipDtoResponse, 0, len(ships))
+
+	for _, s := range ships {
+		dto := toPlanetShipResponse(s)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toPlanetDefenseResponse(
+	defense models.PlanetDefense,
+) dtos.PlanetDefenseDtoResponse {
+	return dtos.PlanetDefenseDtoResponse{
+		Defense: defense.Defense,
+		Count:   defense.Count,
+	}
+}
+
+func toPlanetDefensesResponse(
+	defenses []models.PlanetDefense,
+) []dtos.PlanetDefenseDtoResponse {
+	out := make([]dtos.PlanetDefenseDtoResponse, 0, len(defenses))
+
+	for _, d := range defenses {
+		dto := toPlanetDefenseResponse(d)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
 func ToPlanetsResponse(planets []models.Planet) []dtos.PlanetDtoResponse {
 	out := make([]dtos.PlanetDtoResponse, 0, len(planets))
 
@@ -15,7 +15,7 @@ func ToPlayerCreationRequest(dto dtos.PlayerDtoRequest) request.PlayerCreationRe
 }
 
 func ToPlayerResponse(player models.Player) dtos.PlayerDtoResponse {
-	return dtos.PlayerDtoResponse{
+	out := dtos.PlayerDtoResponse{
 		Id:        player.Id,
 		ApiUser:   player.ApiUser,
 		Universe:  player.Universe,
@@ -23,7 +23,20 @@ func ToPlayerResponse(player models.Player) dtos.PlayerDtoResponse {
 		CreatedAt: player.CreatedAt,
 		Homeworld: player.Homeworld,
 		Planets:   player.Planets,
+		Rank:      player.Rank,
+		Points:    player.Points,
 	}
+
+	if player.Alliance != nil {
+		out.Alliance = &dtos.PlayerAllianceDtoResponse{
+			Id:   player.Alliance.Id,
+			Name: player.Alliance.Name,
+			Tag:  player.Alliance.Tag,
+			Rank: string(player.Alliance.Rank),
+		}
+	}
+
+	return out
 }
 
 func ToPlayersResponse(players []models.Player) []dtos.PlayerDtoResponse {
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+func ToRankingResponse(ranking models.Ranking) dtos.RankingDtoResponse {
+	return dtos.RankingDtoResponse{
+		Player: ranking.Player,
+		Name:   ranking.PlayerName,
+		Points: ranking.Points,
+		Rank:   ranking.Rank,
+	}
+}
+
+func ToRankingsResponse(rankings []models.Ranking) []dtos.RankingDtoResponse {
+	out := make([]dtos.RankingDtoResponse, 0, len(rankings))
+
+	for _, r := range rankings {
+		dto := ToRankingResponse(r)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,89 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToResearchActionCreationRequest(
+	playerId uuid.UUID,
+	dto dtos.ResearchActionDtoRequest,
+) request.ResearchActionCreationRequest {
+	return request.ResearchActionCreationRequest{
+		Player:     playerId,
+		Planet:     dto.Planet,
+		Technology: dto.Technology,
+	}
+}
+
+func ToResearchResponse(research models.Research) dtos.ResearchDtoResponse {
+	dto := dtos.ResearchDtoResponse{
+		Player:       research.Player,
+		Technologies: toPlayerTechnologiesResponse(research.Technologies),
+	}
+
+	if research.Action != nil {
+		action := ToResearchActionResponse(*research.Action)
+		dto.Action = &action
+	}
+
+	return dto
+}
+
+func ToResearchActionResponse(action models.ResearchAction) dtos.ResearchActionDtoResponse {
+	return dtos.ResearchActionDtoResponse{
+		Id:           action.Id,
+		Planet:       action.Planet,
+		Technology:   action.Technology,
+		DesiredLevel: action.DesiredLevel,
+		CreatedAt:    action.CreatedAt,
+		CompletedAt:  action.CompletedAt,
+		Costs:        toResearchActionCostsResponse(action.Costs),
+	}
+}
+
+func toPlayerTechnologyResponse(
+	technology models.PlayerTechnology,
+) dtos.PlayerTechnologyDtoResponse {
+	return dtos.PlayerTechnologyDtoResponse{
+		Technology: technology.Technology,
+		Level:      technology.Level,
+	}
+}
+
+func toPlayerTechnologiesResponse(
+	technologies []models.PlayerTechnology,
+) []dtos.PlayerTechnologyDtoResponse {
+	out := make([]dtos.PlayerTechnologyDtoResponse, 0, len(technologies))
+
+	for _, t := range technologies {
+		dto := toPlayerTechnologyResponse(t)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toResearchActionCostResponse(
+	cost models.ResearchActionCost,
+) dtos.ResearchActionCostDtoResponse {
+	return dtos.ResearchActionCostDtoResponse{
+		Resource: cost.Resource,
+		Amount:   cost.Amount,
+	}
+}
+
+func toResearchActionCostsResponse(
+	costs []models.ResearchActionCost,
+) []dtos.ResearchActionCostDtoResponse {
+	out := make([]dtos.ResearchActionCostDtoResponse, 0, len(costs))
+
+	for _, c := range costs {
+		dto := toResearchActionCostResponse(c)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToShipyardActionCreationRequest(
+	planetId uuid.UUID,
+	dto dtos.ShipyardActionDtoRequest,
+) request.ShipyardActionCreationRequest {
+	return request.ShipyardActionCreationRequest{
+		Planet: planetId,
+		Kind:   models.ShipyardUnitKind(dto.Kind),
+		Unit:   dto.Unit,
+		Count:  dto.Count,
+	}
+}
+
+func ToShipyardActionResponse(action models.ShipyardAction) dtos.ShipyardActionDtoResponse {
+	return dtos.ShipyardActionDtoResponse{
+		Id:                  action.Id,
+		Kind:                string(action.Kind),
+		Unit:                action.Unit,
+		Count:               action.Count,
+		CreatedAt:           action.CreatedAt,
+		NextUnitCompletedAt: action.NextUnitCompletedAt(),
+		CompletedAt:         action.CompletedAt,
+		Costs:               toShipyardActionCostsResponse(action.Costs),
+	}
+}
+
+func toShipyardActionsResponse(
+	actions []models.ShipyardAction,
+) []dtos.ShipyardActionDtoResponse {
+	out := make([]dtos.ShipyardActionDtoResponse, 0, len(actions))
+
+	for _, a := range actions {
+		dto := ToShipyardActionResponse(a)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toShipyardActionCostResponse(
+	cost models.ShipyardActionCost,
+) dtos.ShipyardActionCostDtoResponse {
+	return dtos.ShipyardActionCostDtoResponse{
+		Resource: cost.Resource,
+		Amount:   cost.Amount,
+	}
+}
+
+func toShipyardActionCostsResponse(
+	costs []models.ShipyardActionCost,
+) []dtos.ShipyardActionCostDtoResponse {
+	out := make([]dtos.ShipyardActionCostDtoResponse, 0, len(costs))
+
+	for _, c := range costs {
+		dto := toShipyardActionCostResponse(c)
+		out = append(out, dto)
+	}
+
+	return out
+}
@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+func ToTradeRequest(planetId uuid.UUID, dto dtos.TradeDtoRequest) request.TradeRequest {
+	return request.TradeRequest{
+		Planet: planetId,
+		Sold:   dto.Sold,
+		Bought: dto.Bought,
+		Amount: dto.Amount,
+	}
+}
+
+func ToTradeResponse(trade models.Trade) dtos.TradeDtoResponse {
+	return dtos.TradeDtoResponse{
+		Planet: trade.Planet,
+		Sold: dtos.TradedResourceDtoResponse{
+			Resource: trade.Sold,
+			Amount:   trade.SoldAmount,
+		},
+		Bought: dtos.TradedResourceDtoResponse{
+			Resource: trade.Bought,
+			Amount:   trade.BoughtAmount,
+		},
+		CreatedAt: trade.CreatedAt,
+	}
+}
@@ -25,6 +25,12 @@ func ToUniverseResponse(universe models.Universe) dtos.UniverseDtoResponse {
 		Topology:  toTopologyResponse(universe.Topology),
 		Resources: toResourcesResponse(universe.Resources),
 		Buildings: toBuildingsResponse(universe.Buildings),
+		Ships:     toShipsResponse(universe.Ships),
+		Defenses:  toDefensesResponse(universe.Defenses),
+
+		Technologies: toTechnologiesResponse(universe.Technologies),
+
+		ExchangeRates: toExchangeRatesResponse(universe.ExchangeRates),
 	}
 }
 
@@ -78,12 +84,14 @@ func toBuildingResponse(
 	building models.Building,
 ) dtos.BuildingDtoResponse {
 	return dtos.BuildingDtoResponse{
-		Id:          building.Id,
-		Name:        building.Name,
-		CreatedAt:   building.CreatedAt,
-		Costs:       toBuildingCostsResponse(building.Costs),
-		Productions: toBuildingProductionsResponse(building.Productions),
-		Storages:    toBuildingStoragesResponse(building.Storages),
+		Id:           building.Id,
+		Name:         building.Name,
+		CreatedAt:    building.CreatedAt,
+		Costs:        toBuildingCostsResponse(building.Costs),
+		Productions:  toBuildingProductionsResponse(building.Productions),
+		Consumptions: toBuildingConsumptionsResponse(building.Consumptions),
+		Storages:     toBuildingStoragesResponse(building.Storages),
+		Requirements: toBuildingRequirementsResponse(building.Requirements),
 	}
 }
 
@@ -154,6 +162,33 @@ func toBuildingProductionsResponse(
 	return out
 }
 
+func toBuildingConsumptionResponse(
+	consumption models.BuildingResourceConsumption,
+) dtos.BuildingResourceConsumptionDtoResponse {
+	return dtos.BuildingResourceConsumptionDtoResponse{
+		Resource: consumption.Resource,
+		Base:     consumption.Base,
+		Progress: consumption.Progress,
+	}
+}
+
+func toBuildingConsumptionsResponse(
+	consumptions []models.BuildingResourceConsumption,
+) []dtos.BuildingResourceConsumptionDtoResponse {
+	if consumptions == nil {
+		return nil
+	}
+
+	out := make([]dtos.BuildingResourceConsumptionDtoResponse, 0, len(consumptions))
+
+	for _, c := range consumptions {
+		dto := toBuildingConsumptionResponse(c)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
 func toBuildingStorageResponse(
 	storage models.BuildingResourceStorage,
 ) dtos.BuildingResourceStorageDtoResponse {
@@ -181,3 +216,208 @@ func toBuildingStoragesResponse(
 
 	return out
 }
+
+func toBuildingRequirementResponse(
+	requirement models.BuildingRequirement,
+) dtos.BuildingRequirementDtoResponse {
+	return dtos.BuildingRequirementDtoResponse{
+		Building: requirement.Building,
+		Level:    requirement.Level,
+	}
+}
+
+func toBuildingRequirementsResponse(
+	requirements []models.BuildingRequirement,
+) []dtos.BuildingRequirementDtoResponse {
+	if requirements == nil {
+		return nil
+	}
+
+	out := make([]dtos.BuildingRequirementDtoResponse, 0, len(requirements))
+
+	for _, r := range requirements {
+		dto := toBuildingRequirementResponse(r)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toShipResponse(
+	ship models.Ship,
+) dtos.ShipDtoResponse {
+	return dtos.ShipDtoResponse{
+		Id:            ship.Id,
+		Name:          ship.Name,
+		CreatedAt:     ship.CreatedAt,
+		Speed:         ship.Speed,
+		CargoCapacity: ship.CargoCapacity,
+		Costs:         toUnitCostsResponse(ship.Costs),
+	}
+}
+
+func toShipsResponse(
+	ships []models.Ship,
+) []dtos.ShipDtoResponse {
+	out := make([]dtos.ShipDtoResponse, 0, len(ships))
+
+	for _, s := range ships {
+		dto := toShipResponse(s)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toDefenseResponse(
+	defense models.Defense,
+) dtos.DefenseDtoResponse {
+	return dtos.DefenseDtoResponse{
+		Id:        defense.Id,
+		Name:      defense.Name,
+		CreatedAt: defense.CreatedAt,
+		Costs:     toUnitCostsResponse(defense.Costs),
+	}
+}
+
+func toDefensesResponse(
+	defenses []models.Defense,
+) []dtos.DefenseDtoResponse {
+	out := make([]dtos.DefenseDtoResponse, 0, len(defenses))
+
+	for _, d := range defenses {
+		dto := toDefenseResponse(d)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toTechnologyResponse(
+	technology models.Technology,
+) dtos.TechnologyDtoResponse {
+	return dtos.TechnologyDtoResponse{
+		Id:        technology.Id,
+		Name:      technology.Name,
+		CreatedAt: technology.CreatedAt,
+		Costs:     toTechnologyCostsResponse(technology.Costs),
+	}
+}
+
+func toTechnologiesResponse(
+	technologies []models.Technology,
+) []dtos.TechnologyDtoResponse {
+	out := make([]dtos.TechnologyDtoResponse, 0, len(technologies))
+
+	for _, t := range technologies {
+		dto := toTechnologyResponse(t)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toExchangeRatesResponse(
+	rates []models.ExchangeRate,
+) []dtos.ExchangeRateDtoResponse {
+	out := make([]dtos.ExchangeRateDtoResponse, 0, len(rates))
+
+	for _, r := range rates {
+		dto := dtos.ExchangeRateDtoResponse{
+			Resource: r.Resource,
+			Rate:     r.Rate,
+		}
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toTechnologyCostResponse(
+	cost models.TechnologyCost,
+) dtos.TechnologyCostDtoResponse {
+	return dtos.TechnologyCostDtoResponse{
+		Resource: cost.Resource,
+		Cost:     cost.Cost,
+		Progress: cost.Progress,
+	}
+}
+
+func toTechnologyCostsResponse(
+	costs []models.TechnologyCost,
+) []dtos.TechnologyCostDtoResponse {
+	if costs == nil {
+		return nil
+	}
+
+	out := make([]dtos.TechnologyCostDtoResponse, 0, len(costs))
+
+	for _, c := range costs {
+		dto := toTechnologyCostResponse(c)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func toUnitCostResponse(
+	cost models.UnitCost,
+) dtos.UnitCostDtoResponse {
+	return dtos.UnitCostDtoResponse{
+		Resource: cost.Resource,
+		Cost:     cost.Cost,
+	}
+}
+
+func toUnitCostsResponse(
+	costs []models.UnitCost,
+) []dtos.UnitCostDtoResponse {
+	if costs == nil {
+		return nil
+	}
+
+	out := make([]dtos.UnitCostDtoResponse, 0, len(costs))
+
+	for _, c := range costs {
+		dto := toUnitCostResponse(c)
+		out = append(out, dto)
+	}
+
+	return out
+}
+
+func ToSolarSystemResponse(system models.SolarSystem) dtos.SolarSystemDtoResponse {
+	out := dtos.SolarSystemDtoResponse{
+		Universe:    system.Universe,
+		Galaxy:      system.Galaxy,
+		SolarSystem: system.SolarSystem,
+		Slots:       make([]dtos.SolarSystemSlotDtoResponse, 0, len(system.Slots)),
+	}
+
+	for _, s := range system.Slots {
+		dto := toSolarSystemSlotResponse(s)
+		out.Slots = append(out.Slots, dto)
+	}
+
+	return out
+}
+
+func toSolarSystemSlotResponse(
+	slot models.SolarSystemSlot,
+) dtos.SolarSystemSlotDtoResponse {
+	out := dtos.SolarSystemSlotDtoResponse{
+		Position: slot.Position,
+	}
+
+	if slot.Planet != nil {
+		out.Planet = &dtos.SolarSystemPlanetDtoResponse{
+			Id:        slot.Planet.Id,
+			Name:      slot.Planet.Name,
+			Player:    slot.Planet.PlayerName,
+			Alliance:  slot.Planet.AllianceTag,
+			Homeworld: slot.Planet.Homeworld,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,226 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func MarketOfferEndpoints(usecase drivingports.ForManagingMarketOffer) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(postMarketOffer, usecase)
+	post := rest.NewRoute(http.MethodPost, "/planets/:id/offers", handler)
+	out = append(out, post)
+
+	handler = generateHandler(listMarketOffers, usecase)
+	list := rest.NewRoute(http.MethodGet, "/universes/:id/offers", handler)
+	out = append(out, list)
+
+	handler = generateHandler(acceptMarketOffer, usecase)
+	accept := rest.NewRoute(http.MethodPost, "/offers/:id/accept", handler)
+	out = append(out, accept)
+
+	handler = generateHandler(cancelMarketOffer, usecase)
+	delete := rest.NewRoute(http.MethodDelete, "/offers/:id", handler)
+	out = append(out, delete)
+
+	return out
+}
+
+// postMarketOffer godoc
+//
+//	@Summary		Post market offer
+//	@Description	Puts the offered resource of the planet provided in path parameter in escrow and posts an offer on the market of its universe. The escrow is refunded if the offer is cancelled or expires.
+//	@Tags			market
+//	@Produce		json
+//	@Param			id		path		string						true	"Planet id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.MarketOfferDtoRequest	true	"Offer payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.MarketOfferDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/offers [post]
+func postMarketOffer(c *echo.Context, usecase drivingports.ForManagingMarketOffer) error {
+	maybeId := c.Param("id")
+	planetId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.MarketOfferDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid offer syntax")
+	}
+
+	request := mappers.ToMarketOfferCreationRequest(planetId, inputDto)
+	offer, err := usecase.Post(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrInvalidMarketOffer {
+			return c.JSON(http.StatusBadRequest, "invalid offer")
+		}
+
+		if err == domainerrors.ErrNotEnoughResources {
+			return c.JSON(http.StatusBadRequest, "not enough resources")
+		}
+
+		c.Logger().Error("Failed to post market offer", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to post market offer")
+	}
+
+	out := mappers.ToMarketOfferResponse(offer)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// listMarketOffers godoc
+//
+//	@Summary		List market offers
+//	@Description	Returns the open offers of the universe provided in path parameter, most recent first.
+//	@Tags			market
+//	@Produce		json
+//	@Param			id		path		string	true	"Universe id (UUID)"	Format(uuid)
+//	@Param			page	query		int		false	"Page index, starting at 0"	minimum(0)	default(0)
+//	@Param			size	query		int		false	"Page size"					minimum(1)	maximum(100)	default(20)
+//	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.MarketOfferDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/universes/{id}/offers [get]
+func listMarketOffers(c *echo.Context, usecase drivingports.ForManagingMarketOffer) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	page, err := fetchPageFromQueryParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid pagination")
+	}
+
+	offers, err := usecase.ListForUniverse(c.Request().Context(), id, page)
+	if err != nil {
+		c.Logger().Error("Failed to list market offers", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list market offers")
+	}
+
+	out := mappers.ToMarketOffersResponse(offers)
+	return c.JSON(http.StatusOK, out)
+}
+
+// acceptMarketOffer godoc
+//
+//	@Summary		Accept market offer
+//	@Description	Pays for the offer provided in path parameter with the resources of the planet of the buyer, which receives the escrow. An offer can only be accepted once.
+//	@Tags			market
+//	@Produce		json
+//	@Param			id		path		string									true	"Offer id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.MarketOfferAcceptanceDtoRequest	true	"Acceptance payload"
+//	@Success		200		{object}	rest.ResponseEnvelope[dtos.MarketOfferDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/offers/{id}/accept [post]
+func acceptMarketOffer(c *echo.Context, usecase drivingports.ForManagingMarketOffer) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.MarketOfferAcceptanceDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid acceptance syntax")
+	}
+
+	request := mappers.ToMarketOfferAcceptanceRequest(id, inputDto)
+	offer, err := usecase.Accept(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrMarketOfferNotFound {
+			return c.JSON(http.StatusNotFound, "no such offer")
+		}
+
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrInvalidMarketOffer {
+			return c.JSON(http.StatusBadRequest, "invalid offer")
+		}
+
+		if err == domainerrors.ErrNotEnoughResources {
+			return c.JSON(http.StatusBadRequest, "not enough resources")
+		}
+
+		if err == domainerrors.ErrMarketOfferNotAvailable {
+			return c.JSON(http.StatusConflict, "offer is not available")
+		}
+
+		c.Logger().Error("Failed to accept market offer", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to accept market offer")
+	}
+
+	out := mappers.ToMarketOfferResponse(offer)
+	return c.JSON(http.StatusOK, out)
+}
+
+// cancelMarketOffer godoc
+//
+//	@Summary		Cancel market offer
+//	@Description	Cancels an open offer and refunds the escrow to the planet it was posted from.
+//	@Tags			market
+//	@Produce		json
+//	@Param			id	path		string	true	"Offer id (UUID)"	Format(uuid)
+//	@Success		204	{string}	string
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		409	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/offers/{id} [delete]
+func cancelMarketOffer(c *echo.Context, usecase drivingports.ForManagingMarketOffer) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	err = usecase.Cancel(c.Request().Context(), id)
+	if err != nil {
+		if err == domainerrors.ErrMarketOfferNotFound || err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such offer")
+		}
+
+		if err == domainerrors.ErrMarketOfferNotAvailable {
+			return c.JSON(http.StatusConflict, "offer is not available")
+		}
+
+		c.Logger().Error("Failed to cancel market offer", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to cancel market offer")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,533 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var sampleOfferPlanetId = uuid.MustParse("5c0e4f3a-7d2b-4a1e-9f6c-3b8d2e1a4c7f")
+
+func TestUnit_MarketOffers_PostMarketOffer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingMarketOffer(ctrl)
+
+	t.Run("returns 400 when planet id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleMarketOfferDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid offer syntax", actual)
+	})
+
+	t.Run("forwards offer to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleMarketOfferDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.MarketOfferCreationRequest{
+			Planet:            sampleUuid,
+			OfferedResource:   sampleResourceId,
+			OfferedAmount:     500,
+			RequestedResource: sampleBoughtResourceId,
+			RequestedAmount:   250,
+			DurationHours:     12,
+		}
+		offer := sampleMarketOffer()
+
+		mockUsecase.EXPECT().
+			Post(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(offer, nil)
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.MarketOfferDtoResponse](t, rw)
+		assert.Equal(t, sampleMarketOfferDtoResponse(offer), actual)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleMarketOfferDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Post(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrNotFound)
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 400 when offer is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleMarketOfferDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Post(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrInvalidMarketOffer)
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid offer", actual)
+	})
+
+	t.Run("returns 400 when not enough resources", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleMarketOfferDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Post(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrNotEnoughResources)
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not enough resources", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleMarketOfferDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Post(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, errors.New("stubbed error"))
+
+		err := postMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to post market offer", actual)
+	})
+}
+
+func TestUnit_MarketOffers_ListMarketOffers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingMarketOffer(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := listMarketOffers(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when pagination is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "size", "0")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := listMarketOffers(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid pagination", actual)
+	})
+
+	t.Run("forwards listing to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "1")
+		addQueryParam(t, req, "size", "10")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		offer := sampleMarketOffer()
+		expectedPage := request.PageRequest{Page: 1, Size: 10}
+		mockUsecase.EXPECT().
+			ListForUniverse(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(expectedPage)).
+			Times(1).
+			Return([]models.MarketOffer{offer}, nil)
+
+		err := listMarketOffers(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.MarketOfferDtoResponse](t, rw)
+		expected := []dtos.MarketOfferDtoResponse{sampleMarketOfferDtoResponse(offer)}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("return empty slice when use case returns nil response", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForUniverse(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, nil)
+
+		err := listMarketOffers(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.MarketOfferDtoResponse](t, rw)
+		assert.Equal(t, []dtos.MarketOfferDtoResponse{}, actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForUniverse(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listMarketOffers(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list market offers", actual)
+	})
+}
+
+func TestUnit_MarketOffers_AcceptMarketOffer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingMarketOffer(ctrl)
+
+	body := dtos.MarketOfferAcceptanceDtoRequest{Planet: sampleOfferPlanetId}
+
+	t.Run("returns 400 when offer id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid acceptance syntax", actual)
+	})
+
+	t.Run("forwards acceptance to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.MarketOfferAcceptanceRequest{
+			Offer:  sampleUuid,
+			Planet: sampleOfferPlanetId,
+		}
+		offer := sampleMarketOffer()
+		buyer := uuid.New()
+		acceptedAt := someTime.Add(time.Hour)
+		offer.Status = models.AcceptedOffer
+		offer.Buyer = &buyer
+		offer.BuyerPlanet = &sampleOfferPlanetId
+		offer.AcceptedAt = &acceptedAt
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(offer, nil)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.MarketOfferDtoResponse](t, rw)
+		assert.Equal(t, sampleMarketOfferDtoResponse(offer), actual)
+	})
+
+	t.Run("returns 404 when offer is not found", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrMarketOfferNotFound)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such offer", actual)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrNotFound)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 400 when offer is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrInvalidMarketOffer)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid offer", actual)
+	})
+
+	t.Run("returns 400 when not enough resources", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrNotEnoughResources)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not enough resources", actual)
+	})
+
+	t.Run("returns 409 when offer is not available", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrMarketOfferNotAvailable)
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "offer is not available", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, body)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Accept(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.MarketOffer{}, errors.New("stubbed error"))
+
+		err := acceptMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to accept market offer", actual)
+	})
+}
+
+func TestUnit_MarketOffers_CancelMarketOffer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingMarketOffer(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := cancelMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards cancellation to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(nil)
+
+		err := cancelMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 404 when offer does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrMarketOfferNotFound)
+
+		err := cancelMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such offer", actual)
+	})
+
+	t.Run("returns 409 when offer is not available", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrMarketOfferNotAvailable)
+
+		err := cancelMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "offer is not available", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Cancel(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(errors.New("stubbed error"))
+
+		err := cancelMarketOffer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to cancel market offer", actual)
+	})
+}
+
+func sampleMarketOfferDtoRequest() dtos.MarketOfferDtoRequest {
+	return dtos.MarketOfferDtoRequest{
+		Offered: dtos.TradedResourceDtoRequest{
+			Resource: sampleResourceId,
+			Amount:   500,
+		},
+		Requested: dtos.TradedResourceDtoRequest{
+			Resource: sampleBoughtResourceId,
+			Amount:   250,
+		},
+		Duration: 12,
+	}
+}
+
+func sampleMarketOffer() models.MarketOffer {
+	return models.MarketOffer{
+		Id:                uuid.New(),
+		Universe:          uuid.New(),
+		Player:            uuid.New(),
+		Planet:            sampleUuid,
+		OfferedResource:   sampleResourceId,
+		OfferedAmount:     500,
+		RequestedResource: sampleBoughtResourceId,
+		RequestedAmount:   250,
+		Status:            models.OpenOffer,
+		ExpiresAt:         someTime.Add(12 * time.Hour),
+		CreatedAt:         someTime,
+		Version:           1,
+	}
+}
+
+func sampleMarketOfferDtoResponse(offer models.MarketOffer) dtos.MarketOfferDtoResponse {
+	return dtos.MarketOfferDtoResponse{
+		Id:       offer.Id,
+		Universe: offer.Universe,
+		Player:   offer.Player,
+		Planet:   offer.Planet,
+		Offered: dtos.TradedResourceDtoResponse{
+			Resource: offer.OfferedResource,
+			Amount:   offer.OfferedAmount,
+		},
+		Requested: dtos.TradedResourceDtoResponse{
+			Resource: offer.RequestedResource,
+			Amount:   offer.RequestedAmount,
+		},
+		Status:     string(offer.Status),
+		Buyer:      offer.Buyer,
+		AcceptedAt: offer.AcceptedAt,
+		ExpiresAt:  offer.ExpiresAt,
+		CreatedAt:  offer.CreatedAt,
+	}
+}
@@ -0,0 +1,216 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func MessageEndpoints(usecase drivingports.ForExchangingMessages) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(sendMessage, usecase)
+	post := rest.NewRoute(http.MethodPost, "/players/:id/messages", handler)
+	out = append(out, post)
+
+	handler = generateHandler(listMessages, usecase)
+	list := rest.NewRoute(http.MethodGet, "/players/:id/messages", handler)
+	out = append(out, list)
+
+	handler = generateHandler(markMessageAsRead, usecase)
+	patch := rest.NewRoute(http.MethodPatch, "/players/:id/messages/:message", handler)
+	out = append(out, patch)
+
+	handler = generateHandler(deleteMessage, usecase)
+	delete := rest.NewRoute(http.MethodDelete, "/players/:id/messages/:message", handler)
+	out = append(out, delete)
+
+	return out
+}
+
+// sendMessage godoc
+//
+//	@Summary		Send message
+//	@Description	Sends a message from the player provided in path parameter to the recipient of the payload. Both players need to be part of the same universe.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id		path		string					true	"Sender id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.MessageDtoRequest	true	"Message payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.MessageDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/messages [post]
+func sendMessage(c *echo.Context, usecase drivingports.ForExchangingMessages) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.MessageDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid message syntax")
+	}
+
+	request := mappers.ToMessageCreationRequest(id, inputDto)
+	message, err := usecase.Send(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrPlayerNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		if err == domainerrors.ErrInvalidMessage {
+			return c.JSON(http.StatusBadRequest, "invalid message")
+		}
+
+		if err == domainerrors.ErrPlayersInDifferentUniverse {
+			return c.JSON(http.StatusConflict, "players are in different universes")
+		}
+
+		c.Logger().Error("Failed to send message", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to send message")
+	}
+
+	out := mappers.ToMessageResponse(message)
+	return c.JSON(http.StatusCreated, out)
+}
+
+// listMessages godoc
+//
+//	@Summary		List messages
+//	@Description	Returns the messages received by a player, most recent first.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id		path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Param			page	query		int		false	"Page index, starting at 0"	minimum(0)	default(0)
+//	@Param			size	query		int		false	"Page size"					minimum(1)	maximum(100)	default(20)
+//	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.MessageDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/messages [get]
+func listMessages(c *echo.Context, usecase drivingports.ForExchangingMessages) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	page, err := fetchPageFromQueryParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid pagination")
+	}
+
+	messages, err := usecase.ListForPlayer(c.Request().Context(), id, page)
+	if err != nil {
+		if err == domainerrors.ErrPlayerNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		c.Logger().Error("Failed to list messages", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list messages")
+	}
+
+	out := mappers.ToMessagesResponse(messages)
+	return c.JSON(http.StatusOK, out)
+}
+
+// markMessageAsRead godoc
+//
+//	@Summary		Mark message as read
+//	@Description	Marks a message received by the player as read.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id		path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Param			message	path		string	true	"Message id (UUID)"	Format(uuid)
+//	@Success		204		{string}	string
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/messages/{message} [patch]
+func markMessageAsRead(c *echo.Context, usecase drivingports.ForExchangingMessages) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybeMessage := c.Param("message")
+	message, err := uuid.Parse(maybeMessage)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid message id syntax")
+	}
+
+	err = usecase.MarkAsRead(c.Request().Context(), id, message)
+	if err != nil {
+		if err == domainerrors.ErrMessageNotFound || err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such message")
+		}
+
+		c.Logger().Error("Failed to mark message as read", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to mark message as read")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteMessage godoc
+//
+//	@Summary		Delete message
+//	@Description	Deletes a message received by the player.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id		path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Param			message	path		string	true	"Message id (UUID)"	Format(uuid)
+//	@Success		204		{string}	string
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/messages/{message} [delete]
+func deleteMessage(c *echo.Context, usecase drivingports.ForExchangingMessages) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	maybeMessage := c.Param("message")
+	message, err := uuid.Parse(maybeMessage)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid message id syntax")
+	}
+
+	err = usecase.Delete(c.Request().Context(), id, message)
+	if err != nil {
+		if err == domainerrors.ErrMessageNotFound || err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such message")
+		}
+
+		c.Logger().Error("Failed to delete message", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to delete message")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
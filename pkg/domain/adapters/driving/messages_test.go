@@ -0,0 +1,445 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Messages_SendMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForExchangingMessages(ctrl)
+
+	dto := dtos.MessageDtoRequest{
+		Recipient: uuid.New(),
+		Subject:   "hello",
+		Content:   "how are you?",
+	}
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid message syntax", actual)
+	})
+
+	t.Run("forwards sending to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.MessageCreationRequest{
+			Sender:    sampleUuid,
+			Recipient: dto.Recipient,
+			Subject:   dto.Subject,
+			Content:   dto.Content,
+		}
+		messageId := uuid.New()
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(models.Message{
+				Id:        messageId,
+				Kind:      models.PlayerMessage,
+				Player:    dto.Recipient,
+				Sender:    &sampleUuid,
+				Subject:   dto.Subject,
+				Content:   dto.Content,
+				CreatedAt: someTime,
+			}, nil)
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.MessageDtoResponse](t, rw)
+		expected := dtos.MessageDtoResponse{
+			Id:        messageId,
+			Kind:      "player",
+			Player:    dto.Recipient,
+			Sender:    &sampleUuid,
+			Subject:   dto.Subject,
+			Content:   dto.Content,
+			CreatedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when player does not exist", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Message{}, domainerrors.ErrPlayerNotFound)
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such player", actual)
+	})
+
+	t.Run("returns 400 when message is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Message{}, domainerrors.ErrInvalidMessage)
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid message", actual)
+	})
+
+	t.Run("returns 409 when players are in different universes", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Message{}, domainerrors.ErrPlayersInDifferentUniverse)
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "players are in different universes", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Message{}, errors.New("stubbed error"))
+
+		err := sendMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to send message", actual)
+	})
+}
+
+func TestUnit_Messages_ListMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForExchangingMessages(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := listMessages(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when pagination is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "-1")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := listMessages(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid pagination", actual)
+	})
+
+	t.Run("forwards listing to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "1")
+		addQueryParam(t, req, "size", "10")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		message := models.Message{
+			Id:        uuid.New(),
+			Kind:      models.SystemMessage,
+			Player:    sampleUuid,
+			Subject:   "Building upgraded",
+			Content:   "Building metal mine reached level 2 on planet homeworld.",
+			Read:      true,
+			CreatedAt: someTime,
+		}
+		expectedPage := request.PageRequest{Page: 1, Size: 10}
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(expectedPage)).
+			Times(1).
+			Return([]models.Message{message}, nil)
+
+		err := listMessages(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.MessageDtoResponse](t, rw)
+		expected := []dtos.MessageDtoResponse{
+			{
+				Id:        message.Id,
+				Kind:      "system",
+				Player:    sampleUuid,
+				Subject:   "Building upgraded",
+				Content:   "Building metal mine reached level 2 on planet homeworld.",
+				Read:      true,
+				CreatedAt: someTime,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("return empty slice when use case returns nil response", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, nil)
+
+		err := listMessages(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.MessageDtoResponse](t, rw)
+		assert.Equal(t, []dtos.MessageDtoResponse{}, actual)
+	})
+
+	t.Run("returns 404 when player does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrPlayerNotFound)
+
+		err := listMessages(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such player", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listMessages(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list messages", actual)
+	})
+}
+
+func TestUnit_Messages_MarkMessageAsRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForExchangingMessages(ctrl)
+
+	messageId := uuid.New()
+	addMessagePathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "message", Value: messageId.String()},
+		})
+	}
+
+	t.Run("returns 400 when message id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPatch)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "message", Value: "not-a-uuid"},
+		})
+
+		err := markMessageAsRead(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid message id syntax", actual)
+	})
+
+	t.Run("forwards update to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPatch)
+		ctx, rw := generateTestContextFromRequest(t, req, addMessagePathParams)
+
+		mockUsecase.EXPECT().
+			MarkAsRead(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(messageId)).
+			Times(1).
+			Return(nil)
+
+		err := markMessageAsRead(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 404 when message does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPatch)
+		ctx, rw := generateTestContextFromRequest(t, req, addMessagePathParams)
+
+		mockUsecase.EXPECT().
+			MarkAsRead(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrMessageNotFound)
+
+		err := markMessageAsRead(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such message", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodPatch)
+		ctx, rw := generateTestContextFromRequest(t, req, addMessagePathParams)
+
+		mockUsecase.EXPECT().
+			MarkAsRead(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(errors.New("stubbed error"))
+
+		err := markMessageAsRead(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to mark message as read", actual)
+	})
+}
+
+func TestUnit_Messages_DeleteMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForExchangingMessages(ctrl)
+
+	messageId := uuid.New()
+	addMessagePathParams := func(t *testing.T, c *echo.Context) {
+		t.Helper()
+
+		c.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "message", Value: messageId.String()},
+		})
+	}
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: "not-a-uuid"},
+			{Name: "message", Value: messageId.String()},
+		})
+
+		err := deleteMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards deletion to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addMessagePathParams)
+
+		mockUsecase.EXPECT().
+			Delete(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(messageId)).
+			Times(1).
+			Return(nil)
+
+		err := deleteMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	t.Run("returns 404 when message does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addMessagePathParams)
+
+		mockUsecase.EXPECT().
+			Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrMessageNotFound)
+
+		err := deleteMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such message", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodDelete)
+		ctx, rw := generateTestContextFromRequest(t, req, addMessagePathParams)
+
+		mockUsecase.EXPECT().
+			Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(errors.New("stubbed error"))
+
+		err := deleteMessage(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to delete message", actual)
+	})
+}
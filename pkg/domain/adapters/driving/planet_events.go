@@ -0,0 +1,110 @@
+package drivingadapters
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+// heartbeatInterval is the time between two comments sent on an idle
+// stream. It prevents proxies from closing the connection.
+const heartbeatInterval = 15 * time.Second
+
+func PlanetEventEndpoints(usecase drivingports.ForWatchingPlanetChanges) rest.Routes {
+	var out rest.Routes
+
+	// The stream is not a JSON document: it can't be wrapped in the
+	// response envelope.
+	handler := generateHandler(streamPlanetEvents, usecase)
+	stream := rest.NewRawRoute(http.MethodGet, "/players/:id/events", handler)
+	out = append(out, stream)
+
+	return out
+}
+
+// streamPlanetEvents godoc
+//
+//	@Summary		Stream changes of planets
+//	@Description	Streams as Server-Sent Events the changes committed to the planets of a player. Each change is sent as a `planet` event with the kind of the mutation; clients are expected to fetch the planet again to get its new values. Browsers can't set the Authorization header on an EventSource: the token can also be passed in the `access_token` query parameter or cookie.
+//	@Tags			players
+//	@Produce		text/event-stream
+//	@Param			id				path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Param			access_token	query		string	false	"Token, when it can't be passed in the Authorization header"
+//	@Success		200				{object}	dtos.PlanetChangeDtoResponse
+//	@Failure		400				{string}	string
+//	@Failure		401				{string}	string
+//	@Failure		403				{string}	string
+//	@Failure		404				{string}	string
+//	@Failure		500				{string}	string
+//	@Security		BearerAuth
+//	@Router			/players/{id}/events [get]
+func streamPlanetEvents(c *echo.Context, usecase drivingports.ForWatchingPlanetChanges) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	ctx := c.Request().Context()
+	changes, err := usecase.WatchPlayer(ctx, id)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		c.Logger().Error("Failed to watch player", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to watch player")
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	c.Response().WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(c.Response())
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(mappers.ToPlanetChangeResponse(change))
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(c.Response(), "event: planet\ndata: %s\n\n", data)
+			if err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			_, err := fmt.Fprint(c.Response(), ": heartbeat\n\n")
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
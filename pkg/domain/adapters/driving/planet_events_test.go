@@ -0,0 +1,95 @@
+package drivingadapters
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_PlanetEvents_StreamPlanetEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForWatchingPlanetChanges(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := streamPlanetEvents(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("streams changes of player", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		planet := uuid.MustParse("7c1d6a0e-3b58-4f2a-9e41-5d8c0b7f2a36")
+		changes := make(chan models.PlanetChange, 2)
+		changes <- models.PlanetChange{Planet: planet, Player: sampleUuid, Version: 4, Kind: models.PlanetActionCreated}
+		changes <- models.PlanetChange{Planet: planet, Player: sampleUuid, Version: 4, Kind: models.PlanetDeleted, Deleted: true}
+		close(changes)
+
+		mockUsecase.EXPECT().
+			WatchPlayer(gomock.Any(), sampleUuid).
+			Times(1).
+			Return(changes, nil)
+
+		err := streamPlanetEvents(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "text/event-stream", rw.Header().Get("Content-Type"))
+		expected := "event: planet\n" +
+			`data: {"planet":"7c1d6a0e-3b58-4f2a-9e41-5d8c0b7f2a36","version":4,"kind":"action_created","deleted":false}` + "\n\n" +
+			"event: planet\n" +
+			`data: {"planet":"7c1d6a0e-3b58-4f2a-9e41-5d8c0b7f2a36","version":4,"kind":"deletion","deleted":true}` + "\n\n"
+		assert.Equal(t, expected, rw.Body.String())
+	})
+
+	t.Run("returns 404 when player does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			WatchPlayer(gomock.Any(), sampleUuid).
+			Times(1).
+			Return(nil, domainerrors.ErrNotFound)
+
+		err := streamPlanetEvents(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such player", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			WatchPlayer(gomock.Any(), sampleUuid).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := streamPlanetEvents(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to watch player", actual)
+	})
+}
@@ -0,0 +1,66 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func PlanetHistoryEndpoints(usecase drivingports.ForListingPlanetHistory) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(listPlanetHistory, usecase)
+	list := rest.NewRoute(http.MethodGet, "/planets/:id/history", handler)
+	out = append(out, list)
+
+	return out
+}
+
+// listPlanetHistory godoc
+//
+//	@Summary		List planet history
+//	@Description	Returns the mutations committed to a planet, most recent first. Each event holds the version of the planet before and after the mutation, its kind and how the amount of each resource changed.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id		path		string	true	"Planet id (UUID)"	Format(uuid)
+//	@Param			page	query		int		false	"Page index, starting at 0"	minimum(0)	default(0)
+//	@Param			size	query		int		false	"Page size"					minimum(1)	maximum(100)	default(20)
+//	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.PlanetEventDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/history [get]
+func listPlanetHistory(c *echo.Context, usecase drivingports.ForListingPlanetHistory) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	page, err := fetchPageFromQueryParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid pagination")
+	}
+
+	events, err := usecase.ListHistory(c.Request().Context(), id, page)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		c.Logger().Error("Failed to list planet history", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list planet history")
+	}
+
+	out := mappers.ToPlanetEventsResponse(events)
+	return c.JSON(http.StatusOK, out)
+}
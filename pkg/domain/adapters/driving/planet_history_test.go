@@ -0,0 +1,144 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_PlanetHistory_ListPlanetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForListingPlanetHistory(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := listPlanetHistory(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when pagination is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "-1")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := listPlanetHistory(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid pagination", actual)
+	})
+
+	t.Run("forwards listing to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "1")
+		addQueryParam(t, req, "size", "5")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		event := models.PlanetEvent{
+			Id:            uuid.New(),
+			Planet:        sampleUuid,
+			Kind:          models.PlanetActionCreated,
+			VersionBefore: 7,
+			VersionAfter:  8,
+			Resources: []models.PlanetEventResource{
+				{Resource: sampleResourceId, Delta: -150.5, Produced: 12.5},
+			},
+			CreatedAt: someTime,
+		}
+		expectedPage := request.PageRequest{Page: 1, Size: 5}
+		mockUsecase.EXPECT().
+			ListHistory(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(expectedPage)).
+			Times(1).
+			Return([]models.PlanetEvent{event}, nil)
+
+		err := listPlanetHistory(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.PlanetEventDtoResponse](t, rw)
+		expected := []dtos.PlanetEventDtoResponse{
+			{
+				Id:            event.Id,
+				Planet:        sampleUuid,
+				Kind:          "action_created",
+				VersionBefore: 7,
+				VersionAfter:  8,
+				Resources: []dtos.PlanetEventResourceDtoResponse{
+					{Resource: sampleResourceId, Delta: -150.5, Produced: 12.5},
+				},
+				CreatedAt: someTime,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("return empty slice when use case returns nil response", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListHistory(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, nil)
+
+		err := listPlanetHistory(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[[]dtos.PlanetEventDtoResponse](t, rw)
+		assert.Equal(t, []dtos.PlanetEventDtoResponse{}, actual)
+	})
+
+	t.Run("returns 404 when planet does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListHistory(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, domainerrors.ErrNotFound)
+
+		err := listPlanetHistory(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			ListHistory(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, errors.New("stubbed error"))
+
+		err := listPlanetHistory(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to list planet history", actual)
+	})
+}
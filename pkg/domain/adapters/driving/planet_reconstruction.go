@@ -0,0 +1,69 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func PlanetReconstructionEndpoints(usecase drivingports.ForReconstructingPlanet) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(reconstructPlanet, usecase)
+	get := rest.NewRoute(http.MethodGet, "/planets/:id/reconstruction", handler)
+	out = append(out, get)
+
+	return out
+}
+
+// reconstructPlanet godoc
+//
+//	@Summary		Rebuild planet from its history
+//	@Description	Replays the events of the planet onto the resources it started with to rebuild its state at a moment, by default the current time. When the moment is not older than the last update of the planet, the rebuilt state is compared to the stored one and the divergences are reported. Restricted to administrators.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id	path		string	true	"Planet id (UUID)"						Format(uuid)
+//	@Param			at	query		string	false	"Moment to rebuild the planet at (RFC 3339)"	Format(date-time)
+//	@Success		200	{object}	rest.ResponseEnvelope[dtos.PlanetReconstructionDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/reconstruction [get]
+func reconstructPlanet(c *echo.Context, usecase drivingports.ForReconstructingPlanet) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var moment time.Time
+	if maybeMoment := c.QueryParam("at"); maybeMoment != "" {
+		moment, err = time.Parse(time.RFC3339, maybeMoment)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, "invalid moment")
+		}
+	}
+
+	reconstruction, err := usecase.Reconstruct(c.Request().Context(), id, moment)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		c.Logger().Error("Failed to reconstruct planet", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to reconstruct planet")
+	}
+
+	out := mappers.ToPlanetReconstructionResponse(reconstruction)
+	return c.JSON(http.StatusOK, out)
+}
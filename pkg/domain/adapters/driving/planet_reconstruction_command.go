@@ -0,0 +1,75 @@
+package drivingadapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+)
+
+var (
+	errInvalidReconstructionArgs = errors.New("expected planet id and optional moment")
+	errPlanetDiverged            = errors.New("planet diverged from its events")
+)
+
+// PlanetReconstructionCommand rebuilds a planet from its event log from the
+// command line. It produces the same report as the admin route without
+// requiring a token, which is handy to audit planets from a script.
+type PlanetReconstructionCommand struct {
+	usecase drivingports.ForReconstructingPlanet
+	out     io.Writer
+}
+
+func NewPlanetReconstructionCommand(
+	usecase drivingports.ForReconstructingPlanet,
+	out io.Writer,
+) *PlanetReconstructionCommand {
+	return &PlanetReconstructionCommand{
+		usecase: usecase,
+		out:     out,
+	}
+}
+
+// Run expects the id of the planet, optionally followed by the moment (RFC
+// 3339) to rebuild it at. The report is written as JSON to the output and an
+// error is returned when it contains divergences.
+func (c *PlanetReconstructionCommand) Run(ctx context.Context, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errInvalidReconstructionArgs
+	}
+
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		return err
+	}
+
+	var moment time.Time
+	if len(args) > 1 {
+		moment, err = time.Parse(time.RFC3339, args[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	reconstruction, err := c.usecase.Reconstruct(ctx, id, moment)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(c.out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(mappers.ToPlanetReconstructionResponse(reconstruction)); err != nil {
+		return err
+	}
+
+	if len(reconstruction.Divergences) > 0 {
+		return errPlanetDiverged
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package drivingadapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_PlanetReconstructionCommand_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForReconstructingPlanet(ctrl)
+
+	t.Run("returns error when arguments are missing", func(t *testing.T) {
+		command := NewPlanetReconstructionCommand(mockUsecase, &bytes.Buffer{})
+
+		err := command.Run(t.Context(), nil)
+
+		assert.Equal(t, errInvalidReconstructionArgs, err)
+	})
+
+	t.Run("returns error when planet id is invalid", func(t *testing.T) {
+		command := NewPlanetReconstructionCommand(mockUsecase, &bytes.Buffer{})
+
+		err := command.Run(t.Context(), []string{"not-a-uuid"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("returns error when moment is invalid", func(t *testing.T) {
+		command := NewPlanetReconstructionCommand(mockUsecase, &bytes.Buffer{})
+
+		err := command.Run(t.Context(), []string{sampleUuid.String(), "yesterday"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("writes report of planet rebuilt at requested moment", func(t *testing.T) {
+		var out bytes.Buffer
+		command := NewPlanetReconstructionCommand(mockUsecase, &out)
+
+		reconstruction := models.PlanetReconstruction{
+			Planet:  sampleUuid,
+			At:      someTime,
+			Version: 2,
+			Events:  2,
+			Resources: []models.PlanetResource{
+				{Resource: sampleResourceId, Amount: 125},
+			},
+		}
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), sampleUuid, someTime).
+			Times(1).
+			Return(reconstruction, nil)
+
+		err := command.Run(t.Context(), []string{sampleUuid.String(), someTime.Format(time.RFC3339)})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		var actual dtos.PlanetReconstructionDtoResponse
+		err = json.Unmarshal(out.Bytes(), &actual)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := dtos.PlanetReconstructionDtoResponse{
+			Planet:  sampleUuid,
+			At:      someTime,
+			Version: 2,
+			Events:  2,
+			Resources: []dtos.PlanetResourceDtoResponse{
+				{Resource: sampleResourceId, Amount: 125},
+			},
+			Divergences: []dtos.PlanetDivergenceDtoResponse{},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("writes report and returns error when planet diverged", func(t *testing.T) {
+		var out bytes.Buffer
+		command := NewPlanetReconstructionCommand(mockUsecase, &out)
+
+		reconstruction := models.PlanetReconstruction{
+			Planet: sampleUuid,
+			Divergences: []models.PlanetDivergence{
+				{Kind: models.VersionDivergence, Rebuilt: 2, Stored: 3},
+			},
+		}
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), sampleUuid, time.Time{}).
+			Times(1).
+			Return(reconstruction, nil)
+
+		err := command.Run(t.Context(), []string{sampleUuid.String()})
+
+		assert.Equal(t, errPlanetDiverged, err)
+		assert.Contains(t, out.String(), `"kind": "version"`)
+	})
+
+	t.Run("returns error when use case fails", func(t *testing.T) {
+		var out bytes.Buffer
+		command := NewPlanetReconstructionCommand(mockUsecase, &out)
+
+		expectedErr := errors.New("stubbed error")
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.PlanetReconstruction{}, expectedErr)
+
+		err := command.Run(t.Context(), []string{sampleUuid.String()})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, out.String())
+	})
+}
@@ -0,0 +1,147 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_PlanetReconstruction_ReconstructPlanet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForReconstructingPlanet(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := reconstructPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when moment is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "at", "yesterday")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := reconstructPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid moment", actual)
+	})
+
+	t.Run("forwards reconstruction at current time to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, _ := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(time.Time{})).
+			Times(1).
+			Return(models.PlanetReconstruction{}, nil)
+
+		err := reconstructPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("forwards requested moment to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "at", someTime.Format(time.RFC3339))
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		building := uuid.New()
+		action := uuid.New()
+		reconstruction := models.PlanetReconstruction{
+			Planet:  sampleUuid,
+			At:      someTime,
+			Version: 4,
+			Events:  3,
+			Resources: []models.PlanetResource{
+				{Resource: sampleResourceId, Amount: 1250.5},
+			},
+			Divergences: []models.PlanetDivergence{
+				{Kind: models.VersionDivergence, At: someTime, Rebuilt: 4, Stored: 5},
+				{Kind: models.ResourceDivergence, Resource: sampleResourceId, At: someTime, Rebuilt: 1250.5, Stored: 1251},
+				{Kind: models.BuildingDivergence, Building: building, At: someTime, Rebuilt: 2, Stored: 3},
+				{Kind: models.ActionDivergence, Action: action, At: someTime, Rebuilt: 0, Stored: 1},
+			},
+		}
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(someTime)).
+			Times(1).
+			Return(reconstruction, nil)
+
+		err := reconstructPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.PlanetReconstructionDtoResponse](t, rw)
+		resource := sampleResourceId
+		expected := dtos.PlanetReconstructionDtoResponse{
+			Planet:  sampleUuid,
+			At:      someTime,
+			Version: 4,
+			Events:  3,
+			Resources: []dtos.PlanetResourceDtoResponse{
+				{Resource: sampleResourceId, Amount: 1250.5},
+			},
+			Divergences: []dtos.PlanetDivergenceDtoResponse{
+				{Kind: "version", At: someTime, Rebuilt: 4, Stored: 5},
+				{Kind: "resource", Resource: &resource, At: someTime, Rebuilt: 1250.5, Stored: 1251},
+				{Kind: "building", Building: &building, At: someTime, Rebuilt: 2, Stored: 3},
+				{Kind: "action", Action: &action, At: someTime, Rebuilt: 0, Stored: 1},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when planet does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.PlanetReconstruction{}, domainerrors.ErrNotFound)
+
+		err := reconstructPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Reconstruct(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.PlanetReconstruction{}, errors.New("stubbed error"))
+
+		err := reconstructPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to reconstruct planet", actual)
+	})
+}
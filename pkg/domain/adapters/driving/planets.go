@@ -39,8 +39,11 @@ func PlanetEndpoints(usecase drivingports.ForManagingPlanet) rest.Routes {
 //	@Param			id	path		string	true	"Planet id (UUID)"	Format(uuid)
 //	@Success		200	{object}	rest.ResponseEnvelope[dtos.PlanetDtoResponse]
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
 //	@Failure		404	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/planets/{id} [get]
 func getPlanet(c *echo.Context, usecase drivingports.ForManagingPlanet) error {
 	maybeId := c.Param("id")
@@ -72,7 +75,10 @@ func getPlanet(c *echo.Context, usecase drivingports.ForManagingPlanet) error {
 //	@Param			id	path		string	true	"Player id (UUID)"	Format(uuid)
 //	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.PlanetDtoResponse]
 //	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
 //	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/players/{id}/planets [get]
 func listPlanetsForPlayer(c *echo.Context, usecase drivingports.ForManagingPlanet) error {
 	maybeId := c.Param("id")
@@ -102,8 +108,11 @@ func listPlanetsForPlayer(c *echo.Context, usecase drivingports.ForManagingPlane
 //	@Param			id	path		string	true	"Planet id (UUID)"	Format(uuid)
 //	@Success		204	{string}	string
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
 //	@Failure		409	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/planets/{id} [delete]
 func deletePlanet(c *echo.Context, usecase drivingports.ForManagingPlanet) error {
 	maybeId := c.Param("id")
@@ -37,6 +37,7 @@ func TestUnit_Planets_GetPlanet(t *testing.T) {
 		req := generateTestRequest(t, http.MethodGet)
 		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
 
+		storedResourceId := uuid.New()
 		planet := models.Planet{
 			Id:   uuid.New(),
 			Name: "planet-1",
@@ -56,17 +57,17 @@ func TestUnit_Planets_GetPlanet(t *testing.T) {
 			},
 			Storages: []models.PlanetResourceStorage{
 				{
-					Resource: uuid.New(),
+					Resource: storedResourceId,
 					Storage:  48790,
 				},
 			},
 			Productions: []models.PlanetResourceProduction{
 				{
-					Resource:   uuid.New(),
+					Resource:   storedResourceId,
 					Production: 12,
 				},
 				{
-					Resource:   uuid.New(),
+					Resource:   storedResourceId,
 					Building:   ptrFor(uuid.New()),
 					Production: 8917,
 				},
@@ -77,8 +78,8 @@ func TestUnit_Planets_GetPlanet(t *testing.T) {
 					Level:    14,
 				},
 			},
-			BuildingAction: &models.BuildingAction{
-				Id: sampleUuid,
+			BuildingQueue: []models.BuildingAction{
+				{Id: sampleUuid},
 			},
 		}
 		mockUsecase.EXPECT().
@@ -125,30 +126,35 @@ func TestUnit_Planets_GetPlanet(t *testing.T) {
 					Level:    planet.Buildings[0].Level,
 				},
 			},
-			BuildingAction: &dtos.BuildingActionDtoResponse{
-				Id:          sampleUuid,
-				Costs:       []dtos.BuildingActionCostDtoResponse{},
-				Storages:    []dtos.BuildingActionStorageDtoResponse{},
-				Productions: []dtos.BuildingActionProductionDtoResponse{},
+			Ships:    []dtos.PlanetShipDtoResponse{},
+			Defenses: []dtos.PlanetDefenseDtoResponse{},
+			BuildingQueue: []dtos.BuildingActionDtoResponse{
+				{
+					Id:          sampleUuid,
+					Costs:       []dtos.BuildingActionCostDtoResponse{},
+					Storages:    []dtos.BuildingActionStorageDtoResponse{},
+					Productions: []dtos.BuildingActionProductionDtoResponse{},
+				},
 			},
+			ShipyardQueue: []dtos.ShipyardActionDtoResponse{},
+			Fleets:        []dtos.FleetDtoResponse{},
 		}
 		assert.Equal(t, expected, actual)
 	})
 
-	t.Run("correctly ignores building action when not provided", func(t *testing.T) {
+	t.Run("returns empty building queue when none is provided", func(t *testing.T) {
 		req := generateTestRequest(t, http.MethodGet)
 		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
 
 		planet := models.Planet{
-			Id:             uuid.New(),
-			Name:           "planet-1",
-			CreatedAt:      someTime,
-			UpdatedAt:      someOtherTime,
-			Resources:      []models.PlanetResource{},
-			Storages:       []models.PlanetResourceStorage{},
-			Productions:    []models.PlanetResourceProduction{},
-			Buildings:      []models.PlanetBuilding{},
-			BuildingAction: nil,
+			Id:          uuid.New(),
+			Name:        "planet-1",
+			CreatedAt:   someTime,
+			UpdatedAt:   someOtherTime,
+			Resources:   []models.PlanetResource{},
+			Storages:    []models.PlanetResourceStorage{},
+			Productions: []models.PlanetResourceProduction{},
+			Buildings:   []models.PlanetBuilding{},
 		}
 		mockUsecase.EXPECT().
 			Get(gomock.Any(), gomock.Eq(sampleUuid)).
@@ -161,19 +167,61 @@ func TestUnit_Planets_GetPlanet(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rw.Code)
 		actual := decodeResponseBody[dtos.PlanetDtoResponse](t, rw)
 		expected := dtos.PlanetDtoResponse{
-			Id:             planet.Id,
-			Name:           planet.Name,
-			CreatedAt:      planet.CreatedAt,
-			UpdatedAt:      planet.UpdatedAt,
-			Resources:      []dtos.PlanetResourceDtoResponse{},
-			Storages:       []dtos.PlanetResourceStorageDtoResponse{},
-			Productions:    []dtos.PlanetResourceProductionDtoResponse{},
-			Buildings:      []dtos.PlanetBuildingDtoResponse{},
-			BuildingAction: nil,
+			Id:            planet.Id,
+			Name:          planet.Name,
+			CreatedAt:     planet.CreatedAt,
+			UpdatedAt:     planet.UpdatedAt,
+			Resources:     []dtos.PlanetResourceDtoResponse{},
+			Storages:      []dtos.PlanetResourceStorageDtoResponse{},
+			Productions:   []dtos.PlanetResourceProductionDtoResponse{},
+			Buildings:     []dtos.PlanetBuildingDtoResponse{},
+			Ships:         []dtos.PlanetShipDtoResponse{},
+			Defenses:      []dtos.PlanetDefenseDtoResponse{},
+			BuildingQueue: []dtos.BuildingActionDtoResponse{},
+			ShipyardQueue: []dtos.ShipyardActionDtoResponse{},
+			Fleets:        []dtos.FleetDtoResponse{},
 		}
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("exposes energy produced and consumed by the planet", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		// Energy is the resource which is not stored on the planet.
+		energyResourceId := uuid.New()
+		planet := models.Planet{
+			Id: uuid.New(),
+			Productions: []models.PlanetResourceProduction{
+				{
+					Resource:   energyResourceId,
+					Building:   ptrFor(uuid.New()),
+					Production: 22,
+				},
+				{
+					Resource:   energyResourceId,
+					Building:   ptrFor(uuid.New()),
+					Production: -31,
+				},
+			},
+		}
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(planet, nil)
+
+		err := getPlanet(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.PlanetDtoResponse](t, rw)
+		expected := dtos.PlanetEnergyDtoResponse{
+			Produced: 22,
+			Consumed: 31,
+		}
+		assert.Equal(t, expected, actual.Energy)
+	})
+
 	t.Run("returns 404 when planet does not exist", func(t *testing.T) {
 		req := generateTestRequest(t, http.MethodGet)
 		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
@@ -246,22 +294,32 @@ func TestUnit_Planets_ListPlanetsForPlayer(t *testing.T) {
 		actual := decodeResponseBody[[]dtos.PlanetDtoResponse](t, rw)
 		expected := []dtos.PlanetDtoResponse{
 			{
-				Id:          planets[0].Id,
-				Name:        planets[0].Name,
-				CreatedAt:   planets[0].CreatedAt,
-				Resources:   []dtos.PlanetResourceDtoResponse{},
-				Storages:    []dtos.PlanetResourceStorageDtoResponse{},
-				Productions: []dtos.PlanetResourceProductionDtoResponse{},
-				Buildings:   []dtos.PlanetBuildingDtoResponse{},
+				Id:            planets[0].Id,
+				Name:          planets[0].Name,
+				CreatedAt:     planets[0].CreatedAt,
+				Resources:     []dtos.PlanetResourceDtoResponse{},
+				Storages:      []dtos.PlanetResourceStorageDtoResponse{},
+				Productions:   []dtos.PlanetResourceProductionDtoResponse{},
+				Buildings:     []dtos.PlanetBuildingDtoResponse{},
+				Ships:         []dtos.PlanetShipDtoResponse{},
+				Defenses:      []dtos.PlanetDefenseDtoResponse{},
+				BuildingQueue: []dtos.BuildingActionDtoResponse{},
+				ShipyardQueue: []dtos.ShipyardActionDtoResponse{},
+				Fleets:        []dtos.FleetDtoResponse{},
 			},
 			{
-				Id:          planets[1].Id,
-				Name:        planets[1].Name,
-				CreatedAt:   planets[1].CreatedAt,
-				Resources:   []dtos.PlanetResourceDtoResponse{},
-				Storages:    []dtos.PlanetResourceStorageDtoResponse{},
-				Productions: []dtos.PlanetResourceProductionDtoResponse{},
-				Buildings:   []dtos.PlanetBuildingDtoResponse{},
+				Id:            planets[1].Id,
+				Name:          planets[1].Name,
+				CreatedAt:     planets[1].CreatedAt,
+				Resources:     []dtos.PlanetResourceDtoResponse{},
+				Storages:      []dtos.PlanetResourceStorageDtoResponse{},
+				Productions:   []dtos.PlanetResourceProductionDtoResponse{},
+				Buildings:     []dtos.PlanetBuildingDtoResponse{},
+				Ships:         []dtos.PlanetShipDtoResponse{},
+				Defenses:      []dtos.PlanetDefenseDtoResponse{},
+				BuildingQueue: []dtos.BuildingActionDtoResponse{},
+				ShipyardQueue: []dtos.ShipyardActionDtoResponse{},
+				Fleets:        []dtos.FleetDtoResponse{},
 			},
 		}
 		assert.Equal(t, expected, actual)
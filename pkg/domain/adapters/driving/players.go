@@ -44,8 +44,11 @@ func PlayerEndpoints(usecase drivingports.ForManagingPlayer) rest.Routes {
 //	@Param			request	body		dtos.PlayerDtoRequest	true	"Player payload"
 //	@Success		201		{object}	rest.ResponseEnvelope[dtos.PlayerDtoResponse]
 //	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
 //	@Failure		409		{object}	rest.ResponseEnvelope[string]
 //	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/players [post]
 func createPlayer(c *echo.Context, usecase drivingports.ForManagingPlayer) error {
 	var inputDto dtos.PlayerDtoRequest
@@ -65,6 +68,10 @@ func createPlayer(c *echo.Context, usecase drivingports.ForManagingPlayer) error
 			return c.JSON(http.StatusBadRequest, "no such universe")
 		}
 
+		if err == domainerrors.ErrUniverseFull {
+			return c.JSON(http.StatusConflict, "universe is full")
+		}
+
 		c.Logger().Error("Failed to create player", slog.Any("error", err))
 		return c.JSON(http.StatusInternalServerError, "failed to create player")
 	}
@@ -82,8 +89,11 @@ func createPlayer(c *echo.Context, usecase drivingports.ForManagingPlayer) error
 //	@Param			id	path		string	true	"Player id (UUID)"	Format(uuid)
 //	@Success		200	{object}	rest.ResponseEnvelope[dtos.PlayerDtoResponse]
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
 //	@Failure		404	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/players/{id} [get]
 func getPlayer(c *echo.Context, usecase drivingports.ForManagingPlayer) error {
 	maybeId := c.Param("id")
@@ -114,7 +124,10 @@ func getPlayer(c *echo.Context, usecase drivingports.ForManagingPlayer) error {
 //	@Produce		json
 //	@Success		200			{object}	rest.ResponseEnvelope[[]dtos.PlayerDtoResponse]
 //	@Failure		400			{object}	rest.ResponseEnvelope[string]
+//	@Failure		401			{object}	rest.ResponseEnvelope[string]
+//	@Failure		403			{object}	rest.ResponseEnvelope[string]
 //	@Failure		500			{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/users/{id}/players [get]
 func listPlayersForApiUser(c *echo.Context, usecase drivingports.ForManagingPlayer) error {
 	maybeId := c.Param("id")
@@ -143,7 +156,10 @@ func listPlayersForApiUser(c *echo.Context, usecase drivingports.ForManagingPlay
 //	@Param			id	path		string	true	"Player id (UUID)"	Format(uuid)
 //	@Success		204	{string}	string
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/players/{id} [delete]
 func deletePlayer(c *echo.Context, usecase drivingports.ForManagingPlayer) error {
 	maybeId := c.Param("id")
@@ -109,6 +109,23 @@ func TestUnit_Players_CreatePlayer(t *testing.T) {
 		assert.Equal(t, "no such universe", actual)
 	})
 
+	t.Run("returns 409 when universe is full", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrUniverseFull)
+
+		err := createPlayer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "universe is full", actual)
+	})
+
 	t.Run("returns 500 when use case fails", func(t *testing.T) {
 		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
 		ctx, rw := generateTestContextFromRequest(t, req)
@@ -156,6 +173,8 @@ func TestUnit_Players_GetPlayer(t *testing.T) {
 			CreatedAt: someTime,
 			Homeworld: uuid.New(),
 			Planets:   []uuid.UUID{uuid.New()},
+			Rank:      4,
+			Points:    1234,
 		}
 		mockUsecase.EXPECT().
 			Get(gomock.Any(), gomock.Eq(sampleUuid)).
@@ -175,10 +194,45 @@ func TestUnit_Players_GetPlayer(t *testing.T) {
 			CreatedAt: player.CreatedAt,
 			Homeworld: player.Homeworld,
 			Planets:   player.Planets,
+			Rank:      4,
+			Points:    1234,
 		}
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("includes alliance of player", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		player := models.Player{
+			Id:        uuid.New(),
+			CreatedAt: someTime,
+			Alliance: &models.PlayerAlliance{
+				Id:   uuid.New(),
+				Name: "my alliance",
+				Tag:  "ALLY",
+				Rank: models.OfficerRank,
+			},
+		}
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(player, nil)
+
+		err := getPlayer(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.PlayerDtoResponse](t, rw)
+		expected := &dtos.PlayerAllianceDtoResponse{
+			Id:   player.Alliance.Id,
+			Name: "my alliance",
+			Tag:  "ALLY",
+			Rank: "officer",
+		}
+		assert.Equal(t, expected, actual.Alliance)
+	})
+
 	t.Run("returns 404 when player does not exist", func(t *testing.T) {
 		req := generateTestRequest(t, http.MethodGet)
 		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
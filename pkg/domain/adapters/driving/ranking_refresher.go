@@ -0,0 +1,74 @@
+package drivingadapters
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+)
+
+type RankingRefresherConfig struct {
+	// RefreshInterval is the time between two computations of the rankings.
+	RefreshInterval time.Duration
+}
+
+// RankingRefresher periodically recomputes the rankings of the players. It
+// is meant to be started with the process package. As rankings are replaced
+// atomically, stopping it during a refresh leaves the previous rankings.
+type RankingRefresher struct {
+	conf     RankingRefresherConfig
+	usecase  drivingports.ForRankingPlayers
+	log      *slog.Logger
+	stopChan chan struct{}
+}
+
+func NewRankingRefresher(
+	conf RankingRefresherConfig,
+	usecase drivingports.ForRankingPlayers,
+	log *slog.Logger,
+) *RankingRefresher {
+	return &RankingRefresher{
+		conf:     conf,
+		usecase:  usecase,
+		log:      log,
+		stopChan: make(chan struct{}, 1),
+	}
+}
+
+func (r *RankingRefresher) Start() error {
+	r.log.Info("Starting ranking refresher", slog.Duration("refresh_interval", r.conf.RefreshInterval))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-r.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		err := r.usecase.Refresh(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.log.Error("Failed to refresh rankings", slog.Any("error", err))
+		}
+
+		timer := time.NewTimer(r.conf.RefreshInterval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			r.log.Info("Ranking refresher gracefully shutdown")
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+func (r *RankingRefresher) Stop() error {
+	r.stopChan <- struct{}{}
+	return nil
+}
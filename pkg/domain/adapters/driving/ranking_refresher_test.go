@@ -0,0 +1,110 @@
+package drivingadapters
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_RankingRefresher(t *testing.T) {
+	t.Run("refreshes rankings when started", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForRankingPlayers(ctrl)
+
+		refreshed := make(chan struct{}, 1)
+		mockUsecase.EXPECT().
+			Refresh(gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ any) error {
+				refreshed <- struct{}{}
+				return nil
+			})
+
+		conf := RankingRefresherConfig{RefreshInterval: time.Hour}
+		r := NewRankingRefresher(conf, mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartRankingRefresher(t, r)
+
+		waitFor(t, refreshed)
+
+		stopRankingRefresher(t, r, done)
+	})
+
+	t.Run("refreshes rankings periodically", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForRankingPlayers(ctrl)
+
+		refreshed := make(chan struct{}, 10)
+		mockUsecase.EXPECT().
+			Refresh(gomock.Any()).
+			MinTimes(2).
+			DoAndReturn(func(_ any) error {
+				select {
+				case refreshed <- struct{}{}:
+				default:
+				}
+				return nil
+			})
+
+		conf := RankingRefresherConfig{RefreshInterval: 5 * time.Millisecond}
+		r := NewRankingRefresher(conf, mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartRankingRefresher(t, r)
+
+		waitFor(t, refreshed)
+		waitFor(t, refreshed)
+
+		stopRankingRefresher(t, r, done)
+	})
+
+	t.Run("keeps running when refresh fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForRankingPlayers(ctrl)
+
+		refreshed := make(chan struct{}, 10)
+		mockUsecase.EXPECT().
+			Refresh(gomock.Any()).
+			MinTimes(2).
+			DoAndReturn(func(_ any) error {
+				select {
+				case refreshed <- struct{}{}:
+				default:
+				}
+				return errors.New("stubbed error")
+			})
+
+		conf := RankingRefresherConfig{RefreshInterval: 5 * time.Millisecond}
+		r := NewRankingRefresher(conf, mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartRankingRefresher(t, r)
+
+		waitFor(t, refreshed)
+		waitFor(t, refreshed)
+
+		stopRankingRefresher(t, r, done)
+	})
+}
+
+func asyncStartRankingRefresher(t *testing.T, r *RankingRefresher) <-chan error {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Start()
+	}()
+
+	return done
+}
+
+func stopRankingRefresher(t *testing.T, r *RankingRefresher, done <-chan error) {
+	t.Helper()
+
+	err := r.Stop()
+	require.NoError(t, err, "Actual err: %v", err)
+
+	err = waitFor(t, done)
+	assert.NoError(t, err, "Actual err: %v", err)
+}
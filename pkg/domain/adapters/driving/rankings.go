@@ -0,0 +1,65 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func RankingEndpoints(usecase drivingports.ForRankingPlayers) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(listRankings, usecase)
+	list := rest.NewRoute(http.MethodGet, "/universes/:id/rankings", handler)
+	out = append(out, list)
+
+	return out
+}
+
+// listRankings godoc
+//
+//	@Summary		List rankings
+//	@Description	Returns the players of a universe ordered by rank. Points are the resources spent on buildings and are refreshed periodically.
+//	@Tags			rankings
+//	@Produce		json
+//	@Param			id		path		string	true	"Universe id (UUID)"	Format(uuid)
+//	@Param			page	query		int		false	"Page index, starting at 0"	minimum(0)	default(0)
+//	@Param			size	query		int		false	"Page size"					minimum(1)	maximum(100)	default(20)
+//	@Success		200		{object}	rest.ResponseEnvelope[[]dtos.RankingDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/universes/{id}/rankings [get]
+func listRankings(c *echo.Context, usecase drivingports.ForRankingPlayers) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	page, err := fetchPageFromQueryParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid pagination")
+	}
+
+	rankings, err := usecase.List(c.Request().Context(), id, page)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such universe")
+		}
+
+		c.Logger().Error("Failed to list rankings", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to list rankings")
+	}
+
+	out := mappers.ToRankingsResponse(rankings)
+	return c.JSON(http.StatusOK, out)
+}
@@ -0,0 +1,121 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func ResearchEndpoints(usecase drivingports.ForManagingResearch) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(getResearch, usecase)
+	get := rest.NewRoute(http.MethodGet, "/players/:id/research", handler)
+	out = append(out, get)
+
+	handler = generateHandler(createResearchAction, usecase)
+	post := rest.NewRoute(http.MethodPost, "/players/:id/research", handler)
+	out = append(out, post)
+
+	return out
+}
+
+// getResearch godoc
+//
+//	@Summary		Get research
+//	@Description	Returns the technologies researched by a player along with the research in progress if any.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id	path		string	true	"Player id (UUID)"	Format(uuid)
+//	@Success		200	{object}	rest.ResponseEnvelope[dtos.ResearchDtoResponse]
+//	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
+//	@Failure		404	{object}	rest.ResponseEnvelope[string]
+//	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/research [get]
+func getResearch(c *echo.Context, usecase drivingports.ForManagingResearch) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	research, err := usecase.Get(c.Request().Context(), id)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such player")
+		}
+
+		c.Logger().Error("Failed to get research", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to get research")
+	}
+
+	out := mappers.ToResearchResponse(research)
+	return c.JSON(http.StatusOK, out)
+}
+
+// createResearchAction godoc
+//
+//	@Summary		Create research action
+//	@Description	Starts researching the next level of a technology for the player provided in path parameter. The research runs on the planet provided in the body which pays for it. A player can only research one technology at a time.
+//	@Tags			players
+//	@Produce		json
+//	@Param			id		path		string							true	"Player id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.ResearchActionDtoRequest	true	"Research action payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.ResearchActionDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/players/{id}/research [post]
+func createResearchAction(c *echo.Context, usecase drivingports.ForManagingResearch) error {
+	maybeId := c.Param("id")
+	playerId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.ResearchActionDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid research action syntax")
+	}
+
+	request := mappers.ToResearchActionCreationRequest(playerId, inputDto)
+	action, err := usecase.Create(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrActionAlreadyInProgress {
+			return c.JSON(http.StatusConflict, "action already in progress")
+		}
+
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such player or planet")
+		}
+
+		if err == domainerrors.ErrTechnologyNotFound {
+			return c.JSON(http.StatusBadRequest, "no such technology")
+		}
+
+		if err == domainerrors.ErrNotEnoughResources {
+			return c.JSON(http.StatusBadRequest, "not enough resources")
+		}
+
+		c.Logger().Error("Failed to create research action", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to create research action")
+	}
+
+	out := mappers.ToResearchActionResponse(action)
+	return c.JSON(http.StatusCreated, out)
+}
@@ -0,0 +1,283 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Research_GetResearch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingResearch(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := getResearch(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("forwards fetching to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		action := sampleResearchAction()
+		research := models.Research{
+			Player: sampleUuid,
+			Technologies: []models.PlayerTechnology{
+				{Technology: uuid.New(), Level: 3},
+			},
+			Action: &action,
+		}
+
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Eq(sampleUuid)).
+			Times(1).
+			Return(research, nil)
+
+		err := getResearch(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.ResearchDtoResponse](t, rw)
+		expectedAction := sampleResearchActionDtoResponse(action)
+		expected := dtos.ResearchDtoResponse{
+			Player: sampleUuid,
+			Technologies: []dtos.PlayerTechnologyDtoResponse{
+				{Technology: research.Technologies[0].Technology, Level: 3},
+			},
+			Action: &expectedAction,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when player does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Research{}, domainerrors.ErrNotFound)
+
+		err := getResearch(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such player", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Research{}, errors.New("stubbed error"))
+
+		err := getResearch(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to get research", actual)
+	})
+}
+
+func TestUnit_Research_CreateResearchAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingResearch(ctrl)
+
+	t.Run("returns 400 when player id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResearchActionDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid research action syntax", actual)
+	})
+
+	t.Run("forwards creation to use case", func(t *testing.T) {
+		dto := sampleResearchActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.ResearchActionCreationRequest{
+			Player:     sampleUuid,
+			Planet:     dto.Planet,
+			Technology: dto.Technology,
+		}
+		action := sampleResearchAction()
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(action, nil)
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.ResearchActionDtoResponse](t, rw)
+		assert.Equal(t, sampleResearchActionDtoResponse(action), actual)
+	})
+
+	t.Run("returns 409 when action already exists", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResearchActionDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ResearchAction{}, domainerrors.ErrActionAlreadyInProgress)
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "action already in progress", actual)
+	})
+
+	t.Run("returns 404 when player or planet does not exist", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResearchActionDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ResearchAction{}, domainerrors.ErrNotFound)
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such player or planet", actual)
+	})
+
+	t.Run("returns 400 when technology does not exist", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResearchActionDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ResearchAction{}, domainerrors.ErrTechnologyNotFound)
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such technology", actual)
+	})
+
+	t.Run("returns 400 when planet does not have enough resources", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResearchActionDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ResearchAction{}, domainerrors.ErrNotEnoughResources)
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not enough resources", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleResearchActionDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ResearchAction{}, errors.New("stubbed error"))
+
+		err := createResearchAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to create research action", actual)
+	})
+}
+
+func sampleResearchActionDtoRequest() dtos.ResearchActionDtoRequest {
+	return dtos.ResearchActionDtoRequest{
+		Planet:     uuid.New(),
+		Technology: uuid.New(),
+	}
+}
+
+func sampleResearchAction() models.ResearchAction {
+	return models.ResearchAction{
+		Id:           uuid.New(),
+		Player:       sampleUuid,
+		Planet:       uuid.New(),
+		Technology:   uuid.New(),
+		DesiredLevel: 4,
+		CreatedAt:    someTime,
+		CompletedAt:  someOtherTime,
+		Costs: []models.ResearchActionCost{
+			{
+				Resource: uuid.New(),
+				Amount:   6400,
+			},
+		},
+	}
+}
+
+func sampleResearchActionDtoResponse(action models.ResearchAction) dtos.ResearchActionDtoResponse {
+	return dtos.ResearchActionDtoResponse{
+		Id:           action.Id,
+		Planet:       action.Planet,
+		Technology:   action.Technology,
+		DesiredLevel: action.DesiredLevel,
+		CreatedAt:    action.CreatedAt,
+		CompletedAt:  action.CompletedAt,
+		Costs: []dtos.ResearchActionCostDtoResponse{
+			{Resource: action.Costs[0].Resource, Amount: action.Costs[0].Amount},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package drivingadapters
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"sigs.k8s.io/yaml"
+)
+
+type RulesetConfig struct {
+	// Path is the YAML or JSON file describing the game data. When empty, the
+	// game data already in the database is used as is.
+	Path string
+}
+
+// RulesetLoader imports the game data described in a file. It is meant to
+// be run once at startup, before serving requests: the whole file is
+// validated before anything is written.
+type RulesetLoader struct {
+	conf    RulesetConfig
+	usecase drivingports.ForManagingGameData
+	log     *slog.Logger
+}
+
+func NewRulesetLoader(
+	conf RulesetConfig,
+	usecase drivingports.ForManagingGameData,
+	log *slog.Logger,
+) *RulesetLoader {
+	return &RulesetLoader{
+		conf:    conf,
+		usecase: usecase,
+		log:     log,
+	}
+}
+
+func (l *RulesetLoader) Load(ctx context.Context) error {
+	if l.conf.Path == "" {
+		l.log.Info("No ruleset configured, keeping existing game data")
+		return nil
+	}
+
+	content, err := os.ReadFile(l.conf.Path)
+	if err != nil {
+		return err
+	}
+
+	// Unknown keys are most likely typos which would silently reset a value.
+	var dto dtos.RulesetDto
+	if err := yaml.UnmarshalStrict(content, &dto); err != nil {
+		return err
+	}
+
+	if err := l.usecase.ImportRuleset(ctx, mappers.ToRulesetRequest(dto)); err != nil {
+		return err
+	}
+
+	l.log.Info(
+		"Loaded ruleset",
+		slog.String("path", l.conf.Path),
+		slog.Int("resources", len(dto.Resources)),
+		slog.Int("buildings", len(dto.Buildings)),
+		slog.Int("field_ranges", len(dto.FieldRanges)),
+	)
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package drivingadapters
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const sampleRuleset = `
+resources:
+  - id: b4419b6b-b3bf-4576-aa92-055283addbc8
+    name: metal
+    start_amount: 500
+    start_production: 30
+    start_storage: 10000
+    build_time_hours_per_unit: 0.0004
+buildings:
+  - id: d176e82d-f2ca-4611-996b-c4804096caef
+    name: metal mine
+    costs:
+      - resource: b4419b6b-b3bf-4576-aa92-055283addbc8
+        cost: 60
+        progress: 1.5
+    productions:
+      - resource: b4419b6b-b3bf-4576-aa92-055283addbc8
+        base: 30
+        progress: 1.1
+field_ranges:
+  - position: 0
+    min: 40
+    max: 70
+max_planets_per_player: 5
+`
+
+func TestUnit_RulesetLoader_Load(t *testing.T) {
+	t.Run("imports ruleset described in file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+		metal := uuid.MustParse("b4419b6b-b3bf-4576-aa92-055283addbc8")
+
+		var captured request.RulesetRequest
+		mockUsecase.EXPECT().
+			ImportRuleset(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ any, req request.RulesetRequest) error {
+				captured = req
+				return nil
+			})
+
+		loader := newTestRulesetLoader(writeTestRuleset(t, sampleRuleset), mockUsecase)
+		err := loader.Load(t.Context())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := request.RulesetRequest{
+			Resources: []request.RulesetResourceRequest{
+				{
+					Id: metal,
+					Resource: request.ResourceRequest{
+						Name:                  "metal",
+						StartAmount:           500,
+						StartProduction:       30,
+						StartStorage:          10000,
+						BuildTimeHoursPerUnit: 0.0004,
+					},
+				},
+			},
+			Buildings: []request.RulesetBuildingRequest{
+				{
+					Id: uuid.MustParse("d176e82d-f2ca-4611-996b-c4804096caef"),
+					Building: request.BuildingRequest{
+						Name:        "metal mine",
+						Costs:       []request.BuildingCostRequest{{Resource: metal, Cost: 60, Progress: 1.5}},
+						Productions: []request.BuildingProductionRequest{{Resource: metal, Base: 30, Progress: 1.1}},
+						Storages:    []request.BuildingStorageRequest{},
+					},
+				},
+			},
+			FieldRanges:         []request.PlanetFieldRangeRequest{{Position: 0, Min: 40, Max: 70}},
+			MaxPlanetsPerPlayer: 5,
+		}
+		assert.Equal(t, expected, captured)
+	})
+
+	t.Run("does nothing when no file is configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+		loader := newTestRulesetLoader("", mockUsecase)
+		err := loader.Load(t.Context())
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when file does not exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+		loader := newTestRulesetLoader(filepath.Join(t.TempDir(), "missing.yml"), mockUsecase)
+		err := loader.Load(t.Context())
+
+		assert.ErrorIs(t, err, os.ErrNotExist, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when file contains unknown keys", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+		path := writeTestRuleset(t, "resources:\n  - id: b4419b6b-b3bf-4576-aa92-055283addbc8\n    nmae: metal\n")
+		loader := newTestRulesetLoader(path, mockUsecase)
+		err := loader.Load(t.Context())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("returns error when import fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForManagingGameData(ctrl)
+
+		expectedErr := errors.New("stubbed error")
+		mockUsecase.EXPECT().
+			ImportRuleset(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(expectedErr)
+
+		loader := newTestRulesetLoader(writeTestRuleset(t, sampleRuleset), mockUsecase)
+		err := loader.Load(t.Context())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func newTestRulesetLoader(path string, usecase *drivingportstest.MockForManagingGameData) *RulesetLoader {
+	conf := RulesetConfig{Path: path}
+	return NewRulesetLoader(conf, usecase, slog.New(slog.DiscardHandler))
+}
+
+func writeTestRuleset(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "ruleset.yml")
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return path
+}
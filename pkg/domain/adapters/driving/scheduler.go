@@ -0,0 +1,150 @@
+package drivingadapters
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+)
+
+type SchedulerConfig struct {
+	// PollInterval is the longest time the scheduler waits before checking
+	// for due actions. It allows to pick up actions created while waiting
+	// for the earliest completion.
+	PollInterval time.Duration
+	// Concurrency is the number of planets processed in parallel.
+	Concurrency int
+}
+
+// Scheduler completes the actions of the planets as they are due so that
+// the data in the database does not depend on the planets being read. It
+// is meant to be started with the process package and stopped gracefully:
+// planets being processed when stopping are fully persisted.
+type Scheduler struct {
+	conf     SchedulerConfig
+	usecase  drivingports.ForCompletingActions
+	log      *slog.Logger
+	stopChan chan struct{}
+}
+
+func NewScheduler(
+	conf SchedulerConfig,
+	usecase drivingports.ForCompletingActions,
+	log *slog.Logger,
+) *Scheduler {
+	return &Scheduler{
+		conf:     conf,
+		usecase:  usecase,
+		log:      log,
+		stopChan: make(chan struct{}, 1),
+	}
+}
+
+func (s *Scheduler) Start() error {
+	s.log.Info(
+		"Starting scheduler",
+		slog.Duration("poll_interval", s.conf.PollInterval),
+		slog.Int("concurrency", s.conf.Concurrency),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-s.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		delay := s.conf.PollInterval
+		// In case of failures, the actions are probably still due: waiting
+		// for the poll interval prevents retrying in a tight loop.
+		if s.completeDueActions(ctx) {
+			delay = s.nextWakeUp(ctx)
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.log.Info("Scheduler gracefully shutdown")
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *Scheduler) Stop() error {
+	s.stopChan <- struct{}{}
+	return nil
+}
+
+// completeDueActions returns whether the actions of all the due planets
+// were successfully completed.
+func (s *Scheduler) completeDueActions(ctx context.Context) bool {
+	planets, err := s.usecase.ListDuePlanets(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.log.Error("Failed to list planets with due actions", slog.Any("error", err))
+		}
+		return false
+	}
+
+	// Planets already dispatched are completed even when the scheduler is
+	// stopped: this avoids interrupting a mutation half-way.
+	completionCtx := context.WithoutCancel(ctx)
+
+	planetsChan := make(chan uuid.UUID)
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	for range min(max(s.conf.Concurrency, 1), len(planets)) {
+		wg.Go(func() {
+			for planet := range planetsChan {
+				err := s.usecase.Complete(completionCtx, planet)
+				if err != nil {
+					failed.Store(true)
+					s.log.Error(
+						"Failed to complete actions",
+						slog.String("planet", planet.String()),
+						slog.Any("error", err),
+					)
+				}
+			}
+		})
+	}
+
+dispatch:
+	for _, planet := range planets {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case planetsChan <- planet:
+		}
+	}
+
+	close(planetsChan)
+	wg.Wait()
+
+	return !failed.Load()
+}
+
+func (s *Scheduler) nextWakeUp(ctx context.Context) time.Duration {
+	delay, err := s.usecase.NextCompletion(ctx)
+	if err != nil {
+		if err != domainerrors.ErrNotFound && ctx.Err() == nil {
+			s.log.Error("Failed to fetch next action completion", slog.Any("error", err))
+		}
+		return s.conf.PollInterval
+	}
+
+	return min(delay, s.conf.PollInterval)
+}
@@ -0,0 +1,197 @@
+package drivingadapters
+
+import (
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Scheduler(t *testing.T) {
+	t.Run("completes actions of due planets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForCompletingActions(ctrl)
+		planets := []uuid.UUID{uuid.New(), uuid.New()}
+
+		completed := make(chan uuid.UUID, len(planets))
+		mockUsecase.EXPECT().
+			ListDuePlanets(gomock.Any()).
+			Times(1).
+			Return(planets, nil)
+		mockUsecase.EXPECT().
+			Complete(gomock.Any(), gomock.Any()).
+			Times(2).
+			DoAndReturn(func(_ any, planet uuid.UUID) error {
+				completed <- planet
+				return nil
+			})
+		mockUsecase.EXPECT().
+			NextCompletion(gomock.Any()).
+			Times(1).
+			Return(time.Duration(0), domainerrors.ErrNotFound)
+
+		s := NewScheduler(generateTestSchedulerConfig(), mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartScheduler(t, s)
+
+		actual := []uuid.UUID{waitFor(t, completed), waitFor(t, completed)}
+		assert.ElementsMatch(t, planets, actual)
+
+		stopScheduler(t, s, done)
+	})
+
+	t.Run("wakes up when next action completes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForCompletingActions(ctrl)
+
+		polled := make(chan struct{}, 10)
+		mockUsecase.EXPECT().
+			ListDuePlanets(gomock.Any()).
+			MinTimes(2).
+			DoAndReturn(func(_ any) ([]uuid.UUID, error) {
+				select {
+				case polled <- struct{}{}:
+				default:
+				}
+				return []uuid.UUID{}, nil
+			})
+		mockUsecase.EXPECT().
+			NextCompletion(gomock.Any()).
+			Times(1).
+			Return(10*time.Millisecond, nil)
+		mockUsecase.EXPECT().
+			NextCompletion(gomock.Any()).
+			AnyTimes().
+			Return(time.Hour, nil)
+
+		s := NewScheduler(generateTestSchedulerConfig(), mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartScheduler(t, s)
+
+		waitFor(t, polled)
+		waitFor(t, polled)
+
+		stopScheduler(t, s, done)
+	})
+
+	t.Run("keeps running when listing planets fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForCompletingActions(ctrl)
+
+		polled := make(chan struct{}, 10)
+		mockUsecase.EXPECT().
+			ListDuePlanets(gomock.Any()).
+			MinTimes(2).
+			DoAndReturn(func(_ any) ([]uuid.UUID, error) {
+				select {
+				case polled <- struct{}{}:
+				default:
+				}
+				return nil, errors.New("stubbed error")
+			})
+
+		conf := generateTestSchedulerConfig()
+		conf.PollInterval = 10 * time.Millisecond
+		s := NewScheduler(conf, mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartScheduler(t, s)
+
+		waitFor(t, polled)
+		waitFor(t, polled)
+
+		stopScheduler(t, s, done)
+	})
+
+	t.Run("limits number of planets completed in parallel", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := drivingportstest.NewMockForCompletingActions(ctrl)
+		planets := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+		var running, maxRunning atomic.Int32
+		completed := make(chan uuid.UUID, len(planets))
+		mockUsecase.EXPECT().
+			ListDuePlanets(gomock.Any()).
+			Times(1).
+			Return(planets, nil)
+		mockUsecase.EXPECT().
+			Complete(gomock.Any(), gomock.Any()).
+			Times(len(planets)).
+			DoAndReturn(func(_ any, planet uuid.UUID) error {
+				current := running.Add(1)
+				for {
+					previous := maxRunning.Load()
+					if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+				completed <- planet
+				return nil
+			})
+		mockUsecase.EXPECT().
+			NextCompletion(gomock.Any()).
+			Times(1).
+			Return(time.Hour, nil)
+
+		conf := generateTestSchedulerConfig()
+		conf.Concurrency = 2
+		s := NewScheduler(conf, mockUsecase, slog.New(slog.DiscardHandler))
+		done := asyncStartScheduler(t, s)
+
+		for range planets {
+			waitFor(t, completed)
+		}
+		assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+
+		stopScheduler(t, s, done)
+	})
+}
+
+func generateTestSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		PollInterval: time.Hour,
+		Concurrency:  4,
+	}
+}
+
+func asyncStartScheduler(t *testing.T, s *Scheduler) <-chan error {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Start()
+	}()
+
+	return done
+}
+
+func stopScheduler(t *testing.T, s *Scheduler, done <-chan error) {
+	t.Helper()
+
+	err := s.Stop()
+	require.NoError(t, err, "Actual err: %v", err)
+
+	err = waitFor(t, done)
+	assert.NoError(t, err, "Actual err: %v", err)
+}
+
+func waitFor[T any](t *testing.T, values <-chan T) T {
+	t.Helper()
+
+	select {
+	case value := <-values:
+		return value
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for scheduler")
+	}
+
+	var value T
+	return value
+}
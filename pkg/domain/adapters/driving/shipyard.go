@@ -0,0 +1,85 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func ShipyardEndpoints(createUsecase drivingports.ForCreatingShipyardAction) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(createShipyardAction, createUsecase)
+	post := rest.NewRoute(http.MethodPost, "/planets/:id/shipyard", handler)
+	out = append(out, post)
+
+	return out
+}
+
+// createShipyardAction godoc
+//
+//	@Summary		Queue units in the shipyard
+//	@Description	Queues a batch of ships or defenses in the shipyard of the planet provided in path parameter. Batches are produced one after the other, and units of a batch are delivered one at a time.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id		path		string							true	"Planet id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.ShipyardActionDtoRequest	true	"Shipyard action payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.ShipyardActionDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		409		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/shipyard [post]
+func createShipyardAction(c *echo.Context, usecase drivingports.ForCreatingShipyardAction) error {
+	maybeId := c.Param("id")
+	planetId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.ShipyardActionDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid shipyard action syntax")
+	}
+
+	request := mappers.ToShipyardActionCreationRequest(planetId, inputDto)
+	action, err := usecase.Create(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrUnitNotFound {
+			return c.JSON(http.StatusBadRequest, "no such unit")
+		}
+
+		if err == domainerrors.ErrInvalidUnitCount {
+			return c.JSON(http.StatusBadRequest, "invalid unit count")
+		}
+
+		if err == domainerrors.ErrNotEnoughResources {
+			return c.JSON(http.StatusBadRequest, "not enough resources")
+		}
+
+		if err == domainerrors.ErrShipyardNotBuilt {
+			return c.JSON(http.StatusConflict, "shipyard not built")
+		}
+
+		c.Logger().Error("Failed to create shipyard action", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to create shipyard action")
+	}
+
+	out := mappers.ToShipyardActionResponse(action)
+	return c.JSON(http.StatusCreated, out)
+}
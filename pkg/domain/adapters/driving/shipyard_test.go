@@ -0,0 +1,218 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_Shipyard_CreateShipyardAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForCreatingShipyardAction(ctrl)
+
+	t.Run("returns 400 when planet id is invalid", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid shipyard action syntax", actual)
+	})
+
+	t.Run("forwards creation to use case", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.ShipyardActionCreationRequest{
+			Planet: sampleUuid,
+			Kind:   models.ShipUnit,
+			Unit:   dto.Unit,
+			Count:  dto.Count,
+		}
+		action := models.ShipyardAction{
+			Id:            uuid.New(),
+			Kind:          models.ShipUnit,
+			Unit:          dto.Unit,
+			Count:         3,
+			UnitBuildTime: 2 * time.Minute,
+			CreatedAt:     someTime,
+			CompletedAt:   someOtherTime,
+			Costs: []models.ShipyardActionCost{
+				{
+					Resource: uuid.New(),
+					Amount:   6000,
+				},
+			},
+		}
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(action, nil)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.ShipyardActionDtoResponse](t, rw)
+		expected := dtos.ShipyardActionDtoResponse{
+			Id:                  action.Id,
+			Kind:                "ship",
+			Unit:                action.Unit,
+			Count:               3,
+			CreatedAt:           action.CreatedAt,
+			NextUnitCompletedAt: someOtherTime.Add(-4 * time.Minute),
+			CompletedAt:         action.CompletedAt,
+			Costs: []dtos.ShipyardActionCostDtoResponse{
+				{Resource: action.Costs[0].Resource, Amount: 6000},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ShipyardAction{}, domainerrors.ErrNotFound)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 400 when unit is not found", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ShipyardAction{}, domainerrors.ErrUnitNotFound)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such unit", actual)
+	})
+
+	t.Run("returns 400 when unit count is invalid", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ShipyardAction{}, domainerrors.ErrInvalidUnitCount)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid unit count", actual)
+	})
+
+	t.Run("returns 400 when not enough resources are on the planet", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ShipyardAction{}, domainerrors.ErrNotEnoughResources)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not enough resources", actual)
+	})
+
+	t.Run("returns 409 when shipyard is not built", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ShipyardAction{}, domainerrors.ErrShipyardNotBuilt)
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "shipyard not built", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		dto := sampleShipyardActionDtoRequest()
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, dto)
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.ShipyardAction{}, errors.New("stubbed error"))
+
+		err := createShipyardAction(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to create shipyard action", actual)
+	})
+}
+
+func sampleShipyardActionDtoRequest() dtos.ShipyardActionDtoRequest {
+	return dtos.ShipyardActionDtoRequest{
+		Kind:  "ship",
+		Unit:  uuid.New(),
+		Count: 3,
+	}
+}
@@ -0,0 +1,205 @@
+package drivingadapters
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTokenSecret = "test-secret"
+
+var sampleApiUser = uuid.MustParse("a4c6d8e0-1f3b-4d5c-9e7a-0b2c4d6e8f10")
+
+func TestUnit_NewTokenVerifier(t *testing.T) {
+	t.Run("rejects unsupported algorithm", func(t *testing.T) {
+		conf := AuthConfig{Algorithm: "none"}
+
+		_, err := NewTokenVerifier(conf)
+
+		assert.Equal(t, errUnsupportedAlgorithm, err)
+	})
+
+	t.Run("rejects missing secret", func(t *testing.T) {
+		conf := AuthConfig{Algorithm: "HS256"}
+
+		_, err := NewTokenVerifier(conf)
+
+		assert.Equal(t, errMissingSecret, err)
+	})
+
+	t.Run("rejects placeholder secret", func(t *testing.T) {
+		conf := AuthConfig{Algorithm: "HS256", Secret: "comes-from-the-environment"}
+
+		_, err := NewTokenVerifier(conf)
+
+		assert.Equal(t, errMissingSecret, err)
+	})
+
+	t.Run("rejects invalid public key", func(t *testing.T) {
+		conf := AuthConfig{Algorithm: "RS256", PublicKey: "not-a-key"}
+
+		_, err := NewTokenVerifier(conf)
+
+		assert.Equal(t, errInvalidPublicKey, err)
+	})
+}
+
+func TestUnit_TokenVerifier_Verify(t *testing.T) {
+	verifier := newTestTokenVerifier(t)
+
+	t.Run("returns api user of valid token", func(t *testing.T) {
+		token := signTestToken(t, sampleApiUser, someTime.Add(time.Hour))
+
+		actual, err := verifier.Verify(token, someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, sampleApiUser, actual)
+	})
+
+	t.Run("returns api user of valid token signed with rsa key", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err, "Actual err: %v", err)
+		publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		conf := AuthConfig{
+			Algorithm: "RS256",
+			PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+		}
+		rsaVerifier, err := NewTokenVerifier(conf)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		claims := map[string]any{"sub": sampleApiUser, "exp": someTime.Add(time.Hour).Unix()}
+		token := encodeTestToken(t, "RS256", claims, func(signed []byte) []byte {
+			hash := sha256.Sum256(signed)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+			require.NoError(t, err, "Actual err: %v", err)
+			return signature
+		})
+
+		actual, err := rsaVerifier.Verify(token, someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, sampleApiUser, actual)
+	})
+
+	t.Run("rejects malformed token", func(t *testing.T) {
+		_, err := verifier.Verify("not-a-token", someTime)
+
+		assert.Equal(t, errInvalidToken, err)
+	})
+
+	t.Run("rejects token signed with another secret", func(t *testing.T) {
+		claims := map[string]any{"sub": sampleApiUser, "exp": someTime.Add(time.Hour).Unix()}
+		token := encodeTestToken(t, "HS256", claims, hmacSigner("another-secret"))
+
+		_, err := verifier.Verify(token, someTime)
+
+		assert.Equal(t, errInvalidToken, err)
+	})
+
+	t.Run("rejects token signed with another algorithm", func(t *testing.T) {
+		claims := map[string]any{"sub": sampleApiUser, "exp": someTime.Add(time.Hour).Unix()}
+		token := encodeTestToken(t, "none", claims, func([]byte) []byte { return nil })
+
+		_, err := verifier.Verify(token, someTime)
+
+		assert.Equal(t, errInvalidToken, err)
+	})
+
+	t.Run("rejects expired token", func(t *testing.T) {
+		token := signTestToken(t, sampleApiUser, someTime)
+
+		_, err := verifier.Verify(token, someTime)
+
+		assert.Equal(t, errExpiredToken, err)
+	})
+
+	t.Run("rejects token without expiration", func(t *testing.T) {
+		claims := map[string]any{"sub": sampleApiUser}
+		token := encodeTestToken(t, "HS256", claims, hmacSigner(testTokenSecret))
+
+		_, err := verifier.Verify(token, someTime)
+
+		assert.Equal(t, errExpiredToken, err)
+	})
+
+	t.Run("rejects token not yet valid", func(t *testing.T) {
+		claims := map[string]any{
+			"sub": sampleApiUser,
+			"nbf": someTime.Add(time.Minute).Unix(),
+			"exp": someTime.Add(time.Hour).Unix(),
+		}
+		token := encodeTestToken(t, "HS256", claims, hmacSigner(testTokenSecret))
+
+		_, err := verifier.Verify(token, someTime)
+
+		assert.Equal(t, errInvalidToken, err)
+	})
+
+	t.Run("rejects token with invalid subject", func(t *testing.T) {
+		claims := map[string]any{"sub": "not-a-uuid", "exp": someTime.Add(time.Hour).Unix()}
+		token := encodeTestToken(t, "HS256", claims, hmacSigner(testTokenSecret))
+
+		_, err := verifier.Verify(token, someTime)
+
+		assert.Equal(t, errInvalidToken, err)
+	})
+}
+
+func newTestTokenVerifier(t *testing.T) *TokenVerifier {
+	t.Helper()
+
+	conf := AuthConfig{
+		Algorithm: "HS256",
+		Secret:    testTokenSecret,
+	}
+	verifier, err := NewTokenVerifier(conf)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	return verifier
+}
+
+func signTestToken(t *testing.T, apiUser uuid.UUID, expiresAt time.Time) string {
+	t.Helper()
+
+	claims := map[string]any{
+		"sub": apiUser,
+		"exp": expiresAt.Unix(),
+	}
+	return encodeTestToken(t, "HS256", claims, hmacSigner(testTokenSecret))
+}
+
+func hmacSigner(secret string) func([]byte) []byte {
+	return func(signed []byte) []byte {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(signed)
+		return mac.Sum(nil)
+	}
+}
+
+func encodeTestToken(t *testing.T, algorithm string, claims map[string]any, sign func([]byte) []byte) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"})
+	require.NoError(t, err, "Actual err: %v", err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err, "Actual err: %v", err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature := sign([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
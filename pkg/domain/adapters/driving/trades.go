@@ -0,0 +1,80 @@
+package drivingadapters
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/mappers"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivingports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driving"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+func TradeEndpoints(usecase drivingports.ForTradingResources) rest.Routes {
+	var out rest.Routes
+
+	handler := generateHandler(tradeResources, usecase)
+	post := rest.NewRoute(http.MethodPost, "/planets/:id/trades", handler)
+	out = append(out, post)
+
+	return out
+}
+
+// tradeResources godoc
+//
+//	@Summary		Trade resources
+//	@Description	Exchanges resources of the planet provided in path parameter with the merchant. The amount received depends on the exchange rates of the universe and is rounded down. The bought resource can not exceed the storage of the planet.
+//	@Tags			planets
+//	@Produce		json
+//	@Param			id		path		string					true	"Planet id (UUID)"	Format(uuid)
+//	@Param			request	body		dtos.TradeDtoRequest	true	"Trade payload"
+//	@Success		201		{object}	rest.ResponseEnvelope[dtos.TradeDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/planets/{id}/trades [post]
+func tradeResources(c *echo.Context, usecase drivingports.ForTradingResources) error {
+	maybeId := c.Param("id")
+	planetId, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	var inputDto dtos.TradeDtoRequest
+	err = c.Bind(&inputDto)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid trade syntax")
+	}
+
+	request := mappers.ToTradeRequest(planetId, inputDto)
+	trade, err := usecase.Trade(c.Request().Context(), request)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such planet")
+		}
+
+		if err == domainerrors.ErrInvalidTrade {
+			return c.JSON(http.StatusBadRequest, "invalid trade")
+		}
+
+		if err == domainerrors.ErrNotEnoughResources {
+			return c.JSON(http.StatusBadRequest, "not enough resources")
+		}
+
+		if err == domainerrors.ErrStorageCapacityExceeded {
+			return c.JSON(http.StatusBadRequest, "storage capacity exceeded")
+		}
+
+		c.Logger().Error("Failed to trade resources", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to trade resources")
+	}
+
+	out := mappers.ToTradeResponse(trade)
+	return c.JSON(http.StatusCreated, out)
+}
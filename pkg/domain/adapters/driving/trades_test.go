@@ -0,0 +1,187 @@
+package drivingadapters
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/drivingportstest"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var sampleBoughtResourceId = uuid.MustParse("1b9bb8a0-4f3c-4d38-9e5a-0f2c7d6b3e91")
+
+func TestUnit_Trades_TradeResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForTradingResources(ctrl)
+
+	t.Run("returns 400 when planet id is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{{Name: "id", Value: "not-a-uuid"}})
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when body is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, "not-a-dto-request")
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid trade syntax", actual)
+	})
+
+	t.Run("forwards trade to use case", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		expectedRequest := request.TradeRequest{
+			Planet: sampleUuid,
+			Sold:   sampleResourceId,
+			Bought: sampleBoughtResourceId,
+			Amount: 300,
+		}
+		trade := models.Trade{
+			Planet:       sampleUuid,
+			Sold:         sampleResourceId,
+			SoldAmount:   300,
+			Bought:       sampleBoughtResourceId,
+			BoughtAmount: 200,
+			CreatedAt:    someTime,
+		}
+
+		mockUsecase.EXPECT().
+			Trade(gomock.Any(), gomock.Eq(expectedRequest)).
+			Times(1).
+			Return(trade, nil)
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		actual := decodeResponseBody[dtos.TradeDtoResponse](t, rw)
+		expected := dtos.TradeDtoResponse{
+			Planet: sampleUuid,
+			Sold: dtos.TradedResourceDtoResponse{
+				Resource: sampleResourceId,
+				Amount:   300,
+			},
+			Bought: dtos.TradedResourceDtoResponse{
+				Resource: sampleBoughtResourceId,
+				Amount:   200,
+			},
+			CreatedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when planet is not found", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Trade(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Trade{}, domainerrors.ErrNotFound)
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such planet", actual)
+	})
+
+	t.Run("returns 400 when trade is invalid", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Trade(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Trade{}, domainerrors.ErrInvalidTrade)
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid trade", actual)
+	})
+
+	t.Run("returns 400 when not enough resources", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Trade(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Trade{}, domainerrors.ErrNotEnoughResources)
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "not enough resources", actual)
+	})
+
+	t.Run("returns 400 when storage capacity is exceeded", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Trade(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Trade{}, domainerrors.ErrStorageCapacityExceeded)
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "storage capacity exceeded", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequestWithJsonBody(t, http.MethodPost, sampleTradeDtoRequest())
+		ctx, rw := generateTestContextFromRequest(t, req, addIdPathParam)
+
+		mockUsecase.EXPECT().
+			Trade(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Trade{}, errors.New("stubbed error"))
+
+		err := tradeResources(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to trade resources", actual)
+	})
+}
+
+func sampleTradeDtoRequest() dtos.TradeDtoRequest {
+	return dtos.TradeDtoRequest{
+		Sold:   sampleResourceId,
+		Bought: sampleBoughtResourceId,
+		Amount: 300,
+	}
+}
@@ -3,6 +3,7 @@ package drivingadapters
 import (
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/Knoblauchpilze/backend-toolkit/pkg/rest"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/adapters/driving/dtos"
@@ -28,6 +29,10 @@ func UniverseEndpoints(usecase drivingports.ForManagingUniverse) rest.Routes {
 	list := rest.NewRoute(http.MethodGet, "/universes", handler)
 	out = append(out, list)
 
+	handler = generateHandler(getSolarSystem, usecase)
+	getSystem := rest.NewRoute(http.MethodGet, "/universes/:id/galaxies/:galaxy/systems/:system", handler)
+	out = append(out, getSystem)
+
 	handler = generateHandler(deleteUniverse, usecase)
 	delete := rest.NewRoute(http.MethodDelete, "/universes/:id", handler)
 	out = append(out, delete)
@@ -38,14 +43,17 @@ func UniverseEndpoints(usecase drivingports.ForManagingUniverse) rest.Routes {
 // createUniverse godoc
 //
 //	@Summary		Create universe
-//	@Description	Creates a universe.
+//	@Description	Creates a universe. Restricted to administrators.
 //	@Tags			universes
 //	@Produce		json
 //	@Param			request	body		dtos.UniverseDtoRequest	true	"Universe payload"
 //	@Success		201		{object}	rest.ResponseEnvelope[dtos.UniverseDtoResponse]
 //	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		403		{object}	rest.ResponseEnvelope[string]
 //	@Failure		409		{object}	rest.ResponseEnvelope[string]
 //	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/universes [post]
 func createUniverse(c *echo.Context, usecase drivingports.ForManagingUniverse) error {
 	var inputDto dtos.UniverseDtoRequest
@@ -78,8 +86,10 @@ func createUniverse(c *echo.Context, usecase drivingports.ForManagingUniverse) e
 //	@Param			id	path		string	true	"Universe id (UUID)"	Format(uuid)
 //	@Success		200	{object}	rest.ResponseEnvelope[dtos.UniverseDtoResponse]
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
 //	@Failure		404	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/universes/{id} [get]
 func getUniverse(c *echo.Context, usecase drivingports.ForManagingUniverse) error {
 	maybeId := c.Param("id")
@@ -109,7 +119,9 @@ func getUniverse(c *echo.Context, usecase drivingports.ForManagingUniverse) erro
 //	@Tags			universes
 //	@Produce		json
 //	@Success		200	{object}	rest.ResponseEnvelope[[]dtos.UniverseDtoResponse]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/universes [get]
 func listUniverses(c *echo.Context, usecase drivingports.ForManagingUniverse) error {
 	universes, err := usecase.List(c.Request().Context())
@@ -123,17 +135,70 @@ func listUniverses(c *echo.Context, usecase drivingports.ForManagingUniverse) er
 	return c.JSON(http.StatusOK, out)
 }
 
+// getSolarSystem godoc
+//
+//	@Summary		Get solar system
+//	@Description	Returns the planets orbiting in a solar system of a universe. Free orbits have no planet.
+//	@Tags			universes
+//	@Produce		json
+//	@Param			id		path		string	true	"Universe id (UUID)"	Format(uuid)
+//	@Param			galaxy	path		int		true	"Galaxy index"
+//	@Param			system	path		int		true	"Solar system index"
+//	@Success		200		{object}	rest.ResponseEnvelope[dtos.SolarSystemDtoResponse]
+//	@Failure		400		{object}	rest.ResponseEnvelope[string]
+//	@Failure		401		{object}	rest.ResponseEnvelope[string]
+//	@Failure		404		{object}	rest.ResponseEnvelope[string]
+//	@Failure		500		{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
+//	@Router			/universes/{id}/galaxies/{galaxy}/systems/{system} [get]
+func getSolarSystem(c *echo.Context, usecase drivingports.ForManagingUniverse) error {
+	maybeId := c.Param("id")
+	id, err := uuid.Parse(maybeId)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid id syntax")
+	}
+
+	galaxy, err := strconv.Atoi(c.Param("galaxy"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid galaxy syntax")
+	}
+
+	solarSystem, err := strconv.Atoi(c.Param("system"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, "invalid solar system syntax")
+	}
+
+	system, err := usecase.GetSolarSystem(c.Request().Context(), id, galaxy, solarSystem)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return c.JSON(http.StatusNotFound, "no such universe")
+		}
+		if err == domainerrors.ErrSolarSystemNotFound {
+			return c.JSON(http.StatusNotFound, "no such solar system")
+		}
+
+		c.Logger().Error("Failed to get solar system", slog.Any("error", err))
+		return c.JSON(http.StatusInternalServerError, "failed to get solar system")
+	}
+
+	out := mappers.ToSolarSystemResponse(system)
+	return c.JSON(http.StatusOK, out)
+}
+
 // deleteUniverse godoc
 //
 //	@Summary		Delete universe
-//	@Description	Deletes a universe by id.
+//	@Description	Deletes a universe by id. Restricted to administrators.
 //	@Tags			universes
 //	@Produce		json
 //	@Param			id	path		string	true	"Universe id (UUID)"	Format(uuid)
 //	@Success		204	{string}	string
 //	@Failure		400	{object}	rest.ResponseEnvelope[string]
+//	@Failure		401	{object}	rest.ResponseEnvelope[string]
+//	@Failure		403	{object}	rest.ResponseEnvelope[string]
 //	@Failure		409	{object}	rest.ResponseEnvelope[string]
 //	@Failure		500	{object}	rest.ResponseEnvelope[string]
+//	@Security		BearerAuth
 //	@Router			/universes/{id} [delete]
 func deleteUniverse(c *echo.Context, usecase drivingports.ForManagingUniverse) error {
 	maybeId := c.Param("id")
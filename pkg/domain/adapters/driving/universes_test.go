@@ -110,6 +110,12 @@ func TestUnit_Universes_CreateUniverse(t *testing.T) {
 					CreatedAt: someTime,
 				},
 			},
+			Ships:    []dtos.ShipDtoResponse{},
+			Defenses: []dtos.DefenseDtoResponse{},
+
+			Technologies: []dtos.TechnologyDtoResponse{},
+
+			ExchangeRates: []dtos.ExchangeRateDtoResponse{},
 		}
 		assert.Equal(t, expected, actual)
 	})
@@ -176,6 +182,7 @@ func TestUnit_Universes_GetUniverse(t *testing.T) {
 		buildingCostResourceId := uuid.New()
 		buildingProductionResourceId := uuid.New()
 		buildingStorageResourceId := uuid.New()
+		requiredBuildingId := uuid.New()
 
 		universe := models.Universe{
 			Id:        uuid.New(),
@@ -219,6 +226,18 @@ func TestUnit_Universes_GetUniverse(t *testing.T) {
 							Progress: 1.833,
 						},
 					},
+					Requirements: []models.BuildingRequirement{
+						{
+							Building: requiredBuildingId,
+							Level:    2,
+						},
+					},
+				},
+			},
+			ExchangeRates: []models.ExchangeRate{
+				{
+					Resource: sampleResourceId,
+					Rate:     3,
 				},
 			},
 		}
@@ -274,6 +293,23 @@ func TestUnit_Universes_GetUniverse(t *testing.T) {
 							Progress: 1.833,
 						},
 					},
+					Requirements: []dtos.BuildingRequirementDtoResponse{
+						{
+							Building: requiredBuildingId,
+							Level:    2,
+						},
+					},
+				},
+			},
+			Ships:    []dtos.ShipDtoResponse{},
+			Defenses: []dtos.DefenseDtoResponse{},
+
+			Technologies: []dtos.TechnologyDtoResponse{},
+
+			ExchangeRates: []dtos.ExchangeRateDtoResponse{
+				{
+					Resource: sampleResourceId,
+					Rate:     3,
 				},
 			},
 		}
@@ -376,6 +412,12 @@ func TestUnit_Universes_ListUniverses(t *testing.T) {
 					},
 				},
 				Buildings: []dtos.BuildingDtoResponse{},
+				Ships:     []dtos.ShipDtoResponse{},
+				Defenses:  []dtos.DefenseDtoResponse{},
+
+				Technologies: []dtos.TechnologyDtoResponse{},
+
+				ExchangeRates: []dtos.ExchangeRateDtoResponse{},
 			},
 			{
 				Id:        universes[1].Id,
@@ -383,6 +425,12 @@ func TestUnit_Universes_ListUniverses(t *testing.T) {
 				CreatedAt: universes[1].CreatedAt,
 				Resources: []dtos.ResourceDtoResponse{},
 				Buildings: []dtos.BuildingDtoResponse{},
+				Ships:     []dtos.ShipDtoResponse{},
+				Defenses:  []dtos.DefenseDtoResponse{},
+
+				Technologies: []dtos.TechnologyDtoResponse{},
+
+				ExchangeRates: []dtos.ExchangeRateDtoResponse{},
 			},
 		}
 		assert.Equal(t, expected, actual)
@@ -440,6 +488,173 @@ func TestUnit_Universes_ListUniverses(t *testing.T) {
 	})
 }
 
+func TestUnit_Universes_GetSolarSystem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := drivingportstest.NewMockForManagingUniverse(ctrl)
+
+	t.Run("returns 400 when id is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: "not-a-uuid"},
+			{Name: "galaxy", Value: "1"},
+			{Name: "system", Value: "2"},
+		})
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid id syntax", actual)
+	})
+
+	t.Run("returns 400 when galaxy is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "galaxy", Value: "not-a-number"},
+			{Name: "system", Value: "2"},
+		})
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid galaxy syntax", actual)
+	})
+
+	t.Run("returns 400 when solar system is invalid", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req)
+		ctx.SetPathValues([]echo.PathValue{
+			{Name: "id", Value: sampleUuid.String()},
+			{Name: "galaxy", Value: "1"},
+			{Name: "system", Value: "not-a-number"},
+		})
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "invalid solar system syntax", actual)
+	})
+
+	t.Run("forwards fetching to use case", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addSolarSystemPathParams)
+
+		planetId := uuid.New()
+		system := models.SolarSystem{
+			Universe:    sampleUuid,
+			Galaxy:      1,
+			SolarSystem: 2,
+			Slots: []models.SolarSystemSlot{
+				{
+					Position: 0,
+				},
+				{
+					Position: 1,
+					Planet: &models.SolarSystemPlanet{
+						Id:          planetId,
+						Name:        "my-planet",
+						PlayerName:  "my-player",
+						AllianceTag: "ALLY",
+						Homeworld:   true,
+						Position:    1,
+					},
+				},
+			},
+		}
+
+		mockUsecase.EXPECT().
+			GetSolarSystem(gomock.Any(), gomock.Eq(sampleUuid), gomock.Eq(1), gomock.Eq(2)).
+			Times(1).
+			Return(system, nil)
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		actual := decodeResponseBody[dtos.SolarSystemDtoResponse](t, rw)
+		expected := dtos.SolarSystemDtoResponse{
+			Universe:    sampleUuid,
+			Galaxy:      1,
+			SolarSystem: 2,
+			Slots: []dtos.SolarSystemSlotDtoResponse{
+				{
+					Position: 0,
+				},
+				{
+					Position: 1,
+					Planet: &dtos.SolarSystemPlanetDtoResponse{
+						Id:        planetId,
+						Name:      "my-planet",
+						Player:    "my-player",
+						Alliance:  "ALLY",
+						Homeworld: true,
+					},
+				},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns 404 when universe does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addSolarSystemPathParams)
+
+		mockUsecase.EXPECT().
+			GetSolarSystem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.SolarSystem{}, domainerrors.ErrNotFound)
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such universe", actual)
+	})
+
+	t.Run("returns 404 when solar system does not exist", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addSolarSystemPathParams)
+
+		mockUsecase.EXPECT().
+			GetSolarSystem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.SolarSystem{}, domainerrors.ErrSolarSystemNotFound)
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "no such solar system", actual)
+	})
+
+	t.Run("returns 500 when use case fails", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, rw := generateTestContextFromRequest(t, req, addSolarSystemPathParams)
+
+		mockUsecase.EXPECT().
+			GetSolarSystem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.SolarSystem{}, errors.New("stubbed error"))
+
+		err := getSolarSystem(ctx, mockUsecase)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+		actual := decodeResponseBody[string](t, rw)
+		assert.Equal(t, "failed to get solar system", actual)
+	})
+}
+
 func TestUnit_Universes_DeleteUniverse(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockUsecase := drivingportstest.NewMockForManagingUniverse(ctrl)
@@ -517,3 +732,13 @@ func sampleUniverseDtoRequest() dtos.UniverseDtoRequest {
 		},
 	}
 }
+
+func addSolarSystemPathParams(t *testing.T, c *echo.Context) {
+	t.Helper()
+
+	c.SetPathValues([]echo.PathValue{
+		{Name: "id", Value: sampleUuid.String()},
+		{Name: "galaxy", Value: "1"},
+		{Name: "system", Value: "2"},
+	})
+}
@@ -1,10 +1,21 @@
 package drivingadapters
 
 import (
+	"errors"
+	"strconv"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var errInvalidPage = errors.New("invalid page")
+
 type drivingAdapter[T any] = func(*echo.Context, T) error
 
 func generateHandler[T any](handler drivingAdapter[T], usecase T) echo.HandlerFunc {
@@ -23,3 +34,29 @@ func fetchIdFromQueryParam(key string, c *echo.Context) (exists bool, id uuid.UU
 	id, err = uuid.Parse(maybeId)
 	return exists, id, err
 }
+
+// fetchPageFromQueryParams reads the page and size query parameters. They
+// default to the first page and to the default size when not provided.
+func fetchPageFromQueryParams(c *echo.Context) (request.PageRequest, error) {
+	page := request.PageRequest{
+		Page: 0,
+		Size: defaultPageSize,
+	}
+
+	var err error
+	if maybePage := c.QueryParam("page"); maybePage != "" {
+		page.Page, err = strconv.Atoi(maybePage)
+		if err != nil || page.Page < 0 {
+			return page, errInvalidPage
+		}
+	}
+
+	if maybeSize := c.QueryParam("size"); maybeSize != "" {
+		page.Size, err = strconv.Atoi(maybeSize)
+		if err != nil || page.Size < 1 || page.Size > maxPageSize {
+			return page, errInvalidPage
+		}
+	}
+
+	return page, nil
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,3 +59,69 @@ func TestUnit_FetchIdFromQueryParam(t *testing.T) {
 		assert.Equal(t, sampleUuid, actual)
 	})
 }
+
+func TestUnit_FetchPageFromQueryParams(t *testing.T) {
+	t.Run("returns first page with default size when not provided", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		actual, err := fetchPageFromQueryParams(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := request.PageRequest{Page: 0, Size: defaultPageSize}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns requested page", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "3")
+		addQueryParam(t, req, "size", "12")
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		actual, err := fetchPageFromQueryParams(ctx)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := request.PageRequest{Page: 3, Size: 12}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when page has wrong syntax", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "not-a-number")
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		_, err := fetchPageFromQueryParams(ctx)
+
+		assert.Equal(t, errInvalidPage, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when page is negative", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "page", "-1")
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		_, err := fetchPageFromQueryParams(ctx)
+
+		assert.Equal(t, errInvalidPage, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when size is too large", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "size", "101")
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		_, err := fetchPageFromQueryParams(ctx)
+
+		assert.Equal(t, errInvalidPage, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when size is zero", func(t *testing.T) {
+		req := generateTestRequest(t, http.MethodGet)
+		addQueryParam(t, req, "size", "0")
+		ctx, _ := generateTestContextFromRequest(t, req)
+
+		_, err := fetchPageFromQueryParams(ctx)
+
+		assert.Equal(t, errInvalidPage, err, "Actual err: %v", err)
+	})
+}
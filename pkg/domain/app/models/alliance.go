@@ -0,0 +1,253 @@
+package models
+
+import (
+	"slices"
+	"time"
+	"unicode"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	minAllianceNameLength = 3
+	maxAllianceNameLength = 64
+	minAllianceTagLength  = 2
+	maxAllianceTagLength  = 5
+)
+
+type AllianceRank string
+
+const (
+	FounderRank AllianceRank = "founder"
+	OfficerRank AllianceRank = "officer"
+	MemberRank  AllianceRank = "member"
+)
+
+type AlliancePermission string
+
+const (
+	ManageApplicationsPermission AlliancePermission = "manage_applications"
+	ManageRanksPermission        AlliancePermission = "manage_ranks"
+	DisbandPermission            AlliancePermission = "disband"
+)
+
+var rankPermissions = map[AllianceRank][]AlliancePermission{
+	FounderRank: {
+		ManageApplicationsPermission,
+		ManageRanksPermission,
+		DisbandPermission,
+	},
+	OfficerRank: {
+		ManageApplicationsPermission,
+	},
+	MemberRank: {},
+}
+
+func (r AllianceRank) Permissions() []AlliancePermission {
+	return rankPermissions[r]
+}
+
+func (r AllianceRank) Can(permission AlliancePermission) bool {
+	return slices.Contains(rankPermissions[r], permission)
+}
+
+type AllianceMember struct {
+	Player   uuid.UUID
+	Name     string
+	Rank     AllianceRank
+	JoinedAt time.Time
+}
+
+type AllianceApplication struct {
+	Id        uuid.UUID
+	Alliance  uuid.UUID
+	Player    uuid.UUID
+	CreatedAt time.Time
+}
+
+// Alliance groups players of a universe. Both the name and the tag are
+// unique within a universe.
+type Alliance struct {
+	Id       uuid.UUID
+	Universe uuid.UUID
+	Name     string
+	Tag      string
+
+	CreatedAt time.Time
+
+	Members []AllianceMember
+}
+
+// NewAlliance creates an alliance with the player as its founder. The player
+// can not already be part of another alliance.
+func NewAlliance(founder Player, name string, tag string, createdAt time.Time) (Alliance, error) {
+	if founder.Alliance != nil {
+		return Alliance{}, domainerrors.ErrAlreadyInAlliance
+	}
+
+	if len(name) < minAllianceNameLength || len(name) > maxAllianceNameLength {
+		return Alliance{}, domainerrors.ErrInvalidAlliance
+	}
+	if !isValidAllianceTag(tag) {
+		return Alliance{}, domainerrors.ErrInvalidAlliance
+	}
+
+	alliance := Alliance{
+		Id:        uuid.New(),
+		Universe:  founder.Universe,
+		Name:      name,
+		Tag:       tag,
+		CreatedAt: createdAt,
+		Members: []AllianceMember{
+			{
+				Player:   founder.Id,
+				Name:     founder.Name,
+				Rank:     FounderRank,
+				JoinedAt: createdAt,
+			},
+		},
+	}
+	return alliance, nil
+}
+
+func (a Alliance) Member(player uuid.UUID) (AllianceMember, bool) {
+	for _, member := range a.Members {
+		if member.Player == player {
+			return member, true
+		}
+	}
+
+	return AllianceMember{}, false
+}
+
+// CheckPermission verifies that the player is a member of the alliance with
+// a rank granting the permission.
+func (a Alliance) CheckPermission(player uuid.UUID, permission AlliancePermission) error {
+	member, ok := a.Member(player)
+	if !ok {
+		return domainerrors.ErrNotAllianceMember
+	}
+
+	if !member.Rank.Can(permission) {
+		return domainerrors.ErrMissingAlliancePermission
+	}
+
+	return nil
+}
+
+// Apply creates an application for the player to join the alliance. Alliances
+// of other universes are not visible to the player.
+func (a Alliance) Apply(player Player, createdAt time.Time) (AllianceApplication, error) {
+	if player.Universe != a.Universe {
+		return AllianceApplication{}, domainerrors.ErrAllianceNotFound
+	}
+
+	if player.Alliance != nil {
+		return AllianceApplication{}, domainerrors.ErrAlreadyInAlliance
+	}
+
+	application := AllianceApplication{
+		Id:        uuid.New(),
+		Alliance:  a.Id,
+		Player:    player.Id,
+		CreatedAt: createdAt,
+	}
+	return application, nil
+}
+
+// Accept turns the applicant into a member of the alliance. The player
+// deciding on the application needs to be allowed to manage applications.
+func (a *Alliance) Accept(
+	application AllianceApplication,
+	applicant Player,
+	decider uuid.UUID,
+	joinedAt time.Time,
+) (AllianceMember, error) {
+	err := a.CheckPermission(decider, ManageApplicationsPermission)
+	if err != nil {
+		return AllianceMember{}, err
+	}
+
+	if application.Alliance != a.Id || application.Player != applicant.Id {
+		return AllianceMember{}, domainerrors.ErrApplicationNotFound
+	}
+	if applicant.Alliance != nil {
+		return AllianceMember{}, domainerrors.ErrAlreadyInAlliance
+	}
+
+	member := AllianceMember{
+		Player:   applicant.Id,
+		Name:     applicant.Name,
+		Rank:     MemberRank,
+		JoinedAt: joinedAt,
+	}
+	a.Members = append(a.Members, member)
+
+	return member, nil
+}
+
+// SetRank changes the rank of a member. There is a single founder: it is not
+// possible to promote another member to this rank nor to demote the founder.
+func (a *Alliance) SetRank(
+	requester uuid.UUID,
+	player uuid.UUID,
+	rank AllianceRank,
+) (AllianceMember, error) {
+	err := a.CheckPermission(requester, ManageRanksPermission)
+	if err != nil {
+		return AllianceMember{}, err
+	}
+
+	if rank != OfficerRank && rank != MemberRank {
+		return AllianceMember{}, domainerrors.ErrInvalidAllianceRank
+	}
+
+	for id := range a.Members {
+		if a.Members[id].Player != player {
+			continue
+		}
+
+		if a.Members[id].Rank == FounderRank {
+			return AllianceMember{}, domainerrors.ErrInvalidAllianceRank
+		}
+
+		a.Members[id].Rank = rank
+		return a.Members[id], nil
+	}
+
+	return AllianceMember{}, domainerrors.ErrNotAllianceMember
+}
+
+// Leave removes the player from the alliance. The founder can not leave and
+// needs to disband the alliance instead.
+func (a *Alliance) Leave(player uuid.UUID) error {
+	member, ok := a.Member(player)
+	if !ok {
+		return domainerrors.ErrNotAllianceMember
+	}
+
+	if member.Rank == FounderRank {
+		return domainerrors.ErrFounderCannotLeave
+	}
+
+	a.Members = slices.DeleteFunc(a.Members, func(m AllianceMember) bool {
+		return m.Player == player
+	})
+
+	return nil
+}
+
+func isValidAllianceTag(tag string) bool {
+	if len(tag) < minAllianceTagLength || len(tag) > maxAllianceTagLength {
+		return false
+	}
+
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
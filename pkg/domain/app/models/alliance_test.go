@@ -0,0 +1,267 @@
+package models
+
+import (
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_AllianceRank_Can(t *testing.T) {
+	t.Run("founder has all permissions", func(t *testing.T) {
+		assert.True(t, FounderRank.Can(ManageApplicationsPermission))
+		assert.True(t, FounderRank.Can(ManageRanksPermission))
+		assert.True(t, FounderRank.Can(DisbandPermission))
+	})
+
+	t.Run("officer can only manage applications", func(t *testing.T) {
+		assert.True(t, OfficerRank.Can(ManageApplicationsPermission))
+		assert.False(t, OfficerRank.Can(ManageRanksPermission))
+		assert.False(t, OfficerRank.Can(DisbandPermission))
+	})
+
+	t.Run("member has no permission", func(t *testing.T) {
+		assert.Empty(t, MemberRank.Permissions())
+	})
+}
+
+func TestUnit_NewAlliance(t *testing.T) {
+	founder := Player{Id: uuid.New(), Universe: uuid.New(), Name: "founder"}
+
+	t.Run("creates alliance with founder", func(t *testing.T) {
+		actual, err := NewAlliance(founder, "my alliance", "ALLY", someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := Alliance{
+			Id:        actual.Id,
+			Universe:  founder.Universe,
+			Name:      "my alliance",
+			Tag:       "ALLY",
+			CreatedAt: someTime,
+			Members: []AllianceMember{
+				{Player: founder.Id, Name: "founder", Rank: FounderRank, JoinedAt: someTime},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when founder is already in an alliance", func(t *testing.T) {
+		member := founder
+		member.Alliance = &PlayerAlliance{Id: uuid.New()}
+
+		_, err := NewAlliance(member, "my alliance", "ALLY", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyInAlliance, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when name is too short", func(t *testing.T) {
+		_, err := NewAlliance(founder, "ab", "ALLY", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAlliance, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when tag is too long", func(t *testing.T) {
+		_, err := NewAlliance(founder, "my alliance", "ALLIES", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAlliance, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when tag contains invalid characters", func(t *testing.T) {
+		_, err := NewAlliance(founder, "my alliance", "A-Y", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAlliance, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_Alliance_Apply(t *testing.T) {
+	alliance := newTestAlliance()
+
+	t.Run("creates application", func(t *testing.T) {
+		player := Player{Id: uuid.New(), Universe: alliance.Universe}
+
+		actual, err := alliance.Apply(player, someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := AllianceApplication{
+			Id:        actual.Id,
+			Alliance:  alliance.Id,
+			Player:    player.Id,
+			CreatedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when player is in another universe", func(t *testing.T) {
+		player := Player{Id: uuid.New(), Universe: uuid.New()}
+
+		_, err := alliance.Apply(player, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAllianceNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when player is already in an alliance", func(t *testing.T) {
+		player := Player{
+			Id:       uuid.New(),
+			Universe: alliance.Universe,
+			Alliance: &PlayerAlliance{Id: uuid.New()},
+		}
+
+		_, err := alliance.Apply(player, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyInAlliance, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_Alliance_Accept(t *testing.T) {
+	applicant := Player{Id: uuid.New(), Name: "applicant"}
+
+	t.Run("adds applicant as member", func(t *testing.T) {
+		alliance := newTestAlliance()
+		application := AllianceApplication{Id: uuid.New(), Alliance: alliance.Id, Player: applicant.Id}
+
+		actual, err := alliance.Accept(application, applicant, alliance.Members[1].Player, someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := AllianceMember{
+			Player:   applicant.Id,
+			Name:     "applicant",
+			Rank:     MemberRank,
+			JoinedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+		assert.Len(t, alliance.Members, 4)
+	})
+
+	t.Run("returns error when decider is not allowed", func(t *testing.T) {
+		alliance := newTestAlliance()
+		application := AllianceApplication{Id: uuid.New(), Alliance: alliance.Id, Player: applicant.Id}
+
+		_, err := alliance.Accept(application, applicant, alliance.Members[2].Player, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMissingAlliancePermission, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when decider is not a member", func(t *testing.T) {
+		alliance := newTestAlliance()
+		application := AllianceApplication{Id: uuid.New(), Alliance: alliance.Id, Player: applicant.Id}
+
+		_, err := alliance.Accept(application, applicant, uuid.New(), someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotAllianceMember, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when application targets another alliance", func(t *testing.T) {
+		alliance := newTestAlliance()
+		application := AllianceApplication{Id: uuid.New(), Alliance: uuid.New(), Player: applicant.Id}
+
+		_, err := alliance.Accept(application, applicant, alliance.Members[0].Player, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrApplicationNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when applicant joined another alliance", func(t *testing.T) {
+		alliance := newTestAlliance()
+		application := AllianceApplication{Id: uuid.New(), Alliance: alliance.Id, Player: applicant.Id}
+		member := applicant
+		member.Alliance = &PlayerAlliance{Id: uuid.New()}
+
+		_, err := alliance.Accept(application, member, alliance.Members[0].Player, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyInAlliance, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_Alliance_SetRank(t *testing.T) {
+	t.Run("promotes member", func(t *testing.T) {
+		alliance := newTestAlliance()
+		founder, member := alliance.Members[0].Player, alliance.Members[2].Player
+
+		actual, err := alliance.SetRank(founder, member, OfficerRank)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, OfficerRank, actual.Rank)
+		assert.Equal(t, OfficerRank, alliance.Members[2].Rank)
+	})
+
+	t.Run("returns error when requester is not allowed", func(t *testing.T) {
+		alliance := newTestAlliance()
+		officer, member := alliance.Members[1].Player, alliance.Members[2].Player
+
+		_, err := alliance.SetRank(officer, member, OfficerRank)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMissingAlliancePermission, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when promoting to founder", func(t *testing.T) {
+		alliance := newTestAlliance()
+		founder, member := alliance.Members[0].Player, alliance.Members[2].Player
+
+		_, err := alliance.SetRank(founder, member, FounderRank)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAllianceRank, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when demoting founder", func(t *testing.T) {
+		alliance := newTestAlliance()
+		founder := alliance.Members[0].Player
+
+		_, err := alliance.SetRank(founder, founder, MemberRank)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAllianceRank, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when player is not a member", func(t *testing.T) {
+		alliance := newTestAlliance()
+		founder := alliance.Members[0].Player
+
+		_, err := alliance.SetRank(founder, uuid.New(), MemberRank)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotAllianceMember, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_Alliance_Leave(t *testing.T) {
+	t.Run("removes member", func(t *testing.T) {
+		alliance := newTestAlliance()
+		member := alliance.Members[2].Player
+
+		err := alliance.Leave(member)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		_, ok := alliance.Member(member)
+		assert.False(t, ok)
+		assert.Len(t, alliance.Members, 2)
+	})
+
+	t.Run("returns error when founder leaves", func(t *testing.T) {
+		alliance := newTestAlliance()
+
+		err := alliance.Leave(alliance.Members[0].Player)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFounderCannotLeave, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when player is not a member", func(t *testing.T) {
+		alliance := newTestAlliance()
+
+		err := alliance.Leave(uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotAllianceMember, "Actual err: %v", err)
+	})
+}
+
+func newTestAlliance() Alliance {
+	return Alliance{
+		Id:       uuid.New(),
+		Universe: uuid.New(),
+		Name:     "my alliance",
+		Tag:      "ALLY",
+		Members: []AllianceMember{
+			{Player: uuid.New(), Rank: FounderRank},
+			{Player: uuid.New(), Rank: OfficerRank},
+			{Player: uuid.New(), Rank: MemberRank},
+		},
+	}
+}
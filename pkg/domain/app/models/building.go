@@ -2,19 +2,29 @@ package models
 
 import (
 	"math"
+	"strings"
 	"time"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 )
 
+// demolitionCostRatio is the fraction of the construction cost of a level
+// to pay to demolish it. As the build time derives from the costs, it also
+// applies to the duration of the demolition.
+const demolitionCostRatio = 0.5
+
 type Building struct {
 	Id        uuid.UUID
 	Name      string
 	CreatedAt time.Time
 
-	Costs       []BuildingCost
-	Productions []BuildingResourceProduction
-	Storages    []BuildingResourceStorage
+	Costs        []BuildingCost
+	Productions  []BuildingResourceProduction
+	Consumptions []BuildingResourceConsumption
+	Storages     []BuildingResourceStorage
+
+	Requirements []BuildingRequirement
 }
 
 type BuildingCost struct {
@@ -30,6 +40,12 @@ type BuildingResourceProduction struct {
 	Progress float64
 }
 
+type BuildingResourceConsumption struct {
+	Resource uuid.UUID
+	Base     int
+	Progress float64
+}
+
 type BuildingResourceStorage struct {
 	Resource uuid.UUID
 	Base     int
@@ -37,20 +53,32 @@ type BuildingResourceStorage struct {
 	Progress float64
 }
 
+// BuildingRequirement defines the minimum level another building should
+// reach on a planet before this building can be upgraded there.
+type BuildingRequirement struct {
+	Building uuid.UUID
+	Level    int
+}
+
+// CreateBuildingAction creates an action upgrading the building to the
+// desired level. The action starts at the input time which can be after
+// its creation when other actions are queued before it.
 func (b Building) CreateBuildingAction(
 	desiredLevel int,
 	createdAt time.Time,
+	startAt time.Time,
 ) BuildingAction {
 	costs := b.determineActionCost(desiredLevel)
 	completionTime := b.determineCompletionTime(costs)
 
 	action := BuildingAction{
 		Id:           uuid.New(),
+		Kind:         UpgradeAction,
 		Building:     b.Id,
 		DesiredLevel: desiredLevel,
 
 		CreatedAt:   createdAt,
-		CompletedAt: createdAt.Add(completionTime),
+		CompletedAt: startAt.Add(completionTime),
 
 		Costs:       costs,
 		Storages:    b.determineActionResourceStorage(desiredLevel),
@@ -59,6 +87,122 @@ func (b Building) CreateBuildingAction(
 	return action
 }
 
+// CreateDemolitionAction creates an action lowering the building to the
+// desired level. It costs a fraction of the construction of the level
+// being demolished. Once completed, the productions and storages of the
+// building are the ones of the desired level.
+func (b Building) CreateDemolitionAction(
+	desiredLevel int,
+	createdAt time.Time,
+	startAt time.Time,
+) BuildingAction {
+	costs := b.determineActionCost(desiredLevel + 1)
+	for id := range costs {
+		costs[id].Amount = int(math.Floor(demolitionCostRatio * float64(costs[id].Amount)))
+	}
+	completionTime := b.determineCompletionTime(costs)
+
+	action := BuildingAction{
+		Id:           uuid.New(),
+		Kind:         DemolishAction,
+		Building:     b.Id,
+		DesiredLevel: desiredLevel,
+
+		CreatedAt:   createdAt,
+		CompletedAt: startAt.Add(completionTime),
+
+		Costs:       costs,
+		Storages:    b.determineActionResourceStorage(desiredLevel),
+		Productions: b.determineActionResourceProduction(desiredLevel),
+	}
+	return action
+}
+
+// Validate checks the balancing values of the building. Costs and storages
+// should be positive and a resource can only appear once in each list as
+// the levels are computed per resource. Requirements should target other
+// buildings, each one at most once and with a positive level.
+func (b Building) Validate() error {
+	if strings.TrimSpace(b.Name) == "" {
+		return domainerrors.ErrInvalidBuilding
+	}
+
+	costs := make(map[uuid.UUID]bool)
+	for _, cost := range b.Costs {
+		if cost.Cost <= 0 || cost.Progress <= 0 || costs[cost.Resource] {
+			return domainerrors.ErrInvalidBuilding
+		}
+		costs[cost.Resource] = true
+	}
+
+	productions := make(map[uuid.UUID]bool)
+	for _, production := range b.Productions {
+		if production.Base < 0 || production.Progress <= 0 || productions[production.Resource] {
+			return domainerrors.ErrInvalidBuilding
+		}
+		productions[production.Resource] = true
+	}
+
+	storages := make(map[uuid.UUID]bool)
+	for _, storage := range b.Storages {
+		if storage.Base <= 0 || storage.Scale <= 0 || storage.Progress <= 0 || storages[storage.Resource] {
+			return domainerrors.ErrInvalidBuilding
+		}
+		storages[storage.Resource] = true
+	}
+
+	consumptions := make(map[uuid.UUID]bool)
+	for _, consumption := range b.Consumptions {
+		if consumption.Base < 0 || consumption.Progress <= 0 || consumptions[consumption.Resource] {
+			return domainerrors.ErrInvalidBuilding
+		}
+		consumptions[consumption.Resource] = true
+	}
+
+	requirements := make(map[uuid.UUID]bool)
+	for _, requirement := range b.Requirements {
+		if requirement.Building == b.Id || requirement.Level <= 0 || requirements[requirement.Building] {
+			return domainerrors.ErrInvalidBuilding
+		}
+		requirements[requirement.Building] = true
+	}
+
+	return nil
+}
+
+func (b Building) usedResources() []uuid.UUID {
+	var out []uuid.UUID
+
+	for _, cost := range b.Costs {
+		out = append(out, cost.Resource)
+	}
+	for _, production := range b.Productions {
+		out = append(out, production.Resource)
+	}
+	for _, storage := range b.Storages {
+		out = append(out, storage.Resource)
+	}
+	for _, consumption := range b.Consumptions {
+		out = append(out, consumption.Resource)
+	}
+
+	return out
+}
+
+// Points returns the amount of resources spent to reach the level from
+// scratch, whatever the resource.
+func (b Building) Points(level int) int {
+	points := 0
+
+	for l := 1; l <= level; l++ {
+		for _, cost := range b.determineActionCost(l) {
+			points += cost.Amount
+		}
+	}
+
+	return points
+}
+
 func (b Building) determineActionCost(
 	desiredLevel int,
 ) []BuildingActionCost {
@@ -77,6 +221,9 @@ func (b Building) determineActionCost(
 	return costs
 }
 
+// determineActionResourceProduction returns the productions of the
+// building at the desired level. The bonus of the energy technology is
+// not included: it is applied as the planet produces, see UpdateToTime.
 func (b Building) determineActionResourceProduction(
 	desiredLevel int,
 ) []BuildingActionResourceProduction {
@@ -96,6 +243,19 @@ func (b Building) determineActionResourceProduction(
 		productions = append(productions, production)
 	}
 
+	// Consumptions are stored as negative productions: this way they are
+	// persisted along with the rest of the productions of the planet.
+	// https://ogame.fandom.com/wiki/Metal_Mine#Energy_Consumption
+	for _, baseConsumption := range b.Consumptions {
+		resourceConsumption := math.Floor(float64(baseConsumption.Base) * levelAsFloat * math.Pow(baseConsumption.Progress, levelAsFloat))
+
+		production := BuildingActionResourceProduction{
+			Resource:   baseConsumption.Resource,
+			Production: -int(resourceConsumption),
+		}
+		productions = append(productions, production)
+	}
+
 	return productions
 }
 
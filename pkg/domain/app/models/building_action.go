@@ -6,8 +6,20 @@ import (
 	"github.com/google/uuid"
 )
 
+// maxBuildingQueueLength is the maximum number of building actions queued
+// on a planet, including the one in progress.
+const maxBuildingQueueLength = 5
+
+type BuildingActionKind string
+
+const (
+	UpgradeAction  BuildingActionKind = "upgrade"
+	DemolishAction BuildingActionKind = "demolish"
+)
+
 type BuildingAction struct {
 	Id       uuid.UUID
+	Kind     BuildingActionKind
 	Building uuid.UUID
 
 	DesiredLevel int
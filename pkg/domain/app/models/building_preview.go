@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BuildingLevelPreview describes the upgrade of a building to a level
+// without queuing it.
+type BuildingLevelPreview struct {
+	Building uuid.UUID
+	Level    int
+
+	Costs    []BuildingActionCost
+	Duration time.Duration
+
+	// Storages and Productions are the ones of the building once the level
+	// is reached. Just like for the planet, Productions do not include the
+	// bonus of the energy technology.
+	Storages    []BuildingActionResourceStorage
+	Productions []BuildingActionResourceProduction
+
+	// Affordable tells whether the planet has enough resources to pay for
+	// this level on its own, regardless of the levels before it.
+	Affordable bool
+}
+
+// PreviewBuildingUpgrades describes the next levels of the building,
+// starting after the level reached once the actions already queued for it
+// are completed. The values are the ones the actions would have if they
+// were created at the UpdatedAt field of the planet: callers are expected
+// to trigger UpdateToTime beforehand. The planet is not modified.
+func (p *Planet) PreviewBuildingUpgrades(
+	building Building,
+	levels int,
+) ([]BuildingLevelPreview, error) {
+	level, err := p.queuedBuildingLevel(building.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]BuildingLevelPreview, 0, levels)
+	for desiredLevel := level + 1; desiredLevel <= level+levels; desiredLevel++ {
+		action := building.CreateBuildingAction(desiredLevel, p.UpdatedAt, p.UpdatedAt)
+
+		preview := BuildingLevelPreview{
+			Building:    building.Id,
+			Level:       desiredLevel,
+			Costs:       action.Costs,
+			Duration:    action.CompletedAt.Sub(p.UpdatedAt),
+			Storages:    action.Storages,
+			Productions: action.Productions,
+			Affordable:  p.validateEnoughResources(actionCosts(action.Costs)) == nil,
+		}
+		out = append(out, preview)
+	}
+
+	return out, nil
+}
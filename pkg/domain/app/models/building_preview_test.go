@@ -0,0 +1,93 @@
+package models
+
+import (
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_Planet_PreviewBuildingUpgrades(t *testing.T) {
+	t.Run("returns error when building does not exist on planet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		b := generateTestBuilding(t)
+
+		_, err := p.PreviewBuildingUpgrades(b, 3)
+
+		assert.ErrorIs(t, err, domainerrors.ErrBuildingNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("describes levels following the current one", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		b := generateTestBuilding(t, withBuildingCost, withBuildingProduction, withBuildingStorage)
+
+		actual, err := p.PreviewBuildingUpgrades(b, 3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 3)
+		for id, preview := range actual {
+			action := b.CreateBuildingAction(5+id, someTime, someTime)
+			expected := BuildingLevelPreview{
+				Building:    buildingId,
+				Level:       5 + id,
+				Costs:       action.Costs,
+				Duration:    action.CompletedAt.Sub(someTime),
+				Storages:    action.Storages,
+				Productions: action.Productions,
+				Affordable:  true,
+			}
+			assert.Equal(t, expected, preview)
+		}
+		assert.Less(t, actual[0].Duration, actual[1].Duration)
+	})
+
+	t.Run("starts after the levels already queued", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.BuildingQueue = []BuildingAction{
+			{Id: uuid.New(), Building: buildingId, DesiredLevel: 5},
+			{Id: uuid.New(), Building: uuid.New(), DesiredLevel: 2},
+		}
+		b := generateTestBuilding(t, withBuildingCost)
+
+		actual, err := p.PreviewBuildingUpgrades(b, 2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, 6, actual[0].Level)
+		assert.Equal(t, 7, actual[1].Level)
+	})
+
+	t.Run("tells whether each level is affordable on its own", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		b := generateTestBuilding(t, withBuildingCost)
+
+		action := b.CreateBuildingAction(5, someTime, someTime)
+		for _, cost := range action.Costs {
+			p.Resources = append(p.Resources, PlanetResource{
+				Resource: cost.Resource,
+				Amount:   float64(cost.Amount),
+			})
+		}
+
+		actual, err := p.PreviewBuildingUpgrades(b, 2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 2)
+		assert.True(t, actual[0].Affordable)
+		assert.False(t, actual[1].Affordable)
+	})
+
+	t.Run("does not modify planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		expected := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		expected.Id = p.Id
+		b := generateTestBuilding(t, withBuildingCost)
+
+		_, err := p.PreviewBuildingUpgrades(b, 4)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, p)
+	})
+}
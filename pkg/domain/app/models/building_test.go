@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -18,12 +20,13 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 	t.Run("correctly calculates action costs", func(t *testing.T) {
 		b := generateTestBuilding(t, withBuildingCost)
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		expected := BuildingAction{
 			// The identifier is generated
 			Id:           action.Id,
 			Building:     b.Id,
+			Kind:         UpgradeAction,
 			DesiredLevel: 5,
 
 			CreatedAt: someTime,
@@ -49,11 +52,12 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 	t.Run("correctly calculates action resource productions", func(t *testing.T) {
 		b := generateTestBuilding(t, withBuildingProduction)
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		expected := BuildingAction{
 			Id:           action.Id,
 			Building:     b.Id,
+			Kind:         UpgradeAction,
 			DesiredLevel: 5,
 
 			CreatedAt: someTime,
@@ -76,14 +80,37 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 		assert.Equal(t, expected, action)
 	})
 
+	t.Run("adds resource consumptions as negative productions", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingProduction, withBuildingConsumption)
+
+		action := b.CreateBuildingAction(5, someTime, someTime)
+
+		expected := []BuildingActionResourceProduction{
+			{
+				Resource:   metalResourceId,
+				Production: 682754,
+			},
+			{
+				Resource:   crystalResourceId,
+				Production: 39016,
+			},
+			{
+				Resource:   energyResourceId,
+				Production: -80,
+			},
+		}
+		assert.Equal(t, expected, action.Productions)
+	})
+
 	t.Run("correctly calculates action resource storages", func(t *testing.T) {
 		b := generateTestBuilding(t, withBuildingStorage)
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		expected := BuildingAction{
 			Id:           action.Id,
 			Building:     b.Id,
+			Kind:         UpgradeAction,
 			DesiredLevel: 5,
 
 			CreatedAt: someTime,
@@ -141,7 +168,7 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 			Storages:    []BuildingResourceStorage{},
 		}
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		expectedCosts := []BuildingActionCost{
 			{
@@ -178,7 +205,7 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 			Storages:    []BuildingResourceStorage{},
 		}
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		assert.Equal(t, someTime, action.CreatedAt)
 		assert.Equal(t, someTime, action.CompletedAt)
@@ -201,7 +228,7 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 			Storages:    []BuildingResourceStorage{},
 		}
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		completionTime := 262080 * time.Millisecond
 		assert.Equal(t, someTime, action.CreatedAt)
@@ -225,13 +252,194 @@ func TestUnit_Building_CreateBuildingAction(t *testing.T) {
 			Storages:    []BuildingResourceStorage{},
 		}
 
-		action := b.CreateBuildingAction(5, someTime)
+		action := b.CreateBuildingAction(5, someTime, someTime)
 
 		assert.Equal(t, someTime, action.CreatedAt)
 		assert.Equal(t, someTime, action.CompletedAt)
 	})
 }
 
+func TestUnit_Building_CreateDemolitionAction(t *testing.T) {
+	t.Run("correctly calculates action costs", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+
+		action := b.CreateDemolitionAction(4, someTime, someTime)
+
+		expected := []BuildingActionCost{
+			{
+				Resource: metalResourceId,
+				Amount:   91,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   325,
+			},
+		}
+		assert.Equal(t, expected, action.Costs)
+	})
+
+	t.Run("targets desired level", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+
+		action := b.CreateDemolitionAction(4, someTime, someTime)
+
+		assert.Equal(t, b.Id, action.Building)
+		assert.Equal(t, DemolishAction, action.Kind)
+		assert.Equal(t, 4, action.DesiredLevel)
+	})
+
+	t.Run("uses productions and storages of desired level", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingProduction, withBuildingStorage)
+
+		action := b.CreateDemolitionAction(4, someTime, someTime)
+		upgrade := b.CreateBuildingAction(4, someTime, someTime)
+
+		assert.Equal(t, upgrade.Productions, action.Productions)
+		assert.Equal(t, upgrade.Storages, action.Storages)
+	})
+
+	t.Run("takes less time than building the level", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+
+		action := b.CreateDemolitionAction(4, someTime, someTime)
+		upgrade := b.CreateBuildingAction(5, someTime, someTime)
+
+		assert.Equal(t, someTime, action.CreatedAt)
+		assert.True(t, action.CompletedAt.After(someTime))
+		assert.True(t, action.CompletedAt.Before(upgrade.CompletedAt))
+	})
+}
+
+func TestUnit_Building_Points(t *testing.T) {
+	t.Run("sums costs of all levels", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+
+		actual := b.Points(2)
+
+		assert.Equal(t, 300, actual)
+	})
+
+	t.Run("returns zero when building is not built", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+
+		actual := b.Points(0)
+
+		assert.Equal(t, 0, actual)
+	})
+}
+
+func TestUnit_Building_Validate(t *testing.T) {
+	t.Run("accepts valid building", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost, withBuildingProduction, withBuildingStorage)
+
+		err := b.Validate()
+
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects empty name", func(t *testing.T) {
+		b := generateTestBuilding(t)
+		b.Name = " "
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects cost which is not positive", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+		b.Costs[0].Cost = 0
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects negative production", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingProduction)
+		b.Productions[1].Base = -1
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects storage without scale", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingStorage)
+		b.Storages[0].Scale = 0
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects resource defined twice", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingCost)
+		b.Costs[1].Resource = b.Costs[0].Resource
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects consumption without progress", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingConsumption)
+		b.Consumptions[0].Progress = 0
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects negative consumption", func(t *testing.T) {
+		b := generateTestBuilding(t, withBuildingConsumption)
+		b.Consumptions[0].Base = -1
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("accepts requirement on another building", func(t *testing.T) {
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{{Building: metalMineId, Level: 2}}
+
+		err := b.Validate()
+
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects requirement on the building itself", func(t *testing.T) {
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{{Building: b.Id, Level: 2}}
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects requirement without level", func(t *testing.T) {
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{{Building: metalMineId, Level: 0}}
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects building required twice", func(t *testing.T) {
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{
+			{Building: metalMineId, Level: 2},
+			{Building: metalMineId, Level: 3},
+		}
+
+		err := b.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+}
+
 func generateTestBuilding(
 	t *testing.T,
 	modifiers ...func(*testing.T, *Building),
@@ -292,6 +500,18 @@ func withBuildingStorage(t *testing.T, b *Building) {
 	}
 }
 
+func withBuildingConsumption(t *testing.T, b *Building) {
+	t.Helper()
+
+	b.Consumptions = []BuildingResourceConsumption{
+		{
+			Resource: energyResourceId,
+			Base:     10,
+			Progress: 1.1,
+		},
+	}
+}
+
 func withBuildingProduction(t *testing.T, b *Building) {
 	t.Helper()
 
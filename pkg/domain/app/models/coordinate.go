@@ -1,6 +1,10 @@
 package models
 
-import "math/rand"
+import (
+	"math/rand"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+)
 
 const (
 	homeworldFields    = 163
@@ -8,44 +12,22 @@ const (
 	maxFieldsForBeyond = 70
 )
 
-var (
-	// https://board.en.ogame.gameforge.com/index.php?thread/790879-minimum-planet-size/
-	minFields = map[int]int{
-		0:  95,
-		1:  97,
-		2:  98,
-		3:  123,
-		4:  148,
-		5:  148,
-		6:  141,
-		7:  163,
-		8:  155,
-		9:  151,
-		10: 139,
-		11: 134,
-		12: 109,
-		13: 81,
-		14: 65,
-	}
+// PlanetFieldRange defines the number of fields of the planets created at
+// a position of a solar system. The ranges are part of the game data.
+// https://board.en.ogame.gameforge.com/index.php?thread/790879-minimum-planet-size/
+type PlanetFieldRange struct {
+	Position int
+	Min      int
+	Max      int
+}
 
-	maxFields = map[int]int{
-		0:  108,
-		1:  110,
-		2:  139,
-		3:  210,
-		4:  215,
-		5:  239,
-		6:  242,
-		7:  248,
-		8:  243,
-		9:  225,
-		10: 205,
-		11: 180,
-		12: 121,
-		13: 93,
-		14: 74,
+func (r PlanetFieldRange) Validate() error {
+	if r.Position < 0 || r.Min <= 0 || r.Max <= r.Min {
+		return domainerrors.ErrInvalidRuleset
 	}
-)
+
+	return nil
+}
 
 type Coordinate struct {
 	Galaxy      int
@@ -53,22 +35,45 @@ type Coordinate struct {
 	Position    int
 }
 
-func (c Coordinate) Fields(homeworld bool) int {
+// Fields picks the number of fields of a planet at this coordinate in the
+// range defined for its position. Positions without a range use a default
+// one.
+func (c Coordinate) Fields(homeworld bool, ranges []PlanetFieldRange) int {
 	if homeworld {
 		return homeworldFields
 	}
 
-	min := fieldOrDefault(c.Position, minFields, minFieldsForBeyond)
-	max := fieldOrDefault(c.Position, maxFields, maxFieldsForBeyond)
+	min, max := minFieldsForBeyond, maxFieldsForBeyond
+	for _, r := range ranges {
+		if r.Position == c.Position {
+			min, max = r.Min, r.Max
+		}
+	}
 
 	return min + rand.Intn(max-min)
 }
 
-func fieldOrDefault(position int, table map[int]int, defaultFields int) int {
-	value, ok := table[position]
-	if ok {
-		return value
+// DistanceTo computes the distance between two coordinates as defined in:
+// https://ogame.fandom.com/wiki/Distance
+func (c Coordinate) DistanceTo(other Coordinate) int {
+	if c.Galaxy != other.Galaxy {
+		return 20000 * absInt(c.Galaxy-other.Galaxy)
 	}
 
-	return defaultFields
+	if c.SolarSystem != other.SolarSystem {
+		return 2700 + 95*absInt(c.SolarSystem-other.SolarSystem)
+	}
+
+	if c.Position != other.Position {
+		return 1000 + 5*absInt(c.Position-other.Position)
+	}
+
+	return 5
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
 }
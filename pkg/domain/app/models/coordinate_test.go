@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_Coordinate_Fields(t *testing.T) {
+	ranges := []PlanetFieldRange{
+		{Position: 2, Min: 98, Max: 139},
+	}
+
+	t.Run("returns fixed fields for homeworld", func(t *testing.T) {
+		c := Coordinate{Position: 2}
+
+		actual := c.Fields(true, ranges)
+
+		assert.Equal(t, 163, actual)
+	})
+
+	t.Run("picks fields in range of position", func(t *testing.T) {
+		c := Coordinate{Position: 2}
+
+		actual := c.Fields(false, ranges)
+
+		assert.GreaterOrEqual(t, actual, 98)
+		assert.Less(t, actual, 139)
+	})
+
+	t.Run("uses default range when position has none", func(t *testing.T) {
+		c := Coordinate{Position: 15}
+
+		actual := c.Fields(false, ranges)
+
+		assert.GreaterOrEqual(t, actual, 60)
+		assert.Less(t, actual, 70)
+	})
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Defense struct {
+	Id        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+
+	Costs []UnitCost
+}
+
+// CreateShipyardAction creates a batch producing count defenses. The first
+// defense starts being produced at startAt, which might be later than the
+// creation time if the shipyard is busy. An error is returned if the
+// count is not positive or exceeds MaxShipyardBatchSize, or if the costs
+// of the batch cannot be represented.
+func (d Defense) CreateShipyardAction(
+	count int,
+	shipyardLevel int,
+	createdAt time.Time,
+	startAt time.Time,
+) (ShipyardAction, error) {
+	return newShipyardAction(DefenseUnit, d.Id, d.Costs, count, shipyardLevel, createdAt, startAt)
+}
@@ -9,6 +9,10 @@ const (
 	universeNotFound       errors.ErrorCode = 603
 	playerNotFound         errors.ErrorCode = 604
 	planetResourceNotFound errors.ErrorCode = 605
+	unitNotFound           errors.ErrorCode = 606
+	fleetTargetNotFound    errors.ErrorCode = 607
+	technologyNotFound     errors.ErrorCode = 608
+	messageNotFound        errors.ErrorCode = 609
 
 	nameAlreadyTaken           errors.ErrorCode = 610
 	actionAlreadyInProgress    errors.ErrorCode = 611
@@ -24,14 +28,57 @@ const (
 	universeIsNotEmpty         errors.ErrorCode = 621
 	coordinateAlreadyUsed      errors.ErrorCode = 622
 	allFieldsUsed              errors.ErrorCode = 623
+	colonyLimitReached         errors.ErrorCode = 624
+	shipyardNotBuilt           errors.ErrorCode = 625
+	invalidUnitCount           errors.ErrorCode = 626
+	notEnoughShips             errors.ErrorCode = 627
+	cargoCapacityExceeded      errors.ErrorCode = 628
+	invalidFleet               errors.ErrorCode = 629
+	invalidFleetTarget         errors.ErrorCode = 630
+	fleetAlreadyReturning      errors.ErrorCode = 631
+	fleetAlreadyArrived        errors.ErrorCode = 632
+	fleetLimitReached          errors.ErrorCode = 633
+	requirementsNotMet         errors.ErrorCode = 634
+	buildingQueueFull          errors.ErrorCode = 635
+	invalidBuildingQueue       errors.ErrorCode = 636
+	nothingToDemolish          errors.ErrorCode = 637
+	invalidBuildingActionKind  errors.ErrorCode = 638
+	solarSystemNotFound        errors.ErrorCode = 639
+	playersInDifferentUniverse errors.ErrorCode = 640
+	invalidMessage             errors.ErrorCode = 641
+	allianceNotFound           errors.ErrorCode = 642
+	applicationNotFound        errors.ErrorCode = 643
+	tagAlreadyTaken            errors.ErrorCode = 644
+	invalidAlliance            errors.ErrorCode = 645
+	alreadyInAlliance          errors.ErrorCode = 646
+	alreadyApplied             errors.ErrorCode = 647
+	notAllianceMember          errors.ErrorCode = 648
+	missingAlliancePermission  errors.ErrorCode = 649
+	invalidAllianceRank        errors.ErrorCode = 650
+	founderCannotLeave         errors.ErrorCode = 651
+	invalidTrade               errors.ErrorCode = 652
+	storageCapacityExceeded    errors.ErrorCode = 653
+	marketOfferNotFound        errors.ErrorCode = 654
+	invalidMarketOffer         errors.ErrorCode = 655
+	marketOfferNotAvailable    errors.ErrorCode = 656
+	notResourceOwner           errors.ErrorCode = 657
+	invalidResource            errors.ErrorCode = 658
+	invalidBuilding            errors.ErrorCode = 659
+	invalidRuleset             errors.ErrorCode = 660
+	gameDataRoleRenamed        errors.ErrorCode = 661
+	universeFull               errors.ErrorCode = 662
 )
 
 var (
-	ErrNotFound         = errors.FromCode(resourceNotFound)
-	ErrBuildingNotFound = errors.FromCode(buildingNotFound)
-	ErrUniverseNotFound = errors.FromCode(universeNotFound)
-	ErrPlayerNotFound   = errors.FromCode(playerNotFound)
-	ErrResourceNotFound = errors.FromCode(planetResourceNotFound)
+	ErrNotFound            = errors.FromCode(resourceNotFound)
+	ErrBuildingNotFound    = errors.FromCode(buildingNotFound)
+	ErrUniverseNotFound    = errors.FromCode(universeNotFound)
+	ErrPlayerNotFound      = errors.FromCode(playerNotFound)
+	ErrResourceNotFound    = errors.FromCode(planetResourceNotFound)
+	ErrUnitNotFound        = errors.FromCode(unitNotFound)
+	ErrFleetTargetNotFound = errors.FromCode(fleetTargetNotFound)
+	ErrTechnologyNotFound  = errors.FromCode(technologyNotFound)
+	ErrMessageNotFound     = errors.FromCode(messageNotFound)
 
 	ErrNameAlreadyTaken           = errors.FromCode(nameAlreadyTaken)
 	ErrActionAlreadyInProgress    = errors.FromCode(actionAlreadyInProgress)
@@ -47,4 +94,43 @@ var (
 	ErrUniverseIsNotEmpty         = errors.FromCode(universeIsNotEmpty)
 	ErrCoordinateAlreadyUsed      = errors.FromCode(coordinateAlreadyUsed)
 	ErrAllFieldsUsed              = errors.FromCode(allFieldsUsed)
+	ErrColonyLimitReached         = errors.FromCode(colonyLimitReached)
+	ErrShipyardNotBuilt           = errors.FromCode(shipyardNotBuilt)
+	ErrInvalidUnitCount           = errors.FromCode(invalidUnitCount)
+	ErrNotEnoughShips             = errors.FromCode(notEnoughShips)
+	ErrCargoCapacityExceeded      = errors.FromCode(cargoCapacityExceeded)
+	ErrInvalidFleet               = errors.FromCode(invalidFleet)
+	ErrInvalidFleetTarget         = errors.FromCode(invalidFleetTarget)
+	ErrFleetAlreadyReturning      = errors.FromCode(fleetAlreadyReturning)
+	ErrFleetAlreadyArrived        = errors.FromCode(fleetAlreadyArrived)
+	ErrFleetLimitReached          = errors.FromCode(fleetLimitReached)
+	ErrRequirementsNotMet         = errors.FromCode(requirementsNotMet)
+	ErrBuildingQueueFull          = errors.FromCode(buildingQueueFull)
+	ErrInvalidBuildingQueue       = errors.FromCode(invalidBuildingQueue)
+	ErrNothingToDemolish          = errors.FromCode(nothingToDemolish)
+	ErrInvalidBuildingActionKind  = errors.FromCode(invalidBuildingActionKind)
+	ErrSolarSystemNotFound        = errors.FromCode(solarSystemNotFound)
+	ErrPlayersInDifferentUniverse = errors.FromCode(playersInDifferentUniverse)
+	ErrInvalidMessage             = errors.FromCode(invalidMessage)
+	ErrAllianceNotFound           = errors.FromCode(allianceNotFound)
+	ErrApplicationNotFound        = errors.FromCode(applicationNotFound)
+	ErrTagAlreadyTaken            = errors.FromCode(tagAlreadyTaken)
+	ErrInvalidAlliance            = errors.FromCode(invalidAlliance)
+	ErrAlreadyInAlliance          = errors.FromCode(alreadyInAlliance)
+	ErrAlreadyApplied             = errors.FromCode(alreadyApplied)
+	ErrNotAllianceMember          = errors.FromCode(notAllianceMember)
+	ErrMissingAlliancePermission  = errors.FromCode(missingAlliancePermission)
+	ErrInvalidAllianceRank        = errors.FromCode(invalidAllianceRank)
+	ErrFounderCannotLeave         = errors.FromCode(founderCannotLeave)
+	ErrInvalidTrade               = errors.FromCode(invalidTrade)
+	ErrStorageCapacityExceeded    = errors.FromCode(storageCapacityExceeded)
+	ErrMarketOfferNotFound        = errors.FromCode(marketOfferNotFound)
+	ErrInvalidMarketOffer         = errors.FromCode(invalidMarketOffer)
+	ErrMarketOfferNotAvailable    = errors.FromCode(marketOfferNotAvailable)
+	ErrNotResourceOwner           = errors.FromCode(notResourceOwner)
+	ErrInvalidResource            = errors.FromCode(invalidResource)
+	ErrInvalidBuilding            = errors.FromCode(invalidBuilding)
+	ErrInvalidRuleset             = errors.FromCode(invalidRuleset)
+	ErrGameDataRoleRenamed        = errors.FromCode(gameDataRoleRenamed)
+	ErrUniverseFull               = errors.FromCode(universeFull)
 )
@@ -0,0 +1,183 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+type FleetMission string
+
+const (
+	// TransportMission drops the cargo on the target and flies back to
+	// the origin with the ships and whatever did not fit in the storage
+	// of the target.
+	TransportMission FleetMission = "transport"
+	// DeployMission stations the ships and cargo on the target.
+	DeployMission FleetMission = "deploy"
+)
+
+const (
+	minFleetSpeedPercentage  = 10
+	maxFleetSpeedPercentage  = 100
+	fleetSpeedPercentageStep = 10
+)
+
+// Fleet represents ships travelling from an origin planet to a target
+// planet. The fleet flies back to its origin when ReturnsAt is set:
+// this happens either after a transport mission or when the fleet is
+// recalled.
+type Fleet struct {
+	Id      uuid.UUID
+	Player  uuid.UUID
+	Mission FleetMission
+
+	Origin           uuid.UUID
+	OriginCoordinate Coordinate
+	Target           uuid.UUID
+	TargetCoordinate Coordinate
+
+	Ships []FleetShip
+	Cargo []FleetCargo
+
+	DepartedAt time.Time
+	ArrivesAt  time.Time
+	ReturnsAt  *time.Time
+
+	CreatedAt time.Time
+
+	Version int
+}
+
+type FleetShip struct {
+	Ship  uuid.UUID
+	Count int
+}
+
+type FleetCargo struct {
+	Resource uuid.UUID
+	Amount   int
+}
+
+// FleetTarget describes the planet located at the coordinate a fleet
+// is sent to.
+type FleetTarget struct {
+	Planet     uuid.UUID
+	Player     uuid.UUID
+	Coordinate Coordinate
+}
+
+// FleetDeparture gathers the information needed to send a fleet from
+// a planet. Ships are provided with their characteristics as they are
+// needed to compute the speed and the cargo capacity of the fleet.
+// ActiveFleets is the number of fleets of the player already in flight:
+// it is checked against the limit granted by the technologies.
+type FleetDeparture struct {
+	Mission      FleetMission
+	Target       FleetTarget
+	Speed        int
+	ActiveFleets int
+
+	Ships []FleetDepartureShip
+	Cargo []FleetCargo
+}
+
+type FleetDepartureShip struct {
+	Ship  Ship
+	Count int
+}
+
+func (f Fleet) Returning() bool {
+	return f.ReturnsAt != nil
+}
+
+// NextEventAt returns the time at which the fleet reaches the next
+// planet on its path: the target when outbound, the origin otherwise.
+func (f Fleet) NextEventAt() time.Time {
+	if f.Returning() {
+		return *f.ReturnsAt
+	}
+	return f.ArrivesAt
+}
+
+// InFlight returns true when the fleet did not complete its mission at
+// the input time: a transport fleet still has to fly back to its origin
+// after reaching its target.
+func (f Fleet) InFlight(moment time.Time) bool {
+	if f.Returning() {
+		return f.ReturnsAt.After(moment)
+	}
+	if f.Mission == DeployMission {
+		return f.ArrivesAt.After(moment)
+	}
+	return true
+}
+
+// FlightDuration returns the time needed to fly from the origin to
+// the target.
+func (f Fleet) FlightDuration() time.Duration {
+	return f.ArrivesAt.Sub(f.DepartedAt)
+}
+
+// Recall turns the fleet around: it flies back to its origin and takes
+// as long as it already spent flying to do so. A fleet can't be recalled
+// once it reached its target.
+func (f *Fleet) Recall(moment time.Time) error {
+	if f.Returning() {
+		return domainerrors.ErrFleetAlreadyReturning
+	}
+
+	if !moment.Before(f.ArrivesAt) {
+		return domainerrors.ErrFleetAlreadyArrived
+	}
+
+	returnsAt := moment.Add(moment.Sub(f.DepartedAt))
+	f.ReturnsAt = &returnsAt
+
+	f.Version++
+
+	return nil
+}
+
+// ReturnToOrigin makes the fleet fly back to its origin because its
+// target disappeared: the ships and the cargo are never lost. An outbound
+// fleet turns around as if it was recalled at the input time or, if it
+// already reached the target without being unloaded, flies back from
+// there. The fleet is redirected to its origin so that it does not refer
+// to the target anymore.
+func (f *Fleet) ReturnToOrigin(moment time.Time) {
+	if !f.Returning() {
+		returnsAt := f.ArrivesAt.Add(f.FlightDuration())
+		if moment.Before(f.ArrivesAt) {
+			returnsAt = moment.Add(moment.Sub(f.DepartedAt))
+		}
+		f.ReturnsAt = &returnsAt
+	}
+
+	f.Target = f.Origin
+	f.TargetCoordinate = f.OriginCoordinate
+
+	f.Version++
+}
+
+// determineFlightDuration computes the time needed to travel the distance
+// at the input speed. The speed percentage allows to slow down the fleet
+// and should be a multiple of 10. The result is rounded up to the second.
+// https://ogame.fandom.com/wiki/Flight
+func determineFlightDuration(distance int, speed int, speedPercentage int) time.Duration {
+	percentage := float64(speedPercentage) / 100.0
+
+	seconds := 10.0 + 3500.0/percentage*math.Sqrt(10.0*float64(distance)/float64(speed))
+
+	return time.Duration(math.Ceil(seconds)) * time.Second
+}
+
+func validateFleetSpeedPercentage(speedPercentage int) bool {
+	if speedPercentage < minFleetSpeedPercentage || speedPercentage > maxFleetSpeedPercentage {
+		return false
+	}
+
+	return speedPercentage%fleetSpeedPercentageStep == 0
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_Coordinate_DistanceTo(t *testing.T) {
+	t.Run("computes distance between galaxies", func(t *testing.T) {
+		c1 := Coordinate{Galaxy: 1, SolarSystem: 12, Position: 4}
+		c2 := Coordinate{Galaxy: 3, SolarSystem: 47, Position: 7}
+
+		assert.Equal(t, 40000, c1.DistanceTo(c2))
+		assert.Equal(t, 40000, c2.DistanceTo(c1))
+	})
+
+	t.Run("computes distance between solar systems", func(t *testing.T) {
+		c1 := Coordinate{Galaxy: 1, SolarSystem: 12, Position: 4}
+		c2 := Coordinate{Galaxy: 1, SolarSystem: 15, Position: 7}
+
+		assert.Equal(t, 2985, c1.DistanceTo(c2))
+	})
+
+	t.Run("computes distance between positions", func(t *testing.T) {
+		c1 := Coordinate{Galaxy: 1, SolarSystem: 12, Position: 4}
+		c2 := Coordinate{Galaxy: 1, SolarSystem: 12, Position: 7}
+
+		assert.Equal(t, 1015, c1.DistanceTo(c2))
+	})
+
+	t.Run("returns minimum distance for same coordinate", func(t *testing.T) {
+		c := Coordinate{Galaxy: 1, SolarSystem: 12, Position: 4}
+
+		assert.Equal(t, 5, c.DistanceTo(c))
+	})
+}
+
+func TestUnit_DetermineFlightDuration(t *testing.T) {
+	t.Run("computes duration at full speed", func(t *testing.T) {
+		actual := determineFlightDuration(1015, 5000, 100)
+
+		assert.Equal(t, 4997*time.Second, actual)
+	})
+
+	t.Run("slows down fleet according to speed percentage", func(t *testing.T) {
+		actual := determineFlightDuration(1015, 5000, 50)
+
+		assert.Equal(t, 9984*time.Second, actual)
+	})
+}
+
+func TestUnit_Fleet_Recall(t *testing.T) {
+	t.Run("returns error when fleet is already returning", func(t *testing.T) {
+		f := generateTestFleet(t)
+		returnsAt := someTime.Add(2 * time.Hour)
+		f.ReturnsAt = &returnsAt
+
+		err := f.Recall(someTime.Add(30 * time.Minute))
+
+		assert.ErrorIs(t, err, domainerrors.ErrFleetAlreadyReturning, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fleet already arrived", func(t *testing.T) {
+		f := generateTestFleet(t)
+
+		err := f.Recall(f.ArrivesAt)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFleetAlreadyArrived, "Actual err: %v", err)
+		assert.Nil(t, f.ReturnsAt)
+	})
+
+	t.Run("flies back for as long as it already flew", func(t *testing.T) {
+		f := generateTestFleet(t)
+
+		err := f.Recall(someTime.Add(20 * time.Minute))
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.NotNil(t, f.ReturnsAt)
+		assert.Equal(t, someTime.Add(40*time.Minute), *f.ReturnsAt)
+		assert.True(t, f.Returning())
+		assert.Equal(t, someTime.Add(40*time.Minute), f.NextEventAt())
+	})
+
+	t.Run("bumps version by one", func(t *testing.T) {
+		f := generateTestFleet(t)
+
+		err := f.Recall(someTime.Add(20 * time.Minute))
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 3, f.Version)
+	})
+}
+
+// generateTestFleet returns a transport fleet departing at someTime and
+// flying for one hour.
+func TestUnit_Fleet_ReturnToOrigin(t *testing.T) {
+	t.Run("flies back for as long as it already flew when outbound", func(t *testing.T) {
+		f := generateTestFleet(t)
+
+		f.ReturnToOrigin(someTime.Add(20 * time.Minute))
+
+		require.NotNil(t, f.ReturnsAt)
+		assert.Equal(t, someTime.Add(40*time.Minute), *f.ReturnsAt)
+	})
+
+	t.Run("flies back from the target when it already arrived", func(t *testing.T) {
+		f := generateTestFleet(t)
+
+		f.ReturnToOrigin(someTime.Add(90 * time.Minute))
+
+		require.NotNil(t, f.ReturnsAt)
+		assert.Equal(t, someTime.Add(2*time.Hour), *f.ReturnsAt)
+	})
+
+	t.Run("keeps return time when already returning", func(t *testing.T) {
+		f := generateTestFleet(t)
+		returnsAt := someTime.Add(2 * time.Hour)
+		f.ReturnsAt = &returnsAt
+
+		f.ReturnToOrigin(someTime.Add(90 * time.Minute))
+
+		require.NotNil(t, f.ReturnsAt)
+		assert.Equal(t, returnsAt, *f.ReturnsAt)
+	})
+
+	t.Run("redirects the fleet to its origin and keeps its content", func(t *testing.T) {
+		f := generateTestFleet(t)
+		f.OriginCoordinate = Coordinate{Galaxy: 1, SolarSystem: 12, Position: 4}
+		f.TargetCoordinate = Coordinate{Galaxy: 3, SolarSystem: 47, Position: 7}
+		ships, cargo := f.Ships, f.Cargo
+
+		f.ReturnToOrigin(someTime.Add(20 * time.Minute))
+
+		assert.Equal(t, f.Origin, f.Target)
+		assert.Equal(t, f.OriginCoordinate, f.TargetCoordinate)
+		assert.Equal(t, ships, f.Ships)
+		assert.Equal(t, cargo, f.Cargo)
+		assert.Equal(t, 3, f.Version)
+	})
+}
+
+func generateTestFleet(t *testing.T) Fleet {
+	t.Helper()
+
+	return Fleet{
+		Id:      uuid.New(),
+		Mission: TransportMission,
+		Origin:  uuid.New(),
+		Target:  uuid.New(),
+		Ships: []FleetShip{
+			{Ship: shipId, Count: 3},
+		},
+		Cargo: []FleetCargo{
+			{Resource: metalResourceId, Amount: 1000},
+		},
+		DepartedAt: someTime,
+		ArrivesAt:  someTime.Add(time.Hour),
+		Version:    2,
+	}
+}
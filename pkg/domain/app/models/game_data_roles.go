@@ -0,0 +1,45 @@
+package models
+
+import (
+	"slices"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+// The game data some rules of the game depend on are identified by name
+// rather than by a hard-coded id: this way they follow the ruleset which
+// was loaded in the database. Such game data can't be renamed.
+const (
+	EnergyResourceName     = "energy"
+	ShipyardBuildingName   = "shipyard"
+	EnergyTechnologyName   = "energy technology"
+	ComputerTechnologyName = "computer technology"
+)
+
+var roleNames = []string{
+	EnergyResourceName,
+	ShipyardBuildingName,
+	EnergyTechnologyName,
+	ComputerTechnologyName,
+}
+
+// GameDataRoles holds the ids of the game data the rules of the game
+// depend on. A role without matching game data has a nil id: the rule
+// then behaves as if the player never reached any level in it.
+type GameDataRoles struct {
+	ShipyardBuilding   uuid.UUID
+	EnergyTechnology   uuid.UUID
+	ComputerTechnology uuid.UUID
+}
+
+// ValidateGameDataRename returns an error when game data currently named
+// after one of the roles would get another name: the rules depending on
+// it would silently stop applying.
+func ValidateGameDataRename(current string, name string) error {
+	if current == name || !slices.Contains(roleNames, current) {
+		return nil
+	}
+
+	return domainerrors.ErrGameDataRoleRenamed
+}
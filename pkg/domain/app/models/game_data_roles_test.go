@@ -0,0 +1,28 @@
+package models
+
+import (
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_ValidateGameDataRename(t *testing.T) {
+	t.Run("allows renaming game data without role", func(t *testing.T) {
+		err := ValidateGameDataRename("metal mine", "iron mine")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("allows keeping the name of game data with a role", func(t *testing.T) {
+		err := ValidateGameDataRename(ShipyardBuildingName, ShipyardBuildingName)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("rejects renaming game data with a role", func(t *testing.T) {
+		err := ValidateGameDataRename(EnergyResourceName, "power")
+
+		assert.ErrorIs(t, err, domainerrors.ErrGameDataRoleRenamed, "Actual err: %v", err)
+	})
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+type MarketOfferStatus string
+
+const (
+	// OpenOffer can be accepted by other players until it expires.
+	OpenOffer MarketOfferStatus = "open"
+	// AcceptedOffer was paid for by the buyer: the seller still needs to
+	// receive the requested resource.
+	AcceptedOffer  MarketOfferStatus = "accepted"
+	SettledOffer   MarketOfferStatus = "settled"
+	CancelledOffer MarketOfferStatus = "cancelled"
+	ExpiredOffer   MarketOfferStatus = "expired"
+)
+
+const (
+	minMarketOfferDuration = 1 * time.Hour
+	maxMarketOfferDuration = 72 * time.Hour
+)
+
+// MarketOffer is posted by a player on the market of a universe. The
+// offered resource is held in escrow from the planet of the seller until
+// the offer is either accepted by another player, cancelled or expired.
+type MarketOffer struct {
+	Id       uuid.UUID
+	Universe uuid.UUID
+	Player   uuid.UUID
+	Planet   uuid.UUID
+
+	OfferedResource   uuid.UUID
+	OfferedAmount     int
+	RequestedResource uuid.UUID
+	RequestedAmount   int
+
+	Status MarketOfferStatus
+
+	Buyer       *uuid.UUID
+	BuyerPlanet *uuid.UUID
+	AcceptedAt  *time.Time
+
+	ExpiresAt time.Time
+	CreatedAt time.Time
+
+	Version int
+}
+
+// MarketOfferTerms describes what a player wants to exchange on the
+// market and for how long the offer stays open.
+type MarketOfferTerms struct {
+	OfferedResource   uuid.UUID
+	OfferedAmount     int
+	RequestedResource uuid.UUID
+	RequestedAmount   int
+	Duration          time.Duration
+}
+
+// Pending returns true when the offer still requires an action from the
+// planet of the seller: either it is open or it was not settled yet.
+func (o MarketOffer) Pending() bool {
+	return o.Status == OpenOffer || o.Status == AcceptedOffer
+}
+
+// NextEventAt returns the time at which the planet of the seller should
+// process the offer: its expiration while open, its settlement once it
+// has been accepted.
+func (o MarketOffer) NextEventAt() time.Time {
+	if o.Status == AcceptedOffer && o.AcceptedAt != nil {
+		return *o.AcceptedAt
+	}
+	return o.ExpiresAt
+}
+
+func validateMarketOfferTerms(terms MarketOfferTerms) error {
+	if terms.OfferedAmount <= 0 || terms.RequestedAmount <= 0 {
+		return domainerrors.ErrInvalidMarketOffer
+	}
+	if terms.OfferedResource == terms.RequestedResource {
+		return domainerrors.ErrInvalidMarketOffer
+	}
+	if terms.Duration < minMarketOfferDuration || terms.Duration > maxMarketOfferDuration {
+		return domainerrors.ErrInvalidMarketOffer
+	}
+
+	return nil
+}
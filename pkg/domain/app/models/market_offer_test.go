@@ -0,0 +1,74 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_MarketOffer_Pending(t *testing.T) {
+	t.Run("open offer is pending", func(t *testing.T) {
+		o := generateTestMarketOffer(t)
+
+		assert.True(t, o.Pending())
+	})
+
+	t.Run("accepted offer is pending", func(t *testing.T) {
+		o := generateTestMarketOffer(t)
+		o.Status = AcceptedOffer
+
+		assert.True(t, o.Pending())
+	})
+
+	t.Run("settled offer is not pending", func(t *testing.T) {
+		o := generateTestMarketOffer(t)
+		o.Status = SettledOffer
+
+		assert.False(t, o.Pending())
+	})
+
+	t.Run("cancelled offer is not pending", func(t *testing.T) {
+		o := generateTestMarketOffer(t)
+		o.Status = CancelledOffer
+
+		assert.False(t, o.Pending())
+	})
+}
+
+func TestUnit_MarketOffer_NextEventAt(t *testing.T) {
+	t.Run("returns expiration of open offer", func(t *testing.T) {
+		o := generateTestMarketOffer(t)
+
+		assert.Equal(t, someTime.Add(time.Hour), o.NextEventAt())
+	})
+
+	t.Run("returns acceptance of accepted offer", func(t *testing.T) {
+		o := generateTestMarketOffer(t)
+		o.Status = AcceptedOffer
+		acceptedAt := someTime.Add(time.Minute)
+		o.AcceptedAt = &acceptedAt
+
+		assert.Equal(t, acceptedAt, o.NextEventAt())
+	})
+}
+
+func generateTestMarketOffer(t *testing.T) MarketOffer {
+	t.Helper()
+
+	return MarketOffer{
+		Id:                uuid.New(),
+		Universe:          uuid.New(),
+		Player:            uuid.New(),
+		Planet:            uuid.New(),
+		OfferedResource:   metalResourceId,
+		OfferedAmount:     500,
+		RequestedResource: crystalResourceId,
+		RequestedAmount:   200,
+		Status:            OpenOffer,
+		ExpiresAt:         someTime.Add(time.Hour),
+		CreatedAt:         someTime.Add(-time.Hour),
+		Version:           1,
+	}
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	maxMessageSubjectLength = 128
+	maxMessageContentLength = 4096
+)
+
+type MessageKind string
+
+const (
+	PlayerMessage MessageKind = "player"
+	SystemMessage MessageKind = "system"
+)
+
+// Message is received by a player. Messages sent by the game itself are
+// of the system kind and do not have a sender.
+type Message struct {
+	Id     uuid.UUID
+	Kind   MessageKind
+	Player uuid.UUID
+	Sender *uuid.UUID
+
+	Subject string
+	Content string
+	Read    bool
+
+	CreatedAt time.Time
+}
+
+// NewPlayerMessage creates a message from the sender to the recipient. Both
+// players need to be part of the same universe.
+func NewPlayerMessage(
+	sender Player,
+	recipient Player,
+	subject string,
+	content string,
+	createdAt time.Time,
+) (Message, error) {
+	if sender.Universe != recipient.Universe {
+		return Message{}, domainerrors.ErrPlayersInDifferentUniverse
+	}
+
+	if len(subject) == 0 || len(subject) > maxMessageSubjectLength {
+		return Message{}, domainerrors.ErrInvalidMessage
+	}
+	if len(content) == 0 || len(content) > maxMessageContentLength {
+		return Message{}, domainerrors.ErrInvalidMessage
+	}
+
+	message := Message{
+		Id:        uuid.New(),
+		Kind:      PlayerMessage,
+		Player:    recipient.Id,
+		Sender:    &sender.Id,
+		Subject:   subject,
+		Content:   content,
+		CreatedAt: createdAt,
+	}
+	return message, nil
+}
+
+func newSystemMessage(
+	player uuid.UUID,
+	subject string,
+	content string,
+	createdAt time.Time,
+) Message {
+	return Message{
+		Id:        uuid.New(),
+		Kind:      SystemMessage,
+		Player:    player,
+		Subject:   subject,
+		Content:   content,
+		CreatedAt: createdAt,
+	}
+}
+
+func newBuildingActionCompletedMessage(planet *Planet, action BuildingAction) Message {
+	subject := "Building upgraded"
+	if action.Kind == DemolishAction {
+		subject = "Building demolished"
+	}
+
+	// All the buildings are part of the planet, even at level 0.
+	building, _ := planet.findBuildingById(action.Building)
+	content := fmt.Sprintf(
+		"Building %s reached level %d on planet %s.",
+		building.Name,
+		action.DesiredLevel,
+		planet.Name,
+	)
+
+	return newSystemMessage(planet.Player, subject, content, action.CompletedAt)
+}
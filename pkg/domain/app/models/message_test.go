@@ -0,0 +1,61 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_NewPlayerMessage(t *testing.T) {
+	universe := uuid.New()
+	sender := Player{Id: uuid.New(), Universe: universe}
+	recipient := Player{Id: uuid.New(), Universe: universe}
+
+	t.Run("creates message for recipient", func(t *testing.T) {
+		actual, err := NewPlayerMessage(sender, recipient, "subject", "content", someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := Message{
+			Id:        actual.Id,
+			Kind:      PlayerMessage,
+			Player:    recipient.Id,
+			Sender:    &sender.Id,
+			Subject:   "subject",
+			Content:   "content",
+			CreatedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when players are in different universes", func(t *testing.T) {
+		other := Player{Id: uuid.New(), Universe: uuid.New()}
+
+		_, err := NewPlayerMessage(sender, other, "subject", "content", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayersInDifferentUniverse, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when subject is empty", func(t *testing.T) {
+		_, err := NewPlayerMessage(sender, recipient, "", "content", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMessage, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when content is empty", func(t *testing.T) {
+		_, err := NewPlayerMessage(sender, recipient, "subject", "", someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMessage, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when content is too long", func(t *testing.T) {
+		content := strings.Repeat("a", maxMessageContentLength+1)
+
+		_, err := NewPlayerMessage(sender, recipient, "subject", content, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMessage, "Actual err: %v", err)
+	})
+}
@@ -3,7 +3,9 @@ package models
 import (
 	"testing"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnit_OccupancyMap_PickPosition(t *testing.T) {
@@ -16,8 +18,10 @@ func TestUnit_OccupancyMap_PickPosition(t *testing.T) {
 			},
 		}
 
-		c1 := m.PickPosition()
-		c2 := m.PickPosition()
+		c1, err := m.PickPosition()
+		require.NoError(t, err, "Actual err: %v", err)
+		c2, err := m.PickPosition()
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.NotEqual(t, c1, c2)
 	})
@@ -34,9 +38,28 @@ func TestUnit_OccupancyMap_PickPosition(t *testing.T) {
 			},
 		}
 
-		actual := m.PickPosition()
+		actual, err := m.PickPosition()
+		require.NoError(t, err, "Actual err: %v", err)
 
 		expected := Coordinate{Galaxy: 0, SolarSystem: 0, Position: 1}
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("returns error when all coordinates are used", func(t *testing.T) {
+		m := OccupancyMap{
+			Topology: UniverseTopology{
+				Galaxies:     1,
+				SolarSystems: 1,
+				Orbits:       2,
+			},
+			UsedSlots: map[Coordinate]struct{}{
+				Coordinate{Galaxy: 0, SolarSystem: 0, Position: 0}: struct{}{},
+				Coordinate{Galaxy: 0, SolarSystem: 0, Position: 1}: struct{}{},
+			},
+		}
+
+		_, err := m.PickPosition()
+
+		assert.ErrorIs(t, err, domainerrors.ErrUniverseFull, "Actual err: %v", err)
+	})
 }
@@ -1,22 +1,31 @@
 package models
 
-import "math/rand"
+import (
+	"math/rand"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+)
 
 type OccupancyMap struct {
 	Topology  UniverseTopology
 	UsedSlots map[Coordinate]struct{}
 }
 
-// PickPosition picks a random unoccupied position in the topology. This function
-// can take a long time to complete or even stall in case all slots have been used.
-// It's a rare enough event that it is not accounted for yet.
-func (m *OccupancyMap) PickPosition() Coordinate {
+// PickPosition picks a random unoccupied position in the topology. An error is
+// returned when all the slots have been used. This function can take a long
+// time to complete when only a few slots are left.
+func (m *OccupancyMap) PickPosition() (Coordinate, error) {
 	used := true
 
 	if m.UsedSlots == nil {
 		m.UsedSlots = make(map[Coordinate]struct{})
 	}
 
+	slots := m.Topology.Galaxies * m.Topology.SolarSystems * m.Topology.Orbits
+	if len(m.UsedSlots) >= slots {
+		return Coordinate{}, domainerrors.ErrUniverseFull
+	}
+
 	var out Coordinate
 
 	for used {
@@ -31,5 +40,5 @@ func (m *OccupancyMap) PickPosition() Coordinate {
 
 	m.UsedSlots[out] = struct{}{}
 
-	return out
+	return out, nil
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"math"
+	"slices"
 	"time"
 
 	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
@@ -27,7 +28,45 @@ type Planet struct {
 
 	Buildings []PlanetBuilding
 
-	BuildingAction *BuildingAction
+	Ships    []PlanetShip
+	Defenses []PlanetDefense
+
+	// BuildingQueue is ordered: the first action is the one in progress and
+	// each of the following ones starts when the previous one completes.
+	BuildingQueue []BuildingAction
+	ShipyardQueue []ShipyardAction
+
+	// Technologies are shared by all the planets of the player: they are
+	// attached to the planet as they affect most of its actions. They hold
+	// the levels reached at the time of the last update of the planet.
+	Technologies   []PlayerTechnology
+	ResearchAction *ResearchAction
+
+	// TechnologyUpgrades are the levels reached by the player after the
+	// last update of the planet, including the one of a research running
+	// on another planet, ordered by the time at which they are reached.
+	// They apply to the planet from this time only.
+	TechnologyUpgrades []PlayerTechnology
+
+	// Roles identify the game data some rules depend on. They are loaded
+	// with the planet so that the bonus of the energy technology applies
+	// to its productions as soon as the research completes, on all the
+	// planets of the player.
+	Roles GameDataRoles
+
+	// Fleets contains both the fleets sent from this planet and the ones
+	// flying towards it.
+	Fleets []Fleet
+
+	// MarketOffers contains the offers posted from this planet which are
+	// still pending. An offer accepted from this planet is also attached
+	// to it so that it is persisted along with the payment.
+	MarketOffers []MarketOffer
+
+	// Messages are generated for the player while the planet is modified.
+	// They are not loaded with the planet: only the new ones are persisted
+	// along with it.
+	Messages []Message
 }
 
 type PlanetResource struct {
@@ -46,82 +85,300 @@ type PlanetResourceProduction struct {
 	Production int
 }
 
+// PlanetEnergy summarizes the energy balance of a planet. Energy is not
+// stored: when the consumption exceeds the production, the output of the
+// buildings is reduced accordingly.
+type PlanetEnergy struct {
+	Produced int
+	Consumed int
+}
+
+// PlanetBuilding carries the name of the building so that it can be used
+// in the messages sent to the player.
 type PlanetBuilding struct {
 	Building uuid.UUID
+	Name     string
 	Level    int
 }
 
-// AddBuildingAction adds a building action to the planet.
+type PlanetShip struct {
+	Ship  uuid.UUID
+	Count int
+}
+
+type PlanetDefense struct {
+	Defense uuid.UUID
+	Count   int
+}
+
+// AddBuildingAction appends a building action to the queue of the planet.
+// The action upgrades the building to the level following the one reached
+// once all the actions already queued for it are completed. Its costs are
+// deducted right away and it starts when the last queued action completes.
 // The action will be added with a creation date equal to the UpdatedAt
 // field of the planet. This means that prior to calling this function,
 // callers are expected to trigger UpdateToTime to the desired time.
 // The UpdatedAt field will not be updated.
 func (p *Planet) AddBuildingAction(building Building) error {
-	if p.BuildingAction != nil {
-		return domainerrors.ErrActionAlreadyInProgress
+	if len(p.BuildingQueue) >= maxBuildingQueueLength {
+		return domainerrors.ErrBuildingQueueFull
 	}
 
-	pb, err := p.findBuildingById(building.Id)
+	level, err := p.queuedBuildingLevel(building.Id)
 	if err != nil {
 		return err
 	}
 
+	if !p.requirementsMet(building.Requirements) {
+		return domainerrors.ErrRequirementsNotMet
+	}
+
 	if !p.fieldsAvailable() {
 		return domainerrors.ErrAllFieldsUsed
 	}
 
-	action := building.CreateBuildingAction(pb.Level+1, p.UpdatedAt)
+	startAt := p.UpdatedAt
+	if len(p.BuildingQueue) > 0 {
+		startAt = p.BuildingQueue[len(p.BuildingQueue)-1].CompletedAt
+	}
+
+	action := building.CreateBuildingAction(level+1, p.UpdatedAt, startAt)
 
-	if err := p.validateEnoughResources(action); err != nil {
+	costs := actionCosts(action.Costs)
+	if err := p.validateEnoughResources(costs); err != nil {
 		return err
 	}
 
-	p.deductResources(action)
+	p.deductResources(costs)
 
-	p.BuildingAction = &action
+	p.BuildingQueue = append(p.BuildingQueue, action)
 
 	p.Version++
 
 	return nil
 }
 
-// CancelBuildingAction deletes a building action from the planet.
-// In case there is no action running an error will be returned.
-// The resources used up by the action will be credited back to the
-// resources stored on the planet.
+// CancelBuildingAction removes a building action from the queue of the
+// planet. The actions queued after it which can't be applied anymore are
+// removed as well: this is the case of the ones for the same building as
+// they depend on the level it would have reached, and of the ones whose
+// requirements are not met without it. The buildings are used to fetch
+// the requirements of the queued actions. The costs of all the removed
+// actions are credited back to the planet and the remaining actions are
+// rescheduled to start as early as possible.
 // This means that prior to calling this function, callers are
 // expected to trigger UpdateToTime to the desired time.
 // The UpdatedAt field will not be updated.
-func (p *Planet) CancelBuildingAction() error {
-	if p.BuildingAction == nil {
+func (p *Planet) CancelBuildingAction(action uuid.UUID, buildings []Building) error {
+	queued := slices.ContainsFunc(p.BuildingQueue, func(a BuildingAction) bool {
+		return a.Id == action
+	})
+	if !queued {
 		return domainerrors.ErrNoActionInProgress
 	}
 
-	p.creditResources(*p.BuildingAction)
+	queue, removed := p.splitBuildingQueue(p.BuildingQueue, buildings, action)
+	for _, a := range removed {
+		p.creditResources(a)
+	}
+
+	p.rescheduleBuildingQueue(queue)
+
+	p.Version++
+
+	return nil
+}
+
+// ReorderBuildingQueue changes the order of the actions in the queue of
+// the planet. The order should contain the identifier of every queued
+// action exactly once. The action in progress has to stay first and the
+// actions targeting the same building have to keep their relative order
+// as each of them starts from the level reached by the previous one.
+// Similarly, an upgrade can't be moved before the actions unlocking its
+// requirements: the buildings are used to fetch those requirements.
+// The queue is rescheduled so that each action starts when the previous
+// one completes.
+func (p *Planet) ReorderBuildingQueue(order []uuid.UUID, buildings []Building) error {
+	if len(order) != len(p.BuildingQueue) {
+		return domainerrors.ErrInvalidBuildingQueue
+	}
+
+	positions := make(map[uuid.UUID]int)
+	for position, action := range p.BuildingQueue {
+		positions[action.Id] = position
+	}
+
+	queue := make([]BuildingAction, 0, len(order))
+	last := make(map[uuid.UUID]int)
+	for _, id := range order {
+		position, ok := positions[id]
+		if !ok {
+			return domainerrors.ErrInvalidBuildingQueue
+		}
+		delete(positions, id)
+
+		action := p.BuildingQueue[position]
+		previous, ok := last[action.Building]
+		if ok && position < previous {
+			return domainerrors.ErrInvalidBuildingQueue
+		}
+		last[action.Building] = position
+
+		queue = append(queue, action)
+	}
+
+	if len(queue) > 0 && queue[0].Id != p.BuildingQueue[0].Id {
+		return domainerrors.ErrInvalidBuildingQueue
+	}
+
+	_, removed := p.splitBuildingQueue(queue, buildings, uuid.Nil)
+	if len(removed) > 0 {
+		return domainerrors.ErrRequirementsNotMet
+	}
+
+	p.rescheduleBuildingQueue(queue)
+
+	p.Version++
+
+	return nil
+}
+
+// DemolishBuilding appends an action lowering the level of the building
+// by one to the queue of the planet. Just like for upgrades, the level is
+// the one reached once all the actions already queued for the building
+// are completed, the costs are deducted right away and callers are
+// expected to trigger UpdateToTime beforehand.
+func (p *Planet) DemolishBuilding(building Building) error {
+	if len(p.BuildingQueue) >= maxBuildingQueueLength {
+		return domainerrors.ErrBuildingQueueFull
+	}
+
+	level, err := p.queuedBuildingLevel(building.Id)
+	if err != nil {
+		return err
+	}
+
+	if level == 0 {
+		return domainerrors.ErrNothingToDemolish
+	}
+
+	startAt := p.UpdatedAt
+	if len(p.BuildingQueue) > 0 {
+		startAt = p.BuildingQueue[len(p.BuildingQueue)-1].CompletedAt
+	}
+
+	action := building.CreateDemolitionAction(level-1, p.UpdatedAt, startAt)
+
+	costs := actionCosts(action.Costs)
+	if err := p.validateEnoughResources(costs); err != nil {
+		return err
+	}
+
+	p.deductResources(costs)
 
-	p.BuildingAction = nil
+	p.BuildingQueue = append(p.BuildingQueue, action)
 
 	p.Version++
 
 	return nil
 }
 
+// AddResearchAction starts researching the next level of the technology
+// on the planet. Just like for buildings, the resources are deducted from
+// the planet right away and callers are expected to trigger UpdateToTime
+// beforehand.
+// Only the research running on this planet can be checked here: callers
+// need to make sure the player is not researching on another planet.
+func (p *Planet) AddResearchAction(technology Technology) error {
+	if p.ResearchAction != nil {
+		return domainerrors.ErrActionAlreadyInProgress
+	}
+
+	level := technologyLevel(p.Technologies, technology.Id)
+	action := technology.CreateResearchAction(p.Player, p.Id, level+1, p.UpdatedAt)
+
+	costs := actionCosts(action.Costs)
+	if err := p.validateEnoughResources(costs); err != nil {
+		return err
+	}
+
+	p.deductResources(costs)
+
+	p.ResearchAction = &action
+
+	p.Version++
+
+	return nil
+}
+
+// BuildShips queues the production of count ships in the shipyard of the
+// planet. The production starts after all the batches already queued are
+// completed. Just like for buildings, the resources are deducted right
+// away and callers are expected to trigger UpdateToTime beforehand.
+func (p *Planet) BuildShips(ship Ship, count int, roles GameDataRoles) error {
+	return p.addShipyardAction(count, roles, func(level int, startAt time.Time) (ShipyardAction, error) {
+		return ship.CreateShipyardAction(count, level, p.UpdatedAt, startAt)
+	})
+}
+
+// BuildDefenses behaves like BuildShips but for defenses.
+func (p *Planet) BuildDefenses(defense Defense, count int, roles GameDataRoles) error {
+	return p.addShipyardAction(count, roles, func(level int, startAt time.Time) (ShipyardAction, error) {
+		return defense.CreateShipyardAction(count, level, p.UpdatedAt, startAt)
+	})
+}
+
 func (p *Planet) UpdateToTime(moment time.Time) error {
 	if p.UpdatedAt.After(moment) {
 		return nil
 	}
 
-	if p.BuildingAction != nil && moment.After(p.BuildingAction.CompletedAt) {
+	if len(p.BuildingQueue) > 0 && moment.After(p.BuildingQueue[0].CompletedAt) {
+		return domainerrors.ErrPlanetNotUpToDate
+	}
+
+	if len(p.ShipyardQueue) > 0 && moment.After(p.ShipyardQueue[0].NextUnitCompletedAt()) {
+		return domainerrors.ErrPlanetNotUpToDate
+	}
+
+	if p.ResearchAction != nil && moment.After(p.ResearchAction.CompletedAt) {
+		return domainerrors.ErrPlanetNotUpToDate
+	}
+
+	if len(p.TechnologyUpgrades) > 0 && moment.After(p.TechnologyUpgrades[0].ReachedAt) {
 		return domainerrors.ErrPlanetNotUpToDate
 	}
 
+	for _, fleet := range p.Fleets {
+		if p.HandlesFleetEvent(fleet) && moment.After(fleet.NextEventAt()) {
+			return domainerrors.ErrPlanetNotUpToDate
+		}
+	}
+
+	for _, offer := range p.MarketOffers {
+		if p.HandlesMarketOfferEvent(offer) && moment.After(offer.NextEventAt()) {
+			return domainerrors.ErrPlanetNotUpToDate
+		}
+	}
+
 	elapsed := moment.Sub(p.UpdatedAt)
 	hours := elapsed.Hours()
 
+	factor := p.ProductionFactor()
+
 	productions := make(map[uuid.UUID]float64)
 	for _, pr := range p.Productions {
+		if !p.storesResource(pr.Resource) {
+			continue
+		}
+
+		production := float64(pr.Production)
+		if pr.Building != nil {
+			production = factor * p.boostedProduction(pr.Production)
+		}
+
 		existing := productions[pr.Resource]
-		existing += float64(pr.Production)
+		existing += production
 		productions[pr.Resource] = existing
 	}
 
@@ -158,25 +415,575 @@ func (p *Planet) UpdateToTime(moment time.Time) error {
 	return nil
 }
 
+// Energy returns the energy produced and consumed by the buildings of
+// the planet. Energy is the only resource which is not stored: it is
+// identified as the productions of resources without storage.
+func (p *Planet) Energy() PlanetEnergy {
+	var energy PlanetEnergy
+
+	for _, pr := range p.Productions {
+		if p.storesResource(pr.Resource) {
+			continue
+		}
+
+		switch {
+		case pr.Production < 0:
+			energy.Consumed -= pr.Production
+		case pr.Building != nil:
+			energy.Produced += int(math.Floor(p.boostedProduction(pr.Production)))
+		default:
+			energy.Produced += pr.Production
+		}
+	}
+
+	return energy
+}
+
+// ProductionFactor returns the ratio of the energy available over the
+// energy required by the buildings of the planet, capped at 1. It scales
+// the production of every building: the base production of the planet
+// is not affected.
+func (p *Planet) ProductionFactor() float64 {
+	energy := p.Energy()
+	if energy.Consumed <= energy.Produced {
+		return 1
+	}
+
+	return float64(energy.Produced) / float64(energy.Consumed)
+}
+
+// boostedProduction applies the bonus of the energy technology to the
+// production of a building. Consumptions are not affected.
+func (p *Planet) boostedProduction(production int) float64 {
+	if production < 0 {
+		return float64(production)
+	}
+
+	return determineProductionBonus(p.Technologies, p.Roles) * float64(production)
+}
+
+// ApplyAction completes the first action of the building queue. The next
+// action of the queue, if any, is already scheduled to start at this time.
 func (p *Planet) ApplyAction() error {
-	if p.BuildingAction == nil {
+	if len(p.BuildingQueue) == 0 {
 		return domainerrors.ErrNoActionInProgress
 	}
 
-	if p.BuildingAction.CompletedAt != p.UpdatedAt {
+	action := p.BuildingQueue[0]
+	if action.CompletedAt != p.UpdatedAt {
 		return domainerrors.ErrActionNotCompleted
 	}
 
-	p.updateProductions()
-	p.updateStorages()
+	p.updateProductions(action)
+	p.updateStorages(action)
 
 	for id := range p.Buildings {
-		if p.Buildings[id].Building == p.BuildingAction.Building {
-			p.Buildings[id].Level = p.BuildingAction.DesiredLevel
+		if p.Buildings[id].Building == action.Building {
+			p.Buildings[id].Level = action.DesiredLevel
 		}
 	}
 
-	p.BuildingAction = nil
+	p.BuildingQueue = p.BuildingQueue[1:]
+
+	p.Messages = append(p.Messages, newBuildingActionCompletedMessage(p, action))
+
+	p.Version++
+
+	return nil
+}
+
+// ApplyResearchAction raises the level of the technology researched on
+// the planet. The new level is available to all the planets of the player.
+func (p *Planet) ApplyResearchAction() error {
+	if p.ResearchAction == nil {
+		return domainerrors.ErrNoActionInProgress
+	}
+
+	if p.ResearchAction.CompletedAt != p.UpdatedAt {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	p.setTechnologyLevel(p.ResearchAction.Technology, p.ResearchAction.DesiredLevel)
+
+	p.ResearchAction = nil
+
+	p.Version++
+
+	return nil
+}
+
+// ApplyTechnologyUpgrade raises the level of the first technology reached
+// by the player on another planet. A level which is not greater than the
+// one of the planet, such as one already applied by its own research, is
+// ignored.
+func (p *Planet) ApplyTechnologyUpgrade() error {
+	if len(p.TechnologyUpgrades) == 0 {
+		return domainerrors.ErrNoActionInProgress
+	}
+
+	upgrade := p.TechnologyUpgrades[0]
+	if upgrade.ReachedAt != p.UpdatedAt {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	if upgrade.Level > technologyLevel(p.Technologies, upgrade.Technology) {
+		p.setTechnologyLevel(upgrade.Technology, upgrade.Level)
+	}
+
+	p.TechnologyUpgrades = p.TechnologyUpgrades[1:]
+
+	p.Version++
+
+	return nil
+}
+
+// ApplyShipyardUnit completes the next unit of the first batch in the
+// shipyard queue. The batch is removed from the queue once all its units
+// have been produced.
+func (p *Planet) ApplyShipyardUnit() error {
+	if len(p.ShipyardQueue) == 0 {
+		return domainerrors.ErrNoActionInProgress
+	}
+
+	batch := &p.ShipyardQueue[0]
+	if batch.NextUnitCompletedAt() != p.UpdatedAt {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	switch batch.Kind {
+	case ShipUnit:
+		p.addShips(batch.Unit, 1)
+	case DefenseUnit:
+		p.addDefenses(batch.Unit, 1)
+	}
+
+	batch.Count--
+	if batch.Count <= 0 {
+		p.ShipyardQueue = p.ShipyardQueue[1:]
+	}
+
+	p.Version++
+
+	return nil
+}
+
+// SendFleet creates a fleet leaving the planet. The ships and the cargo
+// are removed from the planet right away. The fleet departs at the time
+// the planet was last updated: just like for buildings, callers are
+// expected to trigger UpdateToTime beforehand.
+// A speed of 0 is interpreted as the maximum speed.
+func (p *Planet) SendFleet(departure FleetDeparture, roles GameDataRoles) (Fleet, error) {
+	speedPercentage := departure.Speed
+	if speedPercentage == 0 {
+		speedPercentage = maxFleetSpeedPercentage
+	}
+	if !validateFleetSpeedPercentage(speedPercentage) {
+		return Fleet{}, domainerrors.ErrInvalidFleet
+	}
+
+	if departure.Mission != TransportMission && departure.Mission != DeployMission {
+		return Fleet{}, domainerrors.ErrInvalidFleet
+	}
+
+	// Both missions move ships and resources between the planets of a
+	// single player.
+	if departure.Target.Planet == p.Id || departure.Target.Player != p.Player {
+		return Fleet{}, domainerrors.ErrInvalidFleetTarget
+	}
+
+	if departure.ActiveFleets >= determineFleetSlots(p.Technologies, roles) {
+		return Fleet{}, domainerrors.ErrFleetLimitReached
+	}
+
+	if len(departure.Ships) == 0 {
+		return Fleet{}, domainerrors.ErrInvalidFleet
+	}
+
+	// Each ship and resource can only be listed once: the amounts are not
+	// summed so that they can not overflow.
+	ships := make(map[uuid.UUID]int)
+	speed := math.MaxInt
+	capacity := 0
+	for _, s := range departure.Ships {
+		if _, ok := ships[s.Ship.Id]; ok || s.Count <= 0 || s.Ship.Speed <= 0 {
+			return Fleet{}, domainerrors.ErrInvalidFleet
+		}
+
+		shipCapacity, ok := multiplyWithoutOverflow(int64(s.Count), int64(s.Ship.CargoCapacity))
+		if !ok || shipCapacity > int64(math.MaxInt-capacity) {
+			return Fleet{}, domainerrors.ErrInvalidFleet
+		}
+
+		ships[s.Ship.Id] = s.Count
+		speed = min(speed, s.Ship.Speed)
+		capacity += int(shipCapacity)
+	}
+
+	cargo := make(map[uuid.UUID]int)
+	load := 0
+	for _, c := range departure.Cargo {
+		if _, ok := cargo[c.Resource]; ok || c.Amount <= 0 {
+			return Fleet{}, domainerrors.ErrInvalidFleet
+		}
+
+		// The load never exceeds the capacity so this can not overflow.
+		if c.Amount > capacity-load {
+			return Fleet{}, domainerrors.ErrCargoCapacityExceeded
+		}
+
+		cargo[c.Resource] = c.Amount
+		load += c.Amount
+	}
+
+	if err := p.validateEnoughShips(ships); err != nil {
+		return Fleet{}, err
+	}
+	if err := p.validateEnoughResources(cargo); err != nil {
+		return Fleet{}, err
+	}
+
+	p.deductShips(ships)
+	p.deductResources(cargo)
+
+	distance := p.Coordinate.DistanceTo(departure.Target.Coordinate)
+	duration := determineFlightDuration(distance, speed, speedPercentage)
+
+	fleet := Fleet{
+		Id:      uuid.New(),
+		Player:  p.Player,
+		Mission: departure.Mission,
+
+		Origin:           p.Id,
+		OriginCoordinate: p.Coordinate,
+		Target:           departure.Target.Planet,
+		TargetCoordinate: departure.Target.Coordinate,
+
+		Ships: []FleetShip{},
+		Cargo: []FleetCargo{},
+
+		DepartedAt: p.UpdatedAt,
+		ArrivesAt:  p.UpdatedAt.Add(duration),
+
+		CreatedAt: p.UpdatedAt,
+	}
+
+	for _, s := range departure.Ships {
+		count, ok := ships[s.Ship.Id]
+		if ok {
+			fleet.Ships = append(fleet.Ships, FleetShip{Ship: s.Ship.Id, Count: count})
+			delete(ships, s.Ship.Id)
+		}
+	}
+	for _, c := range departure.Cargo {
+		amount, ok := cargo[c.Resource]
+		if ok {
+			fleet.Cargo = append(fleet.Cargo, FleetCargo{Resource: c.Resource, Amount: amount})
+			delete(cargo, c.Resource)
+		}
+	}
+
+	p.Fleets = append(p.Fleets, fleet)
+
+	p.Version++
+
+	return fleet, nil
+}
+
+// RecallFleet makes a fleet sent from this planet fly back at the time
+// the planet was last updated.
+func (p *Planet) RecallFleet(id uuid.UUID) error {
+	fleet, err := p.findFleetById(id)
+	if err != nil {
+		return err
+	}
+
+	if fleet.Origin != p.Id {
+		return domainerrors.ErrNotFound
+	}
+
+	err = fleet.Recall(p.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	p.Version++
+
+	return nil
+}
+
+// HandlesFleetEvent returns true when the next event of the fleet should
+// be applied to this planet: this is the case for the target of an
+// outbound fleet and for the origin of a returning fleet.
+func (p *Planet) HandlesFleetEvent(fleet Fleet) bool {
+	if fleet.Returning() {
+		return fleet.Origin == p.Id
+	}
+	return fleet.Target == p.Id
+}
+
+// ApplyFleetArrival unloads the cargo of a fleet reaching this planet.
+// A fleet only unloads what fits in the storage of the planet. A transport
+// fleet then flies back to its origin with the rest, so that no cargo is
+// lost. A deployed fleet is merged with the ships stationed on the planet
+// when its whole cargo could be unloaded: otherwise it flies back to its
+// origin with the rest just like a transport.
+func (p *Planet) ApplyFleetArrival(id uuid.UUID) error {
+	fleet, err := p.findFleetById(id)
+	if err != nil {
+		return err
+	}
+
+	if fleet.Target != p.Id || fleet.Returning() {
+		return domainerrors.ErrInvalidFleet
+	}
+	if fleet.ArrivesAt.After(p.UpdatedAt) {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	fleet.Cargo = p.creditCargoUpToStorage(fleet.Cargo)
+
+	if fleet.Mission == DeployMission && len(fleet.Cargo) == 0 {
+		for _, s := range fleet.Ships {
+			p.addShips(s.Ship, s.Count)
+		}
+		p.removeFleet(id)
+	} else {
+		returnsAt := fleet.ArrivesAt.Add(fleet.FlightDuration())
+		fleet.ReturnsAt = &returnsAt
+		fleet.Version++
+	}
+
+	p.Version++
+
+	return nil
+}
+
+// ApplyFleetReturn brings back the ships and the remaining cargo of a
+// fleet returning to this planet. The fleet has nowhere else to go: its
+// whole cargo is credited so that it is never lost, even when this
+// exceeds the storage of the planet like for resources traded on the
+// market.
+func (p *Planet) ApplyFleetReturn(id uuid.UUID) error {
+	fleet, err := p.findFleetById(id)
+	if err != nil {
+		return err
+	}
+
+	if fleet.Origin != p.Id || !fleet.Returning() {
+		return domainerrors.ErrInvalidFleet
+	}
+	if fleet.ReturnsAt.After(p.UpdatedAt) {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	for _, s := range fleet.Ships {
+		p.addShips(s.Ship, s.Count)
+	}
+	p.creditCargo(fleet.Cargo)
+
+	p.removeFleet(id)
+
+	p.Version++
+
+	return nil
+}
+
+// Trade exchanges resources of the planet with the merchant using the
+// exchange rates of the universe. The bought resource can not exceed the
+// storage of the planet. The trade happens at the time the planet was
+// last updated: callers are expected to trigger UpdateToTime beforehand.
+func (p *Planet) Trade(
+	rates []ExchangeRate,
+	sold uuid.UUID,
+	bought uuid.UUID,
+	amount int,
+) (Trade, error) {
+	boughtAmount, err := determineTradedAmount(rates, sold, bought, amount)
+	if err != nil {
+		return Trade{}, err
+	}
+
+	costs := map[uuid.UUID]int{sold: amount}
+	if err := p.validateEnoughResources(costs); err != nil {
+		return Trade{}, err
+	}
+
+	if err := p.validateEnoughStorage(bought, boughtAmount); err != nil {
+		return Trade{}, err
+	}
+
+	p.deductResources(costs)
+	p.creditCargo([]FleetCargo{{Resource: bought, Amount: boughtAmount}})
+
+	p.Version++
+
+	trade := Trade{
+		Planet:       p.Id,
+		Sold:         sold,
+		SoldAmount:   amount,
+		Bought:       bought,
+		BoughtAmount: boughtAmount,
+		CreatedAt:    p.UpdatedAt,
+	}
+	return trade, nil
+}
+
+// PostMarketOffer puts the offered resource in escrow and creates an offer
+// open until the duration elapses. The requested resource needs to exist
+// on the planet so that the seller can receive it.
+func (p *Planet) PostMarketOffer(universe uuid.UUID, terms MarketOfferTerms) (MarketOffer, error) {
+	if err := validateMarketOfferTerms(terms); err != nil {
+		return MarketOffer{}, err
+	}
+
+	if !p.hasResource(terms.RequestedResource) {
+		return MarketOffer{}, domainerrors.ErrInvalidMarketOffer
+	}
+
+	costs := map[uuid.UUID]int{terms.OfferedResource: terms.OfferedAmount}
+	if err := p.validateEnoughResources(costs); err != nil {
+		return MarketOffer{}, err
+	}
+
+	p.deductResources(costs)
+
+	offer := MarketOffer{
+		Id:       uuid.New(),
+		Universe: universe,
+		Player:   p.Player,
+		Planet:   p.Id,
+
+		OfferedResource:   terms.OfferedResource,
+		OfferedAmount:     terms.OfferedAmount,
+		RequestedResource: terms.RequestedResource,
+		RequestedAmount:   terms.RequestedAmount,
+
+		Status: OpenOffer,
+
+		ExpiresAt: p.UpdatedAt.Add(terms.Duration),
+		CreatedAt: p.UpdatedAt,
+	}
+
+	p.MarketOffers = append(p.MarketOffers, offer)
+
+	p.Version++
+
+	return offer, nil
+}
+
+// CancelMarketOffer closes an open offer posted from this planet and
+// refunds the escrow.
+func (p *Planet) CancelMarketOffer(id uuid.UUID) error {
+	offer, err := p.findMarketOfferById(id)
+	if err != nil {
+		return err
+	}
+
+	if offer.Status != OpenOffer {
+		return domainerrors.ErrMarketOfferNotAvailable
+	}
+
+	p.creditCargo([]FleetCargo{{Resource: offer.OfferedResource, Amount: offer.OfferedAmount}})
+
+	offer.Status = CancelledOffer
+	offer.Version++
+
+	p.Version++
+
+	return nil
+}
+
+// AcceptMarketOffer pays for an offer posted by another player and
+// receives the escrow right away. The seller is credited when its own
+// planet processes the settlement. The offer is accepted at the time
+// the planet was last updated: callers are expected to trigger
+// UpdateToTime beforehand.
+// Resources exchanged on the market are not capped by the storage.
+func (p *Planet) AcceptMarketOffer(offer MarketOffer) (MarketOffer, error) {
+	if offer.Status != OpenOffer || !p.UpdatedAt.Before(offer.ExpiresAt) {
+		return MarketOffer{}, domainerrors.ErrMarketOfferNotAvailable
+	}
+
+	if offer.Player == p.Player {
+		return MarketOffer{}, domainerrors.ErrInvalidMarketOffer
+	}
+	if !p.hasResource(offer.OfferedResource) {
+		return MarketOffer{}, domainerrors.ErrInvalidMarketOffer
+	}
+
+	costs := map[uuid.UUID]int{offer.RequestedResource: offer.RequestedAmount}
+	if err := p.validateEnoughResources(costs); err != nil {
+		return MarketOffer{}, err
+	}
+
+	p.deductResources(costs)
+	p.creditCargo([]FleetCargo{{Resource: offer.OfferedResource, Amount: offer.OfferedAmount}})
+
+	buyer, buyerPlanet, acceptedAt := p.Player, p.Id, p.UpdatedAt
+	offer.Status = AcceptedOffer
+	offer.Buyer = &buyer
+	offer.BuyerPlanet = &buyerPlanet
+	offer.AcceptedAt = &acceptedAt
+	offer.Version++
+
+	p.MarketOffers = append(p.MarketOffers, offer)
+
+	p.Version++
+
+	return offer, nil
+}
+
+// HandlesMarketOfferEvent returns true when the next event of the offer
+// should be applied to this planet: this is only the case for the planet
+// of the seller.
+func (p *Planet) HandlesMarketOfferEvent(offer MarketOffer) bool {
+	return offer.Planet == p.Id && offer.Pending()
+}
+
+// SettleMarketOffer credits the resource paid by the buyer of an offer
+// posted from this planet.
+func (p *Planet) SettleMarketOffer(id uuid.UUID) error {
+	offer, err := p.findMarketOfferById(id)
+	if err != nil {
+		return err
+	}
+
+	if offer.Planet != p.Id || offer.Status != AcceptedOffer {
+		return domainerrors.ErrInvalidMarketOffer
+	}
+	if offer.NextEventAt().After(p.UpdatedAt) {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	p.creditCargo([]FleetCargo{{Resource: offer.RequestedResource, Amount: offer.RequestedAmount}})
+
+	offer.Status = SettledOffer
+	offer.Version++
+
+	p.Version++
+
+	return nil
+}
+
+// ExpireMarketOffer closes an offer posted from this planet which was not
+// accepted in time and refunds the escrow.
+func (p *Planet) ExpireMarketOffer(id uuid.UUID) error {
+	offer, err := p.findMarketOfferById(id)
+	if err != nil {
+		return err
+	}
+
+	if offer.Planet != p.Id || offer.Status != OpenOffer {
+		return domainerrors.ErrInvalidMarketOffer
+	}
+	if offer.ExpiresAt.After(p.UpdatedAt) {
+		return domainerrors.ErrActionNotCompleted
+	}
+
+	p.creditCargo([]FleetCargo{{Resource: offer.OfferedResource, Amount: offer.OfferedAmount}})
+
+	offer.Status = ExpiredOffer
+	offer.Version++
 
 	p.Version++
 
@@ -193,26 +1000,350 @@ func (p *Planet) findBuildingById(id uuid.UUID) (PlanetBuilding, error) {
 	return PlanetBuilding{}, domainerrors.ErrBuildingNotFound
 }
 
+// queuedBuildingLevel returns the level the building will reach once all
+// the actions queued for it are completed.
+func (p *Planet) queuedBuildingLevel(building uuid.UUID) (int, error) {
+	pb, err := p.findBuildingById(building)
+	if err != nil {
+		return 0, err
+	}
+
+	// The queue is processed in order: the last action for the building
+	// defines its final level, whether it is an upgrade or a demolition.
+	level := pb.Level
+	for _, action := range p.BuildingQueue {
+		if action.Building == building {
+			level = action.DesiredLevel
+		}
+	}
+
+	return level, nil
+}
+
+// requirementsMet considers the levels reached once the building queue is
+// completed: this allows to queue an action right after the ones unlocking
+// it.
+func (p *Planet) requirementsMet(requirements []BuildingRequirement) bool {
+	for _, requirement := range requirements {
+		level, err := p.queuedBuildingLevel(requirement.Building)
+		if err != nil || level < requirement.Level {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitBuildingQueue processes the queue in order and separates the
+// actions which can still be applied from the ones which can't. The
+// cancelled action can't be applied and neither can the actions queued
+// after it for the same building: they depend on the level it would have
+// reached. An upgrade additionally requires the levels reached by the
+// actions kept before it to meet the requirements of its building.
+func (p *Planet) splitBuildingQueue(
+	queue []BuildingAction,
+	buildings []Building,
+	cancelled uuid.UUID,
+) ([]BuildingAction, []BuildingAction) {
+	requirements := make(map[uuid.UUID][]BuildingRequirement)
+	for _, b := range buildings {
+		requirements[b.Id] = b.Requirements
+	}
+
+	levels := make(map[uuid.UUID]int)
+	for _, b := range p.Buildings {
+		levels[b.Building] = b.Level
+	}
+
+	kept := make([]BuildingAction, 0, len(queue))
+	var removed []BuildingAction
+	dropped := make(map[uuid.UUID]bool)
+	for _, action := range queue {
+		valid := action.Id != cancelled && !dropped[action.Building]
+		if valid && action.Kind != DemolishAction {
+			for _, requirement := range requirements[action.Building] {
+				valid = valid && levels[requirement.Building] >= requirement.Level
+			}
+		}
+
+		if !valid {
+			dropped[action.Building] = true
+			removed = append(removed, action)
+			continue
+		}
+
+		levels[action.Building] = action.DesiredLevel
+		kept = append(kept, action)
+	}
+
+	return kept, removed
+}
+
+// fieldsAvailable considers that queued upgrades already use a field. A
+// queued demolition only frees its field once completed.
 func (p *Planet) fieldsAvailable() bool {
 	used := 0
 	for _, b := range p.Buildings {
 		used += b.Level
 	}
+	for _, action := range p.BuildingQueue {
+		if action.Kind != DemolishAction {
+			used++
+		}
+	}
 
 	return used < p.Fields
 }
 
+// rescheduleBuildingQueue replaces the building queue with the input one
+// and updates the completion time of its actions so that each one starts
+// when the previous one completes. Actions keep their duration. If the
+// action in progress changes, the new one starts at the UpdatedAt time
+// of the planet.
+func (p *Planet) rescheduleBuildingQueue(queue []BuildingAction) {
+	// The duration of the action in progress is unknown: it is never
+	// rescheduled as it can only be removed from the queue.
+	durations := make(map[uuid.UUID]time.Duration)
+	for id := 1; id < len(p.BuildingQueue); id++ {
+		previous := p.BuildingQueue[id-1]
+		durations[p.BuildingQueue[id].Id] = p.BuildingQueue[id].CompletedAt.Sub(previous.CompletedAt)
+	}
+
+	startAt := p.UpdatedAt
+	for id := range queue {
+		duration, ok := durations[queue[id].Id]
+		if ok {
+			queue[id].CompletedAt = startAt.Add(duration)
+		}
+
+		startAt = queue[id].CompletedAt
+	}
+
+	p.BuildingQueue = queue
+}
+
+func (p *Planet) addShipyardAction(
+	count int,
+	roles GameDataRoles,
+	create func(shipyardLevel int, startAt time.Time) (ShipyardAction, error),
+) error {
+	if count <= 0 || count > MaxShipyardBatchSize {
+		return domainerrors.ErrInvalidUnitCount
+	}
+
+	shipyard, err := p.findBuildingById(roles.ShipyardBuilding)
+	if err != nil || shipyard.Level == 0 {
+		return domainerrors.ErrShipyardNotBuilt
+	}
+
+	startAt := p.UpdatedAt
+	if len(p.ShipyardQueue) > 0 {
+		last := p.ShipyardQueue[len(p.ShipyardQueue)-1]
+		if last.CompletedAt.After(startAt) {
+			startAt = last.CompletedAt
+		}
+	}
+
+	action, err := create(shipyard.Level, startAt)
+	if err != nil {
+		return err
+	}
+
+	costs := actionCosts(action.Costs)
+	if err := p.validateEnoughResources(costs); err != nil {
+		return err
+	}
+
+	p.deductResources(costs)
+
+	p.ShipyardQueue = append(p.ShipyardQueue, action)
+
+	p.Version++
+
+	return nil
+}
+
+func (p *Planet) addShips(ship uuid.UUID, count int) {
+	for id := range p.Ships {
+		if p.Ships[id].Ship == ship {
+			p.Ships[id].Count += count
+			return
+		}
+	}
+
+	p.Ships = append(p.Ships, PlanetShip{Ship: ship, Count: count})
+}
+
+func (p *Planet) addDefenses(defense uuid.UUID, count int) {
+	for id := range p.Defenses {
+		if p.Defenses[id].Defense == defense {
+			p.Defenses[id].Count += count
+			return
+		}
+	}
+
+	p.Defenses = append(p.Defenses, PlanetDefense{Defense: defense, Count: count})
+}
+
+func (p *Planet) setTechnologyLevel(technology uuid.UUID, level int) {
+	for id := range p.Technologies {
+		if p.Technologies[id].Technology == technology {
+			p.Technologies[id].Level = level
+			p.Technologies[id].ReachedAt = p.UpdatedAt
+			return
+		}
+	}
+
+	p.Technologies = append(p.Technologies, PlayerTechnology{
+		Technology: technology,
+		Level:      level,
+		ReachedAt:  p.UpdatedAt,
+	})
+}
+
+func (p *Planet) findFleetById(id uuid.UUID) (*Fleet, error) {
+	for i := range p.Fleets {
+		if p.Fleets[i].Id == id {
+			return &p.Fleets[i], nil
+		}
+	}
+
+	return nil, domainerrors.ErrNotFound
+}
+
+func (p *Planet) removeFleet(id uuid.UUID) {
+	p.Fleets = slices.DeleteFunc(p.Fleets, func(f Fleet) bool {
+		return f.Id == id
+	})
+}
+
+func (p *Planet) findMarketOfferById(id uuid.UUID) (*MarketOffer, error) {
+	for i := range p.MarketOffers {
+		if p.MarketOffers[i].Id == id {
+			return &p.MarketOffers[i], nil
+		}
+	}
+
+	return nil, domainerrors.ErrMarketOfferNotFound
+}
+
+func (p *Planet) hasResource(resource uuid.UUID) bool {
+	for _, r := range p.Resources {
+		if r.Resource == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Planet) validateEnoughShips(ships map[uuid.UUID]int) error {
+	available := make(map[uuid.UUID]int)
+	for _, s := range p.Ships {
+		available[s.Ship] += s.Count
+	}
+
+	for ship, count := range ships {
+		if available[ship] < count {
+			return domainerrors.ErrNotEnoughShips
+		}
+	}
+
+	return nil
+}
+
+func (p *Planet) storesResource(resource uuid.UUID) bool {
+	return slices.ContainsFunc(p.Storages, func(s PlanetResourceStorage) bool {
+		return s.Resource == resource
+	})
+}
+
+func (p *Planet) deductShips(ships map[uuid.UUID]int) {
+	for id, s := range p.Ships {
+		count, ok := ships[s.Ship]
+		if ok {
+			p.Ships[id].Count -= count
+		}
+	}
+}
+
+func (p *Planet) creditCargo(cargo []FleetCargo) {
+	temp := make(map[uuid.UUID]int)
+	for id, pr := range p.Resources {
+		temp[pr.Resource] = id
+	}
+
+	for _, c := range cargo {
+		id, ok := temp[c.Resource]
+		if ok {
+			p.Resources[id].Amount += float64(c.Amount)
+		} else {
+			pr := PlanetResource{
+				Resource: c.Resource,
+				Amount:   float64(c.Amount),
+			}
+			p.Resources = append(p.Resources, pr)
+		}
+	}
+}
+
+// creditCargoUpToStorage credits the cargo without exceeding the storage
+// of the planet and returns what could not be unloaded. Resources already
+// above their storage are not credited at all.
+func (p *Planet) creditCargoUpToStorage(cargo []FleetCargo) []FleetCargo {
+	storages := make(map[uuid.UUID]int)
+	for _, s := range p.Storages {
+		storages[s.Resource] = s.Storage
+	}
+
+	current := make(map[uuid.UUID]float64)
+	for _, r := range p.Resources {
+		current[r.Resource] = r.Amount
+	}
+
+	credited := []FleetCargo{}
+	remaining := []FleetCargo{}
+	for _, c := range cargo {
+		free := max(int(math.Floor(float64(storages[c.Resource])-current[c.Resource])), 0)
+		amount := min(c.Amount, free)
+
+		if amount > 0 {
+			credited = append(credited, FleetCargo{Resource: c.Resource, Amount: amount})
+		}
+		if amount < c.Amount {
+			remaining = append(remaining, FleetCargo{Resource: c.Resource, Amount: c.Amount - amount})
+		}
+	}
+
+	p.creditCargo(credited)
+
+	return remaining
+}
+
+// actionCosts sums the costs of an action per resource. The costs of all
+// the kinds of actions share the same fields.
+func actionCosts[Cost BuildingActionCost | ShipyardActionCost | ResearchActionCost](
+	actionCosts []Cost,
+) map[uuid.UUID]int {
+	costs := make(map[uuid.UUID]int)
+	for _, actionCost := range actionCosts {
+		cost := BuildingActionCost(actionCost)
+		costs[cost.Resource] += cost.Amount
+	}
+	return costs
+}
+
 func (p *Planet) validateEnoughResources(
-	action BuildingAction,
+	costs map[uuid.UUID]int,
 ) error {
 	temp := make(map[uuid.UUID]PlanetResource)
 	for _, resource := range p.Resources {
 		temp[resource.Resource] = resource
 	}
 
-	for _, cost := range action.Costs {
-		actual, ok := temp[cost.Resource]
-		if !ok || actual.Amount < float64(cost.Amount) {
+	for resource, amount := range costs {
+		actual, ok := temp[resource]
+		if !ok || actual.Amount < float64(amount) {
 			return domainerrors.ErrNotEnoughResources
 		}
 	}
@@ -220,18 +1351,37 @@ func (p *Planet) validateEnoughResources(
 	return nil
 }
 
-func (p *Planet) deductResources(
-	action BuildingAction,
-) {
-	temp := make(map[uuid.UUID]BuildingActionCost)
-	for _, cost := range action.Costs {
-		temp[cost.Resource] = cost
+// validateEnoughStorage verifies that crediting the amount of the resource
+// does not exceed its storage. Resources without storage can not be credited.
+func (p *Planet) validateEnoughStorage(resource uuid.UUID, amount int) error {
+	storage := 0
+	for _, s := range p.Storages {
+		if s.Resource == resource {
+			storage = s.Storage
+		}
+	}
+
+	current := 0.0
+	for _, r := range p.Resources {
+		if r.Resource == resource {
+			current = r.Amount
+		}
 	}
 
+	if current+float64(amount) > float64(storage) {
+		return domainerrors.ErrStorageCapacityExceeded
+	}
+
+	return nil
+}
+
+func (p *Planet) deductResources(
+	costs map[uuid.UUID]int,
+) {
 	for id, resource := range p.Resources {
-		cost, ok := temp[resource.Resource]
+		amount, ok := costs[resource.Resource]
 		if ok {
-			p.Resources[id].Amount -= float64(cost.Amount)
+			p.Resources[id].Amount -= float64(amount)
 		}
 	}
 }
@@ -258,17 +1408,17 @@ func (p *Planet) creditResources(
 	}
 }
 
-func (p *Planet) updateProductions() {
+func (p *Planet) updateProductions(action BuildingAction) {
 	temp := make(map[uuid.UUID]int)
 	for id, pr := range p.Productions {
-		if p.Productions[id].Building == nil || *p.Productions[id].Building != p.BuildingAction.Building {
+		if p.Productions[id].Building == nil || *p.Productions[id].Building != action.Building {
 			continue
 		}
 
 		temp[pr.Resource] = id
 	}
 
-	for _, pp := range p.BuildingAction.Productions {
+	for _, pp := range action.Productions {
 		id, ok := temp[pp.Resource]
 
 		if ok {
@@ -276,7 +1426,7 @@ func (p *Planet) updateProductions() {
 		} else {
 			newProd := PlanetResourceProduction{
 				Resource:   pp.Resource,
-				Building:   &p.BuildingAction.Building,
+				Building:   &action.Building,
 				Production: pp.Production,
 			}
 			p.Productions = append(p.Productions, newProd)
@@ -284,13 +1434,13 @@ func (p *Planet) updateProductions() {
 	}
 }
 
-func (p *Planet) updateStorages() {
+func (p *Planet) updateStorages(action BuildingAction) {
 	temp := make(map[uuid.UUID]int)
 	for id, s := range p.Storages {
 		temp[s.Resource] = id
 	}
 
-	for _, s := range p.BuildingAction.Storages {
+	for _, s := range action.Storages {
 		id := temp[s.Resource]
 		p.Storages[id].Storage = s.Storage
 	}
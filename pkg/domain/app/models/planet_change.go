@@ -0,0 +1,41 @@
+package models
+
+import "github.com/google/uuid"
+
+// PlanetChange is published each time a mutation of a planet is committed.
+// It does not carry the planet itself: subscribers are expected to fetch
+// it again if they need the new values. The version is the one of the
+// planet after the mutation, or before it when the planet is deleted. The
+// kind is the one of the event recorded for the mutation.
+type PlanetChange struct {
+	Planet   uuid.UUID
+	Player   uuid.UUID
+	Universe uuid.UUID
+	Version  int
+	Kind     PlanetEventKind
+	Deleted  bool
+}
+
+type PlanetChangeScope string
+
+const (
+	PlayerScope   PlanetChangeScope = "player"
+	PlanetScope   PlanetChangeScope = "planet"
+	UniverseScope PlanetChangeScope = "universe"
+)
+
+// PlanetChangeTopic identifies a set of changes subscribers are interested
+// in: the changes of a single planet, of the planets of a player or of all
+// the planets of a universe.
+type PlanetChangeTopic struct {
+	Scope PlanetChangeScope
+	Id    uuid.UUID
+}
+
+func (c PlanetChange) Topics() []PlanetChangeTopic {
+	return []PlanetChangeTopic{
+		{Scope: PlayerScope, Id: c.Player},
+		{Scope: PlanetScope, Id: c.Planet},
+		{Scope: UniverseScope, Id: c.Universe},
+	}
+}
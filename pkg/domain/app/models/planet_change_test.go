@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_PlanetChange_Topics(t *testing.T) {
+	change := PlanetChange{
+		Planet:   uuid.New(),
+		Player:   uuid.New(),
+		Universe: uuid.New(),
+	}
+
+	expected := []PlanetChangeTopic{
+		{Scope: PlayerScope, Id: change.Player},
+		{Scope: PlanetScope, Id: change.Planet},
+		{Scope: UniverseScope, Id: change.Universe},
+	}
+	assert.Equal(t, expected, change.Topics())
+}
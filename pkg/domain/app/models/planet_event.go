@@ -0,0 +1,479 @@
+package models
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlanetEventKind string
+
+const (
+	PlanetUpdated         PlanetEventKind = "update"
+	PlanetActionCreated   PlanetEventKind = "action_created"
+	PlanetActionCancelled PlanetEventKind = "action_cancelled"
+	PlanetActionApplied   PlanetEventKind = "action_applied"
+	PlanetDeleted         PlanetEventKind = "deletion"
+	// PlanetCreated is only published to the subscribers of the changes:
+	// the starting state of a planet is given by its universe and is not
+	// recorded as an event.
+	PlanetCreated PlanetEventKind = "creation"
+)
+
+// PlanetEvent records a change committed to a planet. A mutation produces
+// one event per action it created, cancelled or applied, and an update for
+// the other changes of the resources. Events are never modified: they
+// describe the history of the planet.
+type PlanetEvent struct {
+	Id     uuid.UUID
+	Planet uuid.UUID
+	Kind   PlanetEventKind
+	// Action is the action created, cancelled or applied by the event. An
+	// update with an action records that the action was rescheduled, for
+	// example when the building queue is reordered. It is nil for the other
+	// updates and for deletions.
+	Action *uuid.UUID
+	// ActionState is the action as it is after a creation or a reschedule,
+	// and as it was before being cancelled or applied. It holds what is
+	// needed to replay the action.
+	ActionState *PlanetEventAction
+
+	// The events of a mutation all start from the version of the planet
+	// before the mutation: only the last one reaches the new version.
+	VersionBefore int
+	VersionAfter  int
+
+	Resources []PlanetEventResource
+
+	CreatedAt time.Time
+}
+
+// PlanetEventResource is the change of the amount of a resource. It is
+// negative when the resource was spent. The part of the change produced by
+// the planet is only set for updates.
+type PlanetEventResource struct {
+	Resource uuid.UUID
+	Delta    float64
+	Produced float64
+}
+
+// PlanetEventAction holds the action of an event: only one of the fields
+// is set, depending on the kind of the action.
+type PlanetEventAction struct {
+	Building *BuildingAction
+	Shipyard *ShipyardAction
+	Research *ResearchAction
+}
+
+// planetEventPrecision is the smallest significant change of an amount:
+// resources are persisted with 5 decimals.
+const planetEventPrecision = 1e-5
+
+// PlanetSnapshot keeps the values of a planet needed to describe how a
+// mutation changed it. The mutations modify the planet in place: the
+// snapshot needs to be taken beforehand.
+type PlanetSnapshot struct {
+	Version   int
+	Resources []PlanetResource
+	Actions   map[uuid.UUID]PlanetSnapshotAction
+
+	// production holds a copy of what determines the production of the
+	// planet, to separate it from the other changes of the resources.
+	production Planet
+}
+
+// PlanetSnapshotAction describes a pending action of a planet: the time
+// at which it completes, the resources deducted when it was created and
+// a copy of the action itself.
+type PlanetSnapshotAction struct {
+	CreatedAt   time.Time
+	CompletedAt time.Time
+	Costs       []PlanetEventResource
+	State       PlanetEventAction
+}
+
+func (p Planet) Snapshot() PlanetSnapshot {
+	out := PlanetSnapshot{
+		Version:   p.Version,
+		Resources: slices.Clone(p.Resources),
+		Actions:   make(map[uuid.UUID]PlanetSnapshotAction),
+		production: Planet{
+			UpdatedAt:     p.UpdatedAt,
+			Resources:     slices.Clone(p.Resources),
+			Storages:      slices.Clone(p.Storages),
+			Productions:   slices.Clone(p.Productions),
+			Buildings:     slices.Clone(p.Buildings),
+			BuildingQueue: slices.Clone(p.BuildingQueue),
+			Technologies:  slices.Clone(p.Technologies),
+			Roles:         p.Roles,
+
+			TechnologyUpgrades: slices.Clone(p.TechnologyUpgrades),
+		},
+	}
+	if p.ResearchAction != nil {
+		action := *p.ResearchAction
+		out.production.ResearchAction = &action
+	}
+
+	for _, action := range p.BuildingQueue {
+		snapshot := PlanetSnapshotAction{
+			CreatedAt:   action.CreatedAt,
+			CompletedAt: action.CompletedAt,
+			State:       PlanetEventAction{Building: &action},
+		}
+		for _, cost := range action.Costs {
+			snapshot.Costs = append(snapshot.Costs, spentResource(cost.Resource, cost.Amount))
+		}
+		out.Actions[action.Id] = snapshot
+	}
+	for _, action := range p.ShipyardQueue {
+		snapshot := PlanetSnapshotAction{
+			CreatedAt:   action.CreatedAt,
+			CompletedAt: action.CompletedAt,
+			State:       PlanetEventAction{Shipyard: &action},
+		}
+		for _, cost := range action.Costs {
+			snapshot.Costs = append(snapshot.Costs, spentResource(cost.Resource, cost.Amount))
+		}
+		out.Actions[action.Id] = snapshot
+	}
+	if p.ResearchAction != nil {
+		action := *p.ResearchAction
+		snapshot := PlanetSnapshotAction{
+			CreatedAt:   action.CreatedAt,
+			CompletedAt: action.CompletedAt,
+			State:       PlanetEventAction{Research: &action},
+		}
+		for _, cost := range action.Costs {
+			snapshot.Costs = append(snapshot.Costs, spentResource(cost.Resource, cost.Amount))
+		}
+		out.Actions[action.Id] = snapshot
+	}
+
+	return out
+}
+
+// NewPlanetEvents describes the mutation which turned the snapshot into the
+// planet. The actions applied while advancing the planet come first, at the
+// time they completed. They are followed by the update of the resources
+// which are not explained by the actions, such as the production or the
+// trades, and by the cancelled, rescheduled and created actions. The
+// cancelled and created actions account for the resources refunded and
+// spent.
+// The production is computed from the snapshot by advancing its buildings
+// and its research to the time of the mutation: the resources received in the meantime (for
+// example by a fleet) are not considered when capping it by the storages.
+// A deleted planet produces a single event where all resources are lost.
+func NewPlanetEvents(before PlanetSnapshot, after Planet, deleted bool) ([]PlanetEvent, error) {
+	moment := after.UpdatedAt
+
+	if deleted {
+		event := newPlanetEvent(after.Id, PlanetDeleted, nil, nil, moment)
+		event.Resources = resourceDeltas(before.Resources, nil)
+		return chainPlanetEvents([]PlanetEvent{event}, before.Version, after.Version), nil
+	}
+
+	current := after.Snapshot()
+	remaining := resourceDeltas(before.Resources, current.Resources)
+
+	var applied, cancelled, rescheduled, created []PlanetEvent
+	for _, id := range sortedSnapshotActions(before.Actions) {
+		action := before.Actions[id]
+
+		state, ok := current.Actions[id]
+		if ok {
+			if !state.CompletedAt.Equal(action.CompletedAt) || !state.CreatedAt.Equal(action.CreatedAt) {
+				event := newPlanetEvent(after.Id, PlanetUpdated, &id, &state.State, moment)
+				rescheduled = append(rescheduled, event)
+			}
+			continue
+		}
+
+		if !action.CompletedAt.After(moment) {
+			event := newPlanetEvent(after.Id, PlanetActionApplied, &id, &action.State, action.CompletedAt)
+			applied = append(applied, event)
+			continue
+		}
+
+		event := newPlanetEvent(after.Id, PlanetActionCancelled, &id, &action.State, moment)
+		for _, cost := range action.Costs {
+			refund := PlanetEventResource{Resource: cost.Resource, Delta: -cost.Delta}
+			event.Resources = append(event.Resources, refund)
+		}
+		remaining = subtractResourceDeltas(remaining, event.Resources)
+		cancelled = append(cancelled, event)
+	}
+
+	for _, id := range sortedSnapshotActions(current.Actions) {
+		if _, ok := before.Actions[id]; ok {
+			continue
+		}
+
+		action := current.Actions[id]
+		event := newPlanetEvent(after.Id, PlanetActionCreated, &id, &action.State, moment)
+		event.Resources = action.Costs
+		remaining = subtractResourceDeltas(remaining, event.Resources)
+		created = append(created, event)
+	}
+
+	produced, err := before.production.produce(moment)
+	if err != nil {
+		return nil, err
+	}
+	remaining = attributeProduction(remaining, produced)
+
+	out := applied
+	changes := len(applied) + len(cancelled) + len(rescheduled) + len(created)
+	if len(remaining) > 0 || changes == 0 {
+		event := newPlanetEvent(after.Id, PlanetUpdated, nil, nil, moment)
+		event.Resources = remaining
+		out = append(out, event)
+	}
+	out = append(out, cancelled...)
+	out = append(out, rescheduled...)
+	out = append(out, created...)
+
+	return chainPlanetEvents(out, before.Version, after.Version), nil
+}
+
+func newPlanetEvent(
+	planet uuid.UUID,
+	kind PlanetEventKind,
+	action *uuid.UUID,
+	state *PlanetEventAction,
+	moment time.Time,
+) PlanetEvent {
+	return PlanetEvent{
+		Id:          uuid.New(),
+		Planet:      planet,
+		Kind:        kind,
+		Action:      action,
+		ActionState: state,
+		CreatedAt:   moment,
+	}
+}
+
+// chainPlanetEvents assigns the versions of the planet to the events of a
+// mutation: the version only changes with the last of them.
+func chainPlanetEvents(events []PlanetEvent, before int, after int) []PlanetEvent {
+	for id := range events {
+		events[id].VersionBefore = before
+		events[id].VersionAfter = before
+	}
+	events[len(events)-1].VersionAfter = after
+
+	return events
+}
+
+// sortedSnapshotActions orders the actions by completion time so that the
+// events of a mutation do not depend on the iteration order of the map.
+func sortedSnapshotActions(actions map[uuid.UUID]PlanetSnapshotAction) []uuid.UUID {
+	out := make([]uuid.UUID, 0, len(actions))
+	for id := range actions {
+		out = append(out, id)
+	}
+
+	slices.SortFunc(out, func(lhs uuid.UUID, rhs uuid.UUID) int {
+		cmp := actions[lhs].CompletedAt.Compare(actions[rhs].CompletedAt)
+		if cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(lhs.String(), rhs.String())
+	})
+
+	return out
+}
+
+// produce advances the planet to the moment with only its buildings and
+// the levels of its technologies and returns how much of each resource was
+// produced. This follows the same steps as when the planet itself is
+// advanced: a technology level reached in the meantime changes the bonus
+// of the productions.
+func (p Planet) produce(moment time.Time) (map[uuid.UUID]float64, error) {
+	p.Resources = slices.Clone(p.Resources)
+	p.Storages = slices.Clone(p.Storages)
+	p.Productions = slices.Clone(p.Productions)
+	p.Buildings = slices.Clone(p.Buildings)
+	p.BuildingQueue = slices.Clone(p.BuildingQueue)
+	p.Technologies = slices.Clone(p.Technologies)
+	p.TechnologyUpgrades = slices.Clone(p.TechnologyUpgrades)
+
+	initial := make(map[uuid.UUID]float64)
+	for _, resource := range p.Resources {
+		initial[resource.Resource] = resource.Amount
+	}
+
+	for {
+		change, ok := p.nextProductionChange()
+		if !ok || change.completedAt.After(moment) {
+			return p.producedSince(initial, moment)
+		}
+
+		// A change completed before the last update of the planet should
+		// already have been applied: it can't be replayed in order.
+		if change.completedAt.Before(p.UpdatedAt) {
+			change.skip()
+			continue
+		}
+
+		err := p.UpdateToTime(change.completedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		err = change.apply()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// productionChange is a pending change of what determines the production
+// of a planet, replayed by produce.
+type productionChange struct {
+	completedAt time.Time
+	skip        func()
+	apply       func() error
+}
+
+// nextProductionChange returns the change of the production of the planet
+// which completes first. On ties, buildings come before the research which
+// comes before the levels reached on other planets.
+func (p *Planet) nextProductionChange() (productionChange, bool) {
+	var changes []productionChange
+	if len(p.BuildingQueue) > 0 {
+		changes = append(changes, productionChange{
+			completedAt: p.BuildingQueue[0].CompletedAt,
+			skip:        func() { p.BuildingQueue = p.BuildingQueue[1:] },
+			apply:       p.ApplyAction,
+		})
+	}
+	if p.ResearchAction != nil {
+		changes = append(changes, productionChange{
+			completedAt: p.ResearchAction.CompletedAt,
+			skip:        func() { p.ResearchAction = nil },
+			apply:       p.ApplyResearchAction,
+		})
+	}
+	if len(p.TechnologyUpgrades) > 0 {
+		changes = append(changes, productionChange{
+			completedAt: p.TechnologyUpgrades[0].ReachedAt,
+			skip:        func() { p.TechnologyUpgrades = p.TechnologyUpgrades[1:] },
+			apply:       p.ApplyTechnologyUpgrade,
+		})
+	}
+
+	if len(changes) == 0 {
+		return productionChange{}, false
+	}
+
+	return slices.MinFunc(changes, func(lhs productionChange, rhs productionChange) int {
+		return lhs.completedAt.Compare(rhs.completedAt)
+	}), true
+}
+
+// producedSince advances the planet to the moment and returns how much of
+// each resource it gained compared to the initial amounts.
+func (p *Planet) producedSince(initial map[uuid.UUID]float64, moment time.Time) (map[uuid.UUID]float64, error) {
+	err := p.UpdateToTime(moment)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]float64)
+	for _, resource := range p.Resources {
+		out[resource.Resource] = resource.Amount - initial[resource.Resource]
+	}
+
+	return out, nil
+}
+
+func spentResource(resource uuid.UUID, amount int) PlanetEventResource {
+	return PlanetEventResource{Resource: resource, Delta: -float64(amount)}
+}
+
+// subtractResourceDeltas removes the changes explained by an action from
+// the ones of the mutation. The changes which are not significant anymore
+// are dropped.
+func subtractResourceDeltas(
+	deltas []PlanetEventResource,
+	explained []PlanetEventResource,
+) []PlanetEventResource {
+	out := slices.Clone(deltas)
+	for _, resource := range explained {
+		id := slices.IndexFunc(out, func(delta PlanetEventResource) bool {
+			return delta.Resource == resource.Resource
+		})
+
+		if id < 0 {
+			out = append(out, PlanetEventResource{Resource: resource.Resource, Delta: -resource.Delta})
+		} else {
+			out[id].Delta -= resource.Delta
+		}
+	}
+
+	return slices.DeleteFunc(out, func(delta PlanetEventResource) bool {
+		return math.Abs(delta.Delta) < planetEventPrecision
+	})
+}
+
+// attributeProduction records which part of the remaining changes comes
+// from the production. A resource can be produced and entirely spent by a
+// trade: it is then reported with no change but with its production.
+func attributeProduction(
+	deltas []PlanetEventResource,
+	produced map[uuid.UUID]float64,
+) []PlanetEventResource {
+	out := slices.Clone(deltas)
+	for id := range out {
+		out[id].Produced = produced[out[id].Resource]
+		delete(produced, out[id].Resource)
+	}
+
+	resources := make([]uuid.UUID, 0, len(produced))
+	for resource, amount := range produced {
+		if math.Abs(amount) >= planetEventPrecision {
+			resources = append(resources, resource)
+		}
+	}
+	slices.SortFunc(resources, func(lhs uuid.UUID, rhs uuid.UUID) int {
+		return strings.Compare(lhs.String(), rhs.String())
+	})
+
+	for _, resource := range resources {
+		out = append(out, PlanetEventResource{Resource: resource, Produced: produced[resource]})
+	}
+
+	return out
+}
+
+// resourceDeltas only reports the resources whose amount changed.
+func resourceDeltas(before []PlanetResource, after []PlanetResource) []PlanetEventResource {
+	amounts := make(map[uuid.UUID]float64)
+	for _, resource := range before {
+		amounts[resource.Resource] = resource.Amount
+	}
+
+	var out []PlanetEventResource
+	for _, resource := range after {
+		delta := resource.Amount - amounts[resource.Resource]
+		delete(amounts, resource.Resource)
+
+		if delta != 0 {
+			out = append(out, PlanetEventResource{Resource: resource.Resource, Delta: delta})
+		}
+	}
+
+	// The remaining resources were removed from the planet.
+	for _, resource := range before {
+		amount, ok := amounts[resource.Resource]
+		if ok && amount != 0 {
+			out = append(out, PlanetEventResource{Resource: resource.Resource, Delta: -amount})
+		}
+	}
+
+	return out
+}
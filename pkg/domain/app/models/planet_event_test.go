@@ -0,0 +1,322 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_PlanetEvent_NewPlanetEvents(t *testing.T) {
+	moment := time.Date(2026, 10, 12, 14, 3, 26, 0, time.UTC)
+	action := BuildingAction{
+		Id:          uuid.New(),
+		CompletedAt: moment.Add(time.Hour),
+		Costs:       []BuildingActionCost{{Resource: metalResourceId, Amount: 40}},
+	}
+
+	generatePlanet := func(version int, metal float64, actions ...BuildingAction) Planet {
+		return Planet{
+			Id:        uuid.New(),
+			UpdatedAt: moment,
+			Version:   version,
+			Resources: []PlanetResource{
+				{Resource: metalResourceId, Amount: metal},
+				{Resource: crystalResourceId, Amount: 50},
+			},
+			BuildingQueue: actions,
+		}
+	}
+
+	t.Run("records resource deltas and versions of update", func(t *testing.T) {
+		before := generatePlanet(3, 100).Snapshot()
+		after := generatePlanet(4, 120.5)
+
+		actual, err := NewPlanetEvents(before, after, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.NotEqual(t, uuid.Nil, actual[0].Id)
+		assert.Equal(t, after.Id, actual[0].Planet)
+		assert.Equal(t, PlanetUpdated, actual[0].Kind)
+		assert.Nil(t, actual[0].Action)
+		assert.Equal(t, 3, actual[0].VersionBefore)
+		assert.Equal(t, 4, actual[0].VersionAfter)
+		assert.Equal(t, moment, actual[0].CreatedAt)
+		expected := []PlanetEventResource{{Resource: metalResourceId, Delta: 20.5}}
+		assert.Equal(t, expected, actual[0].Resources)
+	})
+
+	t.Run("records created action with its costs", func(t *testing.T) {
+		before := generatePlanet(3, 100).Snapshot()
+		after := generatePlanet(4, 60, action)
+
+		actual, err := NewPlanetEvents(before, after, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, PlanetActionCreated, actual[0].Kind)
+		assert.Equal(t, &action.Id, actual[0].Action)
+		assert.Equal(t, &PlanetEventAction{Building: &action}, actual[0].ActionState)
+		assert.Equal(t, 3, actual[0].VersionBefore)
+		assert.Equal(t, 4, actual[0].VersionAfter)
+		expected := []PlanetEventResource{{Resource: metalResourceId, Delta: -40}}
+		assert.Equal(t, expected, actual[0].Resources)
+	})
+
+	t.Run("records cancelled action with its refund", func(t *testing.T) {
+		before := generatePlanet(3, 100, action).Snapshot()
+		after := generatePlanet(4, 140)
+
+		actual, err := NewPlanetEvents(before, after, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, PlanetActionCancelled, actual[0].Kind)
+		assert.Equal(t, &action.Id, actual[0].Action)
+		assert.Equal(t, &PlanetEventAction{Building: &action}, actual[0].ActionState)
+		expected := []PlanetEventResource{{Resource: metalResourceId, Delta: 40}}
+		assert.Equal(t, expected, actual[0].Resources)
+	})
+
+	t.Run("records applied action at its completion time", func(t *testing.T) {
+		applied := BuildingAction{Id: uuid.New(), CompletedAt: moment.Add(-time.Minute)}
+		planet := generatePlanet(3, 100, applied)
+		planet.UpdatedAt = moment.Add(-time.Hour)
+		before := planet.Snapshot()
+		after := generatePlanet(4, 100)
+
+		actual, err := NewPlanetEvents(before, after, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, PlanetActionApplied, actual[0].Kind)
+		assert.Equal(t, &applied.Id, actual[0].Action)
+		assert.Equal(t, applied.CompletedAt, actual[0].CreatedAt)
+		assert.Empty(t, actual[0].Resources)
+	})
+
+	t.Run("records one event per change", func(t *testing.T) {
+		first := BuildingAction{Id: uuid.New(), CompletedAt: moment.Add(-2 * time.Minute)}
+		second := BuildingAction{Id: uuid.New(), CompletedAt: moment.Add(-time.Minute)}
+		planet := generatePlanet(3, 100, first, second, action)
+		planet.UpdatedAt = moment.Add(-time.Hour)
+		before := planet.Snapshot()
+		created := BuildingAction{
+			Id:          uuid.New(),
+			CompletedAt: moment.Add(2 * time.Hour),
+			Costs:       []BuildingActionCost{{Resource: crystalResourceId, Amount: 20}},
+		}
+		after := generatePlanet(4, 150, created)
+		after.Resources[1].Amount = 30
+
+		actual, err := NewPlanetEvents(before, after, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		kinds := make([]PlanetEventKind, 0, len(actual))
+		for _, event := range actual {
+			kinds = append(kinds, event.Kind)
+		}
+		expectedKinds := []PlanetEventKind{
+			PlanetActionApplied,
+			PlanetActionApplied,
+			PlanetUpdated,
+			PlanetActionCancelled,
+			PlanetActionCreated,
+		}
+		assert.Equal(t, expectedKinds, kinds)
+		assert.Equal(t, &first.Id, actual[0].Action)
+		assert.Equal(t, &second.Id, actual[1].Action)
+		assert.Equal(t, &action.Id, actual[3].Action)
+		assert.Equal(t, &created.Id, actual[4].Action)
+
+		expected := []PlanetEventResource{{Resource: metalResourceId, Delta: 10}}
+		assert.Equal(t, expected, actual[2].Resources)
+
+		for id, event := range actual {
+			assert.Equal(t, 3, event.VersionBefore)
+			if id < len(actual)-1 {
+				assert.Equal(t, 3, event.VersionAfter)
+			}
+		}
+		assert.Equal(t, 4, actual[4].VersionAfter)
+	})
+
+	t.Run("records rescheduled action", func(t *testing.T) {
+		before := generatePlanet(3, 100, action).Snapshot()
+		rescheduled := action
+		rescheduled.CompletedAt = moment.Add(2 * time.Hour)
+		after := generatePlanet(4, 100, rescheduled)
+
+		actual, err := NewPlanetEvents(before, after, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, PlanetUpdated, actual[0].Kind)
+		assert.Equal(t, &action.Id, actual[0].Action)
+		assert.Equal(t, &PlanetEventAction{Building: &rescheduled}, actual[0].ActionState)
+		assert.Empty(t, actual[0].Resources)
+	})
+
+	t.Run("records production separately from the other changes", func(t *testing.T) {
+		planet := generatePlanet(3, 100)
+		planet.UpdatedAt = moment.Add(-time.Hour)
+		planet.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 1000},
+			{Resource: crystalResourceId, Storage: 1000},
+		}
+		planet.Productions = []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 30},
+			{Resource: crystalResourceId, Production: 10},
+		}
+		before := planet.Snapshot()
+
+		// 30 metal were produced and 20 were sold for 10 crystal, which
+		// were entirely spent along with the production of crystal.
+		planet.UpdatedAt = moment
+		planet.Version = 4
+		planet.Resources[0].Amount = 110
+
+		actual, err := NewPlanetEvents(before, planet, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		expected := []PlanetEventResource{
+			{Resource: metalResourceId, Delta: 10, Produced: 30},
+			{Resource: crystalResourceId, Delta: 0, Produced: 10},
+		}
+		assert.Equal(t, expected, actual[0].Resources)
+	})
+
+	t.Run("records production boosted by the energy technology", func(t *testing.T) {
+		planet := generatePlanet(3, 100)
+		planet.UpdatedAt = moment.Add(-time.Hour)
+		planet.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 1000},
+		}
+		planet.Productions = []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 30, Building: &metalMineId},
+		}
+		planet.Technologies = []PlayerTechnology{
+			{Technology: energyTechnologyId, Level: 10},
+		}
+		planet.Roles = testRoles
+		before := planet.Snapshot()
+
+		planet.UpdatedAt = moment
+		planet.Version = 4
+		planet.Resources[0].Amount = 133
+
+		actual, err := NewPlanetEvents(before, planet, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		require.Len(t, actual[0].Resources, 1)
+		assert.Equal(t, metalResourceId, actual[0].Resources[0].Resource)
+		assert.InDelta(t, 33, actual[0].Resources[0].Delta, 1e-9)
+		assert.InDelta(t, 33, actual[0].Resources[0].Produced, 1e-9)
+	})
+
+	t.Run("records production boosted once the energy technology research completes", func(t *testing.T) {
+		planet := generatePlanet(3, 100)
+		planet.UpdatedAt = moment.Add(-time.Hour)
+		planet.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 1000},
+		}
+		planet.Productions = []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 30, Building: &metalMineId},
+		}
+		planet.ResearchAction = &ResearchAction{
+			Id:           uuid.New(),
+			Technology:   energyTechnologyId,
+			DesiredLevel: 10,
+			CompletedAt:  moment.Add(-30 * time.Minute),
+		}
+		planet.Roles = testRoles
+		before := planet.Snapshot()
+
+		planet.UpdatedAt = moment
+		planet.Version = 4
+		planet.ResearchAction = nil
+		planet.Technologies = []PlayerTechnology{
+			{Technology: energyTechnologyId, Level: 10},
+		}
+		planet.Resources[0].Amount = 131.5
+
+		actual, err := NewPlanetEvents(before, planet, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, PlanetActionApplied, actual[0].Kind)
+		assert.Equal(t, PlanetUpdated, actual[1].Kind)
+		require.Len(t, actual[1].Resources, 1)
+		assert.InDelta(t, 31.5, actual[1].Resources[0].Delta, 1e-9)
+		assert.InDelta(t, 31.5, actual[1].Resources[0].Produced, 1e-9)
+	})
+
+	t.Run("records production boosted once the energy technology is reached on another planet", func(t *testing.T) {
+		planet := generatePlanet(3, 100)
+		planet.UpdatedAt = moment.Add(-time.Hour)
+		planet.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 1000},
+		}
+		planet.Productions = []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 30, Building: &metalMineId},
+		}
+		planet.TechnologyUpgrades = []PlayerTechnology{
+			{Technology: energyTechnologyId, Level: 10, ReachedAt: moment.Add(-30 * time.Minute)},
+		}
+		planet.Roles = testRoles
+		before := planet.Snapshot()
+
+		planet.UpdatedAt = moment
+		planet.Version = 4
+		planet.TechnologyUpgrades = nil
+		planet.Technologies = []PlayerTechnology{
+			{Technology: energyTechnologyId, Level: 10, ReachedAt: moment.Add(-30 * time.Minute)},
+		}
+		planet.Resources[0].Amount = 131.5
+
+		actual, err := NewPlanetEvents(before, planet, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		require.Len(t, actual[0].Resources, 1)
+		assert.InDelta(t, 31.5, actual[0].Resources[0].Delta, 1e-9)
+		assert.InDelta(t, 31.5, actual[0].Resources[0].Produced, 1e-9)
+	})
+
+	t.Run("records deletion as loss of all resources", func(t *testing.T) {
+		before := generatePlanet(3, 100, action).Snapshot()
+		after := generatePlanet(4, 100, action)
+
+		actual, err := NewPlanetEvents(before, after, true)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, PlanetDeleted, actual[0].Kind)
+		assert.Nil(t, actual[0].Action)
+		expected := []PlanetEventResource{
+			{Resource: metalResourceId, Delta: -100},
+			{Resource: crystalResourceId, Delta: -50},
+		}
+		assert.Equal(t, expected, actual[0].Resources)
+	})
+
+	t.Run("snapshot is not modified by mutation", func(t *testing.T) {
+		planet := generatePlanet(3, 100)
+		before := planet.Snapshot()
+
+		planet.Resources[0].Amount = 10
+		planet.Version = 4
+
+		actual, err := NewPlanetEvents(before, planet, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 1)
+		expected := []PlanetEventResource{{Resource: metalResourceId, Delta: -90}}
+		assert.Equal(t, expected, actual[0].Resources)
+	})
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlanetDivergenceKind string
+
+const (
+	// VersionGapDivergence is reported when two consecutive events do not
+	// follow each other: the planet was modified without being logged.
+	VersionGapDivergence PlanetDivergenceKind = "version_gap"
+	// VersionDivergence is reported when the version reached by the events
+	// is not the one of the stored planet.
+	VersionDivergence PlanetDivergenceKind = "version"
+	// ResourceDivergence is reported when the amount of a resource rebuilt
+	// from the events is not the one of the stored planet.
+	ResourceDivergence PlanetDivergenceKind = "resource"
+	// BuildingDivergence is reported when the level of a building rebuilt
+	// from the events is not the one of the stored planet.
+	BuildingDivergence PlanetDivergenceKind = "building"
+	// ActionDivergence is reported when an action is pending on only one
+	// of the rebuilt and the stored planets, or when an event concerns an
+	// action which is not pending on the rebuilt planet.
+	ActionDivergence PlanetDivergenceKind = "action"
+)
+
+// PlanetReconstruction is the state of a planet rebuilt from its event log
+// at a given moment.
+type PlanetReconstruction struct {
+	Planet uuid.UUID
+	At     time.Time
+
+	// Version is the version of the planet after the last replayed event.
+	Version int
+	Events  int
+
+	Resources []PlanetResource
+
+	// Divergences are only computed when the moment is not older than the
+	// last update of the stored planet.
+	Divergences []PlanetDivergence
+}
+
+// PlanetDivergence describes a difference between the rebuilt and the
+// stored planet. Versions and levels are reported as amounts so that all
+// kinds share the same fields: an action counts as 1 when it is pending.
+// The resource, the building and the action are only set for the matching
+// kinds of divergences.
+type PlanetDivergence struct {
+	Kind     PlanetDivergenceKind
+	Resource uuid.UUID
+	Building uuid.UUID
+	Action   uuid.UUID
+	At       time.Time
+	Rebuilt  float64
+	Stored   float64
+}
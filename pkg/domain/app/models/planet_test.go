@@ -1,6 +1,7 @@
 package models
 
 import (
+	"math"
 	"slices"
 	"testing"
 	"time"
@@ -14,21 +15,32 @@ import (
 var (
 	crystalMineId = uuid.MustParse("3904d34d-9a7e-47d4-a332-091700e2c5c3")
 	metalMineId   = uuid.MustParse("d176e82d-f2ca-4611-996b-c4804096caef")
+
+	shipyardBuildingId   = uuid.MustParse("58d75842-6dc0-4ac0-b36d-55f91b8d060d")
+	energyTechnologyId   = uuid.MustParse("48315497-51de-4e2a-afe0-9bf3c9f00278")
+	computerTechnologyId = uuid.MustParse("78e99c8b-99f6-4e14-be92-cd275dc7c05b")
+	energyResourceId     = uuid.MustParse("3a9e7c1f-5b7d-4a36-8f4e-2c61d0b5e8a4")
+
+	testRoles = GameDataRoles{
+		ShipyardBuilding:   shipyardBuildingId,
+		EnergyTechnology:   energyTechnologyId,
+		ComputerTechnology: computerTechnologyId,
+	}
 )
 
 func TestUnit_Planet_AddBuildingAction(t *testing.T) {
-	t.Run("returns error when planet already has an action", func(t *testing.T) {
+	t.Run("returns error when building queue is full", func(t *testing.T) {
 		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
-		actionId := uuid.New()
-		p.BuildingAction = &BuildingAction{Id: actionId}
+		for range maxBuildingQueueLength {
+			p.BuildingQueue = append(p.BuildingQueue, BuildingAction{Id: uuid.New()})
+		}
 
 		b := generateTestBuilding(t)
 
 		err := p.AddBuildingAction(b)
 
-		assert.ErrorIs(t, err, domainerrors.ErrActionAlreadyInProgress, "Actual err: %v", err)
-		require.NotNil(t, p.BuildingAction)
-		assert.Equal(t, actionId, p.BuildingAction.Id)
+		assert.ErrorIs(t, err, domainerrors.ErrBuildingQueueFull, "Actual err: %v", err)
+		assert.Len(t, p.BuildingQueue, maxBuildingQueueLength)
 		assert.Equal(t, 3, p.Version)
 	})
 
@@ -51,7 +63,7 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 		err := p.AddBuildingAction(b)
 
 		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
-		assert.Nil(t, p.BuildingAction)
+		assert.Empty(t, p.BuildingQueue)
 		assert.Equal(t, 3, p.Version)
 	})
 
@@ -64,7 +76,7 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 		err := p.AddBuildingAction(b)
 
 		assert.ErrorIs(t, err, domainerrors.ErrAllFieldsUsed, "Actual err: %v", err)
-		assert.Nil(t, p.BuildingAction)
+		assert.Empty(t, p.BuildingQueue)
 		assert.Equal(t, 3, p.Version)
 	})
 
@@ -76,22 +88,76 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 		err := p.AddBuildingAction(b)
 
 		assert.ErrorIs(t, err, domainerrors.ErrBuildingNotFound, "Actual err: %v", err)
-		assert.Nil(t, p.BuildingAction)
+		assert.Empty(t, p.BuildingQueue)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("returns error when required building is not built", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{
+			{Building: shipyardBuildingId, Level: 1},
+		}
+
+		err := p.AddBuildingAction(b)
+
+		assert.ErrorIs(t, err, domainerrors.ErrRequirementsNotMet, "Actual err: %v", err)
+		assert.Empty(t, p.BuildingQueue)
 		assert.Equal(t, 3, p.Version)
 	})
 
+	t.Run("returns error when required building level is too low", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
+		p.Buildings = []PlanetBuilding{
+			{Building: buildingId, Level: 4},
+			{Building: shipyardBuildingId, Level: 1},
+		}
+
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{
+			{Building: shipyardBuildingId, Level: 2},
+		}
+
+		err := p.AddBuildingAction(b)
+
+		assert.ErrorIs(t, err, domainerrors.ErrRequirementsNotMet, "Actual err: %v", err)
+		assert.Empty(t, p.BuildingQueue)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("assigns building action when requirements are met", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
+		p.Buildings = []PlanetBuilding{
+			{Building: buildingId, Level: 4},
+			{Building: shipyardBuildingId, Level: 2},
+		}
+
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{
+			{Building: shipyardBuildingId, Level: 2},
+		}
+
+		err := p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 1)
+		assert.Equal(t, b.Id, p.BuildingQueue[0].Building)
+	})
+
 	t.Run("assigns building action to planet", func(t *testing.T) {
 		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
 		b := generateTestBuilding(t, withBuildingCost, withBuildingProduction, withBuildingStorage)
 
 		err := p.AddBuildingAction(b)
 		require.NoError(t, err, "Actual err: %v", err)
-		require.NotNil(t, p.BuildingAction)
+		require.Len(t, p.BuildingQueue, 1)
 
 		completionTime := 1199520 * time.Millisecond
-		expectedAction := &BuildingAction{
-			Id:           p.BuildingAction.Id,
+		expectedAction := BuildingAction{
+			Id:           p.BuildingQueue[0].Id,
 			Building:     b.Id,
+			Kind:         UpgradeAction,
 			DesiredLevel: p.Buildings[0].Level + 1,
 			CreatedAt:    someTime,
 			CompletedAt:  someTime.Add(completionTime),
@@ -126,7 +192,98 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 				},
 			},
 		}
-		assert.Equal(t, expectedAction, p.BuildingAction)
+		assert.Equal(t, []BuildingAction{expectedAction}, p.BuildingQueue)
+	})
+
+	t.Run("queues action after the last one", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		inProgress := BuildingAction{
+			Id:           uuid.New(),
+			Building:     uuid.New(),
+			DesiredLevel: 1,
+			CreatedAt:    someTime,
+			CompletedAt:  someTime.Add(2 * time.Hour),
+		}
+		p.BuildingQueue = []BuildingAction{inProgress}
+		b := generateTestBuilding(t, withBuildingCost)
+
+		err := p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 2)
+		assert.Equal(t, inProgress, p.BuildingQueue[0])
+		actual := p.BuildingQueue[1]
+		assert.Equal(t, b.Id, actual.Building)
+		assert.Equal(t, p.Buildings[0].Level+1, actual.DesiredLevel)
+		assert.Equal(t, someTime, actual.CreatedAt)
+		completionTime := 1199520 * time.Millisecond
+		assert.Equal(t, inProgress.CompletedAt.Add(completionTime), actual.CompletedAt)
+	})
+
+	t.Run("targets level following the ones already queued", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		b := generateTestBuilding(t)
+
+		err := p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+		err = p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 2)
+		assert.Equal(t, p.Buildings[0].Level+1, p.BuildingQueue[0].DesiredLevel)
+		assert.Equal(t, p.Buildings[0].Level+2, p.BuildingQueue[1].DesiredLevel)
+	})
+
+	t.Run("considers queued actions when verifying requirements", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
+		p.Buildings = []PlanetBuilding{
+			{Building: buildingId, Level: 4},
+			{Building: shipyardBuildingId, Level: 1},
+		}
+		p.BuildingQueue = []BuildingAction{
+			{Id: uuid.New(), Building: shipyardBuildingId, DesiredLevel: 2},
+		}
+
+		b := generateTestBuilding(t)
+		b.Requirements = []BuildingRequirement{
+			{Building: shipyardBuildingId, Level: 2},
+		}
+
+		err := p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.BuildingQueue, 2)
+	})
+
+	t.Run("considers queued actions when verifying fields", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.Fields = p.Buildings[0].Level + 1
+		p.BuildingQueue = []BuildingAction{
+			{Id: uuid.New(), Building: buildingId, DesiredLevel: p.Buildings[0].Level + 1},
+		}
+
+		b := generateTestBuilding(t)
+
+		err := p.AddBuildingAction(b)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAllFieldsUsed, "Actual err: %v", err)
+		assert.Len(t, p.BuildingQueue, 1)
+	})
+
+	t.Run("does not count queued demolitions when verifying fields", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.Fields = p.Buildings[0].Level + 1
+		p.BuildingQueue = []BuildingAction{
+			{Id: uuid.New(), Building: buildingId, Kind: DemolishAction, DesiredLevel: p.Buildings[0].Level - 1},
+		}
+
+		b := generateTestBuilding(t)
+
+		err := p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 2)
+		assert.Equal(t, p.Buildings[0].Level, p.BuildingQueue[1].DesiredLevel)
 	})
 
 	t.Run("deducts action costs from the available planet resources", func(t *testing.T) {
@@ -137,10 +294,10 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 
 		err := p.AddBuildingAction(b)
 		require.NoError(t, err, "Actual err: %v", err)
-		require.NotNil(t, p.BuildingAction)
+		require.Len(t, p.BuildingQueue, 1)
 
-		expectedMetalAmount := initialResources[0].Amount - float64(p.BuildingAction.Costs[0].Amount)
-		expectedCrystalAmount := initialResources[1].Amount - float64(p.BuildingAction.Costs[1].Amount)
+		expectedMetalAmount := initialResources[0].Amount - float64(p.BuildingQueue[0].Costs[0].Amount)
+		expectedCrystalAmount := initialResources[1].Amount - float64(p.BuildingQueue[0].Costs[1].Amount)
 		expectedResources := []PlanetResource{
 			{
 				Resource: metalResourceId,
@@ -163,7 +320,7 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 
 		err := p.AddBuildingAction(b)
 		require.NoError(t, err, "Actual err: %v", err)
-		require.NotNil(t, p.BuildingAction)
+		require.Len(t, p.BuildingQueue, 1)
 
 		assert.Equal(t, initialVersion+1, p.Version)
 	})
@@ -175,523 +332,2769 @@ func TestUnit_Planet_AddBuildingAction(t *testing.T) {
 
 		err := p.AddBuildingAction(b)
 		require.NoError(t, err, "Actual err: %v", err)
-		require.NotNil(t, p.BuildingAction)
+		require.Len(t, p.BuildingQueue, 1)
 
 		assert.Equal(t, someTime, p.UpdatedAt)
 	})
 }
 
-func TestUnit_Planet_CancelBuildingAction(t *testing.T) {
-	t.Run("returns error when planet does not have an action", func(t *testing.T) {
+func TestUnit_Planet_DemolishBuilding(t *testing.T) {
+	t.Run("returns error when building queue is full", func(t *testing.T) {
 		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		for range maxBuildingQueueLength {
+			p.BuildingQueue = append(p.BuildingQueue, BuildingAction{Id: uuid.New()})
+		}
+
+		b := generateTestBuilding(t)
 
-		err := p.CancelBuildingAction()
+		err := p.DemolishBuilding(b)
 
-		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+		assert.ErrorIs(t, err, domainerrors.ErrBuildingQueueFull, "Actual err: %v", err)
+		assert.Len(t, p.BuildingQueue, maxBuildingQueueLength)
+		assert.Equal(t, 3, p.Version)
 	})
 
-	t.Run("resets building action in planet", func(t *testing.T) {
+	t.Run("returns error when building does not exist on planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		b := Building{Id: uuid.New()}
+
+		err := p.DemolishBuilding(b)
+
+		assert.ErrorIs(t, err, domainerrors.ErrBuildingNotFound, "Actual err: %v", err)
+		assert.Empty(t, p.BuildingQueue)
+	})
+
+	t.Run("returns error when building is not built", func(t *testing.T) {
 		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
-		p.BuildingAction = &BuildingAction{Id: uuid.New()}
+		p.Buildings[0].Level = 0
+		b := generateTestBuilding(t)
 
-		err := p.CancelBuildingAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.DemolishBuilding(b)
 
-		assert.Nil(t, p.BuildingAction)
+		assert.ErrorIs(t, err, domainerrors.ErrNothingToDemolish, "Actual err: %v", err)
+		assert.Empty(t, p.BuildingQueue)
+		assert.Equal(t, 3, p.Version)
 	})
 
-	t.Run("adds back action costs to the available planet resources", func(t *testing.T) {
+	t.Run("returns error when planet does not have enough resources", func(t *testing.T) {
 		p := generateTestPlanet(t, withPlanetBuilding)
 		p.Resources = []PlanetResource{
 			{
 				Resource: metalResourceId,
-				Amount:   1000,
+				Amount:   189,
 			},
 			{
 				Resource: crystalResourceId,
-				Amount:   2000,
+				// Needed value: 191
+				Amount: 190,
 			},
 		}
 
-		p.BuildingAction = &BuildingAction{
-			Id: uuid.New(),
-			Costs: []BuildingActionCost{
-				{
-					Resource: metalResourceId,
-					Amount:   36,
-				},
-				{
-					Resource: crystalResourceId,
-					Amount:   178,
-				},
-			},
-		}
+		b := generateTestBuilding(t, withBuildingCost)
+
+		err := p.DemolishBuilding(b)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+		assert.Empty(t, p.BuildingQueue)
+	})
+
+	t.Run("assigns demolition action to planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		b := generateTestBuilding(t, withBuildingCost)
 
-		err := p.CancelBuildingAction()
+		err := p.DemolishBuilding(b)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		expected := []PlanetResource{
+		require.Len(t, p.BuildingQueue, 1)
+		actual := p.BuildingQueue[0]
+		assert.Equal(t, b.Id, actual.Building)
+		assert.Equal(t, DemolishAction, actual.Kind)
+		assert.Equal(t, p.Buildings[0].Level-1, actual.DesiredLevel)
+		assert.Equal(t, someTime, actual.CreatedAt)
+		expectedCosts := []BuildingActionCost{
 			{
 				Resource: metalResourceId,
-				Amount:   1036,
+				Amount:   60,
 			},
 			{
 				Resource: crystalResourceId,
-				Amount:   2178,
+				Amount:   191,
 			},
 		}
-		assert.Equal(t, expected, p.Resources)
+		assert.Equal(t, expectedCosts, actual.Costs)
 	})
 
-	t.Run("adds back action costs when resources are not present on the planet", func(t *testing.T) {
-		p := generateTestPlanet(t, withPlanetBuilding)
-		p.Resources = []PlanetResource{}
-		p.BuildingAction = &BuildingAction{
-			Id: uuid.New(),
-			Costs: []BuildingActionCost{
-				{
-					Resource: metalResourceId,
-					Amount:   36,
-				},
-				{
-					Resource: crystalResourceId,
-					Amount:   178,
-				},
-			},
-		}
+	t.Run("targets level reached by the actions already queued", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		b := generateTestBuilding(t)
 
-		err := p.CancelBuildingAction()
+		err := p.AddBuildingAction(b)
+		require.NoError(t, err, "Actual err: %v", err)
+		err = p.DemolishBuilding(b)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		expected := []PlanetResource{
+		require.Len(t, p.BuildingQueue, 2)
+		assert.Equal(t, p.Buildings[0].Level, p.BuildingQueue[1].DesiredLevel)
+	})
+
+	t.Run("deducts action costs from the available planet resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		b := generateTestBuilding(t, withBuildingCost)
+
+		initialResources := slices.Clone(p.Resources)
+
+		err := p.DemolishBuilding(b)
+		require.NoError(t, err, "Actual err: %v", err)
+		require.Len(t, p.BuildingQueue, 1)
+
+		expectedMetalAmount := initialResources[0].Amount - float64(p.BuildingQueue[0].Costs[0].Amount)
+		expectedCrystalAmount := initialResources[1].Amount - float64(p.BuildingQueue[0].Costs[1].Amount)
+		expectedResources := []PlanetResource{
 			{
 				Resource: metalResourceId,
-				Amount:   36,
+				Amount:   expectedMetalAmount,
 			},
 			{
 				Resource: crystalResourceId,
-				Amount:   178,
+				Amount:   expectedCrystalAmount,
 			},
 		}
-		assert.Equal(t, expected, p.Resources)
+		assert.Equal(t, expectedResources, p.Resources)
 	})
 
 	t.Run("bumps version by one", func(t *testing.T) {
 		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
-		p.BuildingAction = &BuildingAction{Id: uuid.New()}
+		b := generateTestBuilding(t, withBuildingCost)
 
 		initialVersion := p.Version
 
-		err := p.CancelBuildingAction()
+		err := p.DemolishBuilding(b)
 		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, initialVersion+1, p.Version)
 	})
+}
 
-	t.Run("does not bump updated at field", func(t *testing.T) {
-		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
-		p.BuildingAction = &BuildingAction{Id: uuid.New()}
+func TestUnit_Planet_AddResearchAction(t *testing.T) {
+	t.Run("returns error when planet already has a research action", func(t *testing.T) {
+		p := generateTestPlanet(t, withResearchResources)
+		actionId := uuid.New()
+		p.ResearchAction = &ResearchAction{Id: actionId}
 
-		err := p.CancelBuildingAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.AddResearchAction(generateTestTechnology(t))
 
-		assert.Equal(t, someTime, p.UpdatedAt)
+		assert.ErrorIs(t, err, domainerrors.ErrActionAlreadyInProgress, "Actual err: %v", err)
+		require.NotNil(t, p.ResearchAction)
+		assert.Equal(t, actionId, p.ResearchAction.Id)
+		assert.Equal(t, 3, p.Version)
 	})
-}
-
-func TestUnit_Planet_UpdateToTime(t *testing.T) {
-	someTime := time.Date(2026, time.June, 25, 20, 19, 37, 0, time.UTC)
-	someTimeLater := someTime.Add(1*time.Hour + 2*time.Minute + 3*time.Second)
 
-	t.Run("does not update resource when no production is defined", func(t *testing.T) {
-		p := Planet{
-			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			UpdatedAt: someTime,
-		}
+	t.Run("returns error when planet does not have enough resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
 
-		err := p.UpdateToTime(someTimeLater)
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.AddResearchAction(generateTestTechnology(t))
 
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 36.0, p.Resources[0].Amount)
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+		assert.Nil(t, p.ResearchAction)
+		assert.Equal(t, 3, p.Version)
 	})
 
-	t.Run("does not change resource value when already over the storage capacity", func(t *testing.T) {
-		p := Planet{
-			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 30}},
-			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
-			UpdatedAt:   someTime,
-		}
+	t.Run("researches first level of unknown technology", func(t *testing.T) {
+		p := generateTestPlanet(t, withResearchResources)
+		p.Player = uuid.New()
+		tech := generateTestTechnology(t)
 
-		err := p.UpdateToTime(someTimeLater)
+		err := p.AddResearchAction(tech)
 		require.NoError(t, err, "Actual err: %v", err)
-
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 36.0, p.Resources[0].Amount)
+		require.NotNil(t, p.ResearchAction)
+
+		expected := &ResearchAction{
+			Id:           p.ResearchAction.Id,
+			Player:       p.Player,
+			Planet:       p.Id,
+			Technology:   tech.Id,
+			DesiredLevel: 1,
+			CreatedAt:    someTime,
+			CompletedAt:  someTime.Add(1152 * time.Second),
+			Costs: []ResearchActionCost{
+				{
+					Resource: crystalResourceId,
+					Amount:   800,
+				},
+				{
+					Resource: deuteriumResourceId,
+					Amount:   400,
+				},
+			},
+		}
+		assert.Equal(t, expected, p.ResearchAction)
 	})
 
-	t.Run("does not change resource when update time is before already computed time", func(t *testing.T) {
-		p := Planet{
-			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
-			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
-			UpdatedAt:   someTimeLater,
+	t.Run("researches next level of known technology", func(t *testing.T) {
+		p := generateTestPlanet(t, withResearchResources)
+		p.Technologies = []PlayerTechnology{
+			{Technology: technologyId, Level: 2},
 		}
 
-		err := p.UpdateToTime(someTime)
+		err := p.AddResearchAction(generateTestTechnology(t))
 		require.NoError(t, err, "Actual err: %v", err)
+		require.NotNil(t, p.ResearchAction)
 
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 36.0, p.Resources[0].Amount)
+		assert.Equal(t, 3, p.ResearchAction.DesiredLevel)
 	})
 
-	t.Run("caps resource at storage capacity", func(t *testing.T) {
-		p := Planet{
-			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 45}},
-			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
-			UpdatedAt:   someTime,
-		}
+	t.Run("deducts action costs from the available planet resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withResearchResources)
 
-		err := p.UpdateToTime(someTimeLater)
+		err := p.AddResearchAction(generateTestTechnology(t))
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 45.0, p.Resources[0].Amount)
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   999999,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   999199,
+			},
+			{
+				Resource: deuteriumResourceId,
+				Amount:   999599,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
 	})
 
-	t.Run("adds full production value when storage is sufficient", func(t *testing.T) {
-		p := Planet{
-			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
-			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
-			UpdatedAt:   someTime,
-		}
+	t.Run("bumps version by one", func(t *testing.T) {
+		p := generateTestPlanet(t, withResearchResources)
 
-		err := p.UpdateToTime(someTimeLater)
+		err := p.AddResearchAction(generateTestTechnology(t))
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 67.025, p.Resources[0].Amount)
+		assert.Equal(t, 4, p.Version)
 	})
+}
 
-	t.Run("adds all production for a resource", func(t *testing.T) {
-		p := Planet{
-			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			Storages:  []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
-			Productions: []PlanetResourceProduction{
-				{Resource: crystalResourceId, Production: 30},
-				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+func TestUnit_Planet_ApplyResearchAction(t *testing.T) {
+	t.Run("returns error when no research action is in progress", func(t *testing.T) {
+		p := generateTestPlanet(t)
+
+		err := p.ApplyResearchAction()
+
+		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet update time is not matching action completion time", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.ResearchAction = &ResearchAction{
+			Technology:   technologyId,
+			DesiredLevel: 1,
+			CompletedAt:  someTime.Add(time.Minute),
+		}
+
+		err := p.ApplyResearchAction()
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+		assert.Empty(t, p.Technologies)
+	})
+
+	t.Run("registers technology researched for the first time", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.ResearchAction = &ResearchAction{
+			Technology:   technologyId,
+			DesiredLevel: 1,
+			CompletedAt:  someTime,
+		}
+
+		err := p.ApplyResearchAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlayerTechnology{
+			{Technology: technologyId, Level: 1, ReachedAt: someTime},
+		}
+		assert.Equal(t, expected, p.Technologies)
+		assert.Nil(t, p.ResearchAction)
+		assert.Equal(t, 4, p.Version)
+	})
+
+	t.Run("updates level of known technology", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Technologies = []PlayerTechnology{
+			{Technology: computerTechnologyId, Level: 1},
+			{Technology: technologyId, Level: 2},
+		}
+		p.ResearchAction = &ResearchAction{
+			Technology:   technologyId,
+			DesiredLevel: 3,
+			CompletedAt:  someTime,
+		}
+
+		err := p.ApplyResearchAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlayerTechnology{
+			{Technology: computerTechnologyId, Level: 1},
+			{Technology: technologyId, Level: 3, ReachedAt: someTime},
+		}
+		assert.Equal(t, expected, p.Technologies)
+	})
+}
+
+func TestUnit_Planet_ApplyTechnologyUpgrade(t *testing.T) {
+	t.Run("returns error when no technology upgrade is pending", func(t *testing.T) {
+		p := generateTestPlanet(t)
+
+		err := p.ApplyTechnologyUpgrade()
+
+		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet update time is not matching the time the level was reached", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.TechnologyUpgrades = []PlayerTechnology{
+			{Technology: technologyId, Level: 1, ReachedAt: someTime.Add(time.Minute)},
+		}
+
+		err := p.ApplyTechnologyUpgrade()
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+		assert.Empty(t, p.Technologies)
+		assert.Len(t, p.TechnologyUpgrades, 1)
+	})
+
+	t.Run("raises the level of the technology", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Technologies = []PlayerTechnology{
+			{Technology: technologyId, Level: 1},
+		}
+		later := PlayerTechnology{Technology: technologyId, Level: 3, ReachedAt: someTime.Add(time.Hour)}
+		p.TechnologyUpgrades = []PlayerTechnology{
+			{Technology: technologyId, Level: 2, ReachedAt: someTime},
+			later,
+		}
+
+		err := p.ApplyTechnologyUpgrade()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlayerTechnology{
+			{Technology: technologyId, Level: 2, ReachedAt: someTime},
+		}
+		assert.Equal(t, expected, p.Technologies)
+		assert.Equal(t, []PlayerTechnology{later}, p.TechnologyUpgrades)
+		assert.Equal(t, 4, p.Version)
+	})
+
+	t.Run("ignores a level which is already reached", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Technologies = []PlayerTechnology{
+			{Technology: technologyId, Level: 2, ReachedAt: someTime},
+		}
+		p.TechnologyUpgrades = []PlayerTechnology{
+			{Technology: technologyId, Level: 2, ReachedAt: someTime},
+		}
+
+		err := p.ApplyTechnologyUpgrade()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlayerTechnology{
+			{Technology: technologyId, Level: 2, ReachedAt: someTime},
+		}
+		assert.Equal(t, expected, p.Technologies)
+		assert.Empty(t, p.TechnologyUpgrades)
+	})
+}
+
+func TestUnit_Planet_CancelBuildingAction(t *testing.T) {
+	t.Run("returns error when planet does not have the action", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.BuildingQueue = []BuildingAction{{Id: uuid.New()}}
+
+		err := p.CancelBuildingAction(uuid.New(), nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+		assert.Len(t, p.BuildingQueue, 1)
+	})
+
+	t.Run("resets building action in planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.BuildingQueue = []BuildingAction{{Id: uuid.New()}}
+
+		err := p.CancelBuildingAction(p.BuildingQueue[0].Id, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.BuildingQueue)
+	})
+
+	t.Run("starts next action when cancelling the one in progress", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		first := BuildingAction{
+			Id:          uuid.New(),
+			Building:    uuid.New(),
+			CreatedAt:   someTime.Add(-1 * time.Hour),
+			CompletedAt: someTime.Add(1 * time.Hour),
+		}
+		second := BuildingAction{
+			Id:          uuid.New(),
+			Building:    uuid.New(),
+			CreatedAt:   someTime.Add(-1 * time.Hour),
+			CompletedAt: someTime.Add(3 * time.Hour),
+		}
+		p.BuildingQueue = []BuildingAction{first, second}
+
+		err := p.CancelBuildingAction(first.Id, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 1)
+		assert.Equal(t, second.Id, p.BuildingQueue[0].Id)
+		assert.Equal(t, someTime.Add(2*time.Hour), p.BuildingQueue[0].CompletedAt)
+	})
+
+	t.Run("removes following actions for the same building", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.Resources = []PlanetResource{}
+		other := uuid.New()
+		p.BuildingQueue = []BuildingAction{
+			{
+				Id:           uuid.New(),
+				Building:     other,
+				DesiredLevel: 1,
+				CompletedAt:  someTime.Add(1 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     buildingId,
+				DesiredLevel: 5,
+				CompletedAt:  someTime.Add(2 * time.Hour),
+				Costs:        []BuildingActionCost{{Resource: metalResourceId, Amount: 10}},
+			},
+			{
+				Id:           uuid.New(),
+				Building:     other,
+				DesiredLevel: 2,
+				CompletedAt:  someTime.Add(4 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     buildingId,
+				DesiredLevel: 6,
+				CompletedAt:  someTime.Add(7 * time.Hour),
+				Costs:        []BuildingActionCost{{Resource: metalResourceId, Amount: 20}},
 			},
-			UpdatedAt: someTime,
 		}
+		expectedIds := []uuid.UUID{p.BuildingQueue[0].Id, p.BuildingQueue[2].Id}
 
-		err := p.UpdateToTime(someTimeLater)
+		err := p.CancelBuildingAction(p.BuildingQueue[1].Id, nil)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 113.5625, p.Resources[0].Amount)
+		require.Len(t, p.BuildingQueue, 2)
+		assert.Equal(t, expectedIds[0], p.BuildingQueue[0].Id)
+		assert.Equal(t, someTime.Add(1*time.Hour), p.BuildingQueue[0].CompletedAt)
+		assert.Equal(t, expectedIds[1], p.BuildingQueue[1].Id)
+		assert.Equal(t, someTime.Add(3*time.Hour), p.BuildingQueue[1].CompletedAt)
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   30,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
 	})
 
-	t.Run("keeps resource at 0 when no storage is defined for it", func(t *testing.T) {
-		p := Planet{
-			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
-			Storages:  nil,
-			Productions: []PlanetResourceProduction{
-				{Resource: crystalResourceId, Production: 30},
-				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+	t.Run("removes following actions whose requirements are not met anymore", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.Resources = []PlanetResource{}
+		p.BuildingQueue = []BuildingAction{
+			{
+				Id:           uuid.New(),
+				Building:     crystalMineId,
+				DesiredLevel: 1,
+				CompletedAt:  someTime.Add(1 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     buildingId,
+				DesiredLevel: 5,
+				CompletedAt:  someTime.Add(2 * time.Hour),
+				Costs:        []BuildingActionCost{{Resource: metalResourceId, Amount: 10}},
+			},
+			{
+				Id:           uuid.New(),
+				Building:     shipyardBuildingId,
+				DesiredLevel: 1,
+				CompletedAt:  someTime.Add(4 * time.Hour),
+				Costs:        []BuildingActionCost{{Resource: metalResourceId, Amount: 20}},
+			},
+			{
+				Id:           uuid.New(),
+				Building:     shipyardBuildingId,
+				DesiredLevel: 2,
+				CompletedAt:  someTime.Add(7 * time.Hour),
+				Costs:        []BuildingActionCost{{Resource: metalResourceId, Amount: 40}},
+			},
+		}
+		buildings := []Building{
+			{
+				Id:           shipyardBuildingId,
+				Requirements: []BuildingRequirement{{Building: buildingId, Level: 5}},
 			},
-			UpdatedAt: someTime,
 		}
+		expectedId := p.BuildingQueue[0].Id
 
-		err := p.UpdateToTime(someTimeLater)
+		err := p.CancelBuildingAction(p.BuildingQueue[1].Id, buildings)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Len(t, p.Resources, 1)
-		assert.Equal(t, 36.0, p.Resources[0].Amount)
+		require.Len(t, p.BuildingQueue, 1)
+		assert.Equal(t, expectedId, p.BuildingQueue[0].Id)
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   70,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("keeps following actions whose requirements are still met", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.BuildingQueue = []BuildingAction{
+			{
+				Id:           uuid.New(),
+				Building:     buildingId,
+				DesiredLevel: 5,
+				CompletedAt:  someTime.Add(1 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     shipyardBuildingId,
+				DesiredLevel: 1,
+				CompletedAt:  someTime.Add(3 * time.Hour),
+			},
+		}
+		buildings := []Building{
+			{
+				Id:           shipyardBuildingId,
+				Requirements: []BuildingRequirement{{Building: buildingId, Level: 4}},
+			},
+		}
+		expectedId := p.BuildingQueue[1].Id
+
+		err := p.CancelBuildingAction(p.BuildingQueue[0].Id, buildings)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 1)
+		assert.Equal(t, expectedId, p.BuildingQueue[0].Id)
+	})
+
+	t.Run("adds back action costs to the available planet resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.Resources = []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   1000,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   2000,
+			},
+		}
+
+		p.BuildingQueue = []BuildingAction{{
+			Id: uuid.New(),
+			Costs: []BuildingActionCost{
+				{
+					Resource: metalResourceId,
+					Amount:   36,
+				},
+				{
+					Resource: crystalResourceId,
+					Amount:   178,
+				},
+			},
+		}}
+
+		err := p.CancelBuildingAction(p.BuildingQueue[0].Id, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   1036,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   2178,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("adds back action costs when resources are not present on the planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.Resources = []PlanetResource{}
+		p.BuildingQueue = []BuildingAction{{
+			Id: uuid.New(),
+			Costs: []BuildingActionCost{
+				{
+					Resource: metalResourceId,
+					Amount:   36,
+				},
+				{
+					Resource: crystalResourceId,
+					Amount:   178,
+				},
+			},
+		}}
+
+		err := p.CancelBuildingAction(p.BuildingQueue[0].Id, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   36,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   178,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("bumps version by one", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.BuildingQueue = []BuildingAction{{Id: uuid.New()}}
+
+		initialVersion := p.Version
+
+		err := p.CancelBuildingAction(p.BuildingQueue[0].Id, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, initialVersion+1, p.Version)
+	})
+
+	t.Run("does not bump updated at field", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding, withManyResources)
+		p.BuildingQueue = []BuildingAction{{Id: uuid.New()}}
+
+		err := p.CancelBuildingAction(p.BuildingQueue[0].Id, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, someTime, p.UpdatedAt)
+	})
+}
+
+func TestUnit_Planet_ReorderBuildingQueue(t *testing.T) {
+	generateQueue := func() []BuildingAction {
+		return []BuildingAction{
+			{
+				Id:           uuid.New(),
+				Building:     buildingId,
+				DesiredLevel: 5,
+				CompletedAt:  someTime.Add(1 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     shipyardBuildingId,
+				DesiredLevel: 1,
+				CompletedAt:  someTime.Add(3 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     buildingId,
+				DesiredLevel: 6,
+				CompletedAt:  someTime.Add(4 * time.Hour),
+			},
+			{
+				Id:           uuid.New(),
+				Building:     crystalMineId,
+				DesiredLevel: 1,
+				CompletedAt:  someTime.Add(8 * time.Hour),
+			},
+		}
+	}
+
+	t.Run("reorders queue and reschedules actions", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[3].Id, q[1].Id, q[2].Id}
+
+		err := p.ReorderBuildingQueue(order, nil)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.BuildingQueue, 4)
+		for id, expected := range order {
+			assert.Equal(t, expected, p.BuildingQueue[id].Id)
+		}
+		assert.Equal(t, someTime.Add(1*time.Hour), p.BuildingQueue[0].CompletedAt)
+		assert.Equal(t, someTime.Add(5*time.Hour), p.BuildingQueue[1].CompletedAt)
+		assert.Equal(t, someTime.Add(7*time.Hour), p.BuildingQueue[2].CompletedAt)
+		assert.Equal(t, someTime.Add(8*time.Hour), p.BuildingQueue[3].CompletedAt)
+		assert.Equal(t, 4, p.Version)
+	})
+
+	t.Run("returns error when action in progress is moved", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[1].Id, q[0].Id, q[2].Id, q[3].Id}
+
+		err := p.ReorderBuildingQueue(order, nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+		assert.Equal(t, q, p.BuildingQueue)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("returns error when levels of a building are swapped", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		p.BuildingQueue[3].Building = shipyardBuildingId
+		p.BuildingQueue[3].DesiredLevel = 2
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[3].Id, q[2].Id, q[1].Id}
+
+		err := p.ReorderBuildingQueue(order, nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when demolition is moved before upgrade of same building", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		p.BuildingQueue[3].Building = shipyardBuildingId
+		p.BuildingQueue[3].Kind = DemolishAction
+		p.BuildingQueue[3].DesiredLevel = 0
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[3].Id, q[1].Id, q[2].Id}
+
+		err := p.ReorderBuildingQueue(order, nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when an action is moved before its requirements", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[3].Id, q[1].Id, q[2].Id}
+		buildings := []Building{
+			{
+				Id:           crystalMineId,
+				Requirements: []BuildingRequirement{{Building: buildingId, Level: 6}},
+			},
+		}
+
+		err := p.ReorderBuildingQueue(order, buildings)
+
+		assert.ErrorIs(t, err, domainerrors.ErrRequirementsNotMet, "Actual err: %v", err)
+		assert.Equal(t, q, p.BuildingQueue)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("allows moving an action as long as its requirements are met", func(t *testing.T) {
+		p := generateTestPlanet(t, withPlanetBuilding)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[3].Id, q[1].Id, q[2].Id}
+		buildings := []Building{
+			{
+				Id:           crystalMineId,
+				Requirements: []BuildingRequirement{{Building: buildingId, Level: 5}},
+			},
+		}
+
+		err := p.ReorderBuildingQueue(order, buildings)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, q[3].Id, p.BuildingQueue[1].Id)
+	})
+
+	t.Run("returns error when an action is missing", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[1].Id, q[2].Id}
+
+		err := p.ReorderBuildingQueue(order, nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when an action is duplicated", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[1].Id, q[1].Id, q[3].Id}
+
+		err := p.ReorderBuildingQueue(order, nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when an action is unknown", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.BuildingQueue = generateQueue()
+		q := p.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[1].Id, q[2].Id, uuid.New()}
+
+		err := p.ReorderBuildingQueue(order, nil)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_Planet_UpdateToTime(t *testing.T) {
+	someTime := time.Date(2026, time.June, 25, 20, 19, 37, 0, time.UTC)
+	someTimeLater := someTime.Add(1*time.Hour + 2*time.Minute + 3*time.Second)
+
+	t.Run("does not update resource when no production is defined", func(t *testing.T) {
+		p := Planet{
+			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			UpdatedAt: someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 36.0, p.Resources[0].Amount)
+	})
+
+	t.Run("does not change resource value when already over the storage capacity", func(t *testing.T) {
+		p := Planet{
+			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 30}},
+			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
+			UpdatedAt:   someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 36.0, p.Resources[0].Amount)
+	})
+
+	t.Run("does not change resource when update time is before already computed time", func(t *testing.T) {
+		p := Planet{
+			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
+			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
+			UpdatedAt:   someTimeLater,
+		}
+
+		err := p.UpdateToTime(someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 36.0, p.Resources[0].Amount)
+	})
+
+	t.Run("caps resource at storage capacity", func(t *testing.T) {
+		p := Planet{
+			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 45}},
+			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
+			UpdatedAt:   someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 45.0, p.Resources[0].Amount)
+	})
+
+	t.Run("adds full production value when storage is sufficient", func(t *testing.T) {
+		p := Planet{
+			Resources:   []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:    []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
+			Productions: []PlanetResourceProduction{{Resource: crystalResourceId, Production: 30}},
+			UpdatedAt:   someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 67.025, p.Resources[0].Amount)
+	})
+
+	t.Run("adds all production for a resource", func(t *testing.T) {
+		p := Planet{
+			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:  []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+			},
+			UpdatedAt: someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 113.5625, p.Resources[0].Amount)
+	})
+
+	t.Run("scales building production when energy is insufficient", func(t *testing.T) {
+		solarPlantId := uuid.New()
+		p := Planet{
+			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:  []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: -20, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: 10, Building: &solarPlantId},
+			},
+			UpdatedAt: someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 90.29375, p.Resources[0].Amount)
+	})
+
+	t.Run("does not scale building production when energy is sufficient", func(t *testing.T) {
+		solarPlantId := uuid.New()
+		p := Planet{
+			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:  []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: -20, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: 30, Building: &solarPlantId},
+			},
+			UpdatedAt: someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 113.5625, p.Resources[0].Amount)
+	})
+
+	t.Run("boosts building production with energy technology", func(t *testing.T) {
+		p := Planet{
+			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:  []PlanetResourceStorage{{Resource: crystalResourceId, Storage: 300}},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+			},
+			Technologies: []PlayerTechnology{
+				{Technology: energyTechnologyId, Level: 10},
+			},
+			Roles:     testRoles,
+			UpdatedAt: someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.InDelta(t, 118.21625, p.Resources[0].Amount, 1e-9)
+	})
+
+	t.Run("keeps resource at 0 when no storage is defined for it", func(t *testing.T) {
+		p := Planet{
+			Resources: []PlanetResource{{Resource: crystalResourceId, Amount: 36}},
+			Storages:  nil,
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+				{Resource: crystalResourceId, Production: 45, Building: &crystalMineId},
+			},
+			UpdatedAt: someTime,
+		}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Resources, 1)
+		assert.Equal(t, 36.0, p.Resources[0].Amount)
+	})
+
+	t.Run("bumps updated at field", func(t *testing.T) {
+		p := Planet{UpdatedAt: someTime}
+
+		err := p.UpdateToTime(someTimeLater)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, someTimeLater, p.UpdatedAt)
+	})
+
+	t.Run("does not bump updated at field when update time is in the past", func(t *testing.T) {
+		p := Planet{UpdatedAt: someTimeLater}
+
+		err := p.UpdateToTime(someTime)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, someTimeLater, p.UpdatedAt)
+	})
+
+	t.Run("returns error when building action finishes before update time", func(t *testing.T) {
+		t1 := time.Date(2026, time.June, 26, 8, 30, 50, 0, time.UTC)
+		t2 := time.Date(2026, time.June, 26, 8, 31, 50, 0, time.UTC)
+		t3 := time.Date(2026, time.June, 26, 8, 32, 50, 0, time.UTC)
+
+		p := Planet{
+			BuildingQueue: []BuildingAction{{
+				CompletedAt: t2,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.UpdateToTime(t3)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+	})
+
+	t.Run("returns error when shipyard unit finishes before update time", func(t *testing.T) {
+		t1 := time.Date(2026, time.June, 26, 8, 30, 50, 0, time.UTC)
+		t2 := time.Date(2026, time.June, 26, 8, 34, 50, 0, time.UTC)
+		t3 := time.Date(2026, time.June, 26, 8, 32, 50, 0, time.UTC)
+
+		p := Planet{
+			ShipyardQueue: []ShipyardAction{
+				{
+					Count:         2,
+					UnitBuildTime: 3 * time.Minute,
+					CompletedAt:   t2,
+				},
+			},
+			UpdatedAt: t1,
+		}
+
+		err := p.UpdateToTime(t3)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+	})
+
+	t.Run("returns error when research action finishes before update time", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.ResearchAction = &ResearchAction{
+			CompletedAt: someTime.Add(time.Minute),
+		}
+
+		err := p.UpdateToTime(someTime.Add(2 * time.Minute))
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+	})
+
+	t.Run("returns error when a technology level is reached before update time", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.TechnologyUpgrades = []PlayerTechnology{
+			{Technology: technologyId, Level: 1, ReachedAt: someTime.Add(time.Minute)},
+		}
+
+		err := p.UpdateToTime(someTime.Add(2 * time.Minute))
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+	})
+
+	t.Run("returns error when fleet arrives before update time", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Target = p.Id
+		p.Fleets = []Fleet{f}
+
+		err := p.UpdateToTime(f.ArrivesAt.Add(time.Second))
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+	})
+
+	t.Run("ignores arrival of fleet sent from the planet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		p.Fleets = []Fleet{f}
+
+		err := p.UpdateToTime(f.ArrivesAt.Add(time.Second))
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("returns error when market offer expires before update time", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		p.MarketOffers = []MarketOffer{o}
+
+		err := p.UpdateToTime(o.ExpiresAt.Add(time.Second))
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+	})
+
+	t.Run("ignores market offer accepted from the planet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		o := generateTestMarketOffer(t)
+		o.Status = AcceptedOffer
+		o.AcceptedAt = &someTime
+		p.MarketOffers = []MarketOffer{o}
+
+		err := p.UpdateToTime(someTime.Add(time.Second))
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestUnit_Planet_Energy(t *testing.T) {
+	t.Run("returns no energy when none is produced nor consumed", func(t *testing.T) {
+		p := Planet{
+			Storages: []PlanetResourceStorage{
+				{Resource: crystalResourceId, Storage: 100},
+			},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+			},
+		}
+
+		actual := p.Energy()
+
+		assert.Equal(t, PlanetEnergy{}, actual)
+	})
+
+	t.Run("sums energy produced and consumed by buildings", func(t *testing.T) {
+		p := Planet{
+			Storages: []PlanetResourceStorage{
+				{Resource: crystalResourceId, Storage: 100},
+			},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Production: 30},
+				{Resource: energyResourceId, Production: -20, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: -14, Building: &metalMineId},
+				{Resource: energyResourceId, Production: 22, Building: &buildingId},
+			},
+		}
+
+		actual := p.Energy()
+
+		expected := PlanetEnergy{
+			Produced: 22,
+			Consumed: 34,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("boosts energy produced by buildings with energy technology", func(t *testing.T) {
+		p := Planet{
+			Productions: []PlanetResourceProduction{
+				{Resource: energyResourceId, Production: -20, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: 22, Building: &buildingId},
+			},
+			Technologies: []PlayerTechnology{
+				{Technology: energyTechnologyId, Level: 10},
+			},
+			Roles: testRoles,
+		}
+
+		actual := p.Energy()
+
+		expected := PlanetEnergy{
+			Produced: 24,
+			Consumed: 20,
+		}
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestUnit_Planet_ProductionFactor(t *testing.T) {
+	t.Run("returns 1 when no energy is consumed", func(t *testing.T) {
+		p := Planet{}
+
+		assert.Equal(t, 1.0, p.ProductionFactor())
+	})
+
+	t.Run("returns 1 when more energy is produced than consumed", func(t *testing.T) {
+		p := Planet{
+			Productions: []PlanetResourceProduction{
+				{Resource: energyResourceId, Production: -20, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: 50, Building: &buildingId},
+			},
+		}
+
+		assert.Equal(t, 1.0, p.ProductionFactor())
+	})
+
+	t.Run("returns ratio of produced over consumed energy", func(t *testing.T) {
+		p := Planet{
+			Productions: []PlanetResourceProduction{
+				{Resource: energyResourceId, Production: -40, Building: &crystalMineId},
+				{Resource: energyResourceId, Production: 10, Building: &buildingId},
+			},
+		}
+
+		assert.Equal(t, 0.25, p.ProductionFactor())
+	})
+
+	t.Run("returns 0 when no energy is produced", func(t *testing.T) {
+		p := Planet{
+			Productions: []PlanetResourceProduction{
+				{Resource: energyResourceId, Production: -40, Building: &crystalMineId},
+			},
+		}
+
+		assert.Equal(t, 0.0, p.ProductionFactor())
+	})
+}
+
+func TestUnit_Planet_ApplyAction(t *testing.T) {
+	t1 := time.Date(2026, time.June, 26, 8, 41, 30, 0, time.UTC)
+	t2 := time.Date(2026, time.June, 26, 8, 42, 30, 0, time.UTC)
+
+	t.Run("returns error when no action is in progress", func(t *testing.T) {
+		p := Planet{}
+
+		err := p.ApplyAction()
+
+		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet update time is not matching action completion time", func(t *testing.T) {
+		p := Planet{
+			BuildingQueue: []BuildingAction{{
+				CompletedAt: t2,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+	})
+
+	t.Run("applies resource production changes when applicable", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Level: 1},
+			},
+			Productions: []PlanetResourceProduction{
+				{Resource: metalResourceId, Production: 50},
+				{Resource: metalResourceId, Production: 30, Building: &metalMineId},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     metalMineId,
+				DesiredLevel: 2,
+				Productions: []BuildingActionResourceProduction{
+					{Resource: metalResourceId, Production: 45},
+				},
+				CompletedAt: t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 50},
+			{Resource: metalResourceId, Production: 45, Building: &metalMineId},
+		}
+		assert.Equal(t, expected, p.Productions)
+	})
+
+	t.Run("registers new resource production for building when applicable", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Level: 1},
+			},
+			Productions: []PlanetResourceProduction{
+				{Resource: metalResourceId, Production: 30},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     metalMineId,
+				DesiredLevel: 2,
+				Productions: []BuildingActionResourceProduction{
+					{Resource: metalResourceId, Production: 45},
+				},
+				CompletedAt: t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 30},
+			{Resource: metalResourceId, Production: 45, Building: &metalMineId},
+		}
+		assert.Equal(t, expected, p.Productions)
+	})
+
+	t.Run("does not change resource production when action has no effect", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Level: 1},
+			},
+			Productions: []PlanetResourceProduction{
+				{Resource: metalResourceId, Production: 30},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     metalMineId,
+				DesiredLevel: 2,
+				Productions:  []BuildingActionResourceProduction{},
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 30},
+		}
+		assert.Equal(t, expected, p.Productions)
+	})
+
+	t.Run("applies resource storage changes when applicable", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Level: 1},
+			},
+			Storages: []PlanetResourceStorage{
+				{Resource: metalResourceId, Storage: 1000},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     metalMineId,
+				DesiredLevel: 2,
+				Storages: []BuildingActionResourceStorage{
+					{Resource: metalResourceId, Storage: 2000},
+				},
+				CompletedAt: t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 2000},
+		}
+		assert.Equal(t, expected, p.Storages)
+	})
+
+	t.Run("does not change resource storage when action has no effect", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Level: 1},
+			},
+			Storages: []PlanetResourceStorage{
+				{Resource: metalResourceId, Storage: 1000},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     metalMineId,
+				DesiredLevel: 2,
+				Storages:     []BuildingActionResourceStorage{},
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 1000},
+		}
+		assert.Equal(t, expected, p.Storages)
+	})
+
+	t.Run("updates building to desired level", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: crystalMineId, Level: 1},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     crystalMineId,
+				DesiredLevel: 2,
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetBuilding{
+			{Building: crystalMineId, Level: 2},
+		}
+		assert.Equal(t, expected, p.Buildings)
+	})
+
+	t.Run("lowers building level when action is a demolition", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: crystalMineId, Level: 2},
+			},
+			Productions: []PlanetResourceProduction{
+				{Resource: crystalResourceId, Building: &crystalMineId, Production: 30},
+			},
+			Storages: []PlanetResourceStorage{
+				{Resource: crystalResourceId, Storage: 500},
+			},
+			BuildingQueue: []BuildingAction{{
+				Building:     crystalMineId,
+				Kind:         DemolishAction,
+				DesiredLevel: 1,
+				CompletedAt:  t1,
+				Productions: []BuildingActionResourceProduction{
+					{Resource: crystalResourceId, Production: 10},
+				},
+				Storages: []BuildingActionResourceStorage{
+					{Resource: crystalResourceId, Storage: 200},
+				},
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expectedBuildings := []PlanetBuilding{
+			{Building: crystalMineId, Level: 1},
+		}
+		assert.Equal(t, expectedBuildings, p.Buildings)
+		expectedProductions := []PlanetResourceProduction{
+			{Resource: crystalResourceId, Building: &crystalMineId, Production: 10},
+		}
+		assert.Equal(t, expectedProductions, p.Productions)
+		expectedStorages := []PlanetResourceStorage{
+			{Resource: crystalResourceId, Storage: 200},
+		}
+		assert.Equal(t, expectedStorages, p.Storages)
+	})
+
+	t.Run("bumps version by one", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: crystalMineId, Level: 1},
+			},
+			Version: 0,
+			BuildingQueue: []BuildingAction{{
+				Building:     crystalMineId,
+				DesiredLevel: 2,
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 1, p.Version)
+	})
+
+	t.Run("does not change updated at field", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: crystalMineId, Level: 1},
+			},
+			Version: 0,
+			BuildingQueue: []BuildingAction{{
+				Building:     crystalMineId,
+				DesiredLevel: 2,
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t1, p.UpdatedAt)
+	})
+
+	t.Run("removes building action from planet", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: crystalMineId, Level: 1},
+			},
+			Version: 0,
+			BuildingQueue: []BuildingAction{{
+				Building:     crystalMineId,
+				DesiredLevel: 2,
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.BuildingQueue)
+	})
+
+	t.Run("notifies player of completed action", func(t *testing.T) {
+		player := uuid.New()
+		p := Planet{
+			Player: player,
+			Name:   "my-planet",
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Name: "metal mine", Level: 1},
+			},
+			BuildingQueue: []BuildingAction{{
+				Kind:         UpgradeAction,
+				Building:     metalMineId,
+				DesiredLevel: 2,
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.Messages, 1)
+		expected := Message{
+			Id:        p.Messages[0].Id,
+			Kind:      SystemMessage,
+			Player:    player,
+			Subject:   "Building upgraded",
+			Content:   "Building metal mine reached level 2 on planet my-planet.",
+			CreatedAt: t1,
+		}
+		assert.Equal(t, expected, p.Messages[0])
+	})
+
+	t.Run("notifies player of completed demolition", func(t *testing.T) {
+		p := Planet{
+			Buildings: []PlanetBuilding{
+				{Building: metalMineId, Level: 2},
+			},
+			BuildingQueue: []BuildingAction{{
+				Kind:         DemolishAction,
+				Building:     metalMineId,
+				DesiredLevel: 1,
+				CompletedAt:  t1,
+			}},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyAction()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.Messages, 1)
+		assert.Equal(t, "Building demolished", p.Messages[0].Subject)
+	})
+}
+
+func TestUnit_Planet_BuildShips(t *testing.T) {
+	t.Run("returns error when count is not positive", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard, withManyResources)
+
+		err := p.BuildShips(generateTestShip(t), 0, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+		assert.Empty(t, p.ShipyardQueue)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("returns error when count exceeds the maximum batch size", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard)
+		resources := p.Resources
+
+		err := p.BuildShips(generateTestShip(t), 5_000_000_000_000_000, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+		assert.Empty(t, p.ShipyardQueue)
+		assert.Equal(t, resources, p.Resources)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("returns error when shipyard is not built", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrShipyardNotBuilt, "Actual err: %v", err)
+		assert.Empty(t, p.ShipyardQueue)
+	})
+
+	t.Run("returns error when shipyard is at level 0", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
+		p.Buildings = []PlanetBuilding{{Building: shipyardBuildingId, Level: 0}}
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrShipyardNotBuilt, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet does not have enough resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard)
+		p.Resources = []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   4000,
+			},
+			{
+				Resource: crystalResourceId,
+				// Needed value: 4000
+				Amount: 3999,
+			},
+		}
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+		assert.Empty(t, p.ShipyardQueue)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("queues action in the shipyard", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard, withManyResources)
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.ShipyardQueue, 1)
+		action := p.ShipyardQueue[0]
+		assert.Equal(t, ShipUnit, action.Kind)
+		assert.Equal(t, shipId, action.Unit)
+		assert.Equal(t, 2, action.Count)
+		assert.Equal(t, someTime, action.CreatedAt)
+		assert.Equal(t, someTime.Add(96*time.Minute), action.CompletedAt)
+	})
+
+	t.Run("starts new batch after the last queued one", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard, withManyResources)
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+		err = p.BuildShips(generateTestShip(t), 1, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.ShipyardQueue, 2)
+		action := p.ShipyardQueue[1]
+		assert.Equal(t, someTime, action.CreatedAt)
+		assert.Equal(t, someTime.Add(144*time.Minute), action.CompletedAt)
+	})
+
+	t.Run("deducts action costs from the available planet resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard, withManyResources)
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   995999,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   995999,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("bumps version by one", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard, withManyResources)
+
+		err := p.BuildShips(generateTestShip(t), 2, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 4, p.Version)
+	})
+}
+
+func TestUnit_Planet_BuildDefenses(t *testing.T) {
+	t.Run("queues action in the shipyard", func(t *testing.T) {
+		p := generateTestPlanet(t, withShipyard, withManyResources)
+		d := Defense{
+			Id: uuid.New(),
+			Costs: []UnitCost{
+				{
+					Resource:              metalResourceId,
+					Cost:                  2000,
+					BuildTimeHoursPerUnit: 0.0004,
+				},
+			},
+		}
+
+		err := p.BuildDefenses(d, 5, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.ShipyardQueue, 1)
+		action := p.ShipyardQueue[0]
+		assert.Equal(t, DefenseUnit, action.Kind)
+		assert.Equal(t, d.Id, action.Unit)
+		assert.Equal(t, 5, action.Count)
+	})
+}
+
+func TestUnit_Planet_ApplyShipyardUnit(t *testing.T) {
+	t1 := time.Date(2026, time.June, 26, 8, 41, 30, 0, time.UTC)
+	t2 := time.Date(2026, time.June, 26, 8, 42, 30, 0, time.UTC)
+
+	t.Run("returns error when shipyard queue is empty", func(t *testing.T) {
+		p := Planet{}
+
+		err := p.ApplyShipyardUnit()
+
+		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet update time is not matching next unit completion time", func(t *testing.T) {
+		p := Planet{
+			ShipyardQueue: []ShipyardAction{
+				{
+					Count:         2,
+					UnitBuildTime: time.Minute,
+					CompletedAt:   t2,
+				},
+			},
+			UpdatedAt: t2,
+		}
+
+		err := p.ApplyShipyardUnit()
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+	})
+
+	t.Run("adds ship to the planet and keeps remaining units in the queue", func(t *testing.T) {
+		p := Planet{
+			ShipyardQueue: []ShipyardAction{
+				{
+					Kind:          ShipUnit,
+					Unit:          shipId,
+					Count:         2,
+					UnitBuildTime: time.Minute,
+					CompletedAt:   t2,
+				},
+			},
+			UpdatedAt: t1,
+			Version:   2,
+		}
+
+		err := p.ApplyShipyardUnit()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetShip{{Ship: shipId, Count: 1}}
+		assert.Equal(t, expected, p.Ships)
+		require.Len(t, p.ShipyardQueue, 1)
+		assert.Equal(t, 1, p.ShipyardQueue[0].Count)
+		assert.Equal(t, t2, p.ShipyardQueue[0].NextUnitCompletedAt())
+		assert.Equal(t, 3, p.Version)
+		assert.Equal(t, t1, p.UpdatedAt)
+	})
+
+	t.Run("increments existing ship count", func(t *testing.T) {
+		p := Planet{
+			Ships: []PlanetShip{{Ship: shipId, Count: 12}},
+			ShipyardQueue: []ShipyardAction{
+				{
+					Kind:          ShipUnit,
+					Unit:          shipId,
+					Count:         1,
+					UnitBuildTime: time.Minute,
+					CompletedAt:   t1,
+				},
+			},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyShipyardUnit()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetShip{{Ship: shipId, Count: 13}}
+		assert.Equal(t, expected, p.Ships)
+	})
+
+	t.Run("adds defense to the planet", func(t *testing.T) {
+		defenseId := uuid.New()
+		p := Planet{
+			ShipyardQueue: []ShipyardAction{
+				{
+					Kind:          DefenseUnit,
+					Unit:          defenseId,
+					Count:         1,
+					UnitBuildTime: time.Minute,
+					CompletedAt:   t1,
+				},
+			},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyShipyardUnit()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetDefense{{Defense: defenseId, Count: 1}}
+		assert.Equal(t, expected, p.Defenses)
+		assert.Empty(t, p.Ships)
+	})
+
+	t.Run("removes batch from the queue when last unit is produced", func(t *testing.T) {
+		nextId := uuid.New()
+		p := Planet{
+			ShipyardQueue: []ShipyardAction{
+				{
+					Kind:          ShipUnit,
+					Unit:          shipId,
+					Count:         1,
+					UnitBuildTime: time.Minute,
+					CompletedAt:   t1,
+				},
+				{
+					Id:            nextId,
+					Kind:          ShipUnit,
+					Unit:          shipId,
+					Count:         1,
+					UnitBuildTime: time.Minute,
+					CompletedAt:   t2,
+				},
+			},
+			UpdatedAt: t1,
+		}
+
+		err := p.ApplyShipyardUnit()
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.ShipyardQueue, 1)
+		assert.Equal(t, nextId, p.ShipyardQueue[0].Id)
+	})
+}
+
+func TestUnit_Planet_SendFleet(t *testing.T) {
+	t.Run("returns error when speed is invalid", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Speed = 45
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+		assert.Empty(t, p.Fleets)
+	})
+
+	t.Run("returns error when mission is unknown", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Mission = "attack"
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when no ship is sent", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Ships = nil
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when target is the planet itself", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Target.Planet = p.Id
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleetTarget, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when deploying to a planet of another player", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Mission = DeployMission
+		d.Target.Player = uuid.New()
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleetTarget, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when transporting to a planet of another player", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Target.Player = uuid.New()
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleetTarget, "Actual err: %v", err)
+		assert.Empty(t, p.Fleets)
+	})
+
+	t.Run("returns error when all fleet slots are used", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.ActiveFleets = 1
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFleetLimitReached, "Actual err: %v", err)
+		assert.Empty(t, p.Fleets)
+	})
+
+	t.Run("grants additional fleet slots with computer technology", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		p.Technologies = []PlayerTechnology{
+			{Technology: computerTechnologyId, Level: 2},
+		}
+		d := generateTestDeparture(t)
+		d.ActiveFleets = 2
+
+		_, err := p.SendFleet(d, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, p.Fleets, 1)
+	})
+
+	t.Run("returns error when cargo exceeds fleet capacity", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Cargo = []FleetCargo{
+			{Resource: metalResourceId, Amount: 10001},
+		}
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrCargoCapacityExceeded, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when a resource is listed twice", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Cargo = []FleetCargo{
+			{Resource: metalResourceId, Amount: 3000},
+			{Resource: metalResourceId, Amount: 3000},
+		}
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+		assert.Equal(t, float64(999999), p.Resources[0].Amount)
+		assert.Empty(t, p.Fleets)
+	})
+
+	t.Run("returns error when cargo amounts would overflow", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Cargo = []FleetCargo{
+			{Resource: metalResourceId, Amount: math.MaxInt},
+			{Resource: crystalResourceId, Amount: math.MaxInt},
+		}
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrCargoCapacityExceeded, "Actual err: %v", err)
+		assert.Equal(t, float64(999999), p.Resources[0].Amount)
+		assert.Empty(t, p.Fleets)
+	})
+
+	t.Run("returns error when a ship is listed twice", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Ships = append(d.Ships, d.Ships[0])
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 5}}, p.Ships)
+	})
+
+	t.Run("returns error when fleet capacity would overflow", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Ships[0].Count = math.MaxInt
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 5}}, p.Ships)
+	})
+
+	t.Run("returns error when planet does not have enough ships", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+		d.Ships[0].Count = 6
+
+		_, err := p.SendFleet(d, testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughShips, "Actual err: %v", err)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("returns error when planet does not have enough resources", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips)
+
+		_, err := p.SendFleet(generateTestDeparture(t), testRoles)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 5}}, p.Ships)
+	})
+
+	t.Run("creates fleet leaving the planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+		d := generateTestDeparture(t)
+
+		actual, err := p.SendFleet(d, testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := Fleet{
+			// The identifier is generated
+			Id:      actual.Id,
+			Player:  p.Player,
+			Mission: TransportMission,
+
+			Origin:           p.Id,
+			OriginCoordinate: p.Coordinate,
+			Target:           d.Target.Planet,
+			TargetCoordinate: d.Target.Coordinate,
+
+			Ships: []FleetShip{
+				{Ship: shipId, Count: 2},
+			},
+			Cargo: []FleetCargo{
+				{Resource: metalResourceId, Amount: 6000},
+			},
+
+			DepartedAt: someTime,
+			ArrivesAt:  someTime.Add(4997 * time.Second),
+
+			CreatedAt: someTime,
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, []Fleet{expected}, p.Fleets)
+	})
+
+	t.Run("removes ships and cargo from the planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+
+		_, err := p.SendFleet(generateTestDeparture(t), testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 3}}, p.Ships)
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   993999,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   999999,
+			},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("bumps version by one", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips, withManyResources)
+
+		_, err := p.SendFleet(generateTestDeparture(t), testRoles)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 4, p.Version)
+	})
+}
+
+func TestUnit_Planet_RecallFleet(t *testing.T) {
+	t.Run("returns error when fleet does not exist", func(t *testing.T) {
+		p := generateTestPlanet(t)
+
+		err := p.RecallFleet(uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fleet was not sent from the planet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Target = p.Id
+		p.Fleets = []Fleet{f}
+
+		err := p.RecallFleet(f.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("makes fleet fly back to the planet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		f.DepartedAt = someTime.Add(-10 * time.Minute)
+		p.Fleets = []Fleet{f}
+
+		err := p.RecallFleet(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.NotNil(t, p.Fleets[0].ReturnsAt)
+		assert.Equal(t, someTime.Add(10*time.Minute), *p.Fleets[0].ReturnsAt)
+		assert.Equal(t, 4, p.Version)
+	})
+}
+
+func TestUnit_Planet_ApplyFleetArrival(t *testing.T) {
+	t.Run("returns error when fleet does not target the planet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fleet did not arrive yet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Target = p.Id
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+	})
+
+	t.Run("unloads cargo and sends transport fleet back", func(t *testing.T) {
+		p := generateTestPlanet(t, withManyResources)
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 2000000},
+		}
+		f := generateTestFleet(t)
+		f.Target = p.Id
+		f.DepartedAt = someTime.Add(-time.Hour)
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.Fleets, 1)
+		assert.Empty(t, p.Fleets[0].Cargo)
+		require.NotNil(t, p.Fleets[0].ReturnsAt)
+		assert.Equal(t, someTime.Add(time.Hour), *p.Fleets[0].ReturnsAt)
+		assert.Equal(t, 3, p.Fleets[0].Version)
+		assert.Equal(t, 1000999.0, p.Resources[0].Amount)
+		assert.Equal(t, 4, p.Version)
+	})
+
+	t.Run("brings back the cargo exceeding the storage", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Resources = []PlanetResource{
+			{Resource: metalResourceId, Amount: 200},
+		}
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 500},
+		}
+		f := generateTestFleet(t)
+		f.Target = p.Id
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 500},
+		}
+		assert.Equal(t, expected, p.Resources)
+		require.Len(t, p.Fleets, 1)
+		assert.Equal(t, []FleetCargo{{Resource: metalResourceId, Amount: 700}}, p.Fleets[0].Cargo)
+		assert.NotNil(t, p.Fleets[0].ReturnsAt)
+	})
+
+	t.Run("brings back the whole cargo when the storage is full", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Resources = []PlanetResource{
+			{Resource: metalResourceId, Amount: 800},
+		}
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 500},
+		}
+		f := generateTestFleet(t)
+		f.Target = p.Id
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 800},
+		}
+		assert.Equal(t, expected, p.Resources)
+		require.Len(t, p.Fleets, 1)
+		assert.Equal(t, f.Cargo, p.Fleets[0].Cargo)
+	})
+
+	t.Run("unloads the whole cargo of a deployed fleet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 2000},
+		}
+		f := generateTestFleet(t)
+		f.Mission = DeployMission
+		f.Target = p.Id
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 1000},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("sends deployed fleet back with the cargo exceeding the storage", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips)
+		p.Resources = []PlanetResource{
+			{Resource: metalResourceId, Amount: 200},
+		}
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 500},
+		}
+		f := generateTestFleet(t)
+		f.Mission = DeployMission
+		f.Target = p.Id
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 500},
+		}
+		assert.Equal(t, expected, p.Resources)
+		require.Len(t, p.Fleets, 1)
+		assert.Equal(t, []FleetCargo{{Resource: metalResourceId, Amount: 700}}, p.Fleets[0].Cargo)
+		assert.NotNil(t, p.Fleets[0].ReturnsAt)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 5}}, p.Ships)
+	})
+
+	t.Run("does not unload deployed fleet when the storage is full", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		p.Resources = []PlanetResource{
+			{Resource: metalResourceId, Amount: 800},
+		}
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 500},
+		}
+		f := generateTestFleet(t)
+		f.Mission = DeployMission
+		f.Target = p.Id
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 800},
+		}
+		assert.Equal(t, expected, p.Resources)
+		require.Len(t, p.Fleets, 1)
+		assert.Equal(t, f.Cargo, p.Fleets[0].Cargo)
+	})
+
+	t.Run("stations deployed ships on the planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips)
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 2000},
+		}
+		f := generateTestFleet(t)
+		f.Mission = DeployMission
+		f.Target = p.Id
+		f.ArrivesAt = someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetArrival(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.Fleets)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 8}}, p.Ships)
+	})
+}
+
+func TestUnit_Planet_ApplyFleetReturn(t *testing.T) {
+	t.Run("returns error when fleet is not returning", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetReturn(f.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleet, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fleet did not return yet", func(t *testing.T) {
+		p := generateTestPlanet(t)
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		returnsAt := someTime.Add(time.Minute)
+		f.ReturnsAt = &returnsAt
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetReturn(f.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+	})
+
+	t.Run("brings back ships and cargo", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips)
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 2000},
+		}
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		f.ReturnsAt = &someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetReturn(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.Fleets)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 8}}, p.Ships)
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 1000},
+		}
+		assert.Equal(t, expected, p.Resources)
+		assert.Equal(t, 4, p.Version)
+	})
+
+	t.Run("brings back the whole cargo even above the storage", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips)
+		p.Resources = []PlanetResource{
+			{Resource: metalResourceId, Amount: 200},
+		}
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 500},
+		}
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		f.ReturnsAt = &someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetReturn(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.Fleets)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 8}}, p.Ships)
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 1200},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+
+	t.Run("brings back cargo when the storage is already full", func(t *testing.T) {
+		p := generateTestPlanet(t, withShips)
+		p.Resources = []PlanetResource{
+			{Resource: metalResourceId, Amount: 800},
+		}
+		p.Storages = []PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 500},
+		}
+		f := generateTestFleet(t)
+		f.Origin = p.Id
+		f.ReturnsAt = &someTime
+		p.Fleets = []Fleet{f}
+
+		err := p.ApplyFleetReturn(f.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.Fleets)
+		assert.Equal(t, []PlanetShip{{Ship: shipId, Count: 8}}, p.Ships)
+		expected := []PlanetResource{
+			{Resource: metalResourceId, Amount: 1800},
+		}
+		assert.Equal(t, expected, p.Resources)
+	})
+}
+
+func TestUnit_Planet_Trade(t *testing.T) {
+	rates := []ExchangeRate{
+		{Resource: metalResourceId, Rate: 3},
+		{Resource: crystalResourceId, Rate: 2},
+	}
+
+	t.Run("returns error when selling and buying the same resource", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		_, err := p.Trade(rates, metalResourceId, metalResourceId, 300)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidTrade, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when amount is not positive", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		_, err := p.Trade(rates, metalResourceId, crystalResourceId, 0)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidTrade, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when resource has no exchange rate", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		_, err := p.Trade(rates, metalResourceId, deuteriumResourceId, 300)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidTrade, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when amount is too small to buy anything", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		_, err := p.Trade(rates, metalResourceId, crystalResourceId, 1)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidTrade, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when not enough resources to sell", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		_, err := p.Trade(rates, metalResourceId, crystalResourceId, 1500)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when bought resource exceeds storage", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		p.Storages[1].Storage = 150
+
+		_, err := p.Trade(rates, metalResourceId, crystalResourceId, 300)
+
+		assert.ErrorIs(t, err, domainerrors.ErrStorageCapacityExceeded, "Actual err: %v", err)
+		assert.Equal(t, 1000.0, p.Resources[0].Amount)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("exchanges resources with the merchant", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		actual, err := p.Trade(rates, metalResourceId, crystalResourceId, 300)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := Trade{
+			Planet:       p.Id,
+			Sold:         metalResourceId,
+			SoldAmount:   300,
+			Bought:       crystalResourceId,
+			BoughtAmount: 200,
+			CreatedAt:    someTime,
+		}
+		assert.Equal(t, expected, actual)
+		expectedResources := []PlanetResource{
+			{Resource: metalResourceId, Amount: 700},
+			{Resource: crystalResourceId, Amount: 300},
+		}
+		assert.Equal(t, expectedResources, p.Resources)
+		assert.Equal(t, 4, p.Version)
+	})
+}
+
+func TestUnit_Planet_PostMarketOffer(t *testing.T) {
+	universe := uuid.New()
+
+	t.Run("returns error when amounts are not positive", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		terms := generateTestMarketOfferTerms(t)
+		terms.RequestedAmount = 0
+
+		_, err := p.PostMarketOffer(universe, terms)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when offering and requesting the same resource", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		terms := generateTestMarketOfferTerms(t)
+		terms.RequestedResource = metalResourceId
+
+		_, err := p.PostMarketOffer(universe, terms)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when duration is too long", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		terms := generateTestMarketOfferTerms(t)
+		terms.Duration = 73 * time.Hour
+
+		_, err := p.PostMarketOffer(universe, terms)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when requested resource does not exist on planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		terms := generateTestMarketOfferTerms(t)
+		terms.RequestedResource = deuteriumResourceId
+
+		_, err := p.PostMarketOffer(universe, terms)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when not enough resources to escrow", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		terms := generateTestMarketOfferTerms(t)
+		terms.OfferedAmount = 1001
+
+		_, err := p.PostMarketOffer(universe, terms)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+		assert.Equal(t, 3, p.Version)
+	})
+
+	t.Run("escrows offered resource", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		p.Player = uuid.New()
+		terms := generateTestMarketOfferTerms(t)
+
+		actual, err := p.PostMarketOffer(universe, terms)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := MarketOffer{
+			Id:                actual.Id,
+			Universe:          universe,
+			Player:            p.Player,
+			Planet:            p.Id,
+			OfferedResource:   metalResourceId,
+			OfferedAmount:     400,
+			RequestedResource: crystalResourceId,
+			RequestedAmount:   150,
+			Status:            OpenOffer,
+			ExpiresAt:         someTime.Add(2 * time.Hour),
+			CreatedAt:         someTime,
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, []MarketOffer{expected}, p.MarketOffers)
+		assert.Equal(t, 600.0, p.Resources[0].Amount)
+		assert.Equal(t, 4, p.Version)
+	})
+}
+
+func TestUnit_Planet_CancelMarketOffer(t *testing.T) {
+	t.Run("returns error when offer does not exist", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+
+		err := p.CancelMarketOffer(uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotFound, "Actual err: %v", err)
 	})
 
-	t.Run("bumps updated at field", func(t *testing.T) {
-		p := Planet{UpdatedAt: someTime}
+	t.Run("returns error when offer is not open", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		o.Status = AcceptedOffer
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.UpdateToTime(someTimeLater)
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.CancelMarketOffer(o.Id)
 
-		assert.Equal(t, someTimeLater, p.UpdatedAt)
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotAvailable, "Actual err: %v", err)
 	})
 
-	t.Run("does not bump updated at field when update time is in the past", func(t *testing.T) {
-		p := Planet{UpdatedAt: someTimeLater}
+	t.Run("refunds escrow", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.UpdateToTime(someTime)
+		err := p.CancelMarketOffer(o.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Equal(t, someTimeLater, p.UpdatedAt)
+		assert.Equal(t, CancelledOffer, p.MarketOffers[0].Status)
+		assert.Equal(t, 2, p.MarketOffers[0].Version)
+		assert.Equal(t, 1500.0, p.Resources[0].Amount)
+		assert.Equal(t, 4, p.Version)
 	})
+}
 
-	t.Run("returns error when building action finishes before update time", func(t *testing.T) {
-		t1 := time.Date(2026, time.June, 26, 8, 30, 50, 0, time.UTC)
-		t2 := time.Date(2026, time.June, 26, 8, 31, 50, 0, time.UTC)
-		t3 := time.Date(2026, time.June, 26, 8, 32, 50, 0, time.UTC)
-
-		p := Planet{
-			BuildingAction: &BuildingAction{
-				CompletedAt: t2,
-			},
-			UpdatedAt: t1,
-		}
+func TestUnit_Planet_AcceptMarketOffer(t *testing.T) {
+	t.Run("returns error when offer is not open", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Status = CancelledOffer
 
-		err := p.UpdateToTime(t3)
+		_, err := p.AcceptMarketOffer(o)
 
-		assert.ErrorIs(t, err, domainerrors.ErrPlanetNotUpToDate, "Actual err is: %v", err)
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotAvailable, "Actual err: %v", err)
 	})
-}
 
-func TestUnit_Planet_ApplyAction(t *testing.T) {
-	t1 := time.Date(2026, time.June, 26, 8, 41, 30, 0, time.UTC)
-	t2 := time.Date(2026, time.June, 26, 8, 42, 30, 0, time.UTC)
+	t.Run("returns error when offer is expired", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.ExpiresAt = someTime
 
-	t.Run("returns error when no action is in progress", func(t *testing.T) {
-		p := Planet{BuildingAction: nil}
+		_, err := p.AcceptMarketOffer(o)
 
-		err := p.ApplyAction()
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotAvailable, "Actual err: %v", err)
+	})
 
-		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+	t.Run("returns error when offer belongs to the player", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Player = p.Player
+
+		_, err := p.AcceptMarketOffer(o)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
 	})
 
-	t.Run("returns error when planet update time is not matching action completion time", func(t *testing.T) {
-		p := Planet{
-			BuildingAction: &BuildingAction{
-				CompletedAt: t2,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("returns error when offered resource does not exist on planet", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.OfferedResource = deuteriumResourceId
 
-		err := p.ApplyAction()
+		_, err := p.AcceptMarketOffer(o)
 
-		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
 	})
 
-	t.Run("applies resource production changes when applicable", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: metalMineId, Level: 1},
-			},
-			Productions: []PlanetResourceProduction{
-				{Resource: metalResourceId, Production: 50},
-				{Resource: metalResourceId, Production: 30, Building: &metalMineId},
-			},
-			BuildingAction: &BuildingAction{
-				Building:     metalMineId,
-				DesiredLevel: 2,
-				Productions: []BuildingActionResourceProduction{
-					{Resource: metalResourceId, Production: 45},
-				},
-				CompletedAt: t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("returns error when not enough resources to pay", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.RequestedAmount = 101
 
-		err := p.ApplyAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		_, err := p.AcceptMarketOffer(o)
 
-		expected := []PlanetResourceProduction{
-			{Resource: metalResourceId, Production: 50},
-			{Resource: metalResourceId, Production: 45, Building: &metalMineId},
-		}
-		assert.Equal(t, expected, p.Productions)
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+		assert.Empty(t, p.MarketOffers)
 	})
 
-	t.Run("registers new resource production for building when applicable", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: metalMineId, Level: 1},
-			},
-			Productions: []PlanetResourceProduction{
-				{Resource: metalResourceId, Production: 30},
-			},
-			BuildingAction: &BuildingAction{
-				Building:     metalMineId,
-				DesiredLevel: 2,
-				Productions: []BuildingActionResourceProduction{
-					{Resource: metalResourceId, Production: 45},
-				},
-				CompletedAt: t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("pays for offer and receives escrow", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		p.Player = uuid.New()
+		o := generateTestMarketOffer(t)
+		o.RequestedAmount = 100
 
-		err := p.ApplyAction()
+		actual, err := p.AcceptMarketOffer(o)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		expected := []PlanetResourceProduction{
-			{Resource: metalResourceId, Production: 30},
-			{Resource: metalResourceId, Production: 45, Building: &metalMineId},
+		assert.Equal(t, AcceptedOffer, actual.Status)
+		require.NotNil(t, actual.Buyer)
+		assert.Equal(t, p.Player, *actual.Buyer)
+		require.NotNil(t, actual.BuyerPlanet)
+		assert.Equal(t, p.Id, *actual.BuyerPlanet)
+		require.NotNil(t, actual.AcceptedAt)
+		assert.Equal(t, someTime, *actual.AcceptedAt)
+		assert.Equal(t, 2, actual.Version)
+		assert.Equal(t, []MarketOffer{actual}, p.MarketOffers)
+		expectedResources := []PlanetResource{
+			{Resource: metalResourceId, Amount: 1500},
+			{Resource: crystalResourceId, Amount: 0},
 		}
-		assert.Equal(t, expected, p.Productions)
+		assert.Equal(t, expectedResources, p.Resources)
+		assert.Equal(t, 4, p.Version)
 	})
 
-	t.Run("does not change resource production when action has no effect", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: metalMineId, Level: 1},
-			},
-			Productions: []PlanetResourceProduction{
-				{Resource: metalResourceId, Production: 30},
-			},
-			BuildingAction: &BuildingAction{
-				Building:     metalMineId,
-				DesiredLevel: 2,
-				Productions:  []BuildingActionResourceProduction{},
-				CompletedAt:  t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("does not cap received resource by storage", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		p.Storages[0].Storage = 10
+		o := generateTestMarketOffer(t)
+		o.RequestedAmount = 100
 
-		err := p.ApplyAction()
+		_, err := p.AcceptMarketOffer(o)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		expected := []PlanetResourceProduction{
-			{Resource: metalResourceId, Production: 30},
-		}
-		assert.Equal(t, expected, p.Productions)
+		assert.Equal(t, 1500.0, p.Resources[0].Amount)
 	})
+}
 
-	t.Run("applies resource storage changes when applicable", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: metalMineId, Level: 1},
-			},
-			Storages: []PlanetResourceStorage{
-				{Resource: metalResourceId, Storage: 1000},
-			},
-			BuildingAction: &BuildingAction{
-				Building:     metalMineId,
-				DesiredLevel: 2,
-				Storages: []BuildingActionResourceStorage{
-					{Resource: metalResourceId, Storage: 2000},
-				},
-				CompletedAt: t1,
-			},
-			UpdatedAt: t1,
-		}
+func TestUnit_Planet_SettleMarketOffer(t *testing.T) {
+	t.Run("returns error when offer is not accepted", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.ApplyAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.SettleMarketOffer(o.Id)
 
-		expected := []PlanetResourceStorage{
-			{Resource: metalResourceId, Storage: 2000},
-		}
-		assert.Equal(t, expected, p.Storages)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
 	})
 
-	t.Run("does not change resource storage when action has no effect", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: metalMineId, Level: 1},
-			},
-			Storages: []PlanetResourceStorage{
-				{Resource: metalResourceId, Storage: 1000},
-			},
-			BuildingAction: &BuildingAction{
-				Building:     metalMineId,
-				DesiredLevel: 2,
-				Storages:     []BuildingActionResourceStorage{},
-				CompletedAt:  t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("returns error when offer was not accepted yet", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		o.Status = AcceptedOffer
+		acceptedAt := someTime.Add(time.Minute)
+		o.AcceptedAt = &acceptedAt
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.ApplyAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.SettleMarketOffer(o.Id)
 
-		expected := []PlanetResourceStorage{
-			{Resource: metalResourceId, Storage: 1000},
-		}
-		assert.Equal(t, expected, p.Storages)
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
 	})
 
-	t.Run("updates building to desired level", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: crystalMineId, Level: 1},
-			},
-			BuildingAction: &BuildingAction{
-				Building:     crystalMineId,
-				DesiredLevel: 2,
-				CompletedAt:  t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("credits requested resource", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		o.Status = AcceptedOffer
+		o.AcceptedAt = &someTime
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.ApplyAction()
+		err := p.SettleMarketOffer(o.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		expected := []PlanetBuilding{
-			{Building: crystalMineId, Level: 2},
-		}
-		assert.Equal(t, expected, p.Buildings)
+		assert.Equal(t, SettledOffer, p.MarketOffers[0].Status)
+		assert.Equal(t, 2, p.MarketOffers[0].Version)
+		assert.Equal(t, 300.0, p.Resources[1].Amount)
+		assert.Equal(t, 4, p.Version)
 	})
+}
 
-	t.Run("bumps version by one", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: crystalMineId, Level: 1},
-			},
-			Version: 0,
-			BuildingAction: &BuildingAction{
-				Building:     crystalMineId,
-				DesiredLevel: 2,
-				CompletedAt:  t1,
-			},
-			UpdatedAt: t1,
-		}
+func TestUnit_Planet_ExpireMarketOffer(t *testing.T) {
+	t.Run("returns error when offer is not open", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		o.Status = CancelledOffer
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.ApplyAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.ExpireMarketOffer(o.Id)
 
-		assert.Equal(t, 1, p.Version)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
 	})
 
-	t.Run("does not change updated at field", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: crystalMineId, Level: 1},
-			},
-			Version: 0,
-			BuildingAction: &BuildingAction{
-				Building:     crystalMineId,
-				DesiredLevel: 2,
-				CompletedAt:  t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("returns error when offer did not expire yet", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.ApplyAction()
-		require.NoError(t, err, "Actual err: %v", err)
+		err := p.ExpireMarketOffer(o.Id)
 
-		assert.Equal(t, t1, p.UpdatedAt)
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
 	})
 
-	t.Run("removes building action from planet", func(t *testing.T) {
-		p := Planet{
-			Buildings: []PlanetBuilding{
-				{Building: crystalMineId, Level: 1},
-			},
-			Version: 0,
-			BuildingAction: &BuildingAction{
-				Building:     crystalMineId,
-				DesiredLevel: 2,
-				CompletedAt:  t1,
-			},
-			UpdatedAt: t1,
-		}
+	t.Run("refunds escrow", func(t *testing.T) {
+		p := generateTestPlanet(t, withTradeResources)
+		o := generateTestMarketOffer(t)
+		o.Planet = p.Id
+		o.ExpiresAt = someTime
+		p.MarketOffers = []MarketOffer{o}
 
-		err := p.ApplyAction()
+		err := p.ExpireMarketOffer(o.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Nil(t, p.BuildingAction)
+		assert.Equal(t, ExpiredOffer, p.MarketOffers[0].Status)
+		assert.Equal(t, 2, p.MarketOffers[0].Version)
+		assert.Equal(t, 1500.0, p.Resources[0].Amount)
+		assert.Equal(t, 4, p.Version)
 	})
 }
 
@@ -731,6 +3134,41 @@ func withManyResources(t *testing.T, p *Planet) {
 	}
 }
 
+func withTradeResources(t *testing.T, p *Planet) {
+	t.Helper()
+
+	p.Resources = []PlanetResource{
+		{Resource: metalResourceId, Amount: 1000},
+		{Resource: crystalResourceId, Amount: 100},
+	}
+	p.Storages = []PlanetResourceStorage{
+		{Resource: metalResourceId, Storage: 10000},
+		{Resource: crystalResourceId, Storage: 10000},
+	}
+}
+
+func generateTestMarketOfferTerms(t *testing.T) MarketOfferTerms {
+	t.Helper()
+
+	return MarketOfferTerms{
+		OfferedResource:   metalResourceId,
+		OfferedAmount:     400,
+		RequestedResource: crystalResourceId,
+		RequestedAmount:   150,
+		Duration:          2 * time.Hour,
+	}
+}
+
+func withResearchResources(t *testing.T, p *Planet) {
+	t.Helper()
+
+	withManyResources(t, p)
+	p.Resources = append(p.Resources, PlanetResource{
+		Resource: deuteriumResourceId,
+		Amount:   999999,
+	})
+}
+
 func withPlanetBuilding(t *testing.T, p *Planet) {
 	p.Buildings = []PlanetBuilding{
 		{
@@ -739,3 +3177,48 @@ func withPlanetBuilding(t *testing.T, p *Planet) {
 		},
 	}
 }
+
+func withShipyard(t *testing.T, p *Planet) {
+	p.Buildings = []PlanetBuilding{
+		{
+			Building: shipyardBuildingId,
+			Level:    1,
+		},
+	}
+}
+
+func withShips(t *testing.T, p *Planet) {
+	p.Ships = []PlanetShip{
+		{
+			Ship:  shipId,
+			Count: 5,
+		},
+	}
+}
+
+// generateTestDeparture returns a transport of 6000 metal with 2 ships
+// towards a planet located 1015 units away from the test planet.
+func generateTestDeparture(t *testing.T) FleetDeparture {
+	t.Helper()
+
+	return FleetDeparture{
+		Mission: TransportMission,
+		Target: FleetTarget{
+			Planet:     uuid.New(),
+			Coordinate: Coordinate{Position: 3},
+		},
+		Ships: []FleetDepartureShip{
+			{
+				Ship: Ship{
+					Id:            shipId,
+					Speed:         5000,
+					CargoCapacity: 5000,
+				},
+				Count: 2,
+			},
+		},
+		Cargo: []FleetCargo{
+			{Resource: metalResourceId, Amount: 6000},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 )
 
@@ -23,25 +24,51 @@ type Player struct {
 
 	Homeworld uuid.UUID
 	Planets   []uuid.UUID
+
+	// Rank and Points are computed periodically: they are zero until the
+	// rankings of the universe are first computed.
+	Rank   int
+	Points int
+
+	// Alliance is nil when the player is not part of an alliance.
+	Alliance *PlayerAlliance
+}
+
+type PlayerAlliance struct {
+	Id   uuid.UUID
+	Name string
+	Tag  string
+	Rank AllianceRank
 }
 
 func (p *Player) CreateHomeworld(
 	universe Universe,
-) Planet {
-	planet := universe.CreatePlanet(p.Id, true)
+) (Planet, error) {
+	planet, err := universe.CreatePlanet(p.Id, true)
+	if err != nil {
+		return Planet{}, err
+	}
 
 	p.Homeworld = planet.Id
 	p.Planets = []uuid.UUID{planet.Id}
 
-	return planet
+	return planet, nil
 }
 
 func (p *Player) Colonize(
 	universe Universe,
-) Planet {
-	planet := universe.CreatePlanet(p.Id, false)
+) (Planet, error) {
+	if len(p.Planets) >= universe.MaxPlanetsPerPlayer {
+		return Planet{}, domainerrors.ErrColonyLimitReached
+	}
+
+	planet, err := universe.CreatePlanet(p.Id, false)
+	if err != nil {
+		return Planet{}, err
+	}
 
 	p.Planets = append(p.Planets, planet.Id)
+	p.Version++
 
-	return planet
+	return planet, nil
 }
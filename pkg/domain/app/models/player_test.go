@@ -3,8 +3,10 @@ package models
 import (
 	"testing"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnit_Player_CreateHomeworld(t *testing.T) {
@@ -16,7 +18,8 @@ func TestUnit_Player_CreateHomeworld(t *testing.T) {
 			Planets: []uuid.UUID{},
 		}
 
-		actual := p.CreateHomeworld(u)
+		actual, err := p.CreateHomeworld(u)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, p.Id, actual.Player)
 		assert.True(t, actual.Homeworld)
@@ -31,7 +34,8 @@ func TestUnit_Player_CreateHomeworld(t *testing.T) {
 			Planets: nil,
 		}
 
-		actual := p.CreateHomeworld(u)
+		actual, err := p.CreateHomeworld(u)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, p.Id, actual.Player)
 		assert.True(t, actual.Homeworld)
@@ -51,7 +55,8 @@ func TestUnit_Player_Colonize(t *testing.T) {
 			Planets:   []uuid.UUID{},
 		}
 
-		actual := p.Colonize(u)
+		actual, err := p.Colonize(u)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, p.Id, actual.Player)
 		assert.False(t, actual.Homeworld)
@@ -67,7 +72,8 @@ func TestUnit_Player_Colonize(t *testing.T) {
 			Planets:   nil,
 		}
 
-		actual := p.Colonize(u)
+		actual, err := p.Colonize(u)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, p.Id, actual.Player)
 		assert.False(t, actual.Homeworld)
@@ -84,7 +90,8 @@ func TestUnit_Player_Colonize(t *testing.T) {
 			Planets:   []uuid.UUID{homeworldId},
 		}
 
-		actual := p.Colonize(u)
+		actual, err := p.Colonize(u)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, p.Id, actual.Player)
 		assert.False(t, actual.Homeworld)
@@ -92,4 +99,46 @@ func TestUnit_Player_Colonize(t *testing.T) {
 		assert.NotEqual(t, actual.Id, p.Homeworld)
 		assert.Equal(t, []uuid.UUID{homeworldId, actual.Id}, p.Planets)
 	})
+	t.Run("increments version", func(t *testing.T) {
+		p := Player{
+			Id:      uuid.New(),
+			Version: 4,
+		}
+
+		_, err := p.Colonize(u)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 5, p.Version)
+	})
+
+	t.Run("returns error when colony limit is reached", func(t *testing.T) {
+		planets := make([]uuid.UUID, 0, u.MaxPlanetsPerPlayer)
+		for range u.MaxPlanetsPerPlayer {
+			planets = append(planets, uuid.New())
+		}
+		p := Player{
+			Id:      uuid.New(),
+			Planets: planets,
+			Version: 4,
+		}
+
+		_, err := p.Colonize(u)
+
+		assert.ErrorIs(t, err, domainerrors.ErrColonyLimitReached, "Actual err: %v", err)
+		assert.Len(t, p.Planets, u.MaxPlanetsPerPlayer)
+		assert.Equal(t, 4, p.Version)
+	})
+
+	t.Run("uses the colony limit of the universe", func(t *testing.T) {
+		limited := sampleUniverse()
+		limited.MaxPlanetsPerPlayer = 2
+		p := Player{
+			Id:      uuid.New(),
+			Planets: []uuid.UUID{uuid.New(), uuid.New()},
+		}
+
+		_, err := p.Colonize(limited)
+
+		assert.ErrorIs(t, err, domainerrors.ErrColonyLimitReached, "Actual err: %v", err)
+	})
 }
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// PlayerBuildingLevel counts the planets of a player on which a building
+// reached a level. It allows to compute rankings without loading planets.
+type PlayerBuildingLevel struct {
+	Player   uuid.UUID
+	Building uuid.UUID
+	Level    int
+	Count    int
+}
+
+type Ranking struct {
+	Player     uuid.UUID
+	PlayerName string
+	Points     int
+	Rank       int
+}
+
+// ComputeRankings ranks the players of the universe by the resources they
+// spent on buildings. Players with the same amount of points share the
+// same rank. Levels of buildings unknown to the universe do not score.
+func ComputeRankings(
+	universe Universe,
+	players []uuid.UUID,
+	levels []PlayerBuildingLevel,
+) []Ranking {
+	buildings := make(map[uuid.UUID]Building)
+	for _, building := range universe.Buildings {
+		buildings[building.Id] = building
+	}
+
+	type buildingLevel struct {
+		building uuid.UUID
+		level    int
+	}
+	pointsPerLevel := make(map[buildingLevel]int)
+
+	pointsPerPlayer := make(map[uuid.UUID]int)
+	for _, player := range players {
+		pointsPerPlayer[player] = 0
+	}
+
+	for _, level := range levels {
+		building, ok := buildings[level.Building]
+		if !ok {
+			continue
+		}
+
+		key := buildingLevel{building: level.Building, level: level.Level}
+		points, ok := pointsPerLevel[key]
+		if !ok {
+			points = building.Points(level.Level)
+			pointsPerLevel[key] = points
+		}
+
+		pointsPerPlayer[level.Player] += level.Count * points
+	}
+
+	out := make([]Ranking, 0, len(pointsPerPlayer))
+	for player, points := range pointsPerPlayer {
+		out = append(out, Ranking{Player: player, Points: points})
+	}
+
+	slices.SortFunc(out, func(lhs Ranking, rhs Ranking) int {
+		if lhs.Points != rhs.Points {
+			return cmp.Compare(rhs.Points, lhs.Points)
+		}
+		return slices.Compare(lhs.Player[:], rhs.Player[:])
+	})
+
+	for id := range out {
+		out[id].Rank = id + 1
+		if id > 0 && out[id].Points == out[id-1].Points {
+			out[id].Rank = out[id-1].Rank
+		}
+	}
+
+	return out
+}
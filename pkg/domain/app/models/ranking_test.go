@@ -0,0 +1,92 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_ComputeRankings(t *testing.T) {
+	player1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	player2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	player3 := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	universe := Universe{
+		Id:        uuid.New(),
+		Buildings: []Building{generateTestBuilding(t, withBuildingCost)},
+	}
+
+	t.Run("sums points of all planets of a player", func(t *testing.T) {
+		levels := []PlayerBuildingLevel{
+			{Player: player1, Building: buildingId, Level: 2, Count: 2},
+			{Player: player1, Building: buildingId, Level: 1, Count: 1},
+		}
+
+		actual := ComputeRankings(universe, []uuid.UUID{player1}, levels)
+
+		expected := []Ranking{
+			{Player: player1, Points: 714, Rank: 1},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("orders players by decreasing points", func(t *testing.T) {
+		levels := []PlayerBuildingLevel{
+			{Player: player1, Building: buildingId, Level: 1, Count: 1},
+			{Player: player2, Building: buildingId, Level: 2, Count: 1},
+		}
+
+		actual := ComputeRankings(universe, []uuid.UUID{player1, player2}, levels)
+
+		expected := []Ranking{
+			{Player: player2, Points: 300, Rank: 1},
+			{Player: player1, Points: 114, Rank: 2},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("assigns same rank to players with same points", func(t *testing.T) {
+		levels := []PlayerBuildingLevel{
+			{Player: player1, Building: buildingId, Level: 1, Count: 1},
+			{Player: player2, Building: buildingId, Level: 1, Count: 1},
+			{Player: player3, Building: buildingId, Level: 2, Count: 1},
+		}
+
+		actual := ComputeRankings(universe, []uuid.UUID{player1, player2, player3}, levels)
+
+		expected := []Ranking{
+			{Player: player3, Points: 300, Rank: 1},
+			{Player: player1, Points: 114, Rank: 2},
+			{Player: player2, Points: 114, Rank: 2},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("ranks players without buildings", func(t *testing.T) {
+		levels := []PlayerBuildingLevel{
+			{Player: player2, Building: buildingId, Level: 1, Count: 1},
+		}
+
+		actual := ComputeRankings(universe, []uuid.UUID{player1, player2}, levels)
+
+		expected := []Ranking{
+			{Player: player2, Points: 114, Rank: 1},
+			{Player: player1, Points: 0, Rank: 2},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("ignores unknown buildings", func(t *testing.T) {
+		levels := []PlayerBuildingLevel{
+			{Player: player1, Building: uuid.New(), Level: 3, Count: 1},
+		}
+
+		actual := ComputeRankings(universe, []uuid.UUID{player1}, levels)
+
+		expected := []Ranking{
+			{Player: player1, Points: 0, Rank: 1},
+		}
+		assert.Equal(t, expected, actual)
+	})
+}
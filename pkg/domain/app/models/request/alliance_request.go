@@ -0,0 +1,19 @@
+package request
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type AllianceCreationRequest struct {
+	Founder uuid.UUID
+	Name    string
+	Tag     string
+}
+
+type AllianceRankRequest struct {
+	Alliance  uuid.UUID
+	Requester uuid.UUID
+	Player    uuid.UUID
+	Rank      models.AllianceRank
+}
@@ -1,10 +1,12 @@
 package request
 
 import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	"github.com/google/uuid"
 )
 
 type BuildingActionCreationRequest struct {
-	Planet   uuid.UUID `json:"planet" format:"uuid"`
-	Building uuid.UUID `json:"building" format:"uuid"`
+	Planet   uuid.UUID                 `json:"planet" format:"uuid"`
+	Building uuid.UUID                 `json:"building" format:"uuid"`
+	Kind     models.BuildingActionKind `json:"kind"`
 }
@@ -0,0 +1,25 @@
+package request
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type FleetCreationRequest struct {
+	Planet  uuid.UUID
+	Mission models.FleetMission
+	Target  models.Coordinate
+	Speed   int
+	Ships   []FleetShipRequest
+	Cargo   []FleetCargoRequest
+}
+
+type FleetShipRequest struct {
+	Ship  uuid.UUID
+	Count int
+}
+
+type FleetCargoRequest struct {
+	Resource uuid.UUID
+	Amount   int
+}
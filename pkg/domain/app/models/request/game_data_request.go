@@ -0,0 +1,90 @@
+package request
+
+import (
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ResourceRequest struct {
+	Name                  string
+	StartAmount           int
+	StartProduction       int
+	StartStorage          int
+	BuildTimeHoursPerUnit float64
+}
+
+type BuildingRequest struct {
+	Name        string
+	Costs       []BuildingCostRequest
+	Productions []BuildingProductionRequest
+	Storages    []BuildingStorageRequest
+}
+
+type BuildingCostRequest struct {
+	Resource uuid.UUID
+	Cost     int
+	Progress float64
+}
+
+type BuildingProductionRequest struct {
+	Resource uuid.UUID
+	Base     int
+	Progress float64
+}
+
+type BuildingStorageRequest struct {
+	Resource uuid.UUID
+	Base     int
+	Scale    float64
+	Progress float64
+}
+
+func FromResourceRequest(resource ResourceRequest) models.Resource {
+	return models.Resource{
+		Id:                    uuid.New(),
+		Name:                  resource.Name,
+		StartAmount:           resource.StartAmount,
+		StartProduction:       resource.StartProduction,
+		StartStorage:          resource.StartStorage,
+		BuildTimeHoursPerUnit: resource.BuildTimeHoursPerUnit,
+		CreatedAt:             time.Now(),
+	}
+}
+
+func FromBuildingRequest(building BuildingRequest) models.Building {
+	out := models.Building{
+		Id:          uuid.New(),
+		Name:        building.Name,
+		CreatedAt:   time.Now(),
+		Costs:       make([]models.BuildingCost, 0, len(building.Costs)),
+		Productions: make([]models.BuildingResourceProduction, 0, len(building.Productions)),
+		Storages:    make([]models.BuildingResourceStorage, 0, len(building.Storages)),
+	}
+
+	for _, cost := range building.Costs {
+		out.Costs = append(out.Costs, models.BuildingCost{
+			Resource: cost.Resource,
+			Cost:     cost.Cost,
+			Progress: cost.Progress,
+		})
+	}
+	for _, production := range building.Productions {
+		out.Productions = append(out.Productions, models.BuildingResourceProduction{
+			Resource: production.Resource,
+			Base:     production.Base,
+			Progress: production.Progress,
+		})
+	}
+	for _, storage := range building.Storages {
+		out.Storages = append(out.Storages, models.BuildingResourceStorage{
+			Resource: storage.Resource,
+			Base:     storage.Base,
+			Scale:    storage.Scale,
+			Progress: storage.Progress,
+		})
+	}
+
+	return out
+}
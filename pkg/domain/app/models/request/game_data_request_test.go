@@ -0,0 +1,56 @@
+package request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_FromResourceRequest(t *testing.T) {
+	beforeConversion := time.Now()
+
+	request := ResourceRequest{
+		Name:                  "metal",
+		StartAmount:           500,
+		StartProduction:       30,
+		StartStorage:          10000,
+		BuildTimeHoursPerUnit: 0.0004,
+	}
+
+	actual := FromResourceRequest(request)
+
+	assert.NotEqual(t, uuid.Nil, actual.Id)
+	assert.Equal(t, request.Name, actual.Name)
+	assert.Equal(t, request.StartAmount, actual.StartAmount)
+	assert.Equal(t, request.StartProduction, actual.StartProduction)
+	assert.Equal(t, request.StartStorage, actual.StartStorage)
+	assert.Equal(t, request.BuildTimeHoursPerUnit, actual.BuildTimeHoursPerUnit)
+	assert.True(t, actual.CreatedAt.After(beforeConversion))
+}
+
+func TestUnit_FromBuildingRequest(t *testing.T) {
+	beforeConversion := time.Now()
+	resource := uuid.MustParse("a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d")
+
+	request := BuildingRequest{
+		Name:        "metal mine",
+		Costs:       []BuildingCostRequest{{Resource: resource, Cost: 60, Progress: 1.5}},
+		Productions: []BuildingProductionRequest{{Resource: resource, Base: 30, Progress: 1.1}},
+		Storages:    []BuildingStorageRequest{{Resource: resource, Base: 5000, Scale: 2.5, Progress: 1.6}},
+	}
+
+	actual := FromBuildingRequest(request)
+
+	assert.NotEqual(t, uuid.Nil, actual.Id)
+	assert.Equal(t, request.Name, actual.Name)
+	assert.True(t, actual.CreatedAt.After(beforeConversion))
+	expectedCosts := []models.BuildingCost{{Resource: resource, Cost: 60, Progress: 1.5}}
+	assert.Equal(t, expectedCosts, actual.Costs)
+	expectedProductions := []models.BuildingResourceProduction{{Resource: resource, Base: 30, Progress: 1.1}}
+	assert.Equal(t, expectedProductions, actual.Productions)
+	expectedStorages := []models.BuildingResourceStorage{{Resource: resource, Base: 5000, Scale: 2.5, Progress: 1.6}}
+	assert.Equal(t, expectedStorages, actual.Storages)
+}
@@ -0,0 +1,17 @@
+package request
+
+import "github.com/google/uuid"
+
+type MarketOfferCreationRequest struct {
+	Planet            uuid.UUID
+	OfferedResource   uuid.UUID
+	OfferedAmount     int
+	RequestedResource uuid.UUID
+	RequestedAmount   int
+	DurationHours     int
+}
+
+type MarketOfferAcceptanceRequest struct {
+	Offer  uuid.UUID
+	Planet uuid.UUID
+}
@@ -0,0 +1,12 @@
+package request
+
+import (
+	"github.com/google/uuid"
+)
+
+type MessageCreationRequest struct {
+	Sender    uuid.UUID
+	Recipient uuid.UUID
+	Subject   string
+	Content   string
+}
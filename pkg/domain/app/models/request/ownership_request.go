@@ -0,0 +1,23 @@
+package request
+
+import "github.com/google/uuid"
+
+type OwnedResource string
+
+const (
+	ApiUserResource     OwnedResource = "api_user"
+	PlayerResource      OwnedResource = "player"
+	PlanetResource      OwnedResource = "planet"
+	FleetResource       OwnedResource = "fleet"
+	MarketOfferResource OwnedResource = "market_offer"
+	GameDataResource    OwnedResource = "game_data"
+)
+
+// OwnershipRequest asks whether the api user owns the resource with the
+// given id. An api user owns their players and everything attached to them.
+// The game data is owned by the administrators and has no id.
+type OwnershipRequest struct {
+	ApiUser  uuid.UUID
+	Resource OwnedResource
+	Id       uuid.UUID
+}
@@ -0,0 +1,11 @@
+package request
+
+// PageRequest selects a subset of a collection. Pages are numbered from 0.
+type PageRequest struct {
+	Page int
+	Size int
+}
+
+func (p PageRequest) Offset() int {
+	return p.Page * p.Size
+}
@@ -0,0 +1,11 @@
+package request
+
+import (
+	"github.com/google/uuid"
+)
+
+type ResearchActionCreationRequest struct {
+	Player     uuid.UUID `json:"player" format:"uuid"`
+	Planet     uuid.UUID `json:"planet" format:"uuid"`
+	Technology uuid.UUID `json:"technology" format:"uuid"`
+}
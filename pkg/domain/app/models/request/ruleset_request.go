@@ -0,0 +1,63 @@
+package request
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+// RulesetRequest describes the whole game data. Unlike the other requests
+// the identifiers are provided so that importing the same ruleset twice
+// updates the existing data instead of duplicating it.
+type RulesetRequest struct {
+	Resources   []RulesetResourceRequest
+	Buildings   []RulesetBuildingRequest
+	FieldRanges []PlanetFieldRangeRequest
+	// MaxPlanetsPerPlayer is left unchanged when not set.
+	MaxPlanetsPerPlayer int
+}
+
+type RulesetResourceRequest struct {
+	Id       uuid.UUID
+	Resource ResourceRequest
+}
+
+type RulesetBuildingRequest struct {
+	Id       uuid.UUID
+	Building BuildingRequest
+}
+
+type PlanetFieldRangeRequest struct {
+	Position int
+	Min      int
+	Max      int
+}
+
+func FromRulesetRequest(ruleset RulesetRequest) models.Ruleset {
+	out := models.Ruleset{
+		Resources:   make([]models.Resource, 0, len(ruleset.Resources)),
+		Buildings:   make([]models.Building, 0, len(ruleset.Buildings)),
+		FieldRanges: make([]models.PlanetFieldRange, 0, len(ruleset.FieldRanges)),
+
+		MaxPlanetsPerPlayer: ruleset.MaxPlanetsPerPlayer,
+	}
+
+	for _, resource := range ruleset.Resources {
+		converted := FromResourceRequest(resource.Resource)
+		converted.Id = resource.Id
+		out.Resources = append(out.Resources, converted)
+	}
+	for _, building := range ruleset.Buildings {
+		converted := FromBuildingRequest(building.Building)
+		converted.Id = building.Id
+		out.Buildings = append(out.Buildings, converted)
+	}
+	for _, fieldRange := range ruleset.FieldRanges {
+		out.FieldRanges = append(out.FieldRanges, models.PlanetFieldRange{
+			Position: fieldRange.Position,
+			Min:      fieldRange.Min,
+			Max:      fieldRange.Max,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,49 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnit_FromRulesetRequest(t *testing.T) {
+	resource := uuid.MustParse("a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d")
+	building := uuid.MustParse("5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a")
+
+	request := RulesetRequest{
+		Resources: []RulesetResourceRequest{
+			{
+				Id:       resource,
+				Resource: ResourceRequest{Name: "metal", StartAmount: 500},
+			},
+		},
+		Buildings: []RulesetBuildingRequest{
+			{
+				Id: building,
+				Building: BuildingRequest{
+					Name:  "metal mine",
+					Costs: []BuildingCostRequest{{Resource: resource, Cost: 60, Progress: 1.5}},
+				},
+			},
+		},
+		FieldRanges:         []PlanetFieldRangeRequest{{Position: 3, Min: 75, Max: 125}},
+		MaxPlanetsPerPlayer: 6,
+	}
+
+	actual := FromRulesetRequest(request)
+
+	assert.Len(t, actual.Resources, 1)
+	assert.Equal(t, resource, actual.Resources[0].Id)
+	assert.Equal(t, "metal", actual.Resources[0].Name)
+	assert.Equal(t, 500, actual.Resources[0].StartAmount)
+	assert.Len(t, actual.Buildings, 1)
+	assert.Equal(t, building, actual.Buildings[0].Id)
+	assert.Equal(t, "metal mine", actual.Buildings[0].Name)
+	expectedCosts := []models.BuildingCost{{Resource: resource, Cost: 60, Progress: 1.5}}
+	assert.Equal(t, expectedCosts, actual.Buildings[0].Costs)
+	expectedRanges := []models.PlanetFieldRange{{Position: 3, Min: 75, Max: 125}}
+	assert.Equal(t, expectedRanges, actual.FieldRanges)
+	assert.Equal(t, 6, actual.MaxPlanetsPerPlayer)
+}
@@ -0,0 +1,13 @@
+package request
+
+import (
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ShipyardActionCreationRequest struct {
+	Planet uuid.UUID
+	Kind   models.ShipyardUnitKind
+	Unit   uuid.UUID
+	Count  int
+}
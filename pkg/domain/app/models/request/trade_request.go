@@ -0,0 +1,10 @@
+package request
+
+import "github.com/google/uuid"
+
+type TradeRequest struct {
+	Planet uuid.UUID
+	Sold   uuid.UUID
+	Bought uuid.UUID
+	Amount int
+}
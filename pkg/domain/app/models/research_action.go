@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResearchAction represents a technology being researched by a player.
+// A player can only research one technology at a time: the action runs
+// on one of the planets of the player and is resolved along with the
+// other events of this planet.
+type ResearchAction struct {
+	Id         uuid.UUID
+	Player     uuid.UUID
+	Planet     uuid.UUID
+	Technology uuid.UUID
+
+	DesiredLevel int
+
+	CreatedAt   time.Time
+	CompletedAt time.Time
+
+	Costs []ResearchActionCost
+}
+
+type ResearchActionCost struct {
+	Resource uuid.UUID
+	Amount   int
+}
+
+// Research summarizes the progress of a player in the technology tree.
+type Research struct {
+	Player       uuid.UUID
+	Technologies []PlayerTechnology
+	Action       *ResearchAction
+}
@@ -1,8 +1,10 @@
 package models
 
 import (
+	"strings"
 	"time"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 )
 
@@ -21,3 +23,19 @@ type Resource struct {
 
 	CreatedAt time.Time
 }
+
+// Validate checks that the resource can be used in a universe: it needs a
+// name and none of its starting values can be negative.
+func (r Resource) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return domainerrors.ErrInvalidResource
+	}
+	if r.StartAmount < 0 || r.StartProduction < 0 || r.StartStorage < 0 {
+		return domainerrors.ErrInvalidResource
+	}
+	if r.BuildTimeHoursPerUnit < 0 {
+		return domainerrors.ErrInvalidResource
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_Resource_Validate(t *testing.T) {
+	t.Run("accepts valid resource", func(t *testing.T) {
+		r := generateTestResource()
+
+		err := r.Validate()
+
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects empty name", func(t *testing.T) {
+		r := generateTestResource()
+		r.Name = ""
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidResource, err)
+	})
+
+	t.Run("rejects negative start amount", func(t *testing.T) {
+		r := generateTestResource()
+		r.StartAmount = -1
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidResource, err)
+	})
+
+	t.Run("rejects negative build time", func(t *testing.T) {
+		r := generateTestResource()
+		r.BuildTimeHoursPerUnit = -0.5
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidResource, err)
+	})
+}
+
+func generateTestResource() Resource {
+	return Resource{
+		Id:                    metalResourceId,
+		Name:                  "metal",
+		StartAmount:           500,
+		StartProduction:       30,
+		StartStorage:          10000,
+		BuildTimeHoursPerUnit: 0.0004,
+		CreatedAt:             someTime,
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+// Ruleset gathers the game data shared by all the universes so that it can
+// be versioned and loaded at once. The buildings can only use resources
+// defined in the ruleset.
+type Ruleset struct {
+	Resources   []Resource
+	Buildings   []Building
+	FieldRanges []PlanetFieldRange
+	// MaxPlanetsPerPlayer is left unchanged when not set.
+	MaxPlanetsPerPlayer int
+}
+
+func (r Ruleset) Validate() error {
+	resources := make(map[uuid.UUID]bool)
+	names := make(map[string]bool)
+	for _, resource := range r.Resources {
+		if err := resource.Validate(); err != nil {
+			return err
+		}
+		if resources[resource.Id] || names[resource.Name] {
+			return domainerrors.ErrInvalidRuleset
+		}
+		resources[resource.Id] = true
+		names[resource.Name] = true
+	}
+
+	buildings := make(map[uuid.UUID]bool)
+	for _, building := range r.Buildings {
+		if err := building.Validate(); err != nil {
+			return err
+		}
+		if buildings[building.Id] {
+			return domainerrors.ErrInvalidRuleset
+		}
+		buildings[building.Id] = true
+
+		for _, resource := range building.usedResources() {
+			if !resources[resource] {
+				return domainerrors.ErrInvalidRuleset
+			}
+		}
+	}
+
+	positions := make(map[int]bool)
+	for _, fieldRange := range r.FieldRanges {
+		if err := fieldRange.Validate(); err != nil {
+			return err
+		}
+		if positions[fieldRange.Position] {
+			return domainerrors.ErrInvalidRuleset
+		}
+		positions[fieldRange.Position] = true
+	}
+
+	if r.MaxPlanetsPerPlayer < 0 {
+		return domainerrors.ErrInvalidRuleset
+	}
+
+	return nil
+}
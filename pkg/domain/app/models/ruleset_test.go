@@ -0,0 +1,121 @@
+package models
+
+import (
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_Ruleset_Validate(t *testing.T) {
+	t.Run("accepts valid ruleset", func(t *testing.T) {
+		r := generateTestRuleset(t)
+
+		err := r.Validate()
+
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects invalid resource", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.Resources[0].Name = ""
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidResource, err)
+	})
+
+	t.Run("rejects resources with the same name", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.Resources[1].Name = r.Resources[0].Name
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+
+	t.Run("rejects invalid building", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.Buildings[0].Costs[0].Cost = 0
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("rejects building using resource not in ruleset", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.Buildings[0].Productions[0].Resource = uuid.MustParse("00000000-1111-2222-1111-000000000000")
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+
+	t.Run("rejects buildings with the same id", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.Buildings = append(r.Buildings, r.Buildings[0])
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+
+	t.Run("rejects empty field range", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.FieldRanges[0].Max = r.FieldRanges[0].Min
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+
+	t.Run("rejects field ranges for the same position", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.FieldRanges[1].Position = r.FieldRanges[0].Position
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+
+	t.Run("accepts ruleset without colony limit", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.MaxPlanetsPerPlayer = 0
+
+		err := r.Validate()
+
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects negative colony limit", func(t *testing.T) {
+		r := generateTestRuleset(t)
+		r.MaxPlanetsPerPlayer = -1
+
+		err := r.Validate()
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+}
+
+func generateTestRuleset(t *testing.T) Ruleset {
+	t.Helper()
+
+	crystal := generateTestResource()
+	crystal.Id = crystalResourceId
+	crystal.Name = "crystal"
+
+	return Ruleset{
+		Resources: []Resource{generateTestResource(), crystal},
+		Buildings: []Building{
+			generateTestBuilding(t, withBuildingCost, withBuildingProduction, withBuildingStorage),
+		},
+		FieldRanges: []PlanetFieldRange{
+			{Position: 0, Min: 95, Max: 108},
+			{Position: 1, Min: 97, Max: 110},
+		},
+		MaxPlanetsPerPlayer: 9,
+	}
+}
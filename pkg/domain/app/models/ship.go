@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Ship struct {
+	Id        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+
+	Speed         int
+	CargoCapacity int
+
+	Costs []UnitCost
+}
+
+// CreateShipyardAction creates a batch producing count ships. The first
+// ship starts being produced at startAt, which might be later than the
+// creation time if the shipyard is busy. An error is returned if the
+// count is not positive or exceeds MaxShipyardBatchSize, or if the costs
+// of the batch cannot be represented.
+func (s Ship) CreateShipyardAction(
+	count int,
+	shipyardLevel int,
+	createdAt time.Time,
+	startAt time.Time,
+) (ShipyardAction, error) {
+	return newShipyardAction(ShipUnit, s.Id, s.Costs, count, shipyardLevel, createdAt, startAt)
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+type ShipyardUnitKind string
+
+const (
+	ShipUnit    ShipyardUnitKind = "ship"
+	DefenseUnit ShipyardUnitKind = "defense"
+)
+
+// MaxShipyardBatchSize is the largest number of units that can be queued
+// in a single shipyard batch. It keeps the costs and the build time of a
+// batch well within the range of the integers used to represent them.
+const MaxShipyardBatchSize = 10000
+
+// UnitCost defines how much of a resource is needed to produce a single
+// unit in the shipyard. Unlike buildings, the cost does not progress.
+type UnitCost struct {
+	Resource              uuid.UUID
+	Cost                  int
+	BuildTimeHoursPerUnit float64
+}
+
+// ShipyardAction represents a batch of identical units queued in the
+// shipyard. Units are produced one at a time: the Count is decremented
+// each time a unit is completed. The CompletedAt field corresponds to
+// the completion of the last unit of the batch.
+type ShipyardAction struct {
+	Id   uuid.UUID
+	Kind ShipyardUnitKind
+	Unit uuid.UUID
+
+	Count         int
+	UnitBuildTime time.Duration
+
+	CreatedAt   time.Time
+	CompletedAt time.Time
+
+	Costs []ShipyardActionCost
+}
+
+type ShipyardActionCost struct {
+	Resource uuid.UUID
+	Amount   int
+}
+
+// NextUnitCompletedAt returns the time at which the next unit of the
+// batch will be completed.
+func (a ShipyardAction) NextUnitCompletedAt() time.Time {
+	remaining := time.Duration(a.Count-1) * a.UnitBuildTime
+	return a.CompletedAt.Add(-remaining)
+}
+
+func newShipyardAction(
+	kind ShipyardUnitKind,
+	unit uuid.UUID,
+	unitCosts []UnitCost,
+	count int,
+	shipyardLevel int,
+	createdAt time.Time,
+	startAt time.Time,
+) (ShipyardAction, error) {
+	if count <= 0 || count > MaxShipyardBatchSize {
+		return ShipyardAction{}, domainerrors.ErrInvalidUnitCount
+	}
+
+	unitBuildTime := determineUnitBuildTime(unitCosts, shipyardLevel)
+
+	costs := []ShipyardActionCost{}
+	for _, c := range unitCosts {
+		// Costs are persisted as 32-bit integers.
+		amount, ok := multiplyWithoutOverflow(int64(c.Cost), int64(count))
+		if !ok || amount <= 0 || amount > math.MaxInt32 {
+			return ShipyardAction{}, domainerrors.ErrInvalidUnitCount
+		}
+
+		cost := ShipyardActionCost{
+			Resource: c.Resource,
+			Amount:   int(amount),
+		}
+		costs = append(costs, cost)
+	}
+
+	duration, ok := multiplyWithoutOverflow(int64(count), int64(unitBuildTime))
+	if !ok {
+		return ShipyardAction{}, domainerrors.ErrInvalidUnitCount
+	}
+
+	action := ShipyardAction{
+		Id:   uuid.New(),
+		Kind: kind,
+		Unit: unit,
+
+		Count:         count,
+		UnitBuildTime: unitBuildTime,
+
+		CreatedAt:   createdAt,
+		CompletedAt: startAt.Add(time.Duration(duration)),
+
+		Costs: costs,
+	}
+
+	return action, nil
+}
+
+// multiplyWithoutOverflow returns the product of the two inputs along
+// with a boolean indicating whether it could be computed without
+// overflowing.
+func multiplyWithoutOverflow(a int64, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+
+	return product, true
+}
+
+// determineUnitBuildTime computes the time needed to produce a single unit.
+// It is expressed with a millisecond precision.
+// https://ogame.fandom.com/wiki/Shipyard
+func determineUnitBuildTime(costs []UnitCost, shipyardLevel int) time.Duration {
+	buildTimeHour := 0.0
+	for _, cost := range costs {
+		buildTimeHour += float64(cost.Cost) * cost.BuildTimeHoursPerUnit
+	}
+
+	buildTimeHour /= float64(1 + shipyardLevel)
+
+	milliSeconds := math.Floor(buildTimeHour * float64(time.Hour.Milliseconds()))
+
+	return time.Duration(milliSeconds) * time.Millisecond
+}
@@ -0,0 +1,204 @@
+package models
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var shipId = uuid.New()
+
+func TestUnit_Ship_CreateShipyardAction(t *testing.T) {
+	t.Run("correctly calculates action costs", func(t *testing.T) {
+		s := generateTestShip(t)
+
+		action, err := s.CreateShipyardAction(3, 1, someTime, someTime)
+		assert.Nil(t, err)
+
+		expected := ShipyardAction{
+			// The identifier is generated
+			Id:   action.Id,
+			Kind: ShipUnit,
+			Unit: s.Id,
+
+			Count: 3,
+			// Ignore the build time here, there are dedicated tests
+			UnitBuildTime: action.UnitBuildTime,
+
+			CreatedAt:   someTime,
+			CompletedAt: action.CompletedAt,
+
+			Costs: []ShipyardActionCost{
+				{
+					Resource: metalResourceId,
+					Amount:   6000,
+				},
+				{
+					Resource: crystalResourceId,
+					Amount:   6000,
+				},
+			},
+		}
+		assert.Equal(t, expected, action)
+	})
+
+	t.Run("correctly calculates unit build time", func(t *testing.T) {
+		s := generateTestShip(t)
+
+		action, err := s.CreateShipyardAction(3, 1, someTime, someTime)
+		assert.Nil(t, err)
+
+		assert.Equal(t, 48*time.Minute, action.UnitBuildTime)
+	})
+
+	t.Run("higher shipyard level reduces build time", func(t *testing.T) {
+		s := generateTestShip(t)
+
+		action, err := s.CreateShipyardAction(3, 4, someTime, someTime)
+		assert.Nil(t, err)
+
+		expected := 19*time.Minute + 12*time.Second
+		assert.Equal(t, expected, action.UnitBuildTime)
+	})
+
+	t.Run("completion time accounts for all units of the batch", func(t *testing.T) {
+		s := generateTestShip(t)
+
+		action, err := s.CreateShipyardAction(3, 1, someTime, someTime)
+		assert.Nil(t, err)
+
+		expected := someTime.Add(144 * time.Minute)
+		assert.Equal(t, expected, action.CompletedAt)
+	})
+
+	t.Run("completion time starts from the provided start time", func(t *testing.T) {
+		s := generateTestShip(t)
+		startAt := someTime.Add(2 * time.Hour)
+
+		action, err := s.CreateShipyardAction(1, 1, someTime, startAt)
+		assert.Nil(t, err)
+
+		assert.Equal(t, someTime, action.CreatedAt)
+		assert.Equal(t, startAt.Add(48*time.Minute), action.CompletedAt)
+	})
+}
+
+func TestUnit_Ship_CreateShipyardAction_InvalidCount(t *testing.T) {
+	t.Run("rejects batches larger than the maximum size", func(t *testing.T) {
+		s := generateTestShip(t)
+
+		_, err := s.CreateShipyardAction(MaxShipyardBatchSize+1, 1, someTime, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+	})
+
+	t.Run("rejects counts overflowing the costs", func(t *testing.T) {
+		s := generateTestShip(t)
+		s.Costs[0].Cost = math.MaxInt64 / 2
+
+		_, err := s.CreateShipyardAction(3, 1, someTime, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+	})
+
+	t.Run("rejects negative costs", func(t *testing.T) {
+		s := generateTestShip(t)
+		s.Costs[0].Cost = -10
+
+		_, err := s.CreateShipyardAction(3, 1, someTime, someTime)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_MultiplyWithoutOverflow(t *testing.T) {
+	t.Run("returns product when it fits", func(t *testing.T) {
+		actual, ok := multiplyWithoutOverflow(3000, 4)
+
+		assert.True(t, ok)
+		assert.Equal(t, int64(12000), actual)
+	})
+
+	t.Run("detects overflow", func(t *testing.T) {
+		_, ok := multiplyWithoutOverflow(math.MaxInt64/2, 3)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("detects overflow for minimum value", func(t *testing.T) {
+		_, ok := multiplyWithoutOverflow(math.MinInt64, -1)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestUnit_Defense_CreateShipyardAction(t *testing.T) {
+	t.Run("creates action for defense", func(t *testing.T) {
+		d := Defense{
+			Id: uuid.New(),
+			Costs: []UnitCost{
+				{
+					Resource:              metalResourceId,
+					Cost:                  2000,
+					BuildTimeHoursPerUnit: 0.0004,
+				},
+			},
+		}
+
+		action, err := d.CreateShipyardAction(2, 1, someTime, someTime)
+		assert.Nil(t, err)
+
+		assert.Equal(t, DefenseUnit, action.Kind)
+		assert.Equal(t, d.Id, action.Unit)
+		assert.Equal(t, 24*time.Minute, action.UnitBuildTime)
+	})
+}
+
+func TestUnit_ShipyardAction_NextUnitCompletedAt(t *testing.T) {
+	t.Run("returns completion time when only one unit remains", func(t *testing.T) {
+		a := ShipyardAction{
+			Count:         1,
+			UnitBuildTime: 5 * time.Minute,
+			CompletedAt:   someTime,
+		}
+
+		assert.Equal(t, someTime, a.NextUnitCompletedAt())
+	})
+
+	t.Run("accounts for remaining units", func(t *testing.T) {
+		a := ShipyardAction{
+			Count:         4,
+			UnitBuildTime: 5 * time.Minute,
+			CompletedAt:   someTime,
+		}
+
+		expected := someTime.Add(-15 * time.Minute)
+		assert.Equal(t, expected, a.NextUnitCompletedAt())
+	})
+}
+
+func generateTestShip(t *testing.T) Ship {
+	t.Helper()
+
+	return Ship{
+		Id:        shipId,
+		Name:      "test-ship",
+		CreatedAt: someTime,
+		Costs: []UnitCost{
+			{
+				Resource:              metalResourceId,
+				Cost:                  2000,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+			{
+				Resource:              crystalResourceId,
+				Cost:                  2000,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+		},
+	}
+}
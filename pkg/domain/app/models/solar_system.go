@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// SolarSystemPlanet describes a planet as seen by any player browsing the
+// universe: only public information is available.
+type SolarSystemPlanet struct {
+	Id         uuid.UUID
+	Name       string
+	PlayerName string
+	// AllianceTag is empty when the player is not part of an alliance.
+	AllianceTag string
+	Homeworld   bool
+	Position    int
+}
+
+// SolarSystemSlot is an orbit of a solar system. The planet is nil when the
+// orbit is free.
+type SolarSystemSlot struct {
+	Position int
+	Planet   *SolarSystemPlanet
+}
+
+type SolarSystem struct {
+	Universe    uuid.UUID
+	Galaxy      int
+	SolarSystem int
+
+	Slots []SolarSystemSlot
+}
+
+// NewSolarSystem arranges the planets into the orbits defined by the
+// topology: there is exactly one slot per orbit, ordered by position.
+func NewSolarSystem(
+	universe uuid.UUID,
+	topology UniverseTopology,
+	galaxy int,
+	solarSystem int,
+	planets []SolarSystemPlanet,
+) SolarSystem {
+	out := SolarSystem{
+		Universe:    universe,
+		Galaxy:      galaxy,
+		SolarSystem: solarSystem,
+		Slots:       make([]SolarSystemSlot, 0, topology.Orbits),
+	}
+
+	for position := range topology.Orbits {
+		out.Slots = append(out.Slots, SolarSystemSlot{Position: position})
+	}
+
+	for _, planet := range planets {
+		if planet.Position < 0 || planet.Position >= topology.Orbits {
+			continue
+		}
+
+		out.Slots[planet.Position].Planet = &planet
+	}
+
+	return out
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_NewSolarSystem(t *testing.T) {
+	universe := uuid.New()
+	topology := UniverseTopology{
+		Galaxies:     2,
+		SolarSystems: 3,
+		Orbits:       4,
+	}
+
+	t.Run("creates one empty slot per orbit", func(t *testing.T) {
+		actual := NewSolarSystem(universe, topology, 1, 2, nil)
+
+		assert.Equal(t, universe, actual.Universe)
+		assert.Equal(t, 1, actual.Galaxy)
+		assert.Equal(t, 2, actual.SolarSystem)
+		expected := []SolarSystemSlot{
+			{Position: 0},
+			{Position: 1},
+			{Position: 2},
+			{Position: 3},
+		}
+		assert.Equal(t, expected, actual.Slots)
+	})
+
+	t.Run("assigns planets to their orbit", func(t *testing.T) {
+		planets := []SolarSystemPlanet{
+			{Id: uuid.New(), Name: "homeworld", PlayerName: "player-1", Homeworld: true, Position: 3},
+			{Id: uuid.New(), Name: "colony", PlayerName: "player-2", Position: 1},
+		}
+
+		actual := NewSolarSystem(universe, topology, 0, 0, planets)
+
+		require.Len(t, actual.Slots, 4)
+		assert.Nil(t, actual.Slots[0].Planet)
+		assert.Equal(t, &planets[1], actual.Slots[1].Planet)
+		assert.Nil(t, actual.Slots[2].Planet)
+		assert.Equal(t, &planets[0], actual.Slots[3].Planet)
+	})
+
+	t.Run("ignores planets outside of the orbits", func(t *testing.T) {
+		planets := []SolarSystemPlanet{
+			{Id: uuid.New(), Position: 4},
+		}
+
+		actual := NewSolarSystem(universe, topology, 0, 0, planets)
+
+		require.Len(t, actual.Slots, 4)
+		for _, slot := range actual.Slots {
+			assert.Nil(t, slot.Planet)
+		}
+	})
+}
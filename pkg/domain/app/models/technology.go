@@ -0,0 +1,128 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// productionBonusPerEnergyTechnologyLevel is the fraction of the base
+	// production added by each level of the energy technology.
+	productionBonusPerEnergyTechnologyLevel = 0.01
+	// baseFleetSlots is the number of fleets a player can have in flight
+	// without having researched the computer technology.
+	baseFleetSlots = 1
+)
+
+type Technology struct {
+	Id        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+
+	Costs []TechnologyCost
+}
+
+type TechnologyCost struct {
+	Resource              uuid.UUID
+	Cost                  int
+	Progress              float64
+	BuildTimeHoursPerUnit float64
+}
+
+// PlayerTechnology is the level reached by a player for a technology and
+// the time at which it was reached. Technologies which were never
+// researched are not listed.
+type PlayerTechnology struct {
+	Technology uuid.UUID
+	Level      int
+	ReachedAt  time.Time
+}
+
+// CreateResearchAction creates an action researching the technology up
+// to the desired level on the input planet.
+func (t Technology) CreateResearchAction(
+	player uuid.UUID,
+	planet uuid.UUID,
+	desiredLevel int,
+	createdAt time.Time,
+) ResearchAction {
+	costs := t.determineActionCost(desiredLevel)
+	completionTime := t.determineCompletionTime(costs)
+
+	action := ResearchAction{
+		Id:           uuid.New(),
+		Player:       player,
+		Planet:       planet,
+		Technology:   t.Id,
+		DesiredLevel: desiredLevel,
+
+		CreatedAt:   createdAt,
+		CompletedAt: createdAt.Add(completionTime),
+
+		Costs: costs,
+	}
+	return action
+}
+
+func (t Technology) determineActionCost(
+	desiredLevel int,
+) []ResearchActionCost {
+	costs := []ResearchActionCost{}
+
+	// https://ogame.fandom.com/wiki/Research
+	for _, baseCost := range t.Costs {
+		resourceCost := math.Floor(float64(baseCost.Cost) * math.Pow(baseCost.Progress, float64(desiredLevel-1)))
+
+		cost := ResearchActionCost{
+			Resource: baseCost.Resource,
+			Amount:   int(resourceCost),
+		}
+		costs = append(costs, cost)
+	}
+
+	return costs
+}
+
+func (t Technology) determineCompletionTime(
+	costs []ResearchActionCost,
+) time.Duration {
+	temp := make(map[uuid.UUID]TechnologyCost)
+	for _, cost := range t.Costs {
+		temp[cost.Resource] = cost
+	}
+
+	researchTimeHour := 0.0
+	for _, cost := range costs {
+		resourceCost := temp[cost.Resource]
+		researchTimeHour += float64(cost.Amount) * resourceCost.BuildTimeHoursPerUnit
+	}
+
+	nanoSeconds := math.Floor(researchTimeHour * float64(time.Hour.Nanoseconds()))
+
+	return time.Duration(nanoSeconds)
+}
+
+func technologyLevel(technologies []PlayerTechnology, technology uuid.UUID) int {
+	for _, t := range technologies {
+		if t.Technology == technology {
+			return t.Level
+		}
+	}
+
+	return 0
+}
+
+// determineProductionBonus returns the factor to apply to the production
+// of buildings based on the level of the energy technology.
+func determineProductionBonus(technologies []PlayerTechnology, roles GameDataRoles) float64 {
+	level := technologyLevel(technologies, roles.EnergyTechnology)
+	return 1.0 + productionBonusPerEnergyTechnologyLevel*float64(level)
+}
+
+// determineFleetSlots returns how many fleets can be in flight at the
+// same time based on the level of the computer technology.
+func determineFleetSlots(technologies []PlayerTechnology, roles GameDataRoles) int {
+	return baseFleetSlots + technologyLevel(technologies, roles.ComputerTechnology)
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	technologyId        = uuid.New()
+	deuteriumResourceId = uuid.MustParse("9665303f-d37f-41e3-ad12-70f8ba8edd14")
+)
+
+func TestUnit_Technology_CreateResearchAction(t *testing.T) {
+	t.Run("correctly calculates action costs", func(t *testing.T) {
+		tech := generateTestTechnology(t)
+		player, planet := uuid.New(), uuid.New()
+
+		action := tech.CreateResearchAction(player, planet, 3, someTime)
+
+		expected := ResearchAction{
+			// The identifier is generated
+			Id:           action.Id,
+			Player:       player,
+			Planet:       planet,
+			Technology:   tech.Id,
+			DesiredLevel: 3,
+
+			CreatedAt: someTime,
+			// Ignore the completion here, there are dedicated tests
+			CompletedAt: action.CompletedAt,
+
+			Costs: []ResearchActionCost{
+				{
+					Resource: crystalResourceId,
+					Amount:   3200,
+				},
+				{
+					Resource: deuteriumResourceId,
+					Amount:   1600,
+				},
+			},
+		}
+		assert.Equal(t, expected, action)
+	})
+
+	t.Run("correctly calculates completion time based on build time per unit", func(t *testing.T) {
+		tech := generateTestTechnology(t)
+
+		action := tech.CreateResearchAction(uuid.New(), uuid.New(), 3, someTime)
+
+		completionTime := 4608 * time.Second
+		assert.Equal(t, someTime, action.CreatedAt)
+		assert.Equal(t, someTime.Add(completionTime), action.CompletedAt)
+	})
+
+	t.Run("completes immediately when no resource is used", func(t *testing.T) {
+		tech := Technology{Id: technologyId, Costs: []TechnologyCost{}}
+
+		action := tech.CreateResearchAction(uuid.New(), uuid.New(), 3, someTime)
+
+		assert.Equal(t, someTime, action.CompletedAt)
+	})
+}
+
+func TestUnit_DetermineProductionBonus(t *testing.T) {
+	t.Run("returns no bonus when energy technology is not researched", func(t *testing.T) {
+		technologies := []PlayerTechnology{
+			{Technology: computerTechnologyId, Level: 4},
+		}
+
+		assert.Equal(t, 1.0, determineProductionBonus(technologies, testRoles))
+	})
+
+	t.Run("adds bonus for each level of energy technology", func(t *testing.T) {
+		technologies := []PlayerTechnology{
+			{Technology: energyTechnologyId, Level: 5},
+		}
+
+		assert.InDelta(t, 1.05, determineProductionBonus(technologies, testRoles), 1e-9)
+	})
+}
+
+func TestUnit_DetermineFleetSlots(t *testing.T) {
+	t.Run("returns base slots when computer technology is not researched", func(t *testing.T) {
+		assert.Equal(t, 1, determineFleetSlots(nil, testRoles))
+	})
+
+	t.Run("adds one slot for each level of computer technology", func(t *testing.T) {
+		technologies := []PlayerTechnology{
+			{Technology: computerTechnologyId, Level: 3},
+		}
+
+		assert.Equal(t, 4, determineFleetSlots(technologies, testRoles))
+	})
+}
+
+func generateTestTechnology(t *testing.T) Technology {
+	t.Helper()
+
+	return Technology{
+		Id:        technologyId,
+		Name:      "test-technology",
+		CreatedAt: someTime,
+		Costs: []TechnologyCost{
+			{
+				Resource:              crystalResourceId,
+				Cost:                  800,
+				Progress:              2,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+			{
+				Resource:              deuteriumResourceId,
+				Cost:                  400,
+				Progress:              2,
+				BuildTimeHoursPerUnit: 0,
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/google/uuid"
+)
+
+// ExchangeRate defines the value of a resource for the merchant of a
+// universe. Rates are relative to each other: with rates of 3, 2 and 1
+// for metal, crystal and deuterium, 3 metal are exchanged for 2 crystal
+// or 1 deuterium.
+type ExchangeRate struct {
+	Resource uuid.UUID
+	Rate     int
+}
+
+// Trade is the result of an exchange of resources with the merchant.
+type Trade struct {
+	Planet uuid.UUID
+
+	Sold       uuid.UUID
+	SoldAmount int
+
+	Bought       uuid.UUID
+	BoughtAmount int
+
+	CreatedAt time.Time
+}
+
+// determineTradedAmount computes the amount of the bought resource obtained
+// in exchange of the sold amount. The result is rounded down. Resources
+// without a rate can not be traded.
+func determineTradedAmount(
+	rates []ExchangeRate,
+	sold uuid.UUID,
+	bought uuid.UUID,
+	amount int,
+) (int, error) {
+	if amount <= 0 || sold == bought {
+		return 0, domainerrors.ErrInvalidTrade
+	}
+
+	var soldRate, boughtRate int
+	for _, r := range rates {
+		switch r.Resource {
+		case sold:
+			soldRate = r.Rate
+		case bought:
+			boughtRate = r.Rate
+		}
+	}
+
+	if soldRate <= 0 || boughtRate <= 0 {
+		return 0, domainerrors.ErrInvalidTrade
+	}
+
+	out := amount * boughtRate / soldRate
+	if out <= 0 {
+		return 0, domainerrors.ErrInvalidTrade
+	}
+
+	return out, nil
+}
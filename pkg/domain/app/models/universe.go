@@ -17,6 +17,18 @@ type Universe struct {
 
 	Resources []Resource
 	Buildings []Building
+	Ships     []Ship
+	Defenses  []Defense
+
+	Technologies []Technology
+
+	// ExchangeRates are used by the merchant: resources without a rate can
+	// not be traded.
+	ExchangeRates []ExchangeRate
+
+	FieldRanges []PlanetFieldRange
+	// MaxPlanetsPerPlayer includes the homeworld.
+	MaxPlanetsPerPlayer int
 
 	OccupancyMap OccupancyMap
 }
@@ -27,23 +39,62 @@ type UniverseTopology struct {
 	Orbits       int
 }
 
-func (u Universe) CreatePlanet(player uuid.UUID, homeworld bool) Planet {
-	createdAt := time.Now()
+// ContainsSolarSystem returns whether the solar system is part of the
+// topology. Coordinates start at 0.
+func (t UniverseTopology) ContainsSolarSystem(galaxy int, solarSystem int) bool {
+	if galaxy < 0 || galaxy >= t.Galaxies {
+		return false
+	}
 
-	coordinate := u.OccupancyMap.PickPosition()
-	fields := coordinate.Fields(homeworld)
+	return solarSystem >= 0 && solarSystem < t.SolarSystems
+}
 
-	planetResources := make([]PlanetResource, 0, len(u.Resources))
-	planetStorages := make([]PlanetResourceStorage, 0, len(u.Resources))
-	planetProductions := make([]PlanetResourceProduction, 0, len(u.Resources))
-	planetBuildings := make([]PlanetBuilding, 0, len(u.Buildings))
+// StartingResources returns the amount of each resource available on a
+// planet when it is created.
+func (u Universe) StartingResources() []PlanetResource {
+	out := make([]PlanetResource, 0, len(u.Resources))
 
 	for _, r := range u.Resources {
+		if r.Name == EnergyResourceName {
+			continue
+		}
+
 		pr := PlanetResource{
 			Resource: r.Id,
 			Amount:   float64(r.StartAmount),
 		}
-		planetResources = append(planetResources, pr)
+		out = append(out, pr)
+	}
+
+	return out
+}
+
+func (u Universe) CreatePlanet(player uuid.UUID, homeworld bool) (Planet, error) {
+	planet := u.StartingPlanet(player, homeworld, time.Now())
+
+	var err error
+	planet.Coordinate, err = u.OccupancyMap.PickPosition()
+	if err != nil {
+		return Planet{}, err
+	}
+	planet.Fields = planet.Coordinate.Fields(homeworld, u.FieldRanges)
+
+	return planet, nil
+}
+
+// StartingPlanet returns a planet as it is when created at the input time,
+// before it is given a position in the universe.
+func (u Universe) StartingPlanet(player uuid.UUID, homeworld bool, createdAt time.Time) Planet {
+	planetStorages := make([]PlanetResourceStorage, 0, len(u.Resources))
+	planetProductions := make([]PlanetResourceProduction, 0, len(u.Resources))
+	planetBuildings := make([]PlanetBuilding, 0, len(u.Buildings))
+
+	for _, r := range u.Resources {
+		// Energy is not stored: it is only produced and consumed by the
+		// buildings of the planet.
+		if r.Name == EnergyResourceName {
+			continue
+		}
 
 		ps := PlanetResourceStorage{
 			Resource: r.Id,
@@ -61,6 +112,7 @@ func (u Universe) CreatePlanet(player uuid.UUID, homeworld bool) Planet {
 	for _, b := range u.Buildings {
 		pb := PlanetBuilding{
 			Building: b.Id,
+			Name:     b.Name,
 			Level:    0,
 		}
 		planetBuildings = append(planetBuildings, pb)
@@ -76,15 +128,19 @@ func (u Universe) CreatePlanet(player uuid.UUID, homeworld bool) Planet {
 		Player:         player,
 		Name:           name,
 		Homeworld:      homeworld,
-		Coordinate:     coordinate,
-		Fields:         fields,
 		CreatedAt:      createdAt,
 		UpdatedAt:      createdAt,
 		Version:        0,
-		Resources:      planetResources,
+		Resources:      u.StartingResources(),
 		Storages:       planetStorages,
 		Productions:    planetProductions,
 		Buildings:      planetBuildings,
-		BuildingAction: nil,
+		Ships:          []PlanetShip{},
+		Defenses:       []PlanetDefense{},
+		BuildingQueue:  []BuildingAction{},
+		ShipyardQueue:  []ShipyardAction{},
+		Fleets:         []Fleet{},
+		Technologies:   []PlayerTechnology{},
+		ResearchAction: nil,
 	}
 }
@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnit_Universe_CreatePlanet(t *testing.T) {
@@ -15,7 +17,8 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 		u := sampleUniverse()
 
 		beforeCreation := time.Now()
-		actual := u.CreatePlanet(playerId, true)
+		actual, err := u.CreatePlanet(playerId, true)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, playerId, actual.Player)
 		assert.Equal(t, "homeworld", actual.Name)
@@ -23,14 +26,15 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 		assert.True(t, beforeCreation.Before(actual.CreatedAt))
 		assert.Equal(t, actual.CreatedAt, actual.UpdatedAt)
 		assert.Zero(t, actual.Version)
-		assert.Nil(t, actual.BuildingAction)
+		assert.Empty(t, actual.BuildingQueue)
 	})
 
 	t.Run("creates a colony belonging to the player", func(t *testing.T) {
 		u := sampleUniverse()
 
 		beforeCreation := time.Now()
-		actual := u.CreatePlanet(playerId, false)
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		assert.Equal(t, playerId, actual.Player)
 		assert.Equal(t, "colony", actual.Name)
@@ -38,13 +42,14 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 		assert.True(t, beforeCreation.Before(actual.CreatedAt))
 		assert.Equal(t, actual.CreatedAt, actual.UpdatedAt)
 		assert.Zero(t, actual.Version)
-		assert.Nil(t, actual.BuildingAction)
+		assert.Empty(t, actual.BuildingQueue)
 	})
 
 	t.Run("assigns start amount for each resource", func(t *testing.T) {
 		u := sampleUniverse()
 
-		actual := u.CreatePlanet(playerId, false)
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		expected := []PlanetResource{
 			{
@@ -62,7 +67,8 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 	t.Run("assigns start storage for each resource", func(t *testing.T) {
 		u := sampleUniverse()
 
-		actual := u.CreatePlanet(playerId, false)
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		expected := []PlanetResourceStorage{
 			{
@@ -80,7 +86,8 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 	t.Run("assigns start production for each resource", func(t *testing.T) {
 		u := sampleUniverse()
 
-		actual := u.CreatePlanet(playerId, false)
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		expected := []PlanetResourceProduction{
 			{
@@ -95,18 +102,42 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 		assert.Equal(t, expected, actual.Productions)
 	})
 
+	t.Run("does not create resource, storage nor production for energy", func(t *testing.T) {
+		u := sampleUniverse()
+		u.Resources = append(u.Resources, Resource{
+			Id:              energyResourceId,
+			Name:            "energy",
+			StartAmount:     10,
+			StartStorage:    20,
+			StartProduction: 30,
+		})
+
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Len(t, actual.Resources, 2)
+		assert.Len(t, actual.Storages, 2)
+		assert.Len(t, actual.Productions, 2)
+		for _, pr := range actual.Productions {
+			assert.NotEqual(t, energyResourceId, pr.Resource)
+		}
+	})
+
 	t.Run("creates each building with level 0", func(t *testing.T) {
 		u := sampleUniverse()
 
-		actual := u.CreatePlanet(playerId, false)
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		expected := []PlanetBuilding{
 			{
 				Building: u.Buildings[0].Id,
+				Name:     u.Buildings[0].Name,
 				Level:    0,
 			},
 			{
 				Building: u.Buildings[1].Id,
+				Name:     u.Buildings[1].Name,
 				Level:    0,
 			},
 		}
@@ -126,7 +157,8 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 			},
 		}
 
-		actual := u.CreatePlanet(playerId, false)
+		actual, err := u.CreatePlanet(playerId, false)
+		require.NoError(t, err, "Actual err: %v", err)
 
 		expected := Coordinate{
 			Galaxy:      0,
@@ -135,6 +167,24 @@ func TestUnit_Universe_CreatePlanet(t *testing.T) {
 		}
 		assert.Equal(t, expected, actual.Coordinate)
 	})
+
+	t.Run("returns error when the universe is full", func(t *testing.T) {
+		u := sampleUniverse()
+		u.OccupancyMap = OccupancyMap{
+			Topology: UniverseTopology{
+				Galaxies:     1,
+				SolarSystems: 1,
+				Orbits:       1,
+			},
+			UsedSlots: map[Coordinate]struct{}{
+				{Galaxy: 0, SolarSystem: 0, Position: 0}: {},
+			},
+		}
+
+		_, err := u.CreatePlanet(playerId, false)
+
+		assert.ErrorIs(t, err, domainerrors.ErrUniverseFull, "Actual err: %v", err)
+	})
 }
 
 func sampleResources() []Resource {
@@ -190,11 +240,85 @@ func sampleOccupancyMap() OccupancyMap {
 	}
 }
 
+func TestUnit_Universe_StartingPlanet(t *testing.T) {
+	t.Run("creates planet at the requested time without coordinate", func(t *testing.T) {
+		u := sampleUniverse()
+		playerId := uuid.New()
+		createdAt := time.Date(2026, 7, 3, 6, 32, 27, 0, time.UTC)
+
+		actual := u.StartingPlanet(playerId, true, createdAt)
+
+		assert.Equal(t, playerId, actual.Player)
+		assert.Equal(t, createdAt, actual.CreatedAt)
+		assert.Equal(t, createdAt, actual.UpdatedAt)
+		assert.Zero(t, actual.Coordinate)
+		assert.Zero(t, actual.Fields)
+		assert.Equal(t, u.StartingResources(), actual.Resources)
+	})
+}
+
+func TestUnit_Universe_StartingResources(t *testing.T) {
+	t.Run("returns start amount of each resource", func(t *testing.T) {
+		u := sampleUniverse()
+
+		actual := u.StartingResources()
+
+		expected := []PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   145,
+			},
+			{
+				Resource: crystalResourceId,
+				Amount:   325,
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("does not return energy", func(t *testing.T) {
+		u := sampleUniverse()
+		u.Resources = append(u.Resources, Resource{
+			Id:          energyResourceId,
+			Name:        "energy",
+			StartAmount: 10,
+		})
+
+		actual := u.StartingResources()
+
+		assert.Len(t, actual, 2)
+	})
+}
+
+func TestUnit_UniverseTopology_ContainsSolarSystem(t *testing.T) {
+	topology := UniverseTopology{
+		Galaxies:     2,
+		SolarSystems: 3,
+		Orbits:       4,
+	}
+
+	t.Run("contains solar systems within bounds", func(t *testing.T) {
+		assert.True(t, topology.ContainsSolarSystem(0, 0))
+		assert.True(t, topology.ContainsSolarSystem(1, 2))
+	})
+
+	t.Run("does not contain galaxy out of bounds", func(t *testing.T) {
+		assert.False(t, topology.ContainsSolarSystem(-1, 0))
+		assert.False(t, topology.ContainsSolarSystem(2, 0))
+	})
+
+	t.Run("does not contain solar system out of bounds", func(t *testing.T) {
+		assert.False(t, topology.ContainsSolarSystem(0, -1))
+		assert.False(t, topology.ContainsSolarSystem(0, 3))
+	})
+}
+
 func sampleUniverse() Universe {
 	return Universe{
-		Id:           uuid.New(),
-		Resources:    sampleResources(),
-		Buildings:    sampleBuildings(),
-		OccupancyMap: sampleOccupancyMap(),
+		Id:                  uuid.New(),
+		Resources:           sampleResources(),
+		Buildings:           sampleBuildings(),
+		MaxPlanetsPerPlayer: 9,
+		OccupancyMap:        sampleOccupancyMap(),
 	}
 }
@@ -0,0 +1,13 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+// ForFetchingGameDataRoles resolves the game data some rules of the game
+// depend on from their name.
+type ForFetchingGameDataRoles interface {
+	GetRoles(ctx context.Context) (models.GameDataRoles, error)
+}
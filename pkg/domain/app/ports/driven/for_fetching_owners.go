@@ -0,0 +1,15 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ForFetchingOwners returns the api user owning each kind of resource.
+type ForFetchingOwners interface {
+	GetPlayerOwner(ctx context.Context, player uuid.UUID) (uuid.UUID, error)
+	GetPlanetOwner(ctx context.Context, planet uuid.UUID) (uuid.UUID, error)
+	GetFleetOwner(ctx context.Context, fleet uuid.UUID) (uuid.UUID, error)
+	GetMarketOfferOwner(ctx context.Context, offer uuid.UUID) (uuid.UUID, error)
+}
@@ -0,0 +1,13 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForFetchingShipyardUnits interface {
+	GetShip(ctx context.Context, id uuid.UUID) (models.Ship, error)
+	GetDefense(ctx context.Context, id uuid.UUID) (models.Defense, error)
+}
@@ -9,4 +9,5 @@ import (
 
 type ForFetchingBuilding interface {
 	Get(ctx context.Context, id uuid.UUID) (models.Building, error)
+	List(ctx context.Context) ([]models.Building, error)
 }
@@ -0,0 +1,25 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingAlliances interface {
+	// Create persists the alliance along with its members.
+	Create(ctx context.Context, alliance models.Alliance) error
+	Get(ctx context.Context, id uuid.UUID) (models.Alliance, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	CreateApplication(ctx context.Context, application models.AllianceApplication) error
+	GetApplication(ctx context.Context, id uuid.UUID) (models.AllianceApplication, error)
+	ListApplications(ctx context.Context, alliance uuid.UUID) ([]models.AllianceApplication, error)
+	DeleteApplication(ctx context.Context, id uuid.UUID) error
+
+	// AddMember also removes all the pending applications of the player.
+	AddMember(ctx context.Context, alliance uuid.UUID, member models.AllianceMember) error
+	UpdateMember(ctx context.Context, alliance uuid.UUID, member models.AllianceMember) error
+	RemoveMember(ctx context.Context, alliance uuid.UUID, player uuid.UUID) error
+}
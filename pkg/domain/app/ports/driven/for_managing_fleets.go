@@ -0,0 +1,15 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingFleets interface {
+	Get(ctx context.Context, id uuid.UUID) (models.Fleet, error)
+	ListForPlayer(ctx context.Context, player uuid.UUID) ([]models.Fleet, error)
+	// ListForPlanetOwner returns the fleets of the player owning the planet.
+	ListForPlanetOwner(ctx context.Context, planet uuid.UUID) ([]models.Fleet, error)
+}
@@ -0,0 +1,24 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+// ForManagingGameData edits the resources and buildings shared by all the
+// universes. Updating a building replaces its costs, productions and
+// storages. Importing a ruleset creates or updates all its game data at
+// once. New resources and buildings are added to the existing planets.
+type ForManagingGameData interface {
+	ListResources(ctx context.Context) ([]models.Resource, error)
+	GetResource(ctx context.Context, id uuid.UUID) (models.Resource, error)
+	CreateResource(ctx context.Context, resource models.Resource) error
+	UpdateResource(ctx context.Context, resource models.Resource) error
+	ListBuildings(ctx context.Context) ([]models.Building, error)
+	GetBuilding(ctx context.Context, id uuid.UUID) (models.Building, error)
+	CreateBuilding(ctx context.Context, building models.Building) error
+	UpdateBuilding(ctx context.Context, building models.Building) error
+	ImportRuleset(ctx context.Context, ruleset models.Ruleset) error
+}
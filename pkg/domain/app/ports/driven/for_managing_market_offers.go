@@ -0,0 +1,17 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+// ForManagingMarketOffers only reads the offers: they are created and
+// modified along with the planets exchanging the resources.
+type ForManagingMarketOffers interface {
+	Get(ctx context.Context, id uuid.UUID) (models.MarketOffer, error)
+	// ListForUniverse returns the open offers of the universe, the most
+	// recent first, starting at the offset.
+	ListForUniverse(ctx context.Context, universe uuid.UUID, offset int, limit int) ([]models.MarketOffer, error)
+}
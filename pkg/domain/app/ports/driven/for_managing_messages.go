@@ -0,0 +1,18 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingMessages interface {
+	Create(ctx context.Context, message models.Message) error
+	Get(ctx context.Context, id uuid.UUID) (models.Message, error)
+	// ListForPlayer returns the messages received by the player, the most
+	// recent first, starting at the offset.
+	ListForPlayer(ctx context.Context, player uuid.UUID, offset int, limit int) ([]models.Message, error)
+	MarkAsRead(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,18 @@
+package drivenports
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingPlanetEvents interface {
+	// ListForPlanet returns the events recorded for the planet, the most
+	// recent first, starting at the offset.
+	ListForPlanet(ctx context.Context, planet uuid.UUID, offset int, limit int) ([]models.PlanetEvent, error)
+	// ListForPlanetUntil returns the events recorded for the planet up to the
+	// moment (included), the oldest first.
+	ListForPlanetUntil(ctx context.Context, planet uuid.UUID, until time.Time) ([]models.PlanetEvent, error)
+}
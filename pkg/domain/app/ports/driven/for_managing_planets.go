@@ -3,10 +3,17 @@ package drivenports
 import (
 	"context"
 
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	"github.com/google/uuid"
 )
 
 type ForManagingPlanets interface {
 	ListForPlayer(ctx context.Context, player uuid.UUID) ([]uuid.UUID, error)
+	// GetAtCoordinate returns the planet located at the coordinate in the
+	// same universe as the origin planet.
+	GetAtCoordinate(ctx context.Context, origin uuid.UUID, coordinate models.Coordinate) (models.FleetTarget, error)
+	// Get returns the planet as stored, without bringing it up to date.
+	Get(ctx context.Context, id uuid.UUID) (models.Planet, error)
+	GetUniverse(ctx context.Context, planet uuid.UUID) (uuid.UUID, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
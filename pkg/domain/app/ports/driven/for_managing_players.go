@@ -10,6 +10,7 @@ import (
 type ForManagingPlayers interface {
 	Create(ctx context.Context, player models.Player, homeworld models.Planet) error
 	Get(ctx context.Context, id uuid.UUID) (models.Player, error)
+	Colonize(ctx context.Context, player models.Player, planet models.Planet) error
 	ListForApiUser(ctx context.Context, apiUser uuid.UUID) ([]models.Player, error)
 	Delete(ctx context.Context, player models.Player) error
 }
@@ -0,0 +1,22 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingRankings interface {
+	ListPlayers(ctx context.Context, universe uuid.UUID) ([]uuid.UUID, error)
+	// ListBuildingLevels aggregates the buildings of all the planets of the
+	// universe: planets sharing a building level for the same player are
+	// counted together.
+	ListBuildingLevels(ctx context.Context, universe uuid.UUID) ([]models.PlayerBuildingLevel, error)
+	// Replace atomically swaps the rankings of the universe for the input
+	// ones.
+	Replace(ctx context.Context, universe uuid.UUID, rankings []models.Ranking) error
+	// List returns the rankings of the universe by increasing rank, starting
+	// at the offset.
+	List(ctx context.Context, universe uuid.UUID, offset int, limit int) ([]models.Ranking, error)
+}
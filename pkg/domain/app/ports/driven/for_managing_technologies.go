@@ -0,0 +1,18 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingTechnologies interface {
+	Get(ctx context.Context, id uuid.UUID) (models.Technology, error)
+	// GetResearchForPlayer returns the technologies researched by the player
+	// along with the research action in progress if any.
+	GetResearchForPlayer(ctx context.Context, player uuid.UUID) (models.Research, error)
+	// ListLevelsForPlayer returns all the levels reached by the player for
+	// the technologies, ordered by the time at which they were reached.
+	ListLevelsForPlayer(ctx context.Context, player uuid.UUID) ([]models.PlayerTechnology, error)
+}
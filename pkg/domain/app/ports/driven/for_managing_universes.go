@@ -10,6 +10,18 @@ import (
 type ForManagingUniverses interface {
 	Create(ctx context.Context, universe models.Universe) error
 	Get(ctx context.Context, id uuid.UUID) (models.Universe, error)
+	// GetTopology returns the topology of the universe without loading its
+	// game data nor its occupancy.
+	GetTopology(ctx context.Context, id uuid.UUID) (models.UniverseTopology, error)
 	List(ctx context.Context) ([]models.Universe, error)
+	ListPlanetsInSolarSystem(
+		ctx context.Context,
+		universe uuid.UUID,
+		galaxy int,
+		solarSystem int,
+	) ([]models.SolarSystemPlanet, error)
+	// ListExchangeRatesForPlanet returns the exchange rates of the universe
+	// the planet belongs to.
+	ListExchangeRatesForPlanet(ctx context.Context, planet uuid.UUID) ([]models.ExchangeRate, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
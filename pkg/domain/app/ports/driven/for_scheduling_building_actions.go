@@ -0,0 +1,18 @@
+package drivenports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ForSchedulingBuildingActions interface {
+	// NextCompletion returns the earliest completion time among the building
+	// actions of all the planets. When no action is queued, ErrNotFound is
+	// returned.
+	NextCompletion(ctx context.Context) (time.Time, error)
+	// ListDuePlanets returns the planets having at least one building action
+	// completed at or before the provided time.
+	ListDuePlanets(ctx context.Context, until time.Time) ([]uuid.UUID, error)
+}
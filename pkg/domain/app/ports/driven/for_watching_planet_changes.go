@@ -0,0 +1,15 @@
+package drivenports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+// ForWatchingPlanetChanges delivers the changes committed to the planets
+// matching a topic. The returned channel is closed once the context is
+// done. Slow subscribers may miss changes rather than blocking the other
+// ones.
+type ForWatchingPlanetChanges interface {
+	Subscribe(ctx context.Context, topic models.PlanetChangeTopic) <-chan models.PlanetChange
+}
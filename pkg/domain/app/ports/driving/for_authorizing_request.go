@@ -0,0 +1,11 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+)
+
+type ForAuthorizingRequest interface {
+	CheckOwnership(ctx context.Context, req request.OwnershipRequest) error
+}
@@ -0,0 +1,17 @@
+package drivingports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ForCompletingActions interface {
+	// NextCompletion returns the delay until the earliest building action of
+	// all the planets completes. When no action is queued, ErrNotFound is
+	// returned.
+	NextCompletion(ctx context.Context) (time.Duration, error)
+	ListDuePlanets(ctx context.Context) ([]uuid.UUID, error)
+	Complete(ctx context.Context, planet uuid.UUID) error
+}
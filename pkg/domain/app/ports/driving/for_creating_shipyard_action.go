@@ -0,0 +1,12 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+)
+
+type ForCreatingShipyardAction interface {
+	Create(ctx context.Context, req request.ShipyardActionCreationRequest) (models.ShipyardAction, error)
+}
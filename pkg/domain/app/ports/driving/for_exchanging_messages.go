@@ -0,0 +1,17 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+type ForExchangingMessages interface {
+	Send(ctx context.Context, req request.MessageCreationRequest) (models.Message, error)
+	ListForPlayer(ctx context.Context, player uuid.UUID, page request.PageRequest) ([]models.Message, error)
+	// MarkAsRead and Delete only affect messages received by the player.
+	MarkAsRead(ctx context.Context, player uuid.UUID, message uuid.UUID) error
+	Delete(ctx context.Context, player uuid.UUID, message uuid.UUID) error
+}
@@ -0,0 +1,13 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+type ForListingPlanetHistory interface {
+	ListHistory(ctx context.Context, planet uuid.UUID, page request.PageRequest) ([]models.PlanetEvent, error)
+}
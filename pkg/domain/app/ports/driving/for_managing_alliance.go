@@ -0,0 +1,25 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+// ForManagingAlliance identifies the player performing the operation: the
+// rank of this player in the alliance defines what is allowed.
+type ForManagingAlliance interface {
+	Create(ctx context.Context, req request.AllianceCreationRequest) (models.Alliance, error)
+	Get(ctx context.Context, id uuid.UUID) (models.Alliance, error)
+	Disband(ctx context.Context, alliance uuid.UUID, player uuid.UUID) error
+
+	Apply(ctx context.Context, alliance uuid.UUID, player uuid.UUID) (models.AllianceApplication, error)
+	ListApplications(ctx context.Context, alliance uuid.UUID, player uuid.UUID) ([]models.AllianceApplication, error)
+	AcceptApplication(ctx context.Context, alliance uuid.UUID, application uuid.UUID, player uuid.UUID) error
+	RejectApplication(ctx context.Context, alliance uuid.UUID, application uuid.UUID, player uuid.UUID) error
+
+	SetRank(ctx context.Context, req request.AllianceRankRequest) error
+	Leave(ctx context.Context, alliance uuid.UUID, player uuid.UUID) error
+}
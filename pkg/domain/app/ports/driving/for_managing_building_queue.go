@@ -0,0 +1,14 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingBuildingQueue interface {
+	List(ctx context.Context, planet uuid.UUID) ([]models.BuildingAction, error)
+	Reorder(ctx context.Context, planet uuid.UUID, order []uuid.UUID) ([]models.BuildingAction, error)
+	Cancel(ctx context.Context, planet uuid.UUID, action uuid.UUID) error
+}
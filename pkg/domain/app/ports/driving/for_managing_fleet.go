@@ -0,0 +1,13 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForManagingFleet interface {
+	ListForPlayer(ctx context.Context, player uuid.UUID) ([]models.Fleet, error)
+	Recall(ctx context.Context, id uuid.UUID) (models.Fleet, error)
+}
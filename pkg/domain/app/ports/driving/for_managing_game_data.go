@@ -0,0 +1,19 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+type ForManagingGameData interface {
+	ListResources(ctx context.Context) ([]models.Resource, error)
+	CreateResource(ctx context.Context, req request.ResourceRequest) (models.Resource, error)
+	UpdateResource(ctx context.Context, id uuid.UUID, req request.ResourceRequest) (models.Resource, error)
+	ListBuildings(ctx context.Context) ([]models.Building, error)
+	CreateBuilding(ctx context.Context, req request.BuildingRequest) (models.Building, error)
+	UpdateBuilding(ctx context.Context, id uuid.UUID, req request.BuildingRequest) (models.Building, error)
+	ImportRuleset(ctx context.Context, req request.RulesetRequest) error
+}
@@ -0,0 +1,16 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+type ForManagingMarketOffer interface {
+	Post(ctx context.Context, req request.MarketOfferCreationRequest) (models.MarketOffer, error)
+	ListForUniverse(ctx context.Context, universe uuid.UUID, page request.PageRequest) ([]models.MarketOffer, error)
+	Accept(ctx context.Context, req request.MarketOfferAcceptanceRequest) (models.MarketOffer, error)
+	Cancel(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,14 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+type ForManagingResearch interface {
+	Get(ctx context.Context, player uuid.UUID) (models.Research, error)
+	Create(ctx context.Context, req request.ResearchActionCreationRequest) (models.ResearchAction, error)
+}
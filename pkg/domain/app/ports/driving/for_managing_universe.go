@@ -12,5 +12,6 @@ type ForManagingUniverse interface {
 	Create(ctx context.Context, req request.UniverseCreationRequest) (models.Universe, error)
 	Get(ctx context.Context, id uuid.UUID) (models.Universe, error)
 	List(ctx context.Context) ([]models.Universe, error)
+	GetSolarSystem(ctx context.Context, universe uuid.UUID, galaxy int, solarSystem int) (models.SolarSystem, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,12 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForPreviewingBuildingUpgrades interface {
+	Preview(ctx context.Context, planet uuid.UUID, building uuid.UUID, levels int) ([]models.BuildingLevelPreview, error)
+}
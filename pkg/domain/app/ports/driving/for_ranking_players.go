@@ -0,0 +1,15 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/google/uuid"
+)
+
+type ForRankingPlayers interface {
+	// Refresh recomputes the rankings of all the universes.
+	Refresh(ctx context.Context) error
+	List(ctx context.Context, universe uuid.UUID, page request.PageRequest) ([]models.Ranking, error)
+}
@@ -0,0 +1,15 @@
+package drivingports
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForReconstructingPlanet interface {
+	// Reconstruct rebuilds the planet at the moment from its event log. A
+	// zero moment stands for the current time.
+	Reconstruct(ctx context.Context, planet uuid.UUID, moment time.Time) (models.PlanetReconstruction, error)
+}
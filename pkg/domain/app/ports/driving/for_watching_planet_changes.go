@@ -0,0 +1,17 @@
+package drivingports
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+type ForWatchingPlanetChanges interface {
+	WatchPlayer(ctx context.Context, player uuid.UUID) (<-chan models.PlanetChange, error)
+	WatchPlanet(ctx context.Context, planet uuid.UUID) (<-chan models.PlanetChange, error)
+	// WatchUniverse only reports the planets created in and deleted from
+	// the universe: the activity of the players on their planets is not
+	// public.
+	WatchUniverse(ctx context.Context, universe uuid.UUID) (<-chan models.PlanetChange, error)
+}
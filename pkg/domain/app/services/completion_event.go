@@ -0,0 +1,184 @@
+package domainservices
+
+import (
+	"slices"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+)
+
+// completionApplier modifies the planet to reflect the completion of an
+// event. The planet is guaranteed to be up to date with the completion
+// time of the event when the applier is called.
+type completionApplier func(planet *models.Planet) error
+
+type completionEvent struct {
+	completedAt time.Time
+	apply       completionApplier
+}
+
+// eventCollector inspects the planet and returns the completion events
+// it knows about. Each kind of event (building action, etc.) has its own
+// collector.
+type eventCollector func(planet *models.Planet) []completionEvent
+
+var eventCollectors = []eventCollector{
+	collectBuildingActionEvents,
+	collectResearchActionEvents,
+	collectTechnologyUpgradeEvents,
+	collectShipyardEvents,
+	collectFleetEvents,
+	collectMarketOfferEvents,
+}
+
+// generateTimeline returns all completion events registered for the planet
+// ordered by completion time. Events completing at the same time are kept
+// in the order of the collectors.
+func generateTimeline(planet *models.Planet) []completionEvent {
+	var timeline []completionEvent
+
+	for _, collector := range eventCollectors {
+		timeline = append(timeline, collector(planet)...)
+	}
+
+	slices.SortStableFunc(timeline, func(e1 completionEvent, e2 completionEvent) int {
+		return e1.completedAt.Compare(e2.completedAt)
+	})
+
+	return timeline
+}
+
+// collectBuildingActionEvents only returns the completion of the first
+// action of the queue: the following ones are collected once it has been
+// applied.
+func collectBuildingActionEvents(planet *models.Planet) []completionEvent {
+	if len(planet.BuildingQueue) == 0 {
+		return nil
+	}
+
+	event := completionEvent{
+		completedAt: planet.BuildingQueue[0].CompletedAt,
+		apply:       applyBuildingAction,
+	}
+
+	return []completionEvent{event}
+}
+
+func applyBuildingAction(planet *models.Planet) error {
+	return planet.ApplyAction()
+}
+
+func collectResearchActionEvents(planet *models.Planet) []completionEvent {
+	if planet.ResearchAction == nil {
+		return nil
+	}
+
+	event := completionEvent{
+		completedAt: planet.ResearchAction.CompletedAt,
+		apply:       applyResearchAction,
+	}
+
+	return []completionEvent{event}
+}
+
+func applyResearchAction(planet *models.Planet) error {
+	return planet.ApplyResearchAction()
+}
+
+// collectTechnologyUpgradeEvents only returns the first level reached on
+// another planet: the following ones are collected once it has been
+// applied.
+func collectTechnologyUpgradeEvents(planet *models.Planet) []completionEvent {
+	if len(planet.TechnologyUpgrades) == 0 {
+		return nil
+	}
+
+	event := completionEvent{
+		completedAt: planet.TechnologyUpgrades[0].ReachedAt,
+		apply:       applyTechnologyUpgrade,
+	}
+
+	return []completionEvent{event}
+}
+
+func applyTechnologyUpgrade(planet *models.Planet) error {
+	return planet.ApplyTechnologyUpgrade()
+}
+
+// collectShipyardEvents only returns the completion of the next unit: the
+// following ones are collected once it has been applied.
+func collectShipyardEvents(planet *models.Planet) []completionEvent {
+	if len(planet.ShipyardQueue) == 0 {
+		return nil
+	}
+
+	event := completionEvent{
+		completedAt: planet.ShipyardQueue[0].NextUnitCompletedAt(),
+		apply:       applyShipyardUnit,
+	}
+
+	return []completionEvent{event}
+}
+
+func applyShipyardUnit(planet *models.Planet) error {
+	return planet.ApplyShipyardUnit()
+}
+
+// collectFleetEvents only considers the fleets whose next event should be
+// applied to the planet: the other ones are handled by the planet at the
+// other end of their path.
+func collectFleetEvents(planet *models.Planet) []completionEvent {
+	var events []completionEvent
+
+	for _, fleet := range planet.Fleets {
+		if !planet.HandlesFleetEvent(fleet) {
+			continue
+		}
+
+		event := completionEvent{
+			completedAt: fleet.NextEventAt(),
+			apply:       generateFleetApplier(fleet),
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func generateFleetApplier(fleet models.Fleet) completionApplier {
+	return func(planet *models.Planet) error {
+		if fleet.Returning() {
+			return planet.ApplyFleetReturn(fleet.Id)
+		}
+		return planet.ApplyFleetArrival(fleet.Id)
+	}
+}
+
+// collectMarketOfferEvents only considers the offers posted from the planet:
+// the ones accepted from it do not require any further action.
+func collectMarketOfferEvents(planet *models.Planet) []completionEvent {
+	var events []completionEvent
+
+	for _, offer := range planet.MarketOffers {
+		if !planet.HandlesMarketOfferEvent(offer) {
+			continue
+		}
+
+		event := completionEvent{
+			completedAt: offer.NextEventAt(),
+			apply:       generateMarketOfferApplier(offer),
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func generateMarketOfferApplier(offer models.MarketOffer) completionApplier {
+	return func(planet *models.Planet) error {
+		if offer.Status == models.AcceptedOffer {
+			return planet.SettleMarketOffer(offer.Id)
+		}
+		return planet.ExpireMarketOffer(offer.Id)
+	}
+}
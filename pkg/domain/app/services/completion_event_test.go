@@ -0,0 +1,197 @@
+package domainservices
+
+import (
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_GenerateTimeline(t *testing.T) {
+	t.Run("returns empty timeline when nothing is in progress", func(t *testing.T) {
+		p := generateTestPlanet()
+
+		actual := generateTimeline(&p)
+
+		assert.Empty(t, actual)
+	})
+
+	t.Run("returns building action completion", func(t *testing.T) {
+		p := generateTestPlanet()
+		action := generateTestBuildingAction(p)
+		p.BuildingQueue = []models.BuildingAction{action}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, t3, actual[0].completedAt)
+	})
+
+	t.Run("returns first queued building action completion only", func(t *testing.T) {
+		p := generateTestPlanet()
+		first := generateTestBuildingAction(p)
+		second := generateTestBuildingAction(p)
+		second.CompletedAt = t4
+		p.BuildingQueue = []models.BuildingAction{first, second}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, t3, actual[0].completedAt)
+	})
+
+	t.Run("building action event applies the action", func(t *testing.T) {
+		p := generateTestPlanet()
+		action := generateTestBuildingAction(p)
+		action.CompletedAt = p.UpdatedAt
+		p.BuildingQueue = []models.BuildingAction{action}
+
+		timeline := generateTimeline(&p)
+		require.Len(t, timeline, 1)
+
+		err := timeline[0].apply(&p)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.BuildingQueue)
+		assert.Empty(t, generateTimeline(&p))
+	})
+
+	t.Run("research action event raises the technology level", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.ResearchAction = &models.ResearchAction{
+			Technology:   technologyId,
+			DesiredLevel: 2,
+			CompletedAt:  p.UpdatedAt,
+		}
+
+		timeline := generateTimeline(&p)
+		require.Len(t, timeline, 1)
+
+		err := timeline[0].apply(&p)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Nil(t, p.ResearchAction)
+		expected := []models.PlayerTechnology{
+			{Technology: technologyId, Level: 2, ReachedAt: p.UpdatedAt},
+		}
+		assert.Equal(t, expected, p.Technologies)
+	})
+
+	t.Run("returns next shipyard unit completion only", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.ShipyardQueue = []models.ShipyardAction{
+			generateTestShipyardAction(),
+			generateTestShipyardAction(),
+		}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, t2, actual[0].completedAt)
+	})
+
+	t.Run("sorts events by completion time", func(t *testing.T) {
+		p := generateTestPlanet()
+		action := generateTestBuildingAction(p)
+		p.BuildingQueue = []models.BuildingAction{action}
+		p.ShipyardQueue = []models.ShipyardAction{generateTestShipyardAction()}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, t2, actual[0].completedAt)
+		assert.Equal(t, t3, actual[1].completedAt)
+	})
+	t.Run("returns arrival of fleets targeting the planet", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.Fleets = []models.Fleet{generateTestFleet(uuid.New(), p.Id)}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, t2, actual[0].completedAt)
+	})
+
+	t.Run("ignores arrival of fleets sent from the planet", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.Fleets = []models.Fleet{generateTestFleet(p.Id, uuid.New())}
+
+		actual := generateTimeline(&p)
+
+		assert.Empty(t, actual)
+	})
+
+	t.Run("returns return of fleets sent from the planet", func(t *testing.T) {
+		p := generateTestPlanet()
+		fleet := generateTestFleet(p.Id, uuid.New())
+		fleet.ReturnsAt = &t3
+		p.Fleets = []models.Fleet{fleet}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, t3, actual[0].completedAt)
+	})
+
+	t.Run("fleet return event brings back the ships", func(t *testing.T) {
+		p := generateTestPlanet()
+		fleet := generateTestFleet(p.Id, uuid.New())
+		fleet.ReturnsAt = &t1
+		p.Fleets = []models.Fleet{fleet}
+
+		timeline := generateTimeline(&p)
+		require.Len(t, timeline, 1)
+
+		err := timeline[0].apply(&p)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.Fleets)
+		expectedShips := []models.PlanetShip{
+			{Ship: shipId, Count: 4},
+		}
+		assert.Equal(t, expectedShips, p.Ships)
+	})
+
+	t.Run("returns expiration of market offers posted from the planet", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.MarketOffers = []models.MarketOffer{generateTestMarketOffer(p.Id)}
+
+		actual := generateTimeline(&p)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, t3, actual[0].completedAt)
+	})
+
+	t.Run("ignores market offers accepted from the planet", func(t *testing.T) {
+		p := generateTestPlanet()
+		offer := generateTestMarketOffer(uuid.New())
+		offer.Status = models.AcceptedOffer
+		offer.AcceptedAt = &t1
+		p.MarketOffers = []models.MarketOffer{offer}
+
+		actual := generateTimeline(&p)
+
+		assert.Empty(t, actual)
+	})
+
+	t.Run("market offer settlement event credits the seller", func(t *testing.T) {
+		p := generateTestPlanet()
+		offer := generateTestMarketOffer(p.Id)
+		offer.Status = models.AcceptedOffer
+		offer.AcceptedAt = &t1
+		p.MarketOffers = []models.MarketOffer{offer}
+
+		timeline := generateTimeline(&p)
+		require.Len(t, timeline, 1)
+		assert.Equal(t, t1, timeline[0].completedAt)
+
+		err := timeline[0].apply(&p)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, models.SettledOffer, p.MarketOffers[0].Status)
+		assert.Equal(t, 2300.0, p.Resources[1].Amount)
+	})
+}
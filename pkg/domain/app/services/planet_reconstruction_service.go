@@ -0,0 +1,377 @@
+package domainservices
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+)
+
+// amountPrecision is the precision with which the database stores the
+// amounts of resources and the deltas of the events.
+const amountPrecision = 1e-5
+
+// ReconstructPlanet rebuilds the planet at the input moment by replaying
+// its events (sorted by creation time) onto the planet as created by its
+// universe. Between two events, the rebuilt planet is advanced with
+// AdvancePlanetToTime: it produces resources and completes its actions on
+// its own. The events then add the actions created, remove the cancelled
+// ones and reschedule the others. Only the changes of the resources which
+// are not explained by the actions nor by the production (trades, fleets,
+// etc.) are taken from the events.
+// The technologies are the levels reached by the player: the ones reached
+// on other planets apply from the time they were reached, as when the
+// planet is advanced.
+// The fleets, the market offers and the fields are not rebuilt and the
+// universe provides its current game data: they show up as divergences
+// when they change the resources.
+// When the moment is not older than the last update of the stored planet,
+// the rebuilt planet is compared to it before being brought to the moment.
+func ReconstructPlanet(
+	universe models.Universe,
+	stored models.Planet,
+	events []models.PlanetEvent,
+	technologies []models.PlayerTechnology,
+	moment time.Time,
+) (models.PlanetReconstruction, error) {
+	rebuilt := universe.StartingPlanet(stored.Player, stored.Homeworld, stored.CreatedAt)
+	rebuilt.Id = stored.Id
+	rebuilt.Roles = stored.Roles
+	rebuilt.Technologies, rebuilt.TechnologyUpgrades = splitTechnologyLevels(technologies, stored.CreatedAt)
+
+	out := models.PlanetReconstruction{
+		Planet: stored.Id,
+		At:     stored.CreatedAt,
+	}
+
+	for _, event := range events {
+		if event.CreatedAt.After(moment) {
+			break
+		}
+
+		if event.VersionBefore != out.Version {
+			out.Divergences = append(out.Divergences, models.PlanetDivergence{
+				Kind:    models.VersionGapDivergence,
+				At:      event.CreatedAt,
+				Rebuilt: float64(out.Version),
+				Stored:  float64(event.VersionBefore),
+			})
+		}
+
+		if err := AdvancePlanetToTime(&rebuilt, event.CreatedAt); err != nil {
+			return models.PlanetReconstruction{}, err
+		}
+
+		out.Divergences = append(out.Divergences, replayEvent(&rebuilt, event)...)
+
+		out.Version = event.VersionAfter
+		out.At = event.CreatedAt
+		out.Events++
+	}
+
+	if !moment.Before(stored.UpdatedAt) {
+		if err := AdvancePlanetToTime(&rebuilt, stored.UpdatedAt); err != nil {
+			return models.PlanetReconstruction{}, err
+		}
+
+		// Each event may be off by the precision of the database.
+		tolerance := amountPrecision * float64(out.Events+1)
+		out.Divergences = append(out.Divergences, comparePlanets(out.Version, rebuilt, stored, tolerance)...)
+	}
+
+	if err := AdvancePlanetToTime(&rebuilt, moment); err != nil {
+		return models.PlanetReconstruction{}, err
+	}
+
+	out.At = moment
+	out.Resources = rebuilt.Resources
+
+	return out, nil
+}
+
+// splitTechnologyLevels separates the highest levels reached by the player
+// when the planet was created from the ones reached afterwards. The levels
+// are expected to be ordered by the time at which they were reached.
+func splitTechnologyLevels(
+	technologies []models.PlayerTechnology,
+	createdAt time.Time,
+) ([]models.PlayerTechnology, []models.PlayerTechnology) {
+	var reached, upgrades []models.PlayerTechnology
+
+	for _, technology := range technologies {
+		if technology.ReachedAt.After(createdAt) {
+			upgrades = append(upgrades, technology)
+			continue
+		}
+
+		id := slices.IndexFunc(reached, func(t models.PlayerTechnology) bool {
+			return t.Technology == technology.Technology
+		})
+		if id < 0 {
+			reached = append(reached, technology)
+		} else if technology.Level > reached[id].Level {
+			reached[id] = technology
+		}
+	}
+
+	return reached, upgrades
+}
+
+// replayEvent applies the event to the planet, which is expected to be up
+// to date with the time of the event. The divergences found along the way
+// are returned.
+func replayEvent(planet *models.Planet, event models.PlanetEvent) []models.PlanetDivergence {
+	var out []models.PlanetDivergence
+
+	var action uuid.UUID
+	if event.Action != nil {
+		action = *event.Action
+	}
+
+	switch {
+	case event.Kind == models.PlanetActionApplied:
+		// The planet completed the action on its own: it should not be
+		// pending anymore.
+		if findPendingAction(planet, action) {
+			out = append(out, newActionDivergence(action, event.CreatedAt, 1, 0))
+		}
+	case event.Kind == models.PlanetActionCreated:
+		addPendingAction(planet, event.ActionState)
+		applyResourceDeltas(planet, event.Resources)
+	case event.Kind == models.PlanetActionCancelled:
+		if !removePendingAction(planet, action) {
+			out = append(out, newActionDivergence(action, event.CreatedAt, 0, 1))
+		}
+		applyResourceDeltas(planet, event.Resources)
+	case event.Kind == models.PlanetUpdated && event.Action != nil:
+		if !removePendingAction(planet, action) {
+			out = append(out, newActionDivergence(action, event.CreatedAt, 0, 1))
+		}
+		addPendingAction(planet, event.ActionState)
+	default:
+		// The production is computed by the planet itself.
+		deltas := make([]models.PlanetEventResource, 0, len(event.Resources))
+		for _, resource := range event.Resources {
+			resource.Delta -= resource.Produced
+			deltas = append(deltas, resource)
+		}
+		applyResourceDeltas(planet, deltas)
+	}
+
+	return out
+}
+
+func findPendingAction(planet *models.Planet, action uuid.UUID) bool {
+	return slices.Contains(pendingActions(*planet), action)
+}
+
+// addPendingAction inserts the action in the queues of the planet. The
+// queues are kept ordered by completion time, as the planet processes
+// them in this order.
+func addPendingAction(planet *models.Planet, state *models.PlanetEventAction) {
+	if state == nil {
+		return
+	}
+
+	if state.Building != nil {
+		planet.BuildingQueue = append(planet.BuildingQueue, *state.Building)
+		slices.SortStableFunc(planet.BuildingQueue, func(lhs models.BuildingAction, rhs models.BuildingAction) int {
+			return lhs.CompletedAt.Compare(rhs.CompletedAt)
+		})
+	}
+	if state.Shipyard != nil {
+		planet.ShipyardQueue = append(planet.ShipyardQueue, *state.Shipyard)
+		slices.SortStableFunc(planet.ShipyardQueue, func(lhs models.ShipyardAction, rhs models.ShipyardAction) int {
+			return lhs.CompletedAt.Compare(rhs.CompletedAt)
+		})
+	}
+	if state.Research != nil {
+		action := *state.Research
+		planet.ResearchAction = &action
+	}
+}
+
+func removePendingAction(planet *models.Planet, action uuid.UUID) bool {
+	found := findPendingAction(planet, action)
+
+	planet.BuildingQueue = slices.DeleteFunc(planet.BuildingQueue, func(a models.BuildingAction) bool {
+		return a.Id == action
+	})
+	planet.ShipyardQueue = slices.DeleteFunc(planet.ShipyardQueue, func(a models.ShipyardAction) bool {
+		return a.Id == action
+	})
+	if planet.ResearchAction != nil && planet.ResearchAction.Id == action {
+		planet.ResearchAction = nil
+	}
+
+	return found
+}
+
+func pendingActions(planet models.Planet) []uuid.UUID {
+	var out []uuid.UUID
+	for _, action := range planet.BuildingQueue {
+		out = append(out, action.Id)
+	}
+	for _, action := range planet.ShipyardQueue {
+		out = append(out, action.Id)
+	}
+	if planet.ResearchAction != nil {
+		out = append(out, planet.ResearchAction.Id)
+	}
+
+	return out
+}
+
+func applyResourceDeltas(planet *models.Planet, deltas []models.PlanetEventResource) {
+	for _, delta := range deltas {
+		id := slices.IndexFunc(planet.Resources, func(resource models.PlanetResource) bool {
+			return resource.Resource == delta.Resource
+		})
+
+		if id < 0 {
+			planet.Resources = append(planet.Resources, models.PlanetResource{
+				Resource: delta.Resource,
+				Amount:   delta.Delta,
+			})
+		} else {
+			planet.Resources[id].Amount += delta.Delta
+		}
+	}
+}
+
+func newActionDivergence(action uuid.UUID, at time.Time, rebuilt float64, stored float64) models.PlanetDivergence {
+	return models.PlanetDivergence{
+		Kind:    models.ActionDivergence,
+		Action:  action,
+		At:      at,
+		Rebuilt: rebuilt,
+		Stored:  stored,
+	}
+}
+
+func comparePlanets(
+	version int,
+	rebuilt models.Planet,
+	stored models.Planet,
+	tolerance float64,
+) []models.PlanetDivergence {
+	var out []models.PlanetDivergence
+
+	if version != stored.Version {
+		out = append(out, models.PlanetDivergence{
+			Kind:    models.VersionDivergence,
+			At:      stored.UpdatedAt,
+			Rebuilt: float64(version),
+			Stored:  float64(stored.Version),
+		})
+	}
+
+	out = append(out, compareResources(rebuilt, stored, tolerance)...)
+	out = append(out, compareBuildings(rebuilt, stored)...)
+	out = append(out, compareActions(rebuilt, stored)...)
+
+	return out
+}
+
+func compareResources(
+	rebuilt models.Planet,
+	stored models.Planet,
+	tolerance float64,
+) []models.PlanetDivergence {
+	var out []models.PlanetDivergence
+
+	storedAmounts := make(map[uuid.UUID]float64)
+	for _, resource := range stored.Resources {
+		storedAmounts[resource.Resource] = resource.Amount
+	}
+	rebuiltAmounts := make(map[uuid.UUID]float64)
+	for _, resource := range rebuilt.Resources {
+		rebuiltAmounts[resource.Resource] = resource.Amount
+	}
+
+	// Iterate over the slices rather than the maps to report divergences in
+	// a stable order. A resource missing on one side counts as 0.
+	compared := make(map[uuid.UUID]bool)
+	for _, resources := range [][]models.PlanetResource{stored.Resources, rebuilt.Resources} {
+		for _, resource := range resources {
+			if compared[resource.Resource] {
+				continue
+			}
+			compared[resource.Resource] = true
+
+			diff := rebuiltAmounts[resource.Resource] - storedAmounts[resource.Resource]
+			if math.Abs(diff) <= tolerance {
+				continue
+			}
+
+			out = append(out, models.PlanetDivergence{
+				Kind:     models.ResourceDivergence,
+				Resource: resource.Resource,
+				At:       stored.UpdatedAt,
+				Rebuilt:  rebuiltAmounts[resource.Resource],
+				Stored:   storedAmounts[resource.Resource],
+			})
+		}
+	}
+
+	return out
+}
+
+func compareBuildings(rebuilt models.Planet, stored models.Planet) []models.PlanetDivergence {
+	var out []models.PlanetDivergence
+
+	storedLevels := make(map[uuid.UUID]int)
+	for _, building := range stored.Buildings {
+		storedLevels[building.Building] = building.Level
+	}
+	rebuiltLevels := make(map[uuid.UUID]int)
+	for _, building := range rebuilt.Buildings {
+		rebuiltLevels[building.Building] = building.Level
+	}
+
+	compared := make(map[uuid.UUID]bool)
+	for _, buildings := range [][]models.PlanetBuilding{stored.Buildings, rebuilt.Buildings} {
+		for _, building := range buildings {
+			if compared[building.Building] {
+				continue
+			}
+			compared[building.Building] = true
+
+			if rebuiltLevels[building.Building] == storedLevels[building.Building] {
+				continue
+			}
+
+			out = append(out, models.PlanetDivergence{
+				Kind:     models.BuildingDivergence,
+				Building: building.Building,
+				At:       stored.UpdatedAt,
+				Rebuilt:  float64(rebuiltLevels[building.Building]),
+				Stored:   float64(storedLevels[building.Building]),
+			})
+		}
+	}
+
+	return out
+}
+
+func compareActions(rebuilt models.Planet, stored models.Planet) []models.PlanetDivergence {
+	var out []models.PlanetDivergence
+
+	storedActions := pendingActions(stored)
+	rebuiltActions := pendingActions(rebuilt)
+
+	for _, action := range storedActions {
+		if !slices.Contains(rebuiltActions, action) {
+			out = append(out, newActionDivergence(action, stored.UpdatedAt, 0, 1))
+		}
+	}
+	for _, action := range rebuiltActions {
+		if !slices.Contains(storedActions, action) {
+			out = append(out, newActionDivergence(action, stored.UpdatedAt, 1, 0))
+		}
+	}
+
+	return out
+}
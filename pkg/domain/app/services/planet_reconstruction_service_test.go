@@ -0,0 +1,492 @@
+package domainservices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit_ReconstructPlanet(t *testing.T) {
+	universe := models.Universe{
+		Resources: []models.Resource{
+			{Id: metalResourceId, StartAmount: 500, StartStorage: 10000, StartProduction: 100},
+			{Id: crystalResourceId, StartAmount: 500, StartStorage: 10000},
+		},
+		Buildings: []models.Building{
+			{Id: crystalMineId},
+		},
+	}
+
+	t.Run("replays events through the timeline of the planet", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.PlanetReconstruction{
+			Planet:  p.Id,
+			At:      t3,
+			Version: 2,
+			Events:  3,
+			Resources: []models.PlanetResource{
+				{Resource: metalResourceId, Amount: 720},
+				{Resource: crystalResourceId, Amount: 550},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("ignores events after the moment", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		events := generateTestPlanetEvents(p)
+		moment := t1.Add(30 * time.Minute)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, moment)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, moment, actual.At)
+		assert.Equal(t, 1, actual.Version)
+		assert.Equal(t, 1, actual.Events)
+		expected := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 550},
+			{Resource: crystalResourceId, Amount: 500},
+		}
+		assert.Equal(t, expected, actual.Resources)
+		assert.Empty(t, actual.Divergences)
+	})
+
+	t.Run("returns starting resources when no event happened before the moment", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, p.CreatedAt)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, p.CreatedAt, actual.At)
+		assert.Zero(t, actual.Version)
+		assert.Zero(t, actual.Events)
+		assert.Equal(t, universe.StartingResources(), actual.Resources)
+	})
+
+	t.Run("replays cancelled action", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		events := generateTestPlanetEvents(p)
+		cancelledAt := t1.Add(30 * time.Minute)
+		events = []models.PlanetEvent{
+			events[0],
+			{
+				Id:            uuid.New(),
+				Planet:        p.Id,
+				Kind:          models.PlanetActionCancelled,
+				Action:        events[0].Action,
+				ActionState:   events[0].ActionState,
+				VersionBefore: 1,
+				VersionAfter:  2,
+				Resources: []models.PlanetEventResource{
+					{Resource: metalResourceId, Delta: 100},
+				},
+				CreatedAt: cancelledAt,
+			},
+		}
+		p.UpdatedAt = cancelledAt
+		p.Buildings[0].Level = 0
+		p.Resources[0].Amount = 650
+		p.Resources[1].Amount = 500
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, cancelledAt)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual.Divergences)
+		expected := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 650},
+			{Resource: crystalResourceId, Amount: 500},
+		}
+		assert.Equal(t, expected, actual.Resources)
+	})
+
+	t.Run("replays technology levels reached on other planets from the time they were reached", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		p.Roles = models.GameDataRoles{EnergyTechnology: technologyId}
+		events := generateTestPlanetEvents(p)
+		events[0].ActionState.Building.Productions = []models.BuildingActionResourceProduction{
+			{Resource: crystalResourceId, Production: 100},
+		}
+		technologies := []models.PlayerTechnology{
+			{Technology: technologyId, Level: 10, ReachedAt: t2},
+		}
+
+		actual, err := ReconstructPlanet(universe, p, events, technologies, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		// The crystal mine completed at t2 gets the 10% bonus of the
+		// energy technology for its whole production.
+		assert.Empty(t, actual.Divergences)
+		expected := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 720},
+			{Resource: crystalResourceId, Amount: 660},
+		}
+		assert.Equal(t, expected, actual.Resources)
+	})
+
+	t.Run("replays rescheduled action", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		events := generateTestPlanetEvents(p)
+		rescheduled := *events[0].ActionState.Building
+		rescheduled.CompletedAt = t3
+		events = []models.PlanetEvent{
+			events[0],
+			{
+				Id:            uuid.New(),
+				Planet:        p.Id,
+				Kind:          models.PlanetUpdated,
+				Action:        events[0].Action,
+				ActionState:   &models.PlanetEventAction{Building: &rescheduled},
+				VersionBefore: 1,
+				VersionAfter:  2,
+				CreatedAt:     t1.Add(30 * time.Minute),
+			},
+		}
+		p.Buildings[0].Level = 0
+		p.BuildingQueue = []models.BuildingAction{rescheduled}
+		p.Resources[0].Amount = 600
+		p.Resources[1].Amount = 500
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual.Divergences)
+	})
+
+	t.Run("replays completed research and fleet arrival", func(t *testing.T) {
+		p := universe.StartingPlanet(uuid.New(), true, t1.Add(-time.Hour))
+		p.UpdatedAt = t2
+		p.Version = 2
+		p.Resources = []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 800},
+			{Resource: crystalResourceId, Amount: 550},
+		}
+		p.Technologies = []models.PlayerTechnology{
+			{Technology: technologyId, Level: 1},
+		}
+		// The fleet flies back to its origin: it is not rebuilt.
+		returnsAt := t4
+		p.Fleets = []models.Fleet{
+			{
+				Id:        uuid.New(),
+				Mission:   models.TransportMission,
+				Target:    p.Id,
+				ArrivesAt: t2,
+				ReturnsAt: &returnsAt,
+			},
+		}
+
+		research := models.ResearchAction{
+			Id:           uuid.New(),
+			Planet:       p.Id,
+			Technology:   technologyId,
+			DesiredLevel: 1,
+			Costs: []models.ResearchActionCost{
+				{Resource: metalResourceId, Amount: 200},
+			},
+			CreatedAt:   t1,
+			CompletedAt: t2,
+		}
+		events := []models.PlanetEvent{
+			{
+				Id:            uuid.New(),
+				Planet:        p.Id,
+				Kind:          models.PlanetActionCreated,
+				Action:        &research.Id,
+				ActionState:   &models.PlanetEventAction{Research: &research},
+				VersionBefore: 0,
+				VersionAfter:  1,
+				Resources: []models.PlanetEventResource{
+					{Resource: metalResourceId, Delta: -200},
+				},
+				CreatedAt: t1,
+			},
+			{
+				Id:            uuid.New(),
+				Planet:        p.Id,
+				Kind:          models.PlanetActionApplied,
+				Action:        &research.Id,
+				ActionState:   &models.PlanetEventAction{Research: &research},
+				VersionBefore: 1,
+				VersionAfter:  1,
+				CreatedAt:     t2,
+			},
+			// The fleet dropped 300 metal and 50 crystal.
+			{
+				Id:            uuid.New(),
+				Planet:        p.Id,
+				Kind:          models.PlanetUpdated,
+				VersionBefore: 1,
+				VersionAfter:  2,
+				Resources: []models.PlanetEventResource{
+					{Resource: metalResourceId, Delta: 400, Produced: 100},
+					{Resource: crystalResourceId, Delta: 50},
+				},
+				CreatedAt: t2,
+			},
+		}
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual.Divergences)
+		assert.Equal(t, 2, actual.Version)
+		expected := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 900},
+			{Resource: crystalResourceId, Amount: 550},
+		}
+		assert.Equal(t, expected, actual.Resources)
+	})
+
+	t.Run("tolerates the precision of the database", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		p.Resources[0].Amount = 620.00001
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual.Divergences)
+	})
+
+	t.Run("reports divergences with the stored planet", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		p.Version = 3
+		p.Resources[1].Amount = 551.5
+		p.Buildings[0].Level = 2
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlanetDivergence{
+			{
+				Kind:    models.VersionDivergence,
+				At:      t2,
+				Rebuilt: 2,
+				Stored:  3,
+			},
+			{
+				Kind:     models.ResourceDivergence,
+				Resource: crystalResourceId,
+				At:       t2,
+				Rebuilt:  550,
+				Stored:   551.5,
+			},
+			{
+				Kind:     models.BuildingDivergence,
+				Building: crystalMineId,
+				At:       t2,
+				Rebuilt:  1,
+				Stored:   2,
+			},
+		}
+		assert.Equal(t, expected, actual.Divergences)
+	})
+
+	t.Run("reports resources missing from the stored planet", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		p.Resources = p.Resources[:1]
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlanetDivergence{
+			{
+				Kind:     models.ResourceDivergence,
+				Resource: crystalResourceId,
+				At:       t2,
+				Rebuilt:  550,
+				Stored:   0,
+			},
+		}
+		assert.Equal(t, expected, actual.Divergences)
+	})
+
+	t.Run("reports actions pending only in the stored planet", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		pending := models.BuildingAction{Id: uuid.New(), CompletedAt: t4}
+		p.BuildingQueue = []models.BuildingAction{pending}
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlanetDivergence{
+			{
+				Kind:    models.ActionDivergence,
+				Action:  pending.Id,
+				At:      t2,
+				Rebuilt: 0,
+				Stored:  1,
+			},
+		}
+		assert.Equal(t, expected, actual.Divergences)
+	})
+
+	t.Run("reports applied actions still pending in the rebuilt planet", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		events := generateTestPlanetEvents(p)
+		events[0].ActionState.Building.CompletedAt = t3
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlanetDivergence{
+			{
+				Kind:    models.ActionDivergence,
+				Action:  *events[0].Action,
+				At:      t2,
+				Rebuilt: 1,
+				Stored:  0,
+			},
+			{
+				Kind:     models.BuildingDivergence,
+				Building: crystalMineId,
+				At:       t2,
+				Rebuilt:  0,
+				Stored:   1,
+			},
+			{
+				Kind:    models.ActionDivergence,
+				Action:  *events[0].Action,
+				At:      t2,
+				Rebuilt: 1,
+				Stored:  0,
+			},
+		}
+		assert.Equal(t, expected, actual.Divergences)
+	})
+
+	t.Run("reports gaps between events", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		p.Version = 3
+		events := generateTestPlanetEvents(p)
+		events[2].VersionBefore = 2
+		events[2].VersionAfter = 3
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := []models.PlanetDivergence{
+			{
+				Kind:    models.VersionGapDivergence,
+				At:      t2,
+				Rebuilt: 1,
+				Stored:  2,
+			},
+		}
+		assert.Equal(t, expected, actual.Divergences)
+	})
+
+	t.Run("does not compare with the stored planet when moment is older", func(t *testing.T) {
+		p := generateTestReconstructedPlanet(universe)
+		p.Version = 3
+		events := generateTestPlanetEvents(p)
+
+		actual, err := ReconstructPlanet(universe, p, events, nil, t1)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual.Divergences)
+	})
+}
+
+// generateTestReconstructedPlanet returns a planet created one hour before
+// t1 and last updated at t2 which matches the events returned by
+// generateTestPlanetEvents.
+func TestUnit_SplitTechnologyLevels(t *testing.T) {
+	otherTechnologyId := uuid.New()
+	technologies := []models.PlayerTechnology{
+		{Technology: technologyId, Level: 1, ReachedAt: t1.Add(-time.Hour)},
+		{Technology: otherTechnologyId, Level: 1, ReachedAt: t1.Add(-time.Hour)},
+		{Technology: technologyId, Level: 2, ReachedAt: t1},
+		{Technology: technologyId, Level: 3, ReachedAt: t2},
+	}
+
+	reached, upgrades := splitTechnologyLevels(technologies, t1)
+
+	expectedReached := []models.PlayerTechnology{
+		{Technology: technologyId, Level: 2, ReachedAt: t1},
+		{Technology: otherTechnologyId, Level: 1, ReachedAt: t1.Add(-time.Hour)},
+	}
+	assert.Equal(t, expectedReached, reached)
+	expectedUpgrades := []models.PlayerTechnology{
+		{Technology: technologyId, Level: 3, ReachedAt: t2},
+	}
+	assert.Equal(t, expectedUpgrades, upgrades)
+}
+
+func generateTestReconstructedPlanet(universe models.Universe) models.Planet {
+	p := universe.StartingPlanet(uuid.New(), true, t1.Add(-time.Hour))
+	p.UpdatedAt = t2
+	p.Version = 2
+	p.Resources = []models.PlanetResource{
+		{Resource: metalResourceId, Amount: 620},
+		{Resource: crystalResourceId, Amount: 550},
+	}
+	p.Buildings[0].Level = 1
+	return p
+}
+
+// generateTestPlanetEvents returns the events of a planet which started a
+// building action at t1 completing at t2 and then received 20 metal and
+// 50 crystal, while producing 100 metal per hour.
+func generateTestPlanetEvents(p models.Planet) []models.PlanetEvent {
+	action := models.BuildingAction{
+		Id:           uuid.New(),
+		Building:     crystalMineId,
+		DesiredLevel: 1,
+		Costs: []models.BuildingActionCost{
+			{Resource: metalResourceId, Amount: 100},
+		},
+		CreatedAt:   t1,
+		CompletedAt: t2,
+	}
+
+	return []models.PlanetEvent{
+		{
+			Id:            uuid.New(),
+			Planet:        p.Id,
+			Kind:          models.PlanetActionCreated,
+			Action:        &action.Id,
+			ActionState:   &models.PlanetEventAction{Building: &action},
+			VersionBefore: 0,
+			VersionAfter:  1,
+			Resources: []models.PlanetEventResource{
+				{Resource: metalResourceId, Delta: -100},
+			},
+			CreatedAt: t1,
+		},
+		{
+			Id:            uuid.New(),
+			Planet:        p.Id,
+			Kind:          models.PlanetActionApplied,
+			Action:        &action.Id,
+			VersionBefore: 1,
+			VersionAfter:  1,
+			CreatedAt:     t2,
+		},
+		{
+			Id:            uuid.New(),
+			Planet:        p.Id,
+			Kind:          models.PlanetUpdated,
+			VersionBefore: 1,
+			VersionAfter:  2,
+			Resources: []models.PlanetEventResource{
+				{Resource: crystalResourceId, Delta: 50},
+				{Resource: metalResourceId, Delta: 120, Produced: 100},
+			},
+			CreatedAt: t2,
+		},
+	}
+}
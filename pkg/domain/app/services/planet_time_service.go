@@ -6,37 +6,33 @@ import (
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 )
 
+// AdvancePlanetToTime brings the planet to the input moment. All completion
+// events happening before (or at) the moment are applied in chronological
+// order: the planet is updated to the completion time of each event before
+// applying it. As applying an event can schedule new ones, the timeline is
+// regenerated after each event.
 func AdvancePlanetToTime(
 	planet *models.Planet,
 	moment time.Time,
 ) error {
-	if planet.BuildingAction == nil {
-		return planet.UpdateToTime(moment)
+	for {
+		timeline := generateTimeline(planet)
+		if len(timeline) == 0 || timeline[0].completedAt.After(moment) {
+			break
+		}
+
+		event := timeline[0]
+
+		err := planet.UpdateToTime(event.completedAt)
+		if err != nil {
+			return err
+		}
+
+		err = event.apply(planet)
+		if err != nil {
+			return err
+		}
 	}
 
-	// TODO: Maybe it would be nicer to have a slice of completionEvent
-	// This slice is populated once based by checking the building action
-	// and potentially other things (e.g. technology)
-	// Each completion event is attached an apply function. This apply
-	// function can either be a buildingApplier, or something else.
-	if planet.BuildingAction.CompletedAt.After(moment) {
-		return planet.UpdateToTime(moment)
-	}
-
-	err := planet.UpdateToTime(planet.BuildingAction.CompletedAt)
-	if err != nil {
-		return err
-	}
-
-	err = planet.ApplyAction()
-	if err != nil {
-		return err
-	}
-
-	err = planet.UpdateToTime(moment)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return planet.UpdateToTime(moment)
 }
@@ -16,6 +16,8 @@ var (
 	crystalResourceId = uuid.MustParse("cd2ac9aa-9968-4ff5-b746-88f1f810fbb3")
 	crystalMineId     = uuid.MustParse("3904d34d-9a7e-47d4-a332-091700e2c5c3")
 	metalStorageId    = uuid.MustParse("22b4c0c3-c8e5-4493-89fc-522fdbb0beee")
+	shipId            = uuid.New()
+	technologyId      = uuid.New()
 
 	t1 = time.Date(2026, time.July, 3, 6, 32, 27, 0, time.UTC)
 	t2 = time.Date(2026, time.July, 3, 7, 32, 27, 0, time.UTC)
@@ -53,7 +55,7 @@ func TestUnit_AdvancePlanetToTime(t *testing.T) {
 	t.Run("updates planet to time when building action finishes after requested time", func(t *testing.T) {
 		p := generateTestPlanet()
 		action := generateTestBuildingAction(p)
-		p.BuildingAction = &action
+		p.BuildingQueue = []models.BuildingAction{action}
 
 		initialBuildings := slices.Clone(p.Buildings)
 		initialStorages := slices.Clone(p.Storages)
@@ -71,24 +73,28 @@ func TestUnit_AdvancePlanetToTime(t *testing.T) {
 				{Resource: metalResourceId, Amount: 1065},
 				{Resource: crystalResourceId, Amount: 2040},
 			},
-			Storages:       initialStorages,
-			Productions:    initialProductions,
-			Buildings:      initialBuildings,
-			BuildingAction: &action,
+			Storages:      initialStorages,
+			Productions:   initialProductions,
+			Buildings:     initialBuildings,
+			BuildingQueue: []models.BuildingAction{action},
 		}
 		assert.Equal(t, expected, p)
 	})
 
 	t.Run("applies building action when it finishes before the requested time", func(t *testing.T) {
 		p := generateTestPlanet()
+		p.Name = "my-planet"
+		p.Buildings[0].Name = "crystal mine"
 		action := generateTestBuildingAction(p)
-		p.BuildingAction = &action
+		p.BuildingQueue = []models.BuildingAction{action}
 
 		err := AdvancePlanetToTime(&p, t4)
 		require.NoError(t, err, "Actual err: %v", err)
+		require.Len(t, p.Messages, 1)
 
 		expected := models.Planet{
 			Id:        p.Id,
+			Name:      "my-planet",
 			CreatedAt: p.CreatedAt,
 			UpdatedAt: t4,
 			Version:   6,
@@ -106,13 +112,206 @@ func TestUnit_AdvancePlanetToTime(t *testing.T) {
 				{Resource: crystalResourceId, Building: &crystalMineId, Production: 1234},
 			},
 			Buildings: []models.PlanetBuilding{
-				{Building: crystalMineId, Level: action.DesiredLevel},
+				{Building: crystalMineId, Name: "crystal mine", Level: action.DesiredLevel},
 				{Building: metalStorageId, Level: 4},
 			},
-			BuildingAction: nil,
+			BuildingQueue: []models.BuildingAction{},
+			Messages: []models.Message{
+				{
+					Id:        p.Messages[0].Id,
+					Kind:      models.SystemMessage,
+					Subject:   "Building upgraded",
+					Content:   "Building crystal mine reached level 3 on planet my-planet.",
+					CreatedAt: t3,
+				},
+			},
 		}
 		assert.Equal(t, expected, p)
 	})
+
+	t.Run("applies building action when it finishes exactly at the requested time", func(t *testing.T) {
+		p := generateTestPlanet()
+		action := generateTestBuildingAction(p)
+		p.BuildingQueue = []models.BuildingAction{action}
+
+		err := AdvancePlanetToTime(&p, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t3, p.UpdatedAt)
+		assert.Empty(t, p.BuildingQueue)
+		expectedBuildings := []models.PlanetBuilding{
+			{Building: crystalMineId, Level: action.DesiredLevel},
+			{Building: metalStorageId, Level: 4},
+		}
+		assert.Equal(t, expectedBuildings, p.Buildings)
+	})
+
+	t.Run("chains building actions completed before the requested time", func(t *testing.T) {
+		p := generateTestPlanet()
+		first := generateTestBuildingAction(p)
+		first.CompletedAt = t2
+		second := generateTestBuildingAction(p)
+		second.DesiredLevel = 4
+		p.BuildingQueue = []models.BuildingAction{first, second}
+
+		err := AdvancePlanetToTime(&p, t4)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t4, p.UpdatedAt)
+		assert.Empty(t, p.BuildingQueue)
+		expectedBuildings := []models.PlanetBuilding{
+			{Building: crystalMineId, Level: 4},
+			{Building: metalStorageId, Level: 4},
+		}
+		assert.Equal(t, expectedBuildings, p.Buildings)
+	})
+
+	t.Run("keeps queued building action completing after the requested time", func(t *testing.T) {
+		p := generateTestPlanet()
+		first := generateTestBuildingAction(p)
+		first.CompletedAt = t2
+		second := generateTestBuildingAction(p)
+		second.DesiredLevel = 4
+		second.CompletedAt = t4
+		p.BuildingQueue = []models.BuildingAction{first, second}
+
+		err := AdvancePlanetToTime(&p, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.BuildingAction{second}, p.BuildingQueue)
+		expectedBuildings := []models.PlanetBuilding{
+			{Building: crystalMineId, Level: 3},
+			{Building: metalStorageId, Level: 4},
+		}
+		assert.Equal(t, expectedBuildings, p.Buildings)
+	})
+
+	t.Run("produces shipyard units completed before the requested time", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.ShipyardQueue = []models.ShipyardAction{generateTestShipyardAction()}
+
+		err := AdvancePlanetToTime(&p, t3.Add(30*time.Minute))
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expectedShips := []models.PlanetShip{
+			{Ship: shipId, Count: 2},
+		}
+		assert.Equal(t, expectedShips, p.Ships)
+		require.Len(t, p.ShipyardQueue, 1)
+		assert.Equal(t, 1, p.ShipyardQueue[0].Count)
+		assert.Equal(t, t3.Add(30*time.Minute), p.UpdatedAt)
+	})
+
+	t.Run("interleaves building action and shipyard units", func(t *testing.T) {
+		p := generateTestPlanet()
+		action := generateTestBuildingAction(p)
+		p.BuildingQueue = []models.BuildingAction{action}
+		p.ShipyardQueue = []models.ShipyardAction{generateTestShipyardAction()}
+
+		err := AdvancePlanetToTime(&p, t4)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, p.BuildingQueue)
+		assert.Empty(t, p.ShipyardQueue)
+		expectedShips := []models.PlanetShip{
+			{Ship: shipId, Count: 3},
+		}
+		assert.Equal(t, expectedShips, p.Ships)
+		assert.Equal(t, t4, p.UpdatedAt)
+	})
+
+	t.Run("completes research action before the requested time", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.ResearchAction = &models.ResearchAction{
+			Technology:   technologyId,
+			DesiredLevel: 1,
+			CompletedAt:  t2,
+		}
+
+		err := AdvancePlanetToTime(&p, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Nil(t, p.ResearchAction)
+		expected := []models.PlayerTechnology{
+			{Technology: technologyId, Level: 1, ReachedAt: t2},
+		}
+		assert.Equal(t, expected, p.Technologies)
+		assert.Equal(t, t3, p.UpdatedAt)
+	})
+
+	t.Run("boosts production once the energy technology research completes", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.Roles = models.GameDataRoles{EnergyTechnology: technologyId}
+		p.ResearchAction = &models.ResearchAction{
+			Technology:   technologyId,
+			DesiredLevel: 1,
+			CompletedAt:  t2,
+		}
+
+		err := AdvancePlanetToTime(&p, t2)
+		require.NoError(t, err, "Actual err: %v", err)
+		before := p.Resources[0].Amount
+
+		err = AdvancePlanetToTime(&p, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		// The building production of the second hour gets the 1% bonus
+		// of the first level of the energy technology.
+		assert.Equal(t, 1065.0, before)
+		assert.InDelta(t, 1130.65, p.Resources[0].Amount, 1e-9)
+		assert.InDelta(t, 2080.26, p.Resources[1].Amount, 1e-9)
+	})
+
+	t.Run("boosts production from the time the energy technology is reached on another planet", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.Roles = models.GameDataRoles{EnergyTechnology: technologyId}
+		p.TechnologyUpgrades = []models.PlayerTechnology{
+			{Technology: technologyId, Level: 1, ReachedAt: t2},
+		}
+
+		err := AdvancePlanetToTime(&p, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		// Only the production of the second hour gets the bonus.
+		assert.InDelta(t, 1130.65, p.Resources[0].Amount, 1e-9)
+		assert.InDelta(t, 2080.26, p.Resources[1].Amount, 1e-9)
+		expected := []models.PlayerTechnology{
+			{Technology: technologyId, Level: 1, ReachedAt: t2},
+		}
+		assert.Equal(t, expected, p.Technologies)
+		assert.Empty(t, p.TechnologyUpgrades)
+	})
+
+	t.Run("unloads transport fleet and schedules its return", func(t *testing.T) {
+		p := generateTestPlanet()
+		fleet := generateTestFleet(uuid.New(), p.Id)
+		p.Fleets = []models.Fleet{fleet}
+
+		err := AdvancePlanetToTime(&p, t3)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, p.Fleets, 1)
+		assert.Empty(t, p.Fleets[0].Cargo)
+		require.NotNil(t, p.Fleets[0].ReturnsAt)
+		assert.Equal(t, t3, *p.Fleets[0].ReturnsAt)
+		expectedResources := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 1630},
+			{Resource: crystalResourceId, Amount: 2080},
+		}
+		assert.Equal(t, expectedResources, p.Resources)
+		assert.Equal(t, t3, p.UpdatedAt)
+	})
+
+	t.Run("refunds expired market offer", func(t *testing.T) {
+		p := generateTestPlanet()
+		p.MarketOffers = []models.MarketOffer{generateTestMarketOffer(p.Id)}
+
+		err := AdvancePlanetToTime(&p, t4)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, models.ExpiredOffer, p.MarketOffers[0].Status)
+		assert.Equal(t, t4, p.UpdatedAt)
+	})
 }
 
 func generateTestPlanet() models.Planet {
@@ -155,3 +354,53 @@ func generateTestBuildingAction(p models.Planet) models.BuildingAction {
 		CompletedAt: t3,
 	}
 }
+
+// generateTestFleet returns a transport fleet departing at t1 and
+// arriving at t2.
+func generateTestFleet(origin uuid.UUID, target uuid.UUID) models.Fleet {
+	return models.Fleet{
+		Id:      uuid.New(),
+		Mission: models.TransportMission,
+		Origin:  origin,
+		Target:  target,
+		Ships: []models.FleetShip{
+			{Ship: shipId, Count: 4},
+		},
+		Cargo: []models.FleetCargo{
+			{Resource: metalResourceId, Amount: 500},
+		},
+		DepartedAt: t1,
+		ArrivesAt:  t2,
+	}
+}
+
+// generateTestMarketOffer returns an open offer of 500 metal against 300
+// crystal posted from the planet and expiring at t3.
+func generateTestMarketOffer(planet uuid.UUID) models.MarketOffer {
+	return models.MarketOffer{
+		Id:                uuid.New(),
+		Player:            uuid.New(),
+		Planet:            planet,
+		OfferedResource:   metalResourceId,
+		OfferedAmount:     500,
+		RequestedResource: crystalResourceId,
+		RequestedAmount:   300,
+		Status:            models.OpenOffer,
+		ExpiresAt:         t3,
+		CreatedAt:         t1,
+	}
+}
+
+// generateTestShipyardAction returns a batch of 3 ships produced at t2, t3
+// and t4.
+func generateTestShipyardAction() models.ShipyardAction {
+	return models.ShipyardAction{
+		Id:            uuid.New(),
+		Kind:          models.ShipUnit,
+		Unit:          shipId,
+		Count:         3,
+		UnitBuildTime: time.Hour,
+		CreatedAt:     t1,
+		CompletedAt:   t4,
+	}
+}
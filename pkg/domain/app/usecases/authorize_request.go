@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type AuthorizeRequestUseCase struct {
+	ownerRepo drivenports.ForFetchingOwners
+	admins    []uuid.UUID
+}
+
+func NewAuthorizeRequestUseCase(
+	ownerRepo drivenports.ForFetchingOwners,
+	admins []uuid.UUID,
+) *AuthorizeRequestUseCase {
+	return &AuthorizeRequestUseCase{
+		ownerRepo: ownerRepo,
+		admins:    admins,
+	}
+}
+
+func (a *AuthorizeRequestUseCase) CheckOwnership(ctx context.Context, req request.OwnershipRequest) error {
+	owner, err := a.fetchOwner(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if owner != req.ApiUser {
+		return domainerrors.ErrNotResourceOwner
+	}
+
+	return nil
+}
+
+func (a *AuthorizeRequestUseCase) fetchOwner(ctx context.Context, req request.OwnershipRequest) (uuid.UUID, error) {
+	switch req.Resource {
+	case request.ApiUserResource:
+		return req.Id, nil
+	case request.PlayerResource:
+		return a.ownerRepo.GetPlayerOwner(ctx, req.Id)
+	case request.PlanetResource:
+		return a.ownerRepo.GetPlanetOwner(ctx, req.Id)
+	case request.FleetResource:
+		return a.ownerRepo.GetFleetOwner(ctx, req.Id)
+	case request.MarketOfferResource:
+		return a.ownerRepo.GetMarketOfferOwner(ctx, req.Id)
+	case request.GameDataResource:
+		if slices.Contains(a.admins, req.ApiUser) {
+			return req.ApiUser, nil
+		}
+		return uuid.Nil, nil
+	default:
+		return uuid.Nil, domainerrors.ErrNotResourceOwner
+	}
+}
@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_AuthorizeRequest_CheckOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForFetchingOwners(ctrl)
+
+	apiUser := uuid.MustParse("3b6f0d9c-8a54-4c77-9f2e-4c1d2a7e6b10")
+	otherUser := uuid.MustParse("a0e4c1b2-93f5-4d8e-b6a7-2f1c0d9e8b34")
+	id := uuid.MustParse("5e2c7a1f-0b3d-4e6a-8c9f-1d2e3f4a5b6c")
+
+	t.Run("accepts request on own api user", func(t *testing.T) {
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.ApiUserResource,
+			Id:       apiUser,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects request on another api user", func(t *testing.T) {
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.ApiUserResource,
+			Id:       otherUser,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotResourceOwner, "Actual err: %v", err)
+	})
+
+	t.Run("accepts request on owned player", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetPlayerOwner(gomock.Any(), id).
+			Times(1).
+			Return(apiUser, nil)
+
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.PlayerResource,
+			Id:       id,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects request on planet owned by another api user", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetPlanetOwner(gomock.Any(), id).
+			Times(1).
+			Return(otherUser, nil)
+
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.PlanetResource,
+			Id:       id,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotResourceOwner, "Actual err: %v", err)
+	})
+
+	t.Run("checks owner of fleet", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetFleetOwner(gomock.Any(), id).
+			Times(1).
+			Return(apiUser, nil)
+
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.FleetResource,
+			Id:       id,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("checks owner of market offer", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetMarketOfferOwner(gomock.Any(), id).
+			Times(1).
+			Return(apiUser, nil)
+
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.MarketOfferResource,
+			Id:       id,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("accepts request on game data from admin", func(t *testing.T) {
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.GameDataResource,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, []uuid.UUID{otherUser, apiUser})
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects request on game data from non admin", func(t *testing.T) {
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.GameDataResource,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, []uuid.UUID{otherUser})
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotResourceOwner, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when owner can not be fetched", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+		mockRepo.EXPECT().
+			GetPlayerOwner(gomock.Any(), id).
+			Times(1).
+			Return(uuid.Nil, expectedErr)
+
+		req := request.OwnershipRequest{
+			ApiUser:  apiUser,
+			Resource: request.PlayerResource,
+			Id:       id,
+		}
+
+		usecase := NewAuthorizeRequestUseCase(mockRepo, nil)
+		err := usecase.CheckOwnership(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+)
+
+type ColonizePlanetUseCase struct {
+	playerRepo   drivenports.ForManagingPlayers
+	universeRepo drivenports.ForManagingUniverses
+}
+
+func NewColonizePlanetUseCase(
+	playerRepo drivenports.ForManagingPlayers,
+	universeRepo drivenports.ForManagingUniverses,
+) *ColonizePlanetUseCase {
+	return &ColonizePlanetUseCase{
+		playerRepo:   playerRepo,
+		universeRepo: universeRepo,
+	}
+}
+
+func (c *ColonizePlanetUseCase) Colonize(
+	ctx context.Context,
+	req request.PlanetCreationRequest,
+) (models.Planet, error) {
+	player, err := c.playerRepo.Get(ctx, req.Player)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return models.Planet{}, domainerrors.ErrPlayerNotFound
+		}
+		return models.Planet{}, err
+	}
+
+	universe, err := c.universeRepo.Get(ctx, player.Universe)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return models.Planet{}, domainerrors.ErrUniverseNotFound
+		}
+		return models.Planet{}, err
+	}
+
+	planet, err := player.Colonize(universe)
+	if err != nil {
+		return models.Planet{}, err
+	}
+
+	err = c.playerRepo.Colonize(ctx, player, planet)
+	if err != nil {
+		return models.Planet{}, err
+	}
+
+	return planet, nil
+}
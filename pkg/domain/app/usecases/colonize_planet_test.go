@@ -0,0 +1,209 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type colonizePlanetTestSuite struct {
+	ctrl             *gomock.Controller
+	mockPlayerRepo   *drivenportstest.MockForManagingPlayers
+	mockUniverseRepo *drivenportstest.MockForManagingUniverses
+	usecase          *ColonizePlanetUseCase
+}
+
+func TestUnit_ColonizePlanet_Colonize(t *testing.T) {
+	suite := setupColonizePlanetTestSuite(t)
+
+	universe := models.Universe{
+		Id: uuid.New(),
+		Resources: []models.Resource{
+			{
+				Id:              metalResourceId,
+				StartAmount:     145,
+				StartStorage:    226,
+				StartProduction: 897,
+			},
+		},
+		Buildings:           []models.Building{{Id: uuid.New()}},
+		MaxPlanetsPerPlayer: 3,
+		OccupancyMap: models.OccupancyMap{
+			Topology: models.UniverseTopology{
+				Galaxies:     2,
+				SolarSystems: 14,
+				Orbits:       17,
+			},
+			UsedSlots: make(map[models.Coordinate]struct{}),
+		},
+	}
+
+	homeworldId := uuid.New()
+	player := models.Player{
+		Id:        uuid.New(),
+		Universe:  universe.Id,
+		Version:   3,
+		Homeworld: homeworldId,
+		Planets:   []uuid.UUID{homeworldId},
+	}
+
+	req := request.PlanetCreationRequest{Player: player.Id}
+
+	t.Run("persists colonized planet with player", func(t *testing.T) {
+		var capturedPlayer models.Player
+		var capturedPlanet models.Planet
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(player.Id)).
+			Times(1).
+			Return(player, nil)
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return(universe, nil)
+		suite.mockPlayerRepo.EXPECT().
+			Colonize(gomock.Any(), gomock.AssignableToTypeOf(capturedPlayer), gomock.AssignableToTypeOf(capturedPlanet)).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, player models.Player, planet models.Planet) error {
+				capturedPlayer = player
+				capturedPlanet = planet
+				return nil
+			})
+
+		actual, err := suite.usecase.Colonize(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, capturedPlanet, actual)
+		assert.Equal(t, player.Id, actual.Player)
+		assert.False(t, actual.Homeworld)
+		assert.Equal(t, 4, capturedPlayer.Version)
+		assert.Equal(t, []uuid.UUID{homeworldId, actual.Id}, capturedPlayer.Planets)
+		expectedResources := []models.PlanetResource{
+			{
+				Resource: metalResourceId,
+				Amount:   145,
+			},
+		}
+		assert.Equal(t, expectedResources, actual.Resources)
+	})
+
+	t.Run("returns player not found when player does not exist", func(t *testing.T) {
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Colonize(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayerNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns universe not found when universe does not exist", func(t *testing.T) {
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(player, nil)
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Universe{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Colonize(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrUniverseNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when colony limit is reached", func(t *testing.T) {
+		crowded := player
+		crowded.Planets = make([]uuid.UUID, 0)
+		for range universe.MaxPlanetsPerPlayer {
+			crowded.Planets = append(crowded.Planets, uuid.New())
+		}
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(crowded, nil)
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(universe, nil)
+
+		_, err := suite.usecase.Colonize(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrColonyLimitReached, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when universe is full", func(t *testing.T) {
+		full := universe
+		full.OccupancyMap = models.OccupancyMap{
+			Topology: models.UniverseTopology{
+				Galaxies:     1,
+				SolarSystems: 1,
+				Orbits:       1,
+			},
+			UsedSlots: map[models.Coordinate]struct{}{
+				{Galaxy: 0, SolarSystem: 0, Position: 0}: {},
+			},
+		}
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(player, nil)
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(full, nil)
+
+		_, err := suite.usecase.Colonize(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrUniverseFull, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(player, nil)
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(universe, nil)
+		expectedErr := errors.New("stubbed error")
+		suite.mockPlayerRepo.EXPECT().
+			Colonize(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(expectedErr)
+
+		_, err := suite.usecase.Colonize(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func setupColonizePlanetTestSuite(t *testing.T) *colonizePlanetTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockPlayerRepo := drivenportstest.NewMockForManagingPlayers(ctrl)
+	mockUniverseRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
+
+	return &colonizePlanetTestSuite{
+		ctrl:             ctrl,
+		mockPlayerRepo:   mockPlayerRepo,
+		mockUniverseRepo: mockUniverseRepo,
+		usecase: NewColonizePlanetUseCase(
+			mockPlayerRepo,
+			mockUniverseRepo,
+		),
+	}
+}
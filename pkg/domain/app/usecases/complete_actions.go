@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type CompleteActionsUseCase struct {
+	actionRepo    drivenports.ForSchedulingBuildingActions
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewCompleteActionsUseCase(
+	actionRepo drivenports.ForSchedulingBuildingActions,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *CompleteActionsUseCase {
+	return &CompleteActionsUseCase{
+		actionRepo:    actionRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+func (c *CompleteActionsUseCase) NextCompletion(ctx context.Context) (time.Duration, error) {
+	moment := c.clock.Now(ctx)
+
+	completedAt, err := c.actionRepo.NextCompletion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return max(completedAt.Sub(moment), 0), nil
+}
+
+func (c *CompleteActionsUseCase) ListDuePlanets(ctx context.Context) ([]uuid.UUID, error) {
+	moment := c.clock.Now(ctx)
+	return c.actionRepo.ListDuePlanets(ctx, moment)
+}
+
+// Complete brings the planet to the current time: this applies all the
+// actions completed in the meantime, exactly as when the planet is read.
+func (c *CompleteActionsUseCase) Complete(ctx context.Context, planet uuid.UUID) error {
+	moment := c.clock.Now(ctx)
+
+	_, err := c.planetMutator.Mutate(ctx, planet, generateUpdateMutator(moment))
+	// The planet might have been deleted since it was listed: there is
+	// nothing left to complete in this case.
+	if err == domainerrors.ErrNotFound {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,150 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type completeActionsTestSuite struct {
+	ctrl              *gomock.Controller
+	mockActionRepo    *drivenportstest.MockForSchedulingBuildingActions
+	mockPlanetMutator *drivenportstest.MockForMutatingPlanet
+	mockClock         *drivenportstest.MockForFetchingTime
+	usecase           *CompleteActionsUseCase
+}
+
+func TestUnit_CompleteActions_NextCompletion(t *testing.T) {
+	t.Run("returns delay until next completion", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockActionRepo.EXPECT().NextCompletion(gomock.Any()).Times(1).Return(t3, nil)
+
+		actual, err := suite.usecase.NextCompletion(t.Context())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, 2*time.Hour, actual)
+	})
+
+	t.Run("returns no delay when action is already due", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockActionRepo.EXPECT().NextCompletion(gomock.Any()).Times(1).Return(t1, nil)
+
+		actual, err := suite.usecase.NextCompletion(t.Context())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, time.Duration(0), actual)
+	})
+
+	t.Run("returns error when no action is queued", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockActionRepo.EXPECT().
+			NextCompletion(gomock.Any()).
+			Times(1).
+			Return(time.Time{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.NextCompletion(t.Context())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_CompleteActions_ListDuePlanets(t *testing.T) {
+	t.Run("lists planets with actions due at current time", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+		expected := []uuid.UUID{uuid.New(), uuid.New()}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockActionRepo.EXPECT().
+			ListDuePlanets(gomock.Any(), gomock.Eq(t2)).
+			Times(1).
+			Return(expected, nil)
+
+		actual, err := suite.usecase.ListDuePlanets(t.Context())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestUnit_CompleteActions_Complete(t *testing.T) {
+	t.Run("applies completed action to planet", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+		planet := generateTestPlanetWithAction(t2)
+		initialLevel := planet.Buildings[0].Level
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Eq(planet.Id), gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Complete(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, initialLevel+1, planet.Buildings[0].Level)
+		assert.Empty(t, planet.BuildingQueue)
+		assert.Equal(t, t3, planet.UpdatedAt)
+	})
+
+	t.Run("ignores planet which does not exist anymore", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+		id := uuid.New()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Eq(id), gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, domainerrors.ErrNotFound)
+
+		err := suite.usecase.Complete(t.Context(), id)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when mutation fails", func(t *testing.T) {
+		suite := setupCompleteActionsTestSuite(t)
+		id := uuid.New()
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Eq(id), gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, expectedErr)
+
+		err := suite.usecase.Complete(t.Context(), id)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func setupCompleteActionsTestSuite(t *testing.T) *completeActionsTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockActionRepo := drivenportstest.NewMockForSchedulingBuildingActions(ctrl)
+	mockPlanetMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &completeActionsTestSuite{
+		ctrl:              ctrl,
+		mockActionRepo:    mockActionRepo,
+		mockPlanetMutator: mockPlanetMutator,
+		mockClock:         mockClock,
+		usecase:           NewCompleteActionsUseCase(mockActionRepo, mockPlanetMutator, mockClock),
+	}
+}
@@ -44,7 +44,15 @@ func (b *CreateBuildingActionUseCase) Create(
 		return models.BuildingAction{}, err
 	}
 
+	if req.Kind != models.UpgradeAction && req.Kind != models.DemolishAction {
+		return models.BuildingAction{}, domainerrors.ErrInvalidBuildingActionKind
+	}
+
 	mutator := generateActionMutator(moment, building)
+	if req.Kind == models.DemolishAction {
+		mutator = generateDemolitionMutator(moment, building)
+	}
+
 	result, err := b.planetMutator.Mutate(ctx, req.Planet, mutator)
 	if err != nil {
 		return models.BuildingAction{}, err
@@ -53,14 +61,19 @@ func (b *CreateBuildingActionUseCase) Create(
 		return models.BuildingAction{}, domainerrors.ErrNotFound
 	}
 
-	if result.Planet.BuildingAction == nil {
+	// The new action is always appended at the end of the queue.
+	count := len(result.Planet.BuildingQueue)
+	if count == 0 {
 		return models.BuildingAction{}, domainerrors.ErrResourceCreationFailed
 	}
 
-	return *result.Planet.BuildingAction, nil
+	return result.Planet.BuildingQueue[count-1], nil
 }
 
-func generateActionMutator(moment time.Time, building models.Building) drivenports.PlanetMutator {
+func generateActionMutator(
+	moment time.Time,
+	building models.Building,
+) drivenports.PlanetMutator {
 	return func(p *models.Planet) (bool, error) {
 		err := domainservices.AdvancePlanetToTime(p, moment)
 		if err != nil {
@@ -70,3 +83,17 @@ func generateActionMutator(moment time.Time, building models.Building) drivenpor
 		return false, p.AddBuildingAction(building)
 	}
 }
+
+func generateDemolitionMutator(
+	moment time.Time,
+	building models.Building,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.DemolishBuilding(building)
+	}
+}
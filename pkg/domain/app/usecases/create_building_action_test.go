@@ -48,6 +48,7 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 		expected := models.BuildingAction{
 			Id:           actual.Id,
 			Building:     request.Building,
+			Kind:         models.UpgradeAction,
 			DesiredLevel: planet.Buildings[0].Level + 1,
 			CreatedAt:    t2,
 			CompletedAt:  t2.Add(completionTime),
@@ -65,7 +66,7 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 			Productions: []models.BuildingActionResourceProduction{},
 		}
 		assert.Equal(t, expected, actual)
-		assert.Equal(t, &expected, planet.BuildingAction)
+		assert.Equal(t, []models.BuildingAction{expected}, planet.BuildingQueue)
 	})
 
 	t.Run("updates planet to current time", func(t *testing.T) {
@@ -99,6 +100,8 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 		suite := setupCreateBuildingActionTestSuite(t)
 
 		planet := generateTestPlanetWithAction(t2)
+		planet.Name = "my-planet"
+		planet.Buildings[0].Name = "metal mine"
 		building := generateTestBuilding(planet)
 		request := generateTestBuildingActionRequest(planet)
 
@@ -117,10 +120,12 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 
 		actual, err := suite.usecase.Create(t.Context(), request)
 		require.NoError(t, err, "Actual err: %v", err)
+		require.Len(t, planet.Messages, 1)
 
 		completionTime := 381600 * time.Millisecond
 		expected := models.Planet{
 			Id:        planet.Id,
+			Name:      "my-planet",
 			Fields:    100,
 			CreatedAt: t1,
 			UpdatedAt: t3,
@@ -140,27 +145,40 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 			Buildings: []models.PlanetBuilding{
 				{
 					Building: request.Building,
+					Name:     "metal mine",
 					Level:    initialLevel + 1,
 				},
 			},
-			BuildingAction: &models.BuildingAction{
-				Id:           actual.Id,
-				Building:     request.Building,
-				DesiredLevel: 4,
-				CreatedAt:    t3,
-				CompletedAt:  t3.Add(completionTime),
-				Costs: []models.BuildingActionCost{
-					{
-						Resource: metalResourceId,
-						Amount:   97,
-					},
-					{
-						Resource: crystalResourceId,
-						Amount:   168,
+			BuildingQueue: []models.BuildingAction{
+				{
+					Id:           actual.Id,
+					Building:     request.Building,
+					Kind:         models.UpgradeAction,
+					DesiredLevel: 4,
+					CreatedAt:    t3,
+					CompletedAt:  t3.Add(completionTime),
+					Costs: []models.BuildingActionCost{
+						{
+							Resource: metalResourceId,
+							Amount:   97,
+						},
+						{
+							Resource: crystalResourceId,
+							Amount:   168,
+						},
 					},
+					Storages:    []models.BuildingActionResourceStorage{},
+					Productions: []models.BuildingActionResourceProduction{},
+				},
+			},
+			Messages: []models.Message{
+				{
+					Id:        planet.Messages[0].Id,
+					Kind:      models.SystemMessage,
+					Subject:   "Building upgraded",
+					Content:   "Building metal mine reached level 3 on planet my-planet.",
+					CreatedAt: t2,
 				},
-				Storages:    []models.BuildingActionResourceStorage{},
-				Productions: []models.BuildingActionResourceProduction{},
 			},
 		}
 		assert.Equal(t, expected, planet)
@@ -188,13 +206,49 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
 	})
 
-	t.Run("returns error when planet already has an action running", func(t *testing.T) {
+	t.Run("queues action after the one already running", func(t *testing.T) {
 		suite := setupCreateBuildingActionTestSuite(t)
 
 		planet := generateTestPlanetWithAction(t3)
 		building := generateTestBuilding(planet)
 		request := generateTestBuildingActionRequest(planet)
 
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), building.Id).
+			Times(1).
+			Return(building, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Create(t.Context(), request)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, planet.Buildings[0].Level+2, actual.DesiredLevel)
+		assert.Equal(t, t2, actual.CreatedAt)
+		assert.True(t, actual.CompletedAt.After(t3))
+		require.Len(t, planet.BuildingQueue, 2)
+		assert.Equal(t, actual, planet.BuildingQueue[1])
+	})
+
+	t.Run("returns error when building queue is full", func(t *testing.T) {
+		suite := setupCreateBuildingActionTestSuite(t)
+
+		planet := generateTestPlanet()
+		for i := range 5 {
+			planet.BuildingQueue = append(planet.BuildingQueue, models.BuildingAction{
+				Id:           uuid.New(),
+				Building:     planet.Buildings[0].Building,
+				DesiredLevel: planet.Buildings[0].Level + i + 1,
+				CreatedAt:    t1,
+				CompletedAt:  t3.Add(time.Duration(i) * time.Hour),
+			})
+		}
+		building := generateTestBuilding(planet)
+		request := generateTestBuildingActionRequest(planet)
+
 		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
 		suite.mockBuildingRepo.EXPECT().
 			Get(gomock.Any(), building.Id).
@@ -207,7 +261,7 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 
 		_, err := suite.usecase.Create(t.Context(), request)
 
-		assert.ErrorIs(t, err, domainerrors.ErrActionAlreadyInProgress, "Actual err: %v", err)
+		assert.ErrorIs(t, err, domainerrors.ErrBuildingQueueFull, "Actual err: %v", err)
 	})
 
 	t.Run("returns error when planet does not contain requested building", func(t *testing.T) {
@@ -218,6 +272,7 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 		req := request.BuildingActionCreationRequest{
 			Planet:   planet.Id,
 			Building: building.Id,
+			Kind:     models.UpgradeAction,
 		}
 
 		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
@@ -243,6 +298,7 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 		req := request.BuildingActionCreationRequest{
 			Planet:   planet.Id,
 			Building: buildingId,
+			Kind:     models.UpgradeAction,
 		}
 
 		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
@@ -278,6 +334,77 @@ func TestUnit_CreateBuildingAction_Create(t *testing.T) {
 
 		assert.ErrorIs(t, err, domainerrors.ErrAllFieldsUsed, "Actual err: %v", err)
 	})
+
+	t.Run("persists created demolition action", func(t *testing.T) {
+		suite := setupCreateBuildingActionTestSuite(t)
+
+		planet := generateTestPlanet()
+		building := generateTestBuilding(planet)
+		request := generateTestBuildingActionRequest(planet)
+		request.Kind = models.DemolishAction
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), building.Id).
+			Times(1).
+			Return(building, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Create(t.Context(), request)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, models.DemolishAction, actual.Kind)
+		assert.Equal(t, request.Building, actual.Building)
+		assert.Equal(t, planet.Buildings[0].Level-1, actual.DesiredLevel)
+		assert.Equal(t, []models.BuildingAction{actual}, planet.BuildingQueue)
+	})
+
+	t.Run("returns error when building has nothing to demolish", func(t *testing.T) {
+		suite := setupCreateBuildingActionTestSuite(t)
+
+		planet := generateTestPlanet()
+		planet.Buildings[0].Level = 0
+		building := generateTestBuilding(planet)
+		request := generateTestBuildingActionRequest(planet)
+		request.Kind = models.DemolishAction
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), building.Id).
+			Times(1).
+			Return(building, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Create(t.Context(), request)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNothingToDemolish, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when action kind is unknown", func(t *testing.T) {
+		suite := setupCreateBuildingActionTestSuite(t)
+
+		planet := generateTestPlanet()
+		building := generateTestBuilding(planet)
+		request := generateTestBuildingActionRequest(planet)
+		request.Kind = "not-a-kind"
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), building.Id).
+			Times(1).
+			Return(building, nil)
+
+		_, err := suite.usecase.Create(t.Context(), request)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingActionKind, "Actual err: %v", err)
+	})
+
 }
 
 func setupCreateBuildingActionTestSuite(t *testing.T) *createBuildingActionTestSuite {
@@ -329,12 +456,14 @@ func generateTestPlanet() models.Planet {
 
 func generateTestPlanetWithAction(completionTime time.Time) models.Planet {
 	p := generateTestPlanet()
-	p.BuildingAction = &models.BuildingAction{
-		Id:           uuid.New(),
-		Building:     p.Buildings[0].Building,
-		DesiredLevel: p.Buildings[0].Level + 1,
-		CreatedAt:    t1,
-		CompletedAt:  completionTime,
+	p.BuildingQueue = []models.BuildingAction{
+		{
+			Id:           uuid.New(),
+			Building:     p.Buildings[0].Building,
+			DesiredLevel: p.Buildings[0].Level + 1,
+			CreatedAt:    t1,
+			CompletedAt:  completionTime,
+		},
 	}
 
 	return p
@@ -366,5 +495,6 @@ func generateTestBuildingActionRequest(
 	return request.BuildingActionCreationRequest{
 		Planet:   planet.Id,
 		Building: planet.Buildings[0].Building,
+		Kind:     models.UpgradeAction,
 	}
 }
@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+)
+
+type CreateShipyardActionUseCase struct {
+	unitRepo      drivenports.ForFetchingShipyardUnits
+	rolesRepo     drivenports.ForFetchingGameDataRoles
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewCreateShipyardActionUseCase(
+	unitRepo drivenports.ForFetchingShipyardUnits,
+	rolesRepo drivenports.ForFetchingGameDataRoles,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *CreateShipyardActionUseCase {
+	return &CreateShipyardActionUseCase{
+		unitRepo:      unitRepo,
+		rolesRepo:     rolesRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+func (s *CreateShipyardActionUseCase) Create(
+	ctx context.Context,
+	req request.ShipyardActionCreationRequest,
+) (models.ShipyardAction, error) {
+	moment := s.clock.Now(ctx)
+
+	if req.Count <= 0 || req.Count > models.MaxShipyardBatchSize {
+		return models.ShipyardAction{}, domainerrors.ErrInvalidUnitCount
+	}
+
+	var ship models.Ship
+	var defense models.Defense
+	var err error
+
+	switch req.Kind {
+	case models.ShipUnit:
+		ship, err = s.unitRepo.GetShip(ctx, req.Unit)
+	case models.DefenseUnit:
+		defense, err = s.unitRepo.GetDefense(ctx, req.Unit)
+	default:
+		return models.ShipyardAction{}, domainerrors.ErrUnitNotFound
+	}
+	if err != nil {
+		return models.ShipyardAction{}, toUnitNotFound(err)
+	}
+
+	roles, err := s.rolesRepo.GetRoles(ctx)
+	if err != nil {
+		return models.ShipyardAction{}, err
+	}
+
+	mutator := generateShipsMutator(moment, ship, req.Count, roles)
+	if req.Kind == models.DefenseUnit {
+		mutator = generateDefensesMutator(moment, defense, req.Count, roles)
+	}
+
+	result, err := s.planetMutator.Mutate(ctx, req.Planet, mutator)
+	if err != nil {
+		return models.ShipyardAction{}, err
+	}
+	if result.Deleted {
+		return models.ShipyardAction{}, domainerrors.ErrNotFound
+	}
+
+	queue := result.Planet.ShipyardQueue
+	if len(queue) == 0 {
+		return models.ShipyardAction{}, domainerrors.ErrResourceCreationFailed
+	}
+
+	return queue[len(queue)-1], nil
+}
+
+func toUnitNotFound(err error) error {
+	if err == domainerrors.ErrNotFound {
+		return domainerrors.ErrUnitNotFound
+	}
+	return err
+}
+
+func generateShipsMutator(
+	moment time.Time,
+	ship models.Ship,
+	count int,
+	roles models.GameDataRoles,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.BuildShips(ship, count, roles)
+	}
+}
+
+func generateDefensesMutator(
+	moment time.Time,
+	defense models.Defense,
+	count int,
+	roles models.GameDataRoles,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.BuildDefenses(defense, count, roles)
+	}
+}
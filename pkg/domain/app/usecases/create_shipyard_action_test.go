@@ -0,0 +1,330 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	shipyardBuildingId = uuid.MustParse("58d75842-6dc0-4ac0-b36d-55f91b8d060d")
+	testRoles          = models.GameDataRoles{
+		ShipyardBuilding: shipyardBuildingId,
+	}
+)
+
+type createShipyardActionTestSuite struct {
+	ctrl          *gomock.Controller
+	mockUnitRepo  *drivenportstest.MockForFetchingShipyardUnits
+	mockRolesRepo *drivenportstest.MockForFetchingGameDataRoles
+	mockMutator   *drivenportstest.MockForMutatingPlanet
+	mockClock     *drivenportstest.MockForFetchingTime
+	usecase       *CreateShipyardActionUseCase
+}
+
+func TestUnit_CreateShipyardAction_Create(t *testing.T) {
+	t.Run("persists created ship batch", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		ship := generateTestShip()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, ship.Id)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), ship.Id).
+			Times(1).
+			Return(ship, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Create(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.ShipyardAction{
+			Id:            actual.Id,
+			Kind:          models.ShipUnit,
+			Unit:          ship.Id,
+			Count:         2,
+			UnitBuildTime: 48 * time.Minute,
+			CreatedAt:     t2,
+			CompletedAt:   t2.Add(96 * time.Minute),
+			Costs: []models.ShipyardActionCost{
+				{
+					Resource: metalResourceId,
+					Amount:   4000,
+				},
+				{
+					Resource: crystalResourceId,
+					Amount:   4000,
+				},
+			},
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, []models.ShipyardAction{expected}, planet.ShipyardQueue)
+	})
+
+	t.Run("persists created defense batch", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		defense := models.Defense{
+			Id: uuid.New(),
+			Costs: []models.UnitCost{
+				{
+					Resource:              metalResourceId,
+					Cost:                  2000,
+					BuildTimeHoursPerUnit: 0.0004,
+				},
+			},
+		}
+		req := generateTestShipyardActionRequest(planet, models.DefenseUnit, defense.Id)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetDefense(gomock.Any(), defense.Id).
+			Times(1).
+			Return(defense, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Create(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, models.DefenseUnit, actual.Kind)
+		assert.Equal(t, defense.Id, actual.Unit)
+		assert.Equal(t, 2, actual.Count)
+	})
+
+	t.Run("updates planet to current time", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		ship := generateTestShip()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, ship.Id)
+
+		initialVersion := planet.Version
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), ship.Id).
+			Times(1).
+			Return(ship, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Create(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t2, planet.UpdatedAt)
+		// One bump due to the update to the current time, one bump for the batch
+		assert.Equal(t, initialVersion+2, planet.Version)
+	})
+
+	t.Run("returns error when count is not positive", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, uuid.New())
+		req.Count = 0
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when count exceeds the maximum batch size", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, uuid.New())
+		req.Count = models.MaxShipyardBatchSize + 1
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidUnitCount, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when unit kind is unknown", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		req := generateTestShipyardActionRequest(planet, "not-a-kind", uuid.New())
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrUnitNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when ship does not exist", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, uuid.New())
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), req.Unit).
+			Times(1).
+			Return(models.Ship{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrUnitNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fetching defense fails", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		req := generateTestShipyardActionRequest(planet, models.DefenseUnit, uuid.New())
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetDefense(gomock.Any(), req.Unit).
+			Times(1).
+			Return(models.Defense{}, expectedErr)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when shipyard is not built", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanet()
+		ship := generateTestShip()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, ship.Id)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), ship.Id).
+			Times(1).
+			Return(ship, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrShipyardNotBuilt, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet is deleted", func(t *testing.T) {
+		suite := setupCreateShipyardActionTestSuite(t)
+
+		planet := generateTestPlanetWithShipyard()
+		ship := generateTestShip()
+		req := generateTestShipyardActionRequest(planet, models.ShipUnit, ship.Id)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), ship.Id).
+			Times(1).
+			Return(ship, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func setupCreateShipyardActionTestSuite(t *testing.T) *createShipyardActionTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUnitRepo := drivenportstest.NewMockForFetchingShipyardUnits(ctrl)
+	mockRolesRepo := drivenportstest.NewMockForFetchingGameDataRoles(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &createShipyardActionTestSuite{
+		ctrl:          ctrl,
+		mockUnitRepo:  mockUnitRepo,
+		mockRolesRepo: mockRolesRepo,
+		mockMutator:   mockMutator,
+		mockClock:     mockClock,
+		usecase: NewCreateShipyardActionUseCase(
+			mockUnitRepo,
+			mockRolesRepo,
+			mockMutator,
+			mockClock,
+		),
+	}
+}
+
+func generateTestPlanetWithShipyard() models.Planet {
+	p := generateTestPlanet()
+	p.Buildings = []models.PlanetBuilding{
+		{
+			Building: shipyardBuildingId,
+			Level:    1,
+		},
+	}
+
+	return p
+}
+
+func generateTestShip() models.Ship {
+	return models.Ship{
+		Id: uuid.New(),
+		Costs: []models.UnitCost{
+			{
+				Resource:              metalResourceId,
+				Cost:                  2000,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+			{
+				Resource:              crystalResourceId,
+				Cost:                  2000,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+		},
+	}
+}
+
+func generateTestShipyardActionRequest(
+	planet models.Planet,
+	kind models.ShipyardUnitKind,
+	unit uuid.UUID,
+) request.ShipyardActionCreationRequest {
+	return request.ShipyardActionCreationRequest{
+		Planet: planet.Id,
+		Kind:   kind,
+		Unit:   unit,
+		Count:  2,
+	}
+}
@@ -51,7 +51,7 @@ func generateActionDeletionMutator(moment time.Time) drivenports.PlanetMutator {
 			return false, err
 		}
 
-		p.BuildingAction = nil
+		p.BuildingQueue = []models.BuildingAction{}
 		p.Version++
 
 		return false, nil
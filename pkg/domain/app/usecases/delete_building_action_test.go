@@ -34,7 +34,7 @@ func TestUnit_DeleteBuildingAction_DeleteForPlanet(t *testing.T) {
 		err := suite.usecase.DeleteForPlanet(t.Context(), planet.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Nil(t, planet.BuildingAction)
+		assert.Empty(t, planet.BuildingQueue)
 	})
 
 	t.Run("updates planet to current time", func(t *testing.T) {
@@ -61,12 +61,14 @@ func TestUnit_DeleteBuildingAction_DeleteForPlanet(t *testing.T) {
 		planet := generateTestPlanet()
 		require.NotEqual(t, 5, planet.Buildings[0].Level)
 		planet.UpdatedAt = t1
-		planet.BuildingAction = &models.BuildingAction{
-			Id:           uuid.New(),
-			Building:     planet.Buildings[0].Building,
-			DesiredLevel: 5,
-			CreatedAt:    t1,
-			CompletedAt:  t2,
+		planet.BuildingQueue = []models.BuildingAction{
+			{
+				Id:           uuid.New(),
+				Building:     planet.Buildings[0].Building,
+				DesiredLevel: 5,
+				CreatedAt:    t1,
+				CompletedAt:  t2,
+			},
 		}
 
 		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
@@ -78,7 +80,7 @@ func TestUnit_DeleteBuildingAction_DeleteForPlanet(t *testing.T) {
 		err := suite.usecase.DeleteForPlanet(t.Context(), planet.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Nil(t, planet.BuildingAction)
+		assert.Empty(t, planet.BuildingQueue)
 		assert.Equal(t, 5, planet.Buildings[0].Level)
 		assert.Equal(t, t3, planet.UpdatedAt)
 	})
@@ -97,7 +99,7 @@ func TestUnit_DeleteBuildingAction_DeleteForPlanet(t *testing.T) {
 		err := suite.usecase.DeleteForPlanet(t.Context(), planet.Id)
 		require.NoError(t, err, "Actual err: %v", err)
 
-		assert.Nil(t, planet.BuildingAction)
+		assert.Empty(t, planet.BuildingQueue)
 	})
 
 	t.Run("returns error when planet is deleted", func(t *testing.T) {
@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_alliances.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_alliances.go -destination=drivenportstest/alliances_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingAlliances is a mock of ForManagingAlliances interface.
+type MockForManagingAlliances struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingAlliancesMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingAlliancesMockRecorder is the mock recorder for MockForManagingAlliances.
+type MockForManagingAlliancesMockRecorder struct {
+	mock *MockForManagingAlliances
+}
+
+// NewMockForManagingAlliances creates a new mock instance.
+func NewMockForManagingAlliances(ctrl *gomock.Controller) *MockForManagingAlliances {
+	mock := &MockForManagingAlliances{ctrl: ctrl}
+	mock.recorder = &MockForManagingAlliancesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingAlliances) EXPECT() *MockForManagingAlliancesMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockForManagingAlliances) AddMember(ctx context.Context, alliance uuid.UUID, member models.AllianceMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, alliance, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockForManagingAlliancesMockRecorder) AddMember(ctx, alliance, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockForManagingAlliances)(nil).AddMember), ctx, alliance, member)
+}
+
+// Create mocks base method.
+func (m *MockForManagingAlliances) Create(ctx context.Context, alliance models.Alliance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, alliance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockForManagingAlliancesMockRecorder) Create(ctx, alliance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockForManagingAlliances)(nil).Create), ctx, alliance)
+}
+
+// CreateApplication mocks base method.
+func (m *MockForManagingAlliances) CreateApplication(ctx context.Context, application models.AllianceApplication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplication", ctx, application)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateApplication indicates an expected call of CreateApplication.
+func (mr *MockForManagingAlliancesMockRecorder) CreateApplication(ctx, application any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockForManagingAlliances)(nil).CreateApplication), ctx, application)
+}
+
+// Delete mocks base method.
+func (m *MockForManagingAlliances) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockForManagingAlliancesMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockForManagingAlliances)(nil).Delete), ctx, id)
+}
+
+// DeleteApplication mocks base method.
+func (m *MockForManagingAlliances) DeleteApplication(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplication", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplication indicates an expected call of DeleteApplication.
+func (mr *MockForManagingAlliancesMockRecorder) DeleteApplication(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockForManagingAlliances)(nil).DeleteApplication), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockForManagingAlliances) Get(ctx context.Context, id uuid.UUID) (models.Alliance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Alliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingAlliancesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingAlliances)(nil).Get), ctx, id)
+}
+
+// GetApplication mocks base method.
+func (m *MockForManagingAlliances) GetApplication(ctx context.Context, id uuid.UUID) (models.AllianceApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", ctx, id)
+	ret0, _ := ret[0].(models.AllianceApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication.
+func (mr *MockForManagingAlliancesMockRecorder) GetApplication(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockForManagingAlliances)(nil).GetApplication), ctx, id)
+}
+
+// ListApplications mocks base method.
+func (m *MockForManagingAlliances) ListApplications(ctx context.Context, alliance uuid.UUID) ([]models.AllianceApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications", ctx, alliance)
+	ret0, _ := ret[0].([]models.AllianceApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplications indicates an expected call of ListApplications.
+func (mr *MockForManagingAlliancesMockRecorder) ListApplications(ctx, alliance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockForManagingAlliances)(nil).ListApplications), ctx, alliance)
+}
+
+// RemoveMember mocks base method.
+func (m *MockForManagingAlliances) RemoveMember(ctx context.Context, alliance, player uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, alliance, player)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockForManagingAlliancesMockRecorder) RemoveMember(ctx, alliance, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockForManagingAlliances)(nil).RemoveMember), ctx, alliance, player)
+}
+
+// UpdateMember mocks base method.
+func (m *MockForManagingAlliances) UpdateMember(ctx context.Context, alliance uuid.UUID, member models.AllianceMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", ctx, alliance, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockForManagingAlliancesMockRecorder) UpdateMember(ctx, alliance, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockForManagingAlliances)(nil).UpdateMember), ctx, alliance, member)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_scheduling_building_actions.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_scheduling_building_actions.go -destination=drivenportstest/building_action_scheduling_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForSchedulingBuildingActions is a mock of ForSchedulingBuildingActions interface.
+type MockForSchedulingBuildingActions struct {
+	ctrl     *gomock.Controller
+	recorder *MockForSchedulingBuildingActionsMockRecorder
+	isgomock struct{}
+}
+
+// MockForSchedulingBuildingActionsMockRecorder is the mock recorder for MockForSchedulingBuildingActions.
+type MockForSchedulingBuildingActionsMockRecorder struct {
+	mock *MockForSchedulingBuildingActions
+}
+
+// NewMockForSchedulingBuildingActions creates a new mock instance.
+func NewMockForSchedulingBuildingActions(ctrl *gomock.Controller) *MockForSchedulingBuildingActions {
+	mock := &MockForSchedulingBuildingActions{ctrl: ctrl}
+	mock.recorder = &MockForSchedulingBuildingActionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForSchedulingBuildingActions) EXPECT() *MockForSchedulingBuildingActionsMockRecorder {
+	return m.recorder
+}
+
+// ListDuePlanets mocks base method.
+func (m *MockForSchedulingBuildingActions) ListDuePlanets(ctx context.Context, until time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDuePlanets", ctx, until)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDuePlanets indicates an expected call of ListDuePlanets.
+func (mr *MockForSchedulingBuildingActionsMockRecorder) ListDuePlanets(ctx, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDuePlanets", reflect.TypeOf((*MockForSchedulingBuildingActions)(nil).ListDuePlanets), ctx, until)
+}
+
+// NextCompletion mocks base method.
+func (m *MockForSchedulingBuildingActions) NextCompletion(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextCompletion", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextCompletion indicates an expected call of NextCompletion.
+func (mr *MockForSchedulingBuildingActionsMockRecorder) NextCompletion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextCompletion", reflect.TypeOf((*MockForSchedulingBuildingActions)(nil).NextCompletion), ctx)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_fleets.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_fleets.go -destination=drivenportstest/fleets_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingFleets is a mock of ForManagingFleets interface.
+type MockForManagingFleets struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingFleetsMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingFleetsMockRecorder is the mock recorder for MockForManagingFleets.
+type MockForManagingFleetsMockRecorder struct {
+	mock *MockForManagingFleets
+}
+
+// NewMockForManagingFleets creates a new mock instance.
+func NewMockForManagingFleets(ctrl *gomock.Controller) *MockForManagingFleets {
+	mock := &MockForManagingFleets{ctrl: ctrl}
+	mock.recorder = &MockForManagingFleetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingFleets) EXPECT() *MockForManagingFleetsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockForManagingFleets) Get(ctx context.Context, id uuid.UUID) (models.Fleet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Fleet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingFleetsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingFleets)(nil).Get), ctx, id)
+}
+
+// ListForPlanetOwner mocks base method.
+func (m *MockForManagingFleets) ListForPlanetOwner(ctx context.Context, planet uuid.UUID) ([]models.Fleet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlanetOwner", ctx, planet)
+	ret0, _ := ret[0].([]models.Fleet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlanetOwner indicates an expected call of ListForPlanetOwner.
+func (mr *MockForManagingFleetsMockRecorder) ListForPlanetOwner(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlanetOwner", reflect.TypeOf((*MockForManagingFleets)(nil).ListForPlanetOwner), ctx, planet)
+}
+
+// ListForPlayer mocks base method.
+func (m *MockForManagingFleets) ListForPlayer(ctx context.Context, player uuid.UUID) ([]models.Fleet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlayer", ctx, player)
+	ret0, _ := ret[0].([]models.Fleet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlayer indicates an expected call of ListForPlayer.
+func (mr *MockForManagingFleetsMockRecorder) ListForPlayer(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlayer", reflect.TypeOf((*MockForManagingFleets)(nil).ListForPlayer), ctx, player)
+}
@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_game_data.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_game_data.go -destination=drivenportstest/game_data_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingGameData is a mock of ForManagingGameData interface.
+type MockForManagingGameData struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingGameDataMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingGameDataMockRecorder is the mock recorder for MockForManagingGameData.
+type MockForManagingGameDataMockRecorder struct {
+	mock *MockForManagingGameData
+}
+
+// NewMockForManagingGameData creates a new mock instance.
+func NewMockForManagingGameData(ctrl *gomock.Controller) *MockForManagingGameData {
+	mock := &MockForManagingGameData{ctrl: ctrl}
+	mock.recorder = &MockForManagingGameDataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingGameData) EXPECT() *MockForManagingGameDataMockRecorder {
+	return m.recorder
+}
+
+// CreateBuilding mocks base method.
+func (m *MockForManagingGameData) CreateBuilding(ctx context.Context, building models.Building) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBuilding", ctx, building)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBuilding indicates an expected call of CreateBuilding.
+func (mr *MockForManagingGameDataMockRecorder) CreateBuilding(ctx, building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBuilding", reflect.TypeOf((*MockForManagingGameData)(nil).CreateBuilding), ctx, building)
+}
+
+// CreateResource mocks base method.
+func (m *MockForManagingGameData) CreateResource(ctx context.Context, resource models.Resource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResource", ctx, resource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateResource indicates an expected call of CreateResource.
+func (mr *MockForManagingGameDataMockRecorder) CreateResource(ctx, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResource", reflect.TypeOf((*MockForManagingGameData)(nil).CreateResource), ctx, resource)
+}
+
+// GetBuilding mocks base method.
+func (m *MockForManagingGameData) GetBuilding(ctx context.Context, id uuid.UUID) (models.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuilding", ctx, id)
+	ret0, _ := ret[0].(models.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuilding indicates an expected call of GetBuilding.
+func (mr *MockForManagingGameDataMockRecorder) GetBuilding(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuilding", reflect.TypeOf((*MockForManagingGameData)(nil).GetBuilding), ctx, id)
+}
+
+// GetResource mocks base method.
+func (m *MockForManagingGameData) GetResource(ctx context.Context, id uuid.UUID) (models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResource", ctx, id)
+	ret0, _ := ret[0].(models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResource indicates an expected call of GetResource.
+func (mr *MockForManagingGameDataMockRecorder) GetResource(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResource", reflect.TypeOf((*MockForManagingGameData)(nil).GetResource), ctx, id)
+}
+
+// ImportRuleset mocks base method.
+func (m *MockForManagingGameData) ImportRuleset(ctx context.Context, ruleset models.Ruleset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRuleset", ctx, ruleset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportRuleset indicates an expected call of ImportRuleset.
+func (mr *MockForManagingGameDataMockRecorder) ImportRuleset(ctx, ruleset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRuleset", reflect.TypeOf((*MockForManagingGameData)(nil).ImportRuleset), ctx, ruleset)
+}
+
+// ListBuildings mocks base method.
+func (m *MockForManagingGameData) ListBuildings(ctx context.Context) ([]models.Building, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildings", ctx)
+	ret0, _ := ret[0].([]models.Building)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuildings indicates an expected call of ListBuildings.
+func (mr *MockForManagingGameDataMockRecorder) ListBuildings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildings", reflect.TypeOf((*MockForManagingGameData)(nil).ListBuildings), ctx)
+}
+
+// ListResources mocks base method.
+func (m *MockForManagingGameData) ListResources(ctx context.Context) ([]models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", ctx)
+	ret0, _ := ret[0].([]models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockForManagingGameDataMockRecorder) ListResources(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockForManagingGameData)(nil).ListResources), ctx)
+}
+
+// UpdateBuilding mocks base method.
+func (m *MockForManagingGameData) UpdateBuilding(ctx context.Context, building models.Building) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuilding", ctx, building)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBuilding indicates an expected call of UpdateBuilding.
+func (mr *MockForManagingGameDataMockRecorder) UpdateBuilding(ctx, building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuilding", reflect.TypeOf((*MockForManagingGameData)(nil).UpdateBuilding), ctx, building)
+}
+
+// UpdateResource mocks base method.
+func (m *MockForManagingGameData) UpdateResource(ctx context.Context, resource models.Resource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResource", ctx, resource)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockForManagingGameDataMockRecorder) UpdateResource(ctx, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockForManagingGameData)(nil).UpdateResource), ctx, resource)
+}
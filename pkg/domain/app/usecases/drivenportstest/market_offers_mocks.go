@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_market_offers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_market_offers.go -destination=drivenportstest/market_offers_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingMarketOffers is a mock of ForManagingMarketOffers interface.
+type MockForManagingMarketOffers struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingMarketOffersMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingMarketOffersMockRecorder is the mock recorder for MockForManagingMarketOffers.
+type MockForManagingMarketOffersMockRecorder struct {
+	mock *MockForManagingMarketOffers
+}
+
+// NewMockForManagingMarketOffers creates a new mock instance.
+func NewMockForManagingMarketOffers(ctrl *gomock.Controller) *MockForManagingMarketOffers {
+	mock := &MockForManagingMarketOffers{ctrl: ctrl}
+	mock.recorder = &MockForManagingMarketOffersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingMarketOffers) EXPECT() *MockForManagingMarketOffersMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockForManagingMarketOffers) Get(ctx context.Context, id uuid.UUID) (models.MarketOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.MarketOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingMarketOffersMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingMarketOffers)(nil).Get), ctx, id)
+}
+
+// ListForUniverse mocks base method.
+func (m *MockForManagingMarketOffers) ListForUniverse(ctx context.Context, universe uuid.UUID, offset, limit int) ([]models.MarketOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUniverse", ctx, universe, offset, limit)
+	ret0, _ := ret[0].([]models.MarketOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUniverse indicates an expected call of ListForUniverse.
+func (mr *MockForManagingMarketOffersMockRecorder) ListForUniverse(ctx, universe, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUniverse", reflect.TypeOf((*MockForManagingMarketOffers)(nil).ListForUniverse), ctx, universe, offset, limit)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_messages.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_messages.go -destination=drivenportstest/messages_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingMessages is a mock of ForManagingMessages interface.
+type MockForManagingMessages struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingMessagesMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingMessagesMockRecorder is the mock recorder for MockForManagingMessages.
+type MockForManagingMessagesMockRecorder struct {
+	mock *MockForManagingMessages
+}
+
+// NewMockForManagingMessages creates a new mock instance.
+func NewMockForManagingMessages(ctrl *gomock.Controller) *MockForManagingMessages {
+	mock := &MockForManagingMessages{ctrl: ctrl}
+	mock.recorder = &MockForManagingMessagesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingMessages) EXPECT() *MockForManagingMessagesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockForManagingMessages) Create(ctx context.Context, message models.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockForManagingMessagesMockRecorder) Create(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockForManagingMessages)(nil).Create), ctx, message)
+}
+
+// Delete mocks base method.
+func (m *MockForManagingMessages) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockForManagingMessagesMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockForManagingMessages)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockForManagingMessages) Get(ctx context.Context, id uuid.UUID) (models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingMessagesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingMessages)(nil).Get), ctx, id)
+}
+
+// ListForPlayer mocks base method.
+func (m *MockForManagingMessages) ListForPlayer(ctx context.Context, player uuid.UUID, offset, limit int) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlayer", ctx, player, offset, limit)
+	ret0, _ := ret[0].([]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlayer indicates an expected call of ListForPlayer.
+func (mr *MockForManagingMessagesMockRecorder) ListForPlayer(ctx, player, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlayer", reflect.TypeOf((*MockForManagingMessages)(nil).ListForPlayer), ctx, player, offset, limit)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockForManagingMessages) MarkAsRead(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockForManagingMessagesMockRecorder) MarkAsRead(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockForManagingMessages)(nil).MarkAsRead), ctx, id)
+}
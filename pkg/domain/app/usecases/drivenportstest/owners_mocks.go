@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_fetching_owners.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_fetching_owners.go -destination=drivenportstest/owners_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForFetchingOwners is a mock of ForFetchingOwners interface.
+type MockForFetchingOwners struct {
+	ctrl     *gomock.Controller
+	recorder *MockForFetchingOwnersMockRecorder
+	isgomock struct{}
+}
+
+// MockForFetchingOwnersMockRecorder is the mock recorder for MockForFetchingOwners.
+type MockForFetchingOwnersMockRecorder struct {
+	mock *MockForFetchingOwners
+}
+
+// NewMockForFetchingOwners creates a new mock instance.
+func NewMockForFetchingOwners(ctrl *gomock.Controller) *MockForFetchingOwners {
+	mock := &MockForFetchingOwners{ctrl: ctrl}
+	mock.recorder = &MockForFetchingOwnersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForFetchingOwners) EXPECT() *MockForFetchingOwnersMockRecorder {
+	return m.recorder
+}
+
+// GetFleetOwner mocks base method.
+func (m *MockForFetchingOwners) GetFleetOwner(ctx context.Context, fleet uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFleetOwner", ctx, fleet)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFleetOwner indicates an expected call of GetFleetOwner.
+func (mr *MockForFetchingOwnersMockRecorder) GetFleetOwner(ctx, fleet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFleetOwner", reflect.TypeOf((*MockForFetchingOwners)(nil).GetFleetOwner), ctx, fleet)
+}
+
+// GetMarketOfferOwner mocks base method.
+func (m *MockForFetchingOwners) GetMarketOfferOwner(ctx context.Context, offer uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarketOfferOwner", ctx, offer)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarketOfferOwner indicates an expected call of GetMarketOfferOwner.
+func (mr *MockForFetchingOwnersMockRecorder) GetMarketOfferOwner(ctx, offer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketOfferOwner", reflect.TypeOf((*MockForFetchingOwners)(nil).GetMarketOfferOwner), ctx, offer)
+}
+
+// GetPlanetOwner mocks base method.
+func (m *MockForFetchingOwners) GetPlanetOwner(ctx context.Context, planet uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlanetOwner", ctx, planet)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlanetOwner indicates an expected call of GetPlanetOwner.
+func (mr *MockForFetchingOwnersMockRecorder) GetPlanetOwner(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanetOwner", reflect.TypeOf((*MockForFetchingOwners)(nil).GetPlanetOwner), ctx, planet)
+}
+
+// GetPlayerOwner mocks base method.
+func (m *MockForFetchingOwners) GetPlayerOwner(ctx context.Context, player uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerOwner", ctx, player)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerOwner indicates an expected call of GetPlayerOwner.
+func (mr *MockForFetchingOwnersMockRecorder) GetPlayerOwner(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerOwner", reflect.TypeOf((*MockForFetchingOwners)(nil).GetPlayerOwner), ctx, player)
+}
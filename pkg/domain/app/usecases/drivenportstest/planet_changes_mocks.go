@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_watching_planet_changes.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_watching_planet_changes.go -destination=drivenportstest/planet_changes_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForWatchingPlanetChanges is a mock of ForWatchingPlanetChanges interface.
+type MockForWatchingPlanetChanges struct {
+	ctrl     *gomock.Controller
+	recorder *MockForWatchingPlanetChangesMockRecorder
+	isgomock struct{}
+}
+
+// MockForWatchingPlanetChangesMockRecorder is the mock recorder for MockForWatchingPlanetChanges.
+type MockForWatchingPlanetChangesMockRecorder struct {
+	mock *MockForWatchingPlanetChanges
+}
+
+// NewMockForWatchingPlanetChanges creates a new mock instance.
+func NewMockForWatchingPlanetChanges(ctrl *gomock.Controller) *MockForWatchingPlanetChanges {
+	mock := &MockForWatchingPlanetChanges{ctrl: ctrl}
+	mock.recorder = &MockForWatchingPlanetChangesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForWatchingPlanetChanges) EXPECT() *MockForWatchingPlanetChangesMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockForWatchingPlanetChanges) Subscribe(ctx context.Context, topic models.PlanetChangeTopic) <-chan models.PlanetChange {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, topic)
+	ret0, _ := ret[0].(<-chan models.PlanetChange)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockForWatchingPlanetChangesMockRecorder) Subscribe(ctx, topic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockForWatchingPlanetChanges)(nil).Subscribe), ctx, topic)
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_planet_events.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_planet_events.go -destination=drivenportstest/planet_events_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingPlanetEvents is a mock of ForManagingPlanetEvents interface.
+type MockForManagingPlanetEvents struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingPlanetEventsMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingPlanetEventsMockRecorder is the mock recorder for MockForManagingPlanetEvents.
+type MockForManagingPlanetEventsMockRecorder struct {
+	mock *MockForManagingPlanetEvents
+}
+
+// NewMockForManagingPlanetEvents creates a new mock instance.
+func NewMockForManagingPlanetEvents(ctrl *gomock.Controller) *MockForManagingPlanetEvents {
+	mock := &MockForManagingPlanetEvents{ctrl: ctrl}
+	mock.recorder = &MockForManagingPlanetEventsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingPlanetEvents) EXPECT() *MockForManagingPlanetEventsMockRecorder {
+	return m.recorder
+}
+
+// ListForPlanet mocks base method.
+func (m *MockForManagingPlanetEvents) ListForPlanet(ctx context.Context, planet uuid.UUID, offset, limit int) ([]models.PlanetEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlanet", ctx, planet, offset, limit)
+	ret0, _ := ret[0].([]models.PlanetEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlanet indicates an expected call of ListForPlanet.
+func (mr *MockForManagingPlanetEventsMockRecorder) ListForPlanet(ctx, planet, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlanet", reflect.TypeOf((*MockForManagingPlanetEvents)(nil).ListForPlanet), ctx, planet, offset, limit)
+}
+
+// ListForPlanetUntil mocks base method.
+func (m *MockForManagingPlanetEvents) ListForPlanetUntil(ctx context.Context, planet uuid.UUID, until time.Time) ([]models.PlanetEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPlanetUntil", ctx, planet, until)
+	ret0, _ := ret[0].([]models.PlanetEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPlanetUntil indicates an expected call of ListForPlanetUntil.
+func (mr *MockForManagingPlanetEventsMockRecorder) ListForPlanetUntil(ctx, planet, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPlanetUntil", reflect.TypeOf((*MockForManagingPlanetEvents)(nil).ListForPlanetUntil), ctx, planet, until)
+}
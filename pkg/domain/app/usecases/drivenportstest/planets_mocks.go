@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -55,6 +56,51 @@ func (mr *MockForManagingPlanetsMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockForManagingPlanets)(nil).Delete), ctx, id)
 }
 
+// Get mocks base method.
+func (m *MockForManagingPlanets) Get(ctx context.Context, id uuid.UUID) (models.Planet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Planet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingPlanetsMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingPlanets)(nil).Get), ctx, id)
+}
+
+// GetAtCoordinate mocks base method.
+func (m *MockForManagingPlanets) GetAtCoordinate(ctx context.Context, origin uuid.UUID, coordinate models.Coordinate) (models.FleetTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAtCoordinate", ctx, origin, coordinate)
+	ret0, _ := ret[0].(models.FleetTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAtCoordinate indicates an expected call of GetAtCoordinate.
+func (mr *MockForManagingPlanetsMockRecorder) GetAtCoordinate(ctx, origin, coordinate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAtCoordinate", reflect.TypeOf((*MockForManagingPlanets)(nil).GetAtCoordinate), ctx, origin, coordinate)
+}
+
+// GetUniverse mocks base method.
+func (m *MockForManagingPlanets) GetUniverse(ctx context.Context, planet uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUniverse", ctx, planet)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUniverse indicates an expected call of GetUniverse.
+func (mr *MockForManagingPlanetsMockRecorder) GetUniverse(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUniverse", reflect.TypeOf((*MockForManagingPlanets)(nil).GetUniverse), ctx, planet)
+}
+
 // ListForPlayer mocks base method.
 func (m *MockForManagingPlanets) ListForPlayer(ctx context.Context, player uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
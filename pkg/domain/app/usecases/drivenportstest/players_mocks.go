@@ -42,6 +42,20 @@ func (m *MockForManagingPlayers) EXPECT() *MockForManagingPlayersMockRecorder {
 	return m.recorder
 }
 
+// Colonize mocks base method.
+func (m *MockForManagingPlayers) Colonize(ctx context.Context, player models.Player, planet models.Planet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Colonize", ctx, player, planet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Colonize indicates an expected call of Colonize.
+func (mr *MockForManagingPlayersMockRecorder) Colonize(ctx, player, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Colonize", reflect.TypeOf((*MockForManagingPlayers)(nil).Colonize), ctx, player, planet)
+}
+
 // Create mocks base method.
 func (m *MockForManagingPlayers) Create(ctx context.Context, player models.Player, homeworld models.Planet) error {
 	m.ctrl.T.Helper()
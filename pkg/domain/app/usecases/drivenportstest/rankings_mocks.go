@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_rankings.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_rankings.go -destination=drivenportstest/rankings_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingRankings is a mock of ForManagingRankings interface.
+type MockForManagingRankings struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingRankingsMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingRankingsMockRecorder is the mock recorder for MockForManagingRankings.
+type MockForManagingRankingsMockRecorder struct {
+	mock *MockForManagingRankings
+}
+
+// NewMockForManagingRankings creates a new mock instance.
+func NewMockForManagingRankings(ctrl *gomock.Controller) *MockForManagingRankings {
+	mock := &MockForManagingRankings{ctrl: ctrl}
+	mock.recorder = &MockForManagingRankingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingRankings) EXPECT() *MockForManagingRankingsMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockForManagingRankings) List(ctx context.Context, universe uuid.UUID, offset, limit int) ([]models.Ranking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, universe, offset, limit)
+	ret0, _ := ret[0].([]models.Ranking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockForManagingRankingsMockRecorder) List(ctx, universe, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockForManagingRankings)(nil).List), ctx, universe, offset, limit)
+}
+
+// ListBuildingLevels mocks base method.
+func (m *MockForManagingRankings) ListBuildingLevels(ctx context.Context, universe uuid.UUID) ([]models.PlayerBuildingLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildingLevels", ctx, universe)
+	ret0, _ := ret[0].([]models.PlayerBuildingLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuildingLevels indicates an expected call of ListBuildingLevels.
+func (mr *MockForManagingRankingsMockRecorder) ListBuildingLevels(ctx, universe any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildingLevels", reflect.TypeOf((*MockForManagingRankings)(nil).ListBuildingLevels), ctx, universe)
+}
+
+// ListPlayers mocks base method.
+func (m *MockForManagingRankings) ListPlayers(ctx context.Context, universe uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlayers", ctx, universe)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlayers indicates an expected call of ListPlayers.
+func (mr *MockForManagingRankingsMockRecorder) ListPlayers(ctx, universe any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayers", reflect.TypeOf((*MockForManagingRankings)(nil).ListPlayers), ctx, universe)
+}
+
+// Replace mocks base method.
+func (m *MockForManagingRankings) Replace(ctx context.Context, universe uuid.UUID, rankings []models.Ranking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, universe, rankings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockForManagingRankingsMockRecorder) Replace(ctx, universe, rankings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockForManagingRankings)(nil).Replace), ctx, universe, rankings)
+}
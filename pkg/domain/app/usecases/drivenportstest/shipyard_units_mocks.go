@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_fetching_shipyard_units.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_fetching_shipyard_units.go -destination=drivenportstest/shipyard_units_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForFetchingShipyardUnits is a mock of ForFetchingShipyardUnits interface.
+type MockForFetchingShipyardUnits struct {
+	ctrl     *gomock.Controller
+	recorder *MockForFetchingShipyardUnitsMockRecorder
+	isgomock struct{}
+}
+
+// MockForFetchingShipyardUnitsMockRecorder is the mock recorder for MockForFetchingShipyardUnits.
+type MockForFetchingShipyardUnitsMockRecorder struct {
+	mock *MockForFetchingShipyardUnits
+}
+
+// NewMockForFetchingShipyardUnits creates a new mock instance.
+func NewMockForFetchingShipyardUnits(ctrl *gomock.Controller) *MockForFetchingShipyardUnits {
+	mock := &MockForFetchingShipyardUnits{ctrl: ctrl}
+	mock.recorder = &MockForFetchingShipyardUnitsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForFetchingShipyardUnits) EXPECT() *MockForFetchingShipyardUnitsMockRecorder {
+	return m.recorder
+}
+
+// GetDefense mocks base method.
+func (m *MockForFetchingShipyardUnits) GetDefense(ctx context.Context, id uuid.UUID) (models.Defense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefense", ctx, id)
+	ret0, _ := ret[0].(models.Defense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefense indicates an expected call of GetDefense.
+func (mr *MockForFetchingShipyardUnitsMockRecorder) GetDefense(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefense", reflect.TypeOf((*MockForFetchingShipyardUnits)(nil).GetDefense), ctx, id)
+}
+
+// GetShip mocks base method.
+func (m *MockForFetchingShipyardUnits) GetShip(ctx context.Context, id uuid.UUID) (models.Ship, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShip", ctx, id)
+	ret0, _ := ret[0].(models.Ship)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShip indicates an expected call of GetShip.
+func (mr *MockForFetchingShipyardUnitsMockRecorder) GetShip(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShip", reflect.TypeOf((*MockForFetchingShipyardUnits)(nil).GetShip), ctx, id)
+}
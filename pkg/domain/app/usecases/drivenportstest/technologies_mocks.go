@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../ports/driven/for_managing_technologies.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../ports/driven/for_managing_technologies.go -destination=drivenportstest/technologies_mocks.go -package=drivenportstest
+//
+
+// Package drivenportstest is a generated GoMock package.
+package drivenportstest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForManagingTechnologies is a mock of ForManagingTechnologies interface.
+type MockForManagingTechnologies struct {
+	ctrl     *gomock.Controller
+	recorder *MockForManagingTechnologiesMockRecorder
+	isgomock struct{}
+}
+
+// MockForManagingTechnologiesMockRecorder is the mock recorder for MockForManagingTechnologies.
+type MockForManagingTechnologiesMockRecorder struct {
+	mock *MockForManagingTechnologies
+}
+
+// NewMockForManagingTechnologies creates a new mock instance.
+func NewMockForManagingTechnologies(ctrl *gomock.Controller) *MockForManagingTechnologies {
+	mock := &MockForManagingTechnologies{ctrl: ctrl}
+	mock.recorder = &MockForManagingTechnologiesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForManagingTechnologies) EXPECT() *MockForManagingTechnologiesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockForManagingTechnologies) Get(ctx context.Context, id uuid.UUID) (models.Technology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Technology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForManagingTechnologiesMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingTechnologies)(nil).Get), ctx, id)
+}
+
+// GetResearchForPlayer mocks base method.
+func (m *MockForManagingTechnologies) GetResearchForPlayer(ctx context.Context, player uuid.UUID) (models.Research, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResearchForPlayer", ctx, player)
+	ret0, _ := ret[0].(models.Research)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResearchForPlayer indicates an expected call of GetResearchForPlayer.
+func (mr *MockForManagingTechnologiesMockRecorder) GetResearchForPlayer(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResearchForPlayer", reflect.TypeOf((*MockForManagingTechnologies)(nil).GetResearchForPlayer), ctx, player)
+}
+
+// ListLevelsForPlayer mocks base method.
+func (m *MockForManagingTechnologies) ListLevelsForPlayer(ctx context.Context, player uuid.UUID) ([]models.PlayerTechnology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLevelsForPlayer", ctx, player)
+	ret0, _ := ret[0].([]models.PlayerTechnology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLevelsForPlayer indicates an expected call of ListLevelsForPlayer.
+func (mr *MockForManagingTechnologiesMockRecorder) ListLevelsForPlayer(ctx, player any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLevelsForPlayer", reflect.TypeOf((*MockForManagingTechnologies)(nil).ListLevelsForPlayer), ctx, player)
+}
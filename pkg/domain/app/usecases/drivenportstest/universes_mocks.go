@@ -85,6 +85,21 @@ func (mr *MockForManagingUniversesMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForManagingUniverses)(nil).Get), ctx, id)
 }
 
+// GetTopology mocks base method.
+func (m *MockForManagingUniverses) GetTopology(ctx context.Context, id uuid.UUID) (models.UniverseTopology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopology", ctx, id)
+	ret0, _ := ret[0].(models.UniverseTopology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopology indicates an expected call of GetTopology.
+func (mr *MockForManagingUniversesMockRecorder) GetTopology(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopology", reflect.TypeOf((*MockForManagingUniverses)(nil).GetTopology), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockForManagingUniverses) List(ctx context.Context) ([]models.Universe, error) {
 	m.ctrl.T.Helper()
@@ -99,3 +114,33 @@ func (mr *MockForManagingUniversesMockRecorder) List(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockForManagingUniverses)(nil).List), ctx)
 }
+
+// ListExchangeRatesForPlanet mocks base method.
+func (m *MockForManagingUniverses) ListExchangeRatesForPlanet(ctx context.Context, planet uuid.UUID) ([]models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExchangeRatesForPlanet", ctx, planet)
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExchangeRatesForPlanet indicates an expected call of ListExchangeRatesForPlanet.
+func (mr *MockForManagingUniversesMockRecorder) ListExchangeRatesForPlanet(ctx, planet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExchangeRatesForPlanet", reflect.TypeOf((*MockForManagingUniverses)(nil).ListExchangeRatesForPlanet), ctx, planet)
+}
+
+// ListPlanetsInSolarSystem mocks base method.
+func (m *MockForManagingUniverses) ListPlanetsInSolarSystem(ctx context.Context, universe uuid.UUID, galaxy, solarSystem int) ([]models.SolarSystemPlanet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlanetsInSolarSystem", ctx, universe, galaxy, solarSystem)
+	ret0, _ := ret[0].([]models.SolarSystemPlanet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlanetsInSolarSystem indicates an expected call of ListPlanetsInSolarSystem.
+func (mr *MockForManagingUniversesMockRecorder) ListPlanetsInSolarSystem(ctx, universe, galaxy, solarSystem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlanetsInSolarSystem", reflect.TypeOf((*MockForManagingUniverses)(nil).ListPlanetsInSolarSystem), ctx, universe, galaxy, solarSystem)
+}
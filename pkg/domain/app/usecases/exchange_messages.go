@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type MessageUseCase struct {
+	messageRepo drivenports.ForManagingMessages
+	playerRepo  drivenports.ForManagingPlayers
+	clock       drivenports.ForFetchingTime
+}
+
+func NewMessageUseCase(
+	messageRepo drivenports.ForManagingMessages,
+	playerRepo drivenports.ForManagingPlayers,
+	clock drivenports.ForFetchingTime,
+) *MessageUseCase {
+	return &MessageUseCase{
+		messageRepo: messageRepo,
+		playerRepo:  playerRepo,
+		clock:       clock,
+	}
+}
+
+func (m *MessageUseCase) Send(
+	ctx context.Context,
+	req request.MessageCreationRequest,
+) (models.Message, error) {
+	sender, err := m.getPlayer(ctx, req.Sender)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	recipient, err := m.getPlayer(ctx, req.Recipient)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	moment := m.clock.Now(ctx)
+	message, err := models.NewPlayerMessage(sender, recipient, req.Subject, req.Content, moment)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	err = m.messageRepo.Create(ctx, message)
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	return message, nil
+}
+
+func (m *MessageUseCase) ListForPlayer(
+	ctx context.Context,
+	player uuid.UUID,
+	page request.PageRequest,
+) ([]models.Message, error) {
+	_, err := m.getPlayer(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.messageRepo.ListForPlayer(ctx, player, page.Offset(), page.Size)
+}
+
+func (m *MessageUseCase) MarkAsRead(ctx context.Context, player uuid.UUID, message uuid.UUID) error {
+	err := m.checkRecipient(ctx, player, message)
+	if err != nil {
+		return err
+	}
+
+	return m.messageRepo.MarkAsRead(ctx, message)
+}
+
+func (m *MessageUseCase) Delete(ctx context.Context, player uuid.UUID, message uuid.UUID) error {
+	err := m.checkRecipient(ctx, player, message)
+	if err != nil {
+		return err
+	}
+
+	return m.messageRepo.Delete(ctx, message)
+}
+
+func (m *MessageUseCase) getPlayer(ctx context.Context, id uuid.UUID) (models.Player, error) {
+	player, err := m.playerRepo.Get(ctx, id)
+	if err == domainerrors.ErrNotFound {
+		return models.Player{}, domainerrors.ErrPlayerNotFound
+	}
+
+	return player, err
+}
+
+// checkRecipient verifies that the message was received by the player: the
+// messages of other players are reported as not existing.
+func (m *MessageUseCase) checkRecipient(ctx context.Context, player uuid.UUID, id uuid.UUID) error {
+	message, err := m.messageRepo.Get(ctx, id)
+	if err == domainerrors.ErrNotFound {
+		return domainerrors.ErrMessageNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if message.Player != player {
+		return domainerrors.ErrMessageNotFound
+	}
+
+	return nil
+}
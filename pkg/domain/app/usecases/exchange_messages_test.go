@@ -0,0 +1,244 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type messageTestSuite struct {
+	mockMessageRepo *drivenportstest.MockForManagingMessages
+	mockPlayerRepo  *drivenportstest.MockForManagingPlayers
+	mockClock       *drivenportstest.MockForFetchingTime
+
+	usecase *MessageUseCase
+}
+
+func setupMessageTestSuite(t *testing.T) messageTestSuite {
+	ctrl := gomock.NewController(t)
+
+	suite := messageTestSuite{
+		mockMessageRepo: drivenportstest.NewMockForManagingMessages(ctrl),
+		mockPlayerRepo:  drivenportstest.NewMockForManagingPlayers(ctrl),
+		mockClock:       drivenportstest.NewMockForFetchingTime(ctrl),
+	}
+	suite.usecase = NewMessageUseCase(suite.mockMessageRepo, suite.mockPlayerRepo, suite.mockClock)
+
+	return suite
+}
+
+func TestUnit_ExchangeMessages_Send(t *testing.T) {
+	universe := uuid.New()
+	sender := models.Player{Id: uuid.New(), Universe: universe}
+	recipient := models.Player{Id: uuid.New(), Universe: universe}
+	req := request.MessageCreationRequest{
+		Sender:    sender.Id,
+		Recipient: recipient.Id,
+		Subject:   "hello",
+		Content:   "how are you?",
+	}
+
+	t.Run("persists message", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(sender.Id)).Times(1).Return(sender, nil)
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(recipient.Id)).Times(1).Return(recipient, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+
+		var captured models.Message
+		suite.mockMessageRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ any, message models.Message) error {
+				captured = message
+				return nil
+			})
+
+		actual, err := suite.usecase.Send(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.Message{
+			Id:        actual.Id,
+			Kind:      models.PlayerMessage,
+			Player:    recipient.Id,
+			Sender:    &sender.Id,
+			Subject:   "hello",
+			Content:   "how are you?",
+			CreatedAt: t1,
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, expected, captured)
+	})
+
+	t.Run("returns error when sender does not exist", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(sender.Id)).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayerNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when recipient does not exist", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(sender.Id)).Times(1).Return(sender, nil)
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(recipient.Id)).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayerNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when players are in different universes", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+		other := models.Player{Id: recipient.Id, Universe: uuid.New()}
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(sender.Id)).Times(1).Return(sender, nil)
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(recipient.Id)).Times(1).Return(other, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayersInDifferentUniverse, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(sender.Id)).Times(1).Return(sender, nil)
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(recipient.Id)).Times(1).Return(recipient, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockMessageRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(expectedErr)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ExchangeMessages_ListForPlayer(t *testing.T) {
+	player := models.Player{Id: uuid.New()}
+
+	t.Run("lists requested page", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+		expected := []models.Message{{Id: uuid.New(), Player: player.Id}}
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(player.Id)).Times(1).Return(player, nil)
+		suite.mockMessageRepo.EXPECT().
+			ListForPlayer(gomock.Any(), gomock.Eq(player.Id), gomock.Eq(10), gomock.Eq(5)).
+			Times(1).
+			Return(expected, nil)
+
+		actual, err := suite.usecase.ListForPlayer(t.Context(), player.Id, request.PageRequest{Page: 2, Size: 5})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when player does not exist", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.ListForPlayer(t.Context(), player.Id, request.PageRequest{Size: 5})
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayerNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ExchangeMessages_MarkAsRead(t *testing.T) {
+	player := uuid.New()
+	message := models.Message{Id: uuid.New(), Player: player}
+
+	t.Run("marks message as read", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockMessageRepo.EXPECT().Get(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(message, nil)
+		suite.mockMessageRepo.EXPECT().MarkAsRead(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(nil)
+
+		err := suite.usecase.MarkAsRead(t.Context(), player, message.Id)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when message does not exist", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockMessageRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Message{}, domainerrors.ErrNotFound)
+
+		err := suite.usecase.MarkAsRead(t.Context(), player, message.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMessageNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when message was received by another player", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockMessageRepo.EXPECT().Get(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(message, nil)
+
+		err := suite.usecase.MarkAsRead(t.Context(), uuid.New(), message.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMessageNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ExchangeMessages_Delete(t *testing.T) {
+	player := uuid.New()
+	message := models.Message{Id: uuid.New(), Player: player}
+
+	t.Run("deletes message", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockMessageRepo.EXPECT().Get(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(message, nil)
+		suite.mockMessageRepo.EXPECT().Delete(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(nil)
+
+		err := suite.usecase.Delete(t.Context(), player, message.Id)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when message was received by another player", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+
+		suite.mockMessageRepo.EXPECT().Get(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(message, nil)
+
+		err := suite.usecase.Delete(t.Context(), uuid.New(), message.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMessageNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		suite := setupMessageTestSuite(t)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockMessageRepo.EXPECT().Get(gomock.Any(), gomock.Eq(message.Id)).Times(1).Return(message, nil)
+		suite.mockMessageRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(1).Return(expectedErr)
+
+		err := suite.usecase.Delete(t.Context(), player, message.Id)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
@@ -1,9 +1,22 @@
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_checking_database_connection.go -destination=drivenportstest/database_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_fetching_owners.go -destination=drivenportstest/owners_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_fetching_game_data_roles.go -destination=drivenportstest/game_data_roles_mocks.go -package=drivenportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_fetching_time.go -destination=drivenportstest/time_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_fetching_shipyard_units.go -destination=drivenportstest/shipyard_units_mocks.go -package=drivenportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_listing_buildings.go -destination=drivenportstest/buildings_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_alliances.go -destination=drivenportstest/alliances_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_fleets.go -destination=drivenportstest/fleets_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_game_data.go -destination=drivenportstest/game_data_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_market_offers.go -destination=drivenportstest/market_offers_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_messages.go -destination=drivenportstest/messages_mocks.go -package=drivenportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_planets.go -destination=drivenportstest/planets_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_planet_events.go -destination=drivenportstest/planet_events_mocks.go -package=drivenportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_players.go -destination=drivenportstest/players_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_rankings.go -destination=drivenportstest/rankings_mocks.go -package=drivenportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_universes.go -destination=drivenportstest/universes_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_managing_technologies.go -destination=drivenportstest/technologies_mocks.go -package=drivenportstest
 //go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_mutating_planet.go -destination=drivenportstest/planet_mutator_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_scheduling_building_actions.go -destination=drivenportstest/building_action_scheduling_mocks.go -package=drivenportstest
+//go:generate go run go.uber.org/mock/mockgen -source=../ports/driven/for_watching_planet_changes.go -destination=drivenportstest/planet_changes_mocks.go -package=drivenportstest
 
 package usecases
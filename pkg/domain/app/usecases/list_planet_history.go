@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type PlanetHistoryUseCase struct {
+	planetRepo drivenports.ForManagingPlanets
+	eventRepo  drivenports.ForManagingPlanetEvents
+}
+
+func NewPlanetHistoryUseCase(
+	planetRepo drivenports.ForManagingPlanets,
+	eventRepo drivenports.ForManagingPlanetEvents,
+) *PlanetHistoryUseCase {
+	return &PlanetHistoryUseCase{
+		planetRepo: planetRepo,
+		eventRepo:  eventRepo,
+	}
+}
+
+// ListHistory returns the mutations committed to the planet, the most recent
+// first.
+func (p *PlanetHistoryUseCase) ListHistory(
+	ctx context.Context,
+	planet uuid.UUID,
+	page request.PageRequest,
+) ([]models.PlanetEvent, error) {
+	_, err := p.planetRepo.GetUniverse(ctx, planet)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.eventRepo.ListForPlanet(ctx, planet, page.Offset(), page.Size)
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_PlanetHistory_ListHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPlanetRepo := drivenportstest.NewMockForManagingPlanets(ctrl)
+	mockEventRepo := drivenportstest.NewMockForManagingPlanetEvents(ctrl)
+
+	planet := uuid.New()
+
+	t.Run("lists requested page", func(t *testing.T) {
+		expected := []models.PlanetEvent{
+			{Id: uuid.New(), Planet: planet, Kind: models.PlanetActionCreated, VersionBefore: 3, VersionAfter: 4},
+		}
+
+		mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), gomock.Eq(planet)).
+			Times(1).
+			Return(uuid.New(), nil)
+		mockEventRepo.EXPECT().
+			ListForPlanet(gomock.Any(), gomock.Eq(planet), gomock.Eq(20), gomock.Eq(10)).
+			Times(1).
+			Return(expected, nil)
+
+		usecase := NewPlanetHistoryUseCase(mockPlanetRepo, mockEventRepo)
+		actual, err := usecase.ListHistory(t.Context(), planet, request.PageRequest{Page: 2, Size: 10})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when planet does not exist", func(t *testing.T) {
+		mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), gomock.Eq(planet)).
+			Times(1).
+			Return(uuid.Nil, domainerrors.ErrNotFound)
+
+		usecase := NewPlanetHistoryUseCase(mockPlanetRepo, mockEventRepo)
+		_, err := usecase.ListHistory(t.Context(), planet, request.PageRequest{Page: 0, Size: 10})
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(uuid.New(), nil)
+		mockEventRepo.EXPECT().
+			ListForPlanet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewPlanetHistoryUseCase(mockPlanetRepo, mockEventRepo)
+		_, err := usecase.ListHistory(t.Context(), planet, request.PageRequest{Page: 0, Size: 10})
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
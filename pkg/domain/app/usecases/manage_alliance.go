@@ -0,0 +1,237 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type AllianceUseCase struct {
+	allianceRepo drivenports.ForManagingAlliances
+	playerRepo   drivenports.ForManagingPlayers
+	clock        drivenports.ForFetchingTime
+}
+
+func NewAllianceUseCase(
+	allianceRepo drivenports.ForManagingAlliances,
+	playerRepo drivenports.ForManagingPlayers,
+	clock drivenports.ForFetchingTime,
+) *AllianceUseCase {
+	return &AllianceUseCase{
+		allianceRepo: allianceRepo,
+		playerRepo:   playerRepo,
+		clock:        clock,
+	}
+}
+
+func (a *AllianceUseCase) Create(
+	ctx context.Context,
+	req request.AllianceCreationRequest,
+) (models.Alliance, error) {
+	founder, err := a.getPlayer(ctx, req.Founder)
+	if err != nil {
+		return models.Alliance{}, err
+	}
+
+	moment := a.clock.Now(ctx)
+	alliance, err := models.NewAlliance(founder, req.Name, req.Tag, moment)
+	if err != nil {
+		return models.Alliance{}, err
+	}
+
+	err = a.allianceRepo.Create(ctx, alliance)
+	if err != nil {
+		return models.Alliance{}, err
+	}
+
+	return alliance, nil
+}
+
+func (a *AllianceUseCase) Get(ctx context.Context, id uuid.UUID) (models.Alliance, error) {
+	alliance, err := a.allianceRepo.Get(ctx, id)
+	if err == domainerrors.ErrNotFound {
+		return models.Alliance{}, domainerrors.ErrAllianceNotFound
+	}
+
+	return alliance, err
+}
+
+func (a *AllianceUseCase) Disband(ctx context.Context, id uuid.UUID, player uuid.UUID) error {
+	alliance, err := a.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = alliance.CheckPermission(player, models.DisbandPermission)
+	if err != nil {
+		return err
+	}
+
+	return a.allianceRepo.Delete(ctx, alliance.Id)
+}
+
+func (a *AllianceUseCase) Apply(
+	ctx context.Context,
+	id uuid.UUID,
+	player uuid.UUID,
+) (models.AllianceApplication, error) {
+	alliance, err := a.Get(ctx, id)
+	if err != nil {
+		return models.AllianceApplication{}, err
+	}
+
+	applicant, err := a.getPlayer(ctx, player)
+	if err != nil {
+		return models.AllianceApplication{}, err
+	}
+
+	moment := a.clock.Now(ctx)
+	application, err := alliance.Apply(applicant, moment)
+	if err != nil {
+		return models.AllianceApplication{}, err
+	}
+
+	err = a.allianceRepo.CreateApplication(ctx, application)
+	if err != nil {
+		return models.AllianceApplication{}, err
+	}
+
+	return application, nil
+}
+
+func (a *AllianceUseCase) ListApplications(
+	ctx context.Context,
+	id uuid.UUID,
+	player uuid.UUID,
+) ([]models.AllianceApplication, error) {
+	alliance, err := a.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = alliance.CheckPermission(player, models.ManageApplicationsPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.allianceRepo.ListApplications(ctx, alliance.Id)
+}
+
+func (a *AllianceUseCase) AcceptApplication(
+	ctx context.Context,
+	id uuid.UUID,
+	applicationId uuid.UUID,
+	player uuid.UUID,
+) error {
+	alliance, application, err := a.getApplication(ctx, id, applicationId, player)
+	if err != nil {
+		return err
+	}
+
+	applicant, err := a.getPlayer(ctx, application.Player)
+	if err != nil {
+		return err
+	}
+
+	moment := a.clock.Now(ctx)
+	member, err := alliance.Accept(application, applicant, player, moment)
+	if err != nil {
+		return err
+	}
+
+	return a.allianceRepo.AddMember(ctx, alliance.Id, member)
+}
+
+func (a *AllianceUseCase) RejectApplication(
+	ctx context.Context,
+	id uuid.UUID,
+	applicationId uuid.UUID,
+	player uuid.UUID,
+) error {
+	_, application, err := a.getApplication(ctx, id, applicationId, player)
+	if err != nil {
+		return err
+	}
+
+	err = a.allianceRepo.DeleteApplication(ctx, application.Id)
+	if err == domainerrors.ErrNotFound {
+		return domainerrors.ErrApplicationNotFound
+	}
+
+	return err
+}
+
+func (a *AllianceUseCase) SetRank(ctx context.Context, req request.AllianceRankRequest) error {
+	alliance, err := a.Get(ctx, req.Alliance)
+	if err != nil {
+		return err
+	}
+
+	member, err := alliance.SetRank(req.Requester, req.Player, req.Rank)
+	if err != nil {
+		return err
+	}
+
+	return a.allianceRepo.UpdateMember(ctx, alliance.Id, member)
+}
+
+func (a *AllianceUseCase) Leave(ctx context.Context, id uuid.UUID, player uuid.UUID) error {
+	alliance, err := a.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = alliance.Leave(player)
+	if err != nil {
+		return err
+	}
+
+	return a.allianceRepo.RemoveMember(ctx, alliance.Id, player)
+}
+
+func (a *AllianceUseCase) getPlayer(ctx context.Context, id uuid.UUID) (models.Player, error) {
+	player, err := a.playerRepo.Get(ctx, id)
+	if err == domainerrors.ErrNotFound {
+		return models.Player{}, domainerrors.ErrPlayerNotFound
+	}
+
+	return player, err
+}
+
+// getApplication fetches an application of the alliance on behalf of a player
+// allowed to manage them. Applications to other alliances are reported as not
+// existing.
+func (a *AllianceUseCase) getApplication(
+	ctx context.Context,
+	id uuid.UUID,
+	applicationId uuid.UUID,
+	player uuid.UUID,
+) (models.Alliance, models.AllianceApplication, error) {
+	alliance, err := a.Get(ctx, id)
+	if err != nil {
+		return models.Alliance{}, models.AllianceApplication{}, err
+	}
+
+	err = alliance.CheckPermission(player, models.ManageApplicationsPermission)
+	if err != nil {
+		return models.Alliance{}, models.AllianceApplication{}, err
+	}
+
+	application, err := a.allianceRepo.GetApplication(ctx, applicationId)
+	if err == domainerrors.ErrNotFound {
+		return models.Alliance{}, models.AllianceApplication{}, domainerrors.ErrApplicationNotFound
+	}
+	if err != nil {
+		return models.Alliance{}, models.AllianceApplication{}, err
+	}
+
+	if application.Alliance != alliance.Id {
+		return models.Alliance{}, models.AllianceApplication{}, domainerrors.ErrApplicationNotFound
+	}
+
+	return alliance, application, nil
+}
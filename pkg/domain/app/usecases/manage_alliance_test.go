@@ -0,0 +1,446 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type allianceTestSuite struct {
+	mockAllianceRepo *drivenportstest.MockForManagingAlliances
+	mockPlayerRepo   *drivenportstest.MockForManagingPlayers
+	mockClock        *drivenportstest.MockForFetchingTime
+
+	usecase *AllianceUseCase
+}
+
+func setupAllianceTestSuite(t *testing.T) allianceTestSuite {
+	ctrl := gomock.NewController(t)
+
+	suite := allianceTestSuite{
+		mockAllianceRepo: drivenportstest.NewMockForManagingAlliances(ctrl),
+		mockPlayerRepo:   drivenportstest.NewMockForManagingPlayers(ctrl),
+		mockClock:        drivenportstest.NewMockForFetchingTime(ctrl),
+	}
+	suite.usecase = NewAllianceUseCase(suite.mockAllianceRepo, suite.mockPlayerRepo, suite.mockClock)
+
+	return suite
+}
+
+var (
+	testAllianceId = uuid.New()
+	testUniverseId = uuid.New()
+	testFounderId  = uuid.New()
+	testOfficerId  = uuid.New()
+	testMemberId   = uuid.New()
+)
+
+func newTestAlliance() models.Alliance {
+	return models.Alliance{
+		Id:       testAllianceId,
+		Universe: testUniverseId,
+		Name:     "my alliance",
+		Tag:      "ALLY",
+		Members: []models.AllianceMember{
+			{Player: testFounderId, Rank: models.FounderRank},
+			{Player: testOfficerId, Rank: models.OfficerRank},
+			{Player: testMemberId, Rank: models.MemberRank},
+		},
+	}
+}
+
+func TestUnit_ManageAlliance_Create(t *testing.T) {
+	founder := models.Player{Id: uuid.New(), Universe: uuid.New(), Name: "founder"}
+	req := request.AllianceCreationRequest{
+		Founder: founder.Id,
+		Name:    "my alliance",
+		Tag:     "ALLY",
+	}
+
+	t.Run("persists alliance", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(founder.Id)).Times(1).Return(founder, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+
+		var captured models.Alliance
+		suite.mockAllianceRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ any, alliance models.Alliance) error {
+				captured = alliance
+				return nil
+			})
+
+		actual, err := suite.usecase.Create(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.Alliance{
+			Id:        actual.Id,
+			Universe:  founder.Universe,
+			Name:      "my alliance",
+			Tag:       "ALLY",
+			CreatedAt: t1,
+			Members: []models.AllianceMember{
+				{Player: founder.Id, Name: "founder", Rank: models.FounderRank, JoinedAt: t1},
+			},
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, expected, captured)
+	})
+
+	t.Run("returns error when founder does not exist", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrPlayerNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when alliance is invalid", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		invalid := req
+		invalid.Tag = "A"
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(founder, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+
+		_, err := suite.usecase.Create(t.Context(), invalid)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAlliance, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(founder, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockAllianceRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrTagAlreadyTaken)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrTagAlreadyTaken, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_Get(t *testing.T) {
+	t.Run("returns error when alliance does not exist", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Get(t.Context(), uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrAllianceNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_Disband(t *testing.T) {
+	alliance := newTestAlliance()
+
+	t.Run("deletes alliance when requested by founder", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Eq(alliance.Id)).Times(1).Return(alliance, nil)
+		suite.mockAllianceRepo.EXPECT().Delete(gomock.Any(), gomock.Eq(alliance.Id)).Times(1).Return(nil)
+
+		err := suite.usecase.Disband(t.Context(), alliance.Id, testFounderId)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when requested by officer", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Eq(alliance.Id)).Times(1).Return(alliance, nil)
+
+		err := suite.usecase.Disband(t.Context(), alliance.Id, testOfficerId)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMissingAlliancePermission, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_Apply(t *testing.T) {
+	alliance := newTestAlliance()
+	player := models.Player{Id: uuid.New(), Universe: alliance.Universe}
+
+	t.Run("persists application", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Eq(alliance.Id)).Times(1).Return(alliance, nil)
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(player.Id)).Times(1).Return(player, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockAllianceRepo.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+		actual, err := suite.usecase.Apply(t.Context(), alliance.Id, player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.AllianceApplication{
+			Id:        actual.Id,
+			Alliance:  alliance.Id,
+			Player:    player.Id,
+			CreatedAt: t1,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when alliance does not exist", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Alliance{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Apply(t.Context(), alliance.Id, player.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAllianceNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when player is in another universe", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		other := models.Player{Id: player.Id, Universe: uuid.New()}
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(other, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+
+		_, err := suite.usecase.Apply(t.Context(), alliance.Id, player.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrAllianceNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_ListApplications(t *testing.T) {
+	alliance := newTestAlliance()
+
+	t.Run("lists applications for officer", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		expected := []models.AllianceApplication{{Id: uuid.New(), Alliance: alliance.Id}}
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+		suite.mockAllianceRepo.EXPECT().
+			ListApplications(gomock.Any(), gomock.Eq(alliance.Id)).
+			Times(1).
+			Return(expected, nil)
+
+		actual, err := suite.usecase.ListApplications(t.Context(), alliance.Id, testOfficerId)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when player is not a member", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+
+		_, err := suite.usecase.ListApplications(t.Context(), alliance.Id, uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotAllianceMember, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_AcceptApplication(t *testing.T) {
+	alliance := newTestAlliance()
+	applicant := models.Player{Id: uuid.New(), Universe: alliance.Universe, Name: "applicant"}
+	application := models.AllianceApplication{Id: uuid.New(), Alliance: alliance.Id, Player: applicant.Id}
+
+	t.Run("adds applicant as member", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(newTestAlliance(), nil)
+		suite.mockAllianceRepo.EXPECT().
+			GetApplication(gomock.Any(), gomock.Eq(application.Id)).
+			Times(1).
+			Return(application, nil)
+		suite.mockPlayerRepo.EXPECT().Get(gomock.Any(), gomock.Eq(applicant.Id)).Times(1).Return(applicant, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+
+		expectedMember := models.AllianceMember{
+			Player:   applicant.Id,
+			Name:     "applicant",
+			Rank:     models.MemberRank,
+			JoinedAt: t1,
+		}
+		suite.mockAllianceRepo.EXPECT().
+			AddMember(gomock.Any(), gomock.Any(), gomock.Eq(expectedMember)).
+			Times(1).
+			Return(nil)
+
+		err := suite.usecase.AcceptApplication(t.Context(), alliance.Id, application.Id, testOfficerId)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when player is not allowed", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+
+		err := suite.usecase.AcceptApplication(t.Context(), alliance.Id, application.Id, testMemberId)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMissingAlliancePermission, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when application does not exist", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+		suite.mockAllianceRepo.EXPECT().
+			GetApplication(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.AllianceApplication{}, domainerrors.ErrNotFound)
+
+		err := suite.usecase.AcceptApplication(t.Context(), alliance.Id, application.Id, testOfficerId)
+
+		assert.ErrorIs(t, err, domainerrors.ErrApplicationNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when application targets another alliance", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		other := models.AllianceApplication{Id: application.Id, Alliance: uuid.New(), Player: applicant.Id}
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+		suite.mockAllianceRepo.EXPECT().GetApplication(gomock.Any(), gomock.Any()).Times(1).Return(other, nil)
+
+		err := suite.usecase.AcceptApplication(t.Context(), alliance.Id, application.Id, testOfficerId)
+
+		assert.ErrorIs(t, err, domainerrors.ErrApplicationNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_RejectApplication(t *testing.T) {
+	alliance := newTestAlliance()
+	application := models.AllianceApplication{Id: uuid.New(), Alliance: alliance.Id, Player: uuid.New()}
+
+	t.Run("deletes application", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+		suite.mockAllianceRepo.EXPECT().GetApplication(gomock.Any(), gomock.Any()).Times(1).Return(application, nil)
+		suite.mockAllianceRepo.EXPECT().
+			DeleteApplication(gomock.Any(), gomock.Eq(application.Id)).
+			Times(1).
+			Return(nil)
+
+		err := suite.usecase.RejectApplication(t.Context(), alliance.Id, application.Id, testFounderId)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when application was already handled", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(alliance, nil)
+		suite.mockAllianceRepo.EXPECT().GetApplication(gomock.Any(), gomock.Any()).Times(1).Return(application, nil)
+		suite.mockAllianceRepo.EXPECT().
+			DeleteApplication(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+
+		err := suite.usecase.RejectApplication(t.Context(), alliance.Id, application.Id, testFounderId)
+
+		assert.ErrorIs(t, err, domainerrors.ErrApplicationNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_SetRank(t *testing.T) {
+	alliance := newTestAlliance()
+
+	t.Run("updates member", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		req := request.AllianceRankRequest{
+			Alliance:  alliance.Id,
+			Requester: testFounderId,
+			Player:    testMemberId,
+			Rank:      models.OfficerRank,
+		}
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(newTestAlliance(), nil)
+		expectedMember := models.AllianceMember{Player: testMemberId, Rank: models.OfficerRank}
+		suite.mockAllianceRepo.EXPECT().
+			UpdateMember(gomock.Any(), gomock.Any(), gomock.Eq(expectedMember)).
+			Times(1).
+			Return(nil)
+
+		err := suite.usecase.SetRank(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when rank is invalid", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		req := request.AllianceRankRequest{
+			Alliance:  alliance.Id,
+			Requester: testFounderId,
+			Player:    testMemberId,
+			Rank:      "emperor",
+		}
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(newTestAlliance(), nil)
+
+		err := suite.usecase.SetRank(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidAllianceRank, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageAlliance_Leave(t *testing.T) {
+	alliance := newTestAlliance()
+
+	t.Run("removes member", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(newTestAlliance(), nil)
+		suite.mockAllianceRepo.EXPECT().
+			RemoveMember(gomock.Any(), gomock.Eq(alliance.Id), gomock.Eq(testMemberId)).
+			Times(1).
+			Return(nil)
+
+		err := suite.usecase.Leave(t.Context(), alliance.Id, testMemberId)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when founder leaves", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(newTestAlliance(), nil)
+
+		err := suite.usecase.Leave(t.Context(), alliance.Id, testFounderId)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFounderCannotLeave, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		suite := setupAllianceTestSuite(t)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockAllianceRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(newTestAlliance(), nil)
+		suite.mockAllianceRepo.EXPECT().RemoveMember(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(expectedErr)
+
+		err := suite.usecase.Leave(t.Context(), alliance.Id, testMemberId)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type BuildingQueueUseCase struct {
+	buildingRepo  drivenports.ForFetchingBuilding
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewBuildingQueueUseCase(
+	buildingRepo drivenports.ForFetchingBuilding,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *BuildingQueueUseCase {
+	return &BuildingQueueUseCase{
+		buildingRepo:  buildingRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+// List returns the building actions queued on the planet. Actions which
+// are already completed are first applied to the planet.
+func (b *BuildingQueueUseCase) List(
+	ctx context.Context,
+	planet uuid.UUID,
+) ([]models.BuildingAction, error) {
+	moment := b.clock.Now(ctx)
+
+	result, err := b.planetMutator.Mutate(ctx, planet, generateUpdateMutator(moment))
+	if err != nil {
+		return nil, err
+	}
+	if result.Deleted {
+		return nil, domainerrors.ErrNotFound
+	}
+
+	return result.Planet.BuildingQueue, nil
+}
+
+func (b *BuildingQueueUseCase) Reorder(
+	ctx context.Context,
+	planet uuid.UUID,
+	order []uuid.UUID,
+) ([]models.BuildingAction, error) {
+	moment := b.clock.Now(ctx)
+
+	buildings, err := b.buildingRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mutator := generateReorderMutator(moment, order, buildings)
+	result, err := b.planetMutator.Mutate(ctx, planet, mutator)
+	if err != nil {
+		return nil, err
+	}
+	if result.Deleted {
+		return nil, domainerrors.ErrNotFound
+	}
+
+	return result.Planet.BuildingQueue, nil
+}
+
+// Cancel removes the action from the queue of the planet and refunds its
+// costs. Later actions for the same building are cancelled as well as
+// they would otherwise target a level which can't be reached anymore, and
+// so are the ones whose requirements are not met without the action.
+func (b *BuildingQueueUseCase) Cancel(
+	ctx context.Context,
+	planet uuid.UUID,
+	action uuid.UUID,
+) error {
+	moment := b.clock.Now(ctx)
+
+	buildings, err := b.buildingRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	mutator := generateCancelMutator(moment, action, buildings)
+	result, err := b.planetMutator.Mutate(ctx, planet, mutator)
+	if err != nil {
+		return err
+	}
+	if result.Deleted {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func generateReorderMutator(
+	moment time.Time,
+	order []uuid.UUID,
+	buildings []models.Building,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.ReorderBuildingQueue(order, buildings)
+	}
+}
+
+func generateCancelMutator(
+	moment time.Time,
+	action uuid.UUID,
+	buildings []models.Building,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.CancelBuildingAction(action, buildings)
+	}
+}
@@ -0,0 +1,381 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type buildingQueueTestSuite struct {
+	ctrl             *gomock.Controller
+	mockBuildingRepo *drivenportstest.MockForFetchingBuilding
+	mockMutator      *drivenportstest.MockForMutatingPlanet
+	mockClock        *drivenportstest.MockForFetchingTime
+	usecase          *BuildingQueueUseCase
+}
+
+func TestUnit_ManageBuildingQueue_List(t *testing.T) {
+	t.Run("returns queue of the planet", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		expected := planet.BuildingQueue
+		moment := t1.Add(30 * time.Minute)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(moment)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.List(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, moment, planet.UpdatedAt)
+	})
+
+	t.Run("applies completed actions", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		last := planet.BuildingQueue[2]
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3.Add(time.Minute))
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.List(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.BuildingAction{last}, actual)
+		assert.Equal(t, 3, planet.Buildings[0].Level)
+		assert.Equal(t, 1, planet.Buildings[1].Level)
+	})
+
+	t.Run("returns error when planet is deleted", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		id := uuid.New()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.List(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when mutator fails", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		id := uuid.New()
+		errSample := errors.New("sample error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, errSample)
+
+		_, err := suite.usecase.List(t.Context(), id)
+
+		assert.ErrorIs(t, err, errSample, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageBuildingQueue_Reorder(t *testing.T) {
+	t.Run("moves queued actions", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		initialVersion := planet.Version
+		q := planet.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[2].Id, q[1].Id}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Reorder(t.Context(), planet.Id, order)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 3)
+		for i, action := range actual {
+			assert.Equal(t, order[i], action.Id)
+		}
+		assert.Equal(t, t4, actual[2].CompletedAt)
+		assert.Equal(t, initialVersion+2, planet.Version)
+	})
+
+	t.Run("returns error when head of the queue changes", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		q := planet.BuildingQueue
+		order := []uuid.UUID{q[1].Id, q[0].Id, q[2].Id}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Reorder(t.Context(), planet.Id, order)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidBuildingQueue, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when requirements are not met anymore", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		planet.Buildings = append(planet.Buildings, models.PlanetBuilding{
+			Building: uuid.New(),
+			Level:    0,
+		})
+		planet.BuildingQueue[2].Building = planet.Buildings[2].Building
+		planet.BuildingQueue[2].DesiredLevel = 1
+		q := planet.BuildingQueue
+		order := []uuid.UUID{q[0].Id, q[2].Id, q[1].Id}
+		buildings := []models.Building{
+			{
+				Id: q[2].Building,
+				Requirements: []models.BuildingRequirement{
+					{Building: q[1].Building, Level: 1},
+				},
+			},
+		}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return(buildings, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Reorder(t.Context(), planet.Id, order)
+
+		assert.ErrorIs(t, err, domainerrors.ErrRequirementsNotMet, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when buildings can't be fetched", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		id := uuid.New()
+		errSample := errors.New("sample error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return(nil, errSample)
+
+		_, err := suite.usecase.Reorder(t.Context(), id, []uuid.UUID{})
+
+		assert.ErrorIs(t, err, errSample, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet is deleted", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		id := uuid.New()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.Reorder(t.Context(), id, []uuid.UUID{})
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageBuildingQueue_Cancel(t *testing.T) {
+	t.Run("refunds cancelled action", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		q := planet.BuildingQueue
+		initialMetal := planet.Resources[0].Amount
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Cancel(t.Context(), planet.Id, q[1].Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, planet.BuildingQueue, 2)
+		assert.Equal(t, q[0].Id, planet.BuildingQueue[0].Id)
+		assert.Equal(t, q[2].Id, planet.BuildingQueue[1].Id)
+		assert.Equal(t, t3, planet.BuildingQueue[1].CompletedAt)
+		assert.Equal(t, initialMetal+100, planet.Resources[0].Amount)
+	})
+
+	t.Run("cancels following actions for the same building", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		q := planet.BuildingQueue
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Cancel(t.Context(), planet.Id, q[0].Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, planet.BuildingQueue, 1)
+		assert.Equal(t, q[1].Id, planet.BuildingQueue[0].Id)
+		assert.Equal(t, t2, planet.BuildingQueue[0].CompletedAt)
+	})
+
+	t.Run("cancels following actions whose requirements are not met anymore", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+		planet.Buildings = append(planet.Buildings, models.PlanetBuilding{
+			Building: uuid.New(),
+			Level:    0,
+		})
+		planet.BuildingQueue[2].Building = planet.Buildings[2].Building
+		planet.BuildingQueue[2].DesiredLevel = 1
+		q := planet.BuildingQueue
+		buildings := []models.Building{
+			{
+				Id: q[2].Building,
+				Requirements: []models.BuildingRequirement{
+					{Building: q[1].Building, Level: 1},
+				},
+			},
+		}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return(buildings, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Cancel(t.Context(), planet.Id, q[1].Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, planet.BuildingQueue, 1)
+		assert.Equal(t, q[0].Id, planet.BuildingQueue[0].Id)
+	})
+
+	t.Run("returns error when action is not queued", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		planet := generateTestPlanetWithQueue()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Cancel(t.Context(), planet.Id, uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNoActionInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet is deleted", func(t *testing.T) {
+		suite := setupBuildingQueueTestSuite(t)
+
+		id := uuid.New()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockBuildingRepo.EXPECT().List(gomock.Any()).Times(1).Return([]models.Building{}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		err := suite.usecase.Cancel(t.Context(), id, uuid.New())
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func setupBuildingQueueTestSuite(t *testing.T) *buildingQueueTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockBuildingRepo := drivenportstest.NewMockForFetchingBuilding(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &buildingQueueTestSuite{
+		ctrl:             ctrl,
+		mockBuildingRepo: mockBuildingRepo,
+		mockMutator:      mockMutator,
+		mockClock:        mockClock,
+		usecase:          NewBuildingQueueUseCase(mockBuildingRepo, mockMutator, mockClock),
+	}
+}
+
+// generateTestPlanetWithQueue returns a planet with three queued actions
+// completing respectively at t2, t3 and t4. The first and last actions
+// upgrade the same building.
+func generateTestPlanetWithQueue() models.Planet {
+	p := generateTestPlanet()
+	p.Buildings = append(p.Buildings, models.PlanetBuilding{
+		Building: uuid.New(),
+		Level:    0,
+	})
+
+	p.BuildingQueue = []models.BuildingAction{
+		{
+			Id:           uuid.New(),
+			Building:     p.Buildings[0].Building,
+			DesiredLevel: 3,
+			CreatedAt:    t1,
+			CompletedAt:  t2,
+		},
+		{
+			Id:           uuid.New(),
+			Building:     p.Buildings[1].Building,
+			DesiredLevel: 1,
+			CreatedAt:    t1,
+			CompletedAt:  t3,
+			Costs: []models.BuildingActionCost{
+				{Resource: metalResourceId, Amount: 100},
+			},
+		},
+		{
+			Id:           uuid.New(),
+			Building:     p.Buildings[0].Building,
+			DesiredLevel: 4,
+			CreatedAt:    t1,
+			CompletedAt:  t4,
+		},
+	}
+
+	return p
+}
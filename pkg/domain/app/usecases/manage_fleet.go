@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type FleetUseCase struct {
+	fleetRepo     drivenports.ForManagingFleets
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewFleetUseCase(
+	fleetRepo drivenports.ForManagingFleets,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *FleetUseCase {
+	return &FleetUseCase{
+		fleetRepo:     fleetRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+// ListForPlayer returns the fleets of the player. Fleets which reached
+// their next planet are first resolved by updating the planets at both
+// ends of their path.
+func (f *FleetUseCase) ListForPlayer(ctx context.Context, player uuid.UUID) ([]models.Fleet, error) {
+	moment := f.clock.Now(ctx)
+
+	fleets, err := f.fleetRepo.ListForPlayer(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := false
+	for _, fleet := range fleets {
+		if fleet.NextEventAt().After(moment) {
+			continue
+		}
+
+		if !fleet.Returning() {
+			_, err := f.planetMutator.Mutate(ctx, fleet.Target, generateUpdateMutator(moment))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err := f.planetMutator.Mutate(ctx, fleet.Origin, generateUpdateMutator(moment))
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = true
+	}
+
+	if !resolved {
+		return fleets, nil
+	}
+
+	return f.fleetRepo.ListForPlayer(ctx, player)
+}
+
+func (f *FleetUseCase) Recall(ctx context.Context, id uuid.UUID) (models.Fleet, error) {
+	moment := f.clock.Now(ctx)
+
+	fleet, err := f.fleetRepo.Get(ctx, id)
+	if err != nil {
+		return models.Fleet{}, err
+	}
+
+	result, err := f.planetMutator.Mutate(ctx, fleet.Origin, generateRecallMutator(moment, id))
+	if err != nil {
+		return models.Fleet{}, err
+	}
+	if result.Deleted {
+		return models.Fleet{}, domainerrors.ErrNotFound
+	}
+
+	for _, fleet := range result.Planet.Fleets {
+		if fleet.Id == id {
+			return fleet, nil
+		}
+	}
+
+	return models.Fleet{}, domainerrors.ErrNotFound
+}
+
+func generateRecallMutator(moment time.Time, id uuid.UUID) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.RecallFleet(id)
+	}
+}
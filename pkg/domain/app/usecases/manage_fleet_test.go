@@ -0,0 +1,250 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fleetTestSuite struct {
+	ctrl          *gomock.Controller
+	mockFleetRepo *drivenportstest.MockForManagingFleets
+	mockMutator   *drivenportstest.MockForMutatingPlanet
+	mockClock     *drivenportstest.MockForFetchingTime
+	usecase       *FleetUseCase
+}
+
+func TestUnit_ManageFleet_ListForPlayer(t *testing.T) {
+	t.Run("returns fleets which did not reach their next planet", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		player := uuid.New()
+		fleets := []models.Fleet{generateTestFleet()}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlayer(gomock.Any(), player).
+			Times(1).
+			Return(fleets, nil)
+
+		actual, err := suite.usecase.ListForPlayer(t.Context(), player)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, fleets, actual)
+	})
+
+	t.Run("resolves fleet which reached its target before listing", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		player := uuid.New()
+		fleet := generateTestFleet()
+		resolved := fleet
+		returnsAt := t4
+		resolved.ReturnsAt = &returnsAt
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		gomock.InOrder(
+			suite.mockFleetRepo.EXPECT().
+				ListForPlayer(gomock.Any(), player).
+				Times(1).
+				Return([]models.Fleet{fleet}, nil),
+			suite.mockMutator.EXPECT().
+				Mutate(gomock.Any(), fleet.Target, gomock.Any()).
+				Times(1).
+				Return(generateMutationResult(models.Planet{}), nil),
+			suite.mockMutator.EXPECT().
+				Mutate(gomock.Any(), fleet.Origin, gomock.Any()).
+				Times(1).
+				Return(generateMutationResult(models.Planet{}), nil),
+			suite.mockFleetRepo.EXPECT().
+				ListForPlayer(gomock.Any(), player).
+				Times(1).
+				Return([]models.Fleet{resolved}, nil),
+		)
+
+		actual, err := suite.usecase.ListForPlayer(t.Context(), player)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.Fleet{resolved}, actual)
+	})
+
+	t.Run("resolves returning fleet on its origin only", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		player := uuid.New()
+		fleet := generateTestFleet()
+		returnsAt := t3
+		fleet.ReturnsAt = &returnsAt
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t4)
+		gomock.InOrder(
+			suite.mockFleetRepo.EXPECT().
+				ListForPlayer(gomock.Any(), player).
+				Times(1).
+				Return([]models.Fleet{fleet}, nil),
+			suite.mockMutator.EXPECT().
+				Mutate(gomock.Any(), fleet.Origin, gomock.Any()).
+				Times(1).
+				Return(generateMutationResult(models.Planet{}), nil),
+			suite.mockFleetRepo.EXPECT().
+				ListForPlayer(gomock.Any(), player).
+				Times(1).
+				Return([]models.Fleet{}, nil),
+		)
+
+		actual, err := suite.usecase.ListForPlayer(t.Context(), player)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+
+	t.Run("returns error when listing fails", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		player := uuid.New()
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlayer(gomock.Any(), player).
+			Times(1).
+			Return(nil, expectedErr)
+
+		_, err := suite.usecase.ListForPlayer(t.Context(), player)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when resolving fleet fails", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		player := uuid.New()
+		fleet := generateTestFleet()
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlayer(gomock.Any(), player).
+			Times(1).
+			Return([]models.Fleet{fleet}, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), fleet.Target, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, expectedErr)
+
+		_, err := suite.usecase.ListForPlayer(t.Context(), player)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageFleet_Recall(t *testing.T) {
+	t.Run("makes fleet fly back to its origin", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		fleet := generateTestFleet()
+		planet := generateTestPlanet()
+		planet.Id = fleet.Origin
+		planet.Fleets = []models.Fleet{fleet}
+		moment := t1.Add(30 * time.Minute)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(moment)
+		suite.mockFleetRepo.EXPECT().
+			Get(gomock.Any(), fleet.Id).
+			Times(1).
+			Return(fleet, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), fleet.Origin, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Recall(t.Context(), fleet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.NotNil(t, actual.ReturnsAt)
+		assert.Equal(t, t2, *actual.ReturnsAt)
+		assert.Equal(t, moment, planet.UpdatedAt)
+	})
+
+	t.Run("returns error when fleet does not exist", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		id := uuid.New()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockFleetRepo.EXPECT().
+			Get(gomock.Any(), id).
+			Times(1).
+			Return(models.Fleet{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Recall(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fleet already arrived", func(t *testing.T) {
+		suite := setupFleetTestSuite(t)
+
+		fleet := generateTestFleet()
+		planet := generateTestPlanet()
+		planet.Id = fleet.Origin
+		planet.Fleets = []models.Fleet{fleet}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockFleetRepo.EXPECT().
+			Get(gomock.Any(), fleet.Id).
+			Times(1).
+			Return(fleet, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), fleet.Origin, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Recall(t.Context(), fleet.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFleetAlreadyArrived, "Actual err: %v", err)
+	})
+}
+
+func setupFleetTestSuite(t *testing.T) *fleetTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockFleetRepo := drivenportstest.NewMockForManagingFleets(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &fleetTestSuite{
+		ctrl:          ctrl,
+		mockFleetRepo: mockFleetRepo,
+		mockMutator:   mockMutator,
+		mockClock:     mockClock,
+		usecase:       NewFleetUseCase(mockFleetRepo, mockMutator, mockClock),
+	}
+}
+
+// generateTestFleet returns a transport fleet departing at t1 and
+// arriving at t3.
+func generateTestFleet() models.Fleet {
+	return models.Fleet{
+		Id:      uuid.New(),
+		Player:  uuid.New(),
+		Mission: models.TransportMission,
+		Origin:  uuid.New(),
+		Target:  uuid.New(),
+		Ships: []models.FleetShip{
+			{Ship: uuid.New(), Count: 2},
+		},
+		Cargo:      []models.FleetCargo{},
+		DepartedAt: t1,
+		ArrivesAt:  t3,
+	}
+}
@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type GameDataUseCase struct {
+	repo drivenports.ForManagingGameData
+}
+
+func NewGameDataUseCase(repo drivenports.ForManagingGameData) *GameDataUseCase {
+	return &GameDataUseCase{
+		repo: repo,
+	}
+}
+
+func (u *GameDataUseCase) ListResources(ctx context.Context) ([]models.Resource, error) {
+	return u.repo.ListResources(ctx)
+}
+
+func (u *GameDataUseCase) CreateResource(ctx context.Context, req request.ResourceRequest) (models.Resource, error) {
+	resource := request.FromResourceRequest(req)
+	if err := resource.Validate(); err != nil {
+		return models.Resource{}, err
+	}
+
+	err := u.repo.CreateResource(ctx, resource)
+	if err != nil {
+		return models.Resource{}, err
+	}
+
+	return resource, nil
+}
+
+func (u *GameDataUseCase) UpdateResource(
+	ctx context.Context,
+	id uuid.UUID,
+	req request.ResourceRequest,
+) (models.Resource, error) {
+	resource := request.FromResourceRequest(req)
+	resource.Id = id
+	if err := resource.Validate(); err != nil {
+		return models.Resource{}, err
+	}
+
+	current, err := u.repo.GetResource(ctx, id)
+	if err != nil {
+		return models.Resource{}, err
+	}
+	if err := models.ValidateGameDataRename(current.Name, resource.Name); err != nil {
+		return models.Resource{}, err
+	}
+
+	err = u.repo.UpdateResource(ctx, resource)
+	if err != nil {
+		return models.Resource{}, err
+	}
+
+	return u.repo.GetResource(ctx, id)
+}
+
+func (u *GameDataUseCase) ListBuildings(ctx context.Context) ([]models.Building, error) {
+	return u.repo.ListBuildings(ctx)
+}
+
+func (u *GameDataUseCase) CreateBuilding(ctx context.Context, req request.BuildingRequest) (models.Building, error) {
+	building := request.FromBuildingRequest(req)
+	if err := building.Validate(); err != nil {
+		return models.Building{}, err
+	}
+
+	err := u.repo.CreateBuilding(ctx, building)
+	if err != nil {
+		return models.Building{}, err
+	}
+
+	// The build time of the costs comes from the resources.
+	return u.repo.GetBuilding(ctx, building.Id)
+}
+
+func (u *GameDataUseCase) UpdateBuilding(
+	ctx context.Context,
+	id uuid.UUID,
+	req request.BuildingRequest,
+) (models.Building, error) {
+	building := request.FromBuildingRequest(req)
+	building.Id = id
+	if err := building.Validate(); err != nil {
+		return models.Building{}, err
+	}
+
+	current, err := u.repo.GetBuilding(ctx, id)
+	if err != nil {
+		return models.Building{}, err
+	}
+	if err := models.ValidateGameDataRename(current.Name, building.Name); err != nil {
+		return models.Building{}, err
+	}
+
+	err = u.repo.UpdateBuilding(ctx, building)
+	if err != nil {
+		return models.Building{}, err
+	}
+
+	return u.repo.GetBuilding(ctx, id)
+}
+
+func (u *GameDataUseCase) ImportRuleset(ctx context.Context, req request.RulesetRequest) error {
+	ruleset := request.FromRulesetRequest(req)
+	if err := ruleset.Validate(); err != nil {
+		return err
+	}
+
+	if err := u.validateRulesetRenames(ctx, ruleset); err != nil {
+		return err
+	}
+
+	return u.repo.ImportRuleset(ctx, ruleset)
+}
+
+// validateRulesetRenames checks that importing the ruleset does not rename
+// existing game data some rules depend on.
+func (u *GameDataUseCase) validateRulesetRenames(ctx context.Context, ruleset models.Ruleset) error {
+	current := make(map[uuid.UUID]string)
+
+	resources, err := u.repo.ListResources(ctx)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources {
+		current[resource.Id] = resource.Name
+	}
+
+	buildings, err := u.repo.ListBuildings(ctx)
+	if err != nil {
+		return err
+	}
+	for _, building := range buildings {
+		current[building.Id] = building.Name
+	}
+
+	for _, resource := range ruleset.Resources {
+		if err := models.ValidateGameDataRename(current[resource.Id], resource.Name); err != nil {
+			return err
+		}
+	}
+	for _, building := range ruleset.Buildings {
+		if err := models.ValidateGameDataRename(current[building.Id], building.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,388 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var gameDataId = uuid.MustParse("5c0b8b47-8f1e-4c2a-9d3b-7e6f5a4d3c2b")
+
+func TestUnit_ManageGameData_CreateResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("persists created resource", func(t *testing.T) {
+		var captured models.Resource
+		mockRepo.EXPECT().
+			CreateResource(gomock.Any(), gomock.AssignableToTypeOf(captured)).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, resource models.Resource) error {
+				captured = resource
+				return nil
+			})
+
+		beforeInsertion := time.Now()
+
+		usecase := NewGameDataUseCase(mockRepo)
+		actual, err := usecase.CreateResource(t.Context(), generateTestResourceRequest())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, "metal", captured.Name)
+		assert.True(t, beforeInsertion.Before(captured.CreatedAt))
+		assert.Equal(t, captured, actual)
+	})
+
+	t.Run("rejects invalid resource", func(t *testing.T) {
+		req := generateTestResourceRequest()
+		req.StartStorage = -1
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.CreateResource(t.Context(), req)
+
+		assert.Equal(t, domainerrors.ErrInvalidResource, err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+		mockRepo.EXPECT().
+			CreateResource(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(expectedErr)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.CreateResource(t.Context(), generateTestResourceRequest())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageGameData_UpdateResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("updates resource and returns it", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetResource(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Resource{Id: gameDataId, Name: "ore"}, nil)
+		var captured models.Resource
+		mockRepo.EXPECT().
+			UpdateResource(gomock.Any(), gomock.AssignableToTypeOf(captured)).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, resource models.Resource) error {
+				captured = resource
+				return nil
+			})
+		expected := models.Resource{Id: gameDataId, Name: "metal"}
+		mockRepo.EXPECT().
+			GetResource(gomock.Any(), gameDataId).
+			Times(1).
+			Return(expected, nil)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		actual, err := usecase.UpdateResource(t.Context(), gameDataId, generateTestResourceRequest())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, gameDataId, captured.Id)
+		assert.Equal(t, 0.0004, captured.BuildTimeHoursPerUnit)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when resource does not exist", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetResource(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Resource{}, domainerrors.ErrNotFound)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateResource(t.Context(), gameDataId, generateTestResourceRequest())
+
+		assert.Equal(t, domainerrors.ErrNotFound, err)
+	})
+
+	t.Run("rejects renaming a resource the rules depend on", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetResource(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Resource{Id: gameDataId, Name: models.EnergyResourceName}, nil)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateResource(t.Context(), gameDataId, generateTestResourceRequest())
+
+		assert.Equal(t, domainerrors.ErrGameDataRoleRenamed, err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetResource(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Resource{Id: gameDataId, Name: "metal"}, nil)
+		mockRepo.EXPECT().
+			UpdateResource(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateResource(t.Context(), gameDataId, generateTestResourceRequest())
+
+		assert.Equal(t, domainerrors.ErrNotFound, err)
+	})
+}
+
+func TestUnit_ManageGameData_CreateBuilding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("persists created building and returns it", func(t *testing.T) {
+		var captured models.Building
+		mockRepo.EXPECT().
+			CreateBuilding(gomock.Any(), gomock.AssignableToTypeOf(captured)).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, building models.Building) error {
+				captured = building
+				return nil
+			})
+		expected := models.Building{Name: "metal mine"}
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID) (models.Building, error) {
+				assert.Equal(t, captured.Id, id)
+				return expected, nil
+			})
+
+		usecase := NewGameDataUseCase(mockRepo)
+		actual, err := usecase.CreateBuilding(t.Context(), generateTestBuildingRequest())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, "metal mine", captured.Name)
+		assert.Len(t, captured.Costs, 1)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rejects invalid building", func(t *testing.T) {
+		req := generateTestBuildingRequest()
+		req.Costs[0].Cost = 0
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.CreateBuilding(t.Context(), req)
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+		mockRepo.EXPECT().
+			CreateBuilding(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(expectedErr)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.CreateBuilding(t.Context(), generateTestBuildingRequest())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageGameData_UpdateBuilding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("updates building and returns it", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Building{Id: gameDataId, Name: "metal mine"}, nil)
+		var captured models.Building
+		mockRepo.EXPECT().
+			UpdateBuilding(gomock.Any(), gomock.AssignableToTypeOf(captured)).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, building models.Building) error {
+				captured = building
+				return nil
+			})
+		expected := models.Building{Id: gameDataId, Name: "metal mine"}
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gameDataId).
+			Times(1).
+			Return(expected, nil)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		actual, err := usecase.UpdateBuilding(t.Context(), gameDataId, generateTestBuildingRequest())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, gameDataId, captured.Id)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rejects invalid building", func(t *testing.T) {
+		req := generateTestBuildingRequest()
+		req.Name = ""
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateBuilding(t.Context(), gameDataId, req)
+
+		assert.Equal(t, domainerrors.ErrInvalidBuilding, err)
+	})
+
+	t.Run("keeps the name of a building the rules depend on", func(t *testing.T) {
+		current := models.Building{Id: gameDataId, Name: models.ShipyardBuildingName}
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gameDataId).
+			Times(1).
+			Return(current, nil)
+		mockRepo.EXPECT().UpdateBuilding(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gameDataId).
+			Times(1).
+			Return(current, nil)
+		req := generateTestBuildingRequest()
+		req.Name = models.ShipyardBuildingName
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateBuilding(t.Context(), gameDataId, req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("rejects renaming a building the rules depend on", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Building{Id: gameDataId, Name: models.ShipyardBuildingName}, nil)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateBuilding(t.Context(), gameDataId, generateTestBuildingRequest())
+
+		assert.Equal(t, domainerrors.ErrGameDataRoleRenamed, err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetBuilding(gomock.Any(), gameDataId).
+			Times(1).
+			Return(models.Building{Id: gameDataId, Name: "metal mine"}, nil)
+		mockRepo.EXPECT().
+			UpdateBuilding(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(domainerrors.ErrNotFound)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		_, err := usecase.UpdateBuilding(t.Context(), gameDataId, generateTestBuildingRequest())
+
+		assert.Equal(t, domainerrors.ErrNotFound, err)
+	})
+}
+
+func TestUnit_ManageGameData_ImportRuleset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForManagingGameData(ctrl)
+
+	t.Run("imports ruleset with provided identifiers", func(t *testing.T) {
+		mockRepo.EXPECT().ListResources(gomock.Any()).Times(1).Return(nil, nil)
+		mockRepo.EXPECT().ListBuildings(gomock.Any()).Times(1).Return(nil, nil)
+		var captured models.Ruleset
+		mockRepo.EXPECT().
+			ImportRuleset(gomock.Any(), gomock.AssignableToTypeOf(captured)).
+			Times(1).
+			DoAndReturn(func(ctx context.Context, ruleset models.Ruleset) error {
+				captured = ruleset
+				return nil
+			})
+
+		usecase := NewGameDataUseCase(mockRepo)
+		err := usecase.ImportRuleset(t.Context(), generateTestRulesetRequest())
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, captured.Resources, 1)
+		assert.Equal(t, metalResourceId, captured.Resources[0].Id)
+		require.Len(t, captured.Buildings, 1)
+		assert.Equal(t, gameDataId, captured.Buildings[0].Id)
+		assert.Equal(t, []models.PlanetFieldRange{{Position: 1, Min: 40, Max: 70}}, captured.FieldRanges)
+	})
+
+	t.Run("rejects ruleset with building using undeclared resource", func(t *testing.T) {
+		req := generateTestRulesetRequest()
+		req.Resources = nil
+
+		usecase := NewGameDataUseCase(mockRepo)
+		err := usecase.ImportRuleset(t.Context(), req)
+
+		assert.Equal(t, domainerrors.ErrInvalidRuleset, err)
+	})
+
+	t.Run("rejects ruleset renaming a building the rules depend on", func(t *testing.T) {
+		mockRepo.EXPECT().ListResources(gomock.Any()).Times(1).Return(nil, nil)
+		mockRepo.EXPECT().
+			ListBuildings(gomock.Any()).
+			Times(1).
+			Return([]models.Building{{Id: gameDataId, Name: models.ShipyardBuildingName}}, nil)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		err := usecase.ImportRuleset(t.Context(), generateTestRulesetRequest())
+
+		assert.Equal(t, domainerrors.ErrGameDataRoleRenamed, err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+		mockRepo.EXPECT().ListResources(gomock.Any()).Times(1).Return(nil, nil)
+		mockRepo.EXPECT().ListBuildings(gomock.Any()).Times(1).Return(nil, nil)
+		mockRepo.EXPECT().
+			ImportRuleset(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(expectedErr)
+
+		usecase := NewGameDataUseCase(mockRepo)
+		err := usecase.ImportRuleset(t.Context(), generateTestRulesetRequest())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func generateTestResourceRequest() request.ResourceRequest {
+	return request.ResourceRequest{
+		Name:                  "metal",
+		StartAmount:           500,
+		StartProduction:       30,
+		StartStorage:          10000,
+		BuildTimeHoursPerUnit: 0.0004,
+	}
+}
+
+func generateTestBuildingRequest() request.BuildingRequest {
+	return request.BuildingRequest{
+		Name: "metal mine",
+		Costs: []request.BuildingCostRequest{
+			{Resource: metalResourceId, Cost: 60, Progress: 1.5},
+		},
+		Productions: []request.BuildingProductionRequest{
+			{Resource: metalResourceId, Base: 30, Progress: 1.1},
+		},
+	}
+}
+
+func generateTestRulesetRequest() request.RulesetRequest {
+	return request.RulesetRequest{
+		Resources: []request.RulesetResourceRequest{
+			{Id: metalResourceId, Resource: generateTestResourceRequest()},
+		},
+		Buildings: []request.RulesetBuildingRequest{
+			{Id: gameDataId, Building: generateTestBuildingRequest()},
+		},
+		FieldRanges: []request.PlanetFieldRangeRequest{
+			{Position: 1, Min: 40, Max: 70},
+		},
+	}
+}
@@ -0,0 +1,192 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type MarketOfferUseCase struct {
+	offerRepo     drivenports.ForManagingMarketOffers
+	planetRepo    drivenports.ForManagingPlanets
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewMarketOfferUseCase(
+	offerRepo drivenports.ForManagingMarketOffers,
+	planetRepo drivenports.ForManagingPlanets,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *MarketOfferUseCase {
+	return &MarketOfferUseCase{
+		offerRepo:     offerRepo,
+		planetRepo:    planetRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+func (m *MarketOfferUseCase) Post(
+	ctx context.Context,
+	req request.MarketOfferCreationRequest,
+) (models.MarketOffer, error) {
+	universe, err := m.planetRepo.GetUniverse(ctx, req.Planet)
+	if err != nil {
+		return models.MarketOffer{}, err
+	}
+
+	terms := models.MarketOfferTerms{
+		OfferedResource:   req.OfferedResource,
+		OfferedAmount:     req.OfferedAmount,
+		RequestedResource: req.RequestedResource,
+		RequestedAmount:   req.RequestedAmount,
+		Duration:          time.Duration(req.DurationHours) * time.Hour,
+	}
+
+	moment := m.clock.Now(ctx)
+
+	var offer models.MarketOffer
+	result, err := m.planetMutator.Mutate(ctx, req.Planet, generatePostOfferMutator(moment, universe, terms, &offer))
+	if err != nil {
+		return models.MarketOffer{}, err
+	}
+	if result.Deleted {
+		return models.MarketOffer{}, domainerrors.ErrNotFound
+	}
+
+	return offer, nil
+}
+
+func (m *MarketOfferUseCase) ListForUniverse(
+	ctx context.Context,
+	universe uuid.UUID,
+	page request.PageRequest,
+) ([]models.MarketOffer, error) {
+	return m.offerRepo.ListForUniverse(ctx, universe, page.Offset(), page.Size)
+}
+
+// Accept pays for the offer from the planet of the buyer. The seller is
+// then updated to receive the payment: this is not required as it also
+// happens on its next update but it makes the exchange visible right away.
+func (m *MarketOfferUseCase) Accept(
+	ctx context.Context,
+	req request.MarketOfferAcceptanceRequest,
+) (models.MarketOffer, error) {
+	offer, err := m.getOffer(ctx, req.Offer)
+	if err != nil {
+		return models.MarketOffer{}, err
+	}
+
+	universe, err := m.planetRepo.GetUniverse(ctx, req.Planet)
+	if err != nil {
+		return models.MarketOffer{}, err
+	}
+	if universe != offer.Universe {
+		return models.MarketOffer{}, domainerrors.ErrMarketOfferNotFound
+	}
+
+	moment := m.clock.Now(ctx)
+
+	var accepted models.MarketOffer
+	result, err := m.planetMutator.Mutate(ctx, req.Planet, generateAcceptOfferMutator(moment, offer, &accepted))
+	if err != nil {
+		return models.MarketOffer{}, err
+	}
+	if result.Deleted {
+		return models.MarketOffer{}, domainerrors.ErrNotFound
+	}
+
+	// The payment is already committed: failing to settle the offer now
+	// should not be reported to the buyer.
+	// nolint:errcheck
+	m.planetMutator.Mutate(ctx, offer.Planet, generateUpdateMutator(moment))
+
+	return accepted, nil
+}
+
+func (m *MarketOfferUseCase) Cancel(ctx context.Context, id uuid.UUID) error {
+	offer, err := m.getOffer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	moment := m.clock.Now(ctx)
+
+	result, err := m.planetMutator.Mutate(ctx, offer.Planet, generateCancelOfferMutator(moment, id))
+	if err != nil {
+		return err
+	}
+	if result.Deleted {
+		return domainerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (m *MarketOfferUseCase) getOffer(ctx context.Context, id uuid.UUID) (models.MarketOffer, error) {
+	offer, err := m.offerRepo.Get(ctx, id)
+	if err == domainerrors.ErrNotFound {
+		return models.MarketOffer{}, domainerrors.ErrMarketOfferNotFound
+	}
+
+	return offer, err
+}
+
+func generatePostOfferMutator(
+	moment time.Time,
+	universe uuid.UUID,
+	terms models.MarketOfferTerms,
+	offer *models.MarketOffer,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		*offer, err = p.PostMarketOffer(universe, terms)
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+}
+
+func generateAcceptOfferMutator(
+	moment time.Time,
+	offer models.MarketOffer,
+	accepted *models.MarketOffer,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		*accepted, err = p.AcceptMarketOffer(offer)
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+}
+
+func generateCancelOfferMutator(moment time.Time, id uuid.UUID) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.CancelMarketOffer(id)
+	}
+}
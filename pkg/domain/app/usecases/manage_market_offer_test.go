@@ -0,0 +1,395 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type marketOfferTestSuite struct {
+	ctrl           *gomock.Controller
+	mockOfferRepo  *drivenportstest.MockForManagingMarketOffers
+	mockPlanetRepo *drivenportstest.MockForManagingPlanets
+	mockMutator    *drivenportstest.MockForMutatingPlanet
+	mockClock      *drivenportstest.MockForFetchingTime
+	usecase        *MarketOfferUseCase
+}
+
+var marketUniverseId = uuid.MustParse("9682f17b-f5f0-4eda-a747-2537d2151837")
+
+func TestUnit_MarketOfferUseCase_Post(t *testing.T) {
+	t.Run("escrows offered resource from the planet", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		planet := generateTestPlanet()
+		req := generateTestMarketOfferCreationRequest(planet)
+
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), planet.Id).
+			Times(1).
+			Return(marketUniverseId, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Post(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.MarketOffer{
+			Id:                actual.Id,
+			Universe:          marketUniverseId,
+			Player:            planet.Player,
+			Planet:            planet.Id,
+			OfferedResource:   metalResourceId,
+			OfferedAmount:     500,
+			RequestedResource: crystalResourceId,
+			RequestedAmount:   250,
+			Status:            models.OpenOffer,
+			ExpiresAt:         t2.Add(12 * time.Hour),
+			CreatedAt:         t2,
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, 99499.0, planet.Resources[0].Amount)
+	})
+
+	t.Run("returns error when universe fails to be fetched", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		planet := generateTestPlanet()
+		req := generateTestMarketOfferCreationRequest(planet)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), planet.Id).
+			Times(1).
+			Return(uuid.Nil, expectedErr)
+
+		_, err := suite.usecase.Post(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when offer is invalid", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		planet := generateTestPlanet()
+		req := generateTestMarketOfferCreationRequest(planet)
+		req.DurationHours = 0
+
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), planet.Id).
+			Times(1).
+			Return(marketUniverseId, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Post(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidMarketOffer, "Actual err: %v", err)
+	})
+
+	t.Run("returns not found when planet is deleted", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		planet := generateTestPlanet()
+		req := generateTestMarketOfferCreationRequest(planet)
+
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), planet.Id).
+			Times(1).
+			Return(marketUniverseId, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.Post(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_MarketOfferUseCase_ListForUniverse(t *testing.T) {
+	t.Run("returns offers of the requested page", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		offer := generateTestMarketOffer(uuid.New())
+		page := request.PageRequest{Page: 2, Size: 10}
+
+		suite.mockOfferRepo.EXPECT().
+			ListForUniverse(gomock.Any(), marketUniverseId, 20, 10).
+			Times(1).
+			Return([]models.MarketOffer{offer}, nil)
+
+		actual, err := suite.usecase.ListForUniverse(t.Context(), marketUniverseId, page)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, []models.MarketOffer{offer}, actual)
+	})
+
+	t.Run("returns error when offers fail to be fetched", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockOfferRepo.EXPECT().
+			ListForUniverse(gomock.Any(), marketUniverseId, 0, 20).
+			Times(1).
+			Return(nil, expectedErr)
+
+		_, err := suite.usecase.ListForUniverse(
+			t.Context(),
+			marketUniverseId,
+			request.PageRequest{Page: 0, Size: 20},
+		)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_MarketOfferUseCase_Accept(t *testing.T) {
+	t.Run("pays for the offer and settles it for the seller", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		seller := generateTestPlanet()
+		buyer := generateTestPlanet()
+		buyer.Player = uuid.New()
+		offer := generateTestMarketOffer(seller.Id)
+		seller.MarketOffers = []models.MarketOffer{offer}
+		req := request.MarketOfferAcceptanceRequest{Offer: offer.Id, Planet: buyer.Id}
+
+		suite.mockOfferRepo.EXPECT().Get(gomock.Any(), offer.Id).Times(1).Return(offer, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), buyer.Id).
+			Times(1).
+			Return(marketUniverseId, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), buyer.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&buyer))
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), seller.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&seller))
+
+		actual, err := suite.usecase.Accept(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, models.AcceptedOffer, actual.Status)
+		require.NotNil(t, actual.BuyerPlanet)
+		assert.Equal(t, buyer.Id, *actual.BuyerPlanet)
+		require.NotNil(t, actual.AcceptedAt)
+		assert.Equal(t, t2, *actual.AcceptedAt)
+		expectedResources := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 100499},
+			{Resource: crystalResourceId, Amount: 99749},
+		}
+		assert.Equal(t, expectedResources, buyer.Resources)
+	})
+
+	t.Run("returns error when offer does not exist", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		req := request.MarketOfferAcceptanceRequest{Offer: uuid.New(), Planet: uuid.New()}
+
+		suite.mockOfferRepo.EXPECT().
+			Get(gomock.Any(), req.Offer).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Accept(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when offer belongs to another universe", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		offer := generateTestMarketOffer(uuid.New())
+		req := request.MarketOfferAcceptanceRequest{Offer: offer.Id, Planet: uuid.New()}
+
+		suite.mockOfferRepo.EXPECT().Get(gomock.Any(), offer.Id).Times(1).Return(offer, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), req.Planet).
+			Times(1).
+			Return(uuid.New(), nil)
+
+		_, err := suite.usecase.Accept(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when offer was accepted concurrently", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		offer := generateTestMarketOffer(uuid.New())
+		req := request.MarketOfferAcceptanceRequest{Offer: offer.Id, Planet: uuid.New()}
+
+		suite.mockOfferRepo.EXPECT().Get(gomock.Any(), offer.Id).Times(1).Return(offer, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), req.Planet).
+			Times(1).
+			Return(marketUniverseId, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), req.Planet, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, domainerrors.ErrMarketOfferNotAvailable)
+
+		_, err := suite.usecase.Accept(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotAvailable, "Actual err: %v", err)
+	})
+
+	t.Run("ignores failure to settle the offer for the seller", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		buyer := generateTestPlanet()
+		buyer.Player = uuid.New()
+		offer := generateTestMarketOffer(uuid.New())
+		req := request.MarketOfferAcceptanceRequest{Offer: offer.Id, Planet: buyer.Id}
+
+		suite.mockOfferRepo.EXPECT().Get(gomock.Any(), offer.Id).Times(1).Return(offer, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), buyer.Id).
+			Times(1).
+			Return(marketUniverseId, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), buyer.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&buyer))
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), offer.Planet, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, errors.New("stubbed error"))
+
+		_, err := suite.usecase.Accept(t.Context(), req)
+
+		assert.NoError(t, err, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_MarketOfferUseCase_Cancel(t *testing.T) {
+	t.Run("refunds escrow to the seller", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		seller := generateTestPlanet()
+		offer := generateTestMarketOffer(seller.Id)
+		seller.MarketOffers = []models.MarketOffer{offer}
+
+		suite.mockOfferRepo.EXPECT().Get(gomock.Any(), offer.Id).Times(1).Return(offer, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), seller.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&seller))
+
+		err := suite.usecase.Cancel(t.Context(), offer.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, models.CancelledOffer, seller.MarketOffers[0].Status)
+		assert.Equal(t, 100499.0, seller.Resources[0].Amount)
+	})
+
+	t.Run("returns error when offer does not exist", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		id := uuid.New()
+
+		suite.mockOfferRepo.EXPECT().
+			Get(gomock.Any(), id).
+			Times(1).
+			Return(models.MarketOffer{}, domainerrors.ErrNotFound)
+
+		err := suite.usecase.Cancel(t.Context(), id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when offer is not open anymore", func(t *testing.T) {
+		suite := setupMarketOfferTestSuite(t)
+
+		seller := generateTestPlanet()
+		offer := generateTestMarketOffer(seller.Id)
+		offer.Status = models.AcceptedOffer
+		offer.AcceptedAt = &t3
+		seller.MarketOffers = []models.MarketOffer{offer}
+
+		suite.mockOfferRepo.EXPECT().Get(gomock.Any(), offer.Id).Times(1).Return(offer, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), seller.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&seller))
+
+		err := suite.usecase.Cancel(t.Context(), offer.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrMarketOfferNotAvailable, "Actual err: %v", err)
+	})
+}
+
+func setupMarketOfferTestSuite(t *testing.T) *marketOfferTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockOfferRepo := drivenportstest.NewMockForManagingMarketOffers(ctrl)
+	mockPlanetRepo := drivenportstest.NewMockForManagingPlanets(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &marketOfferTestSuite{
+		ctrl:           ctrl,
+		mockOfferRepo:  mockOfferRepo,
+		mockPlanetRepo: mockPlanetRepo,
+		mockMutator:    mockMutator,
+		mockClock:      mockClock,
+		usecase:        NewMarketOfferUseCase(mockOfferRepo, mockPlanetRepo, mockMutator, mockClock),
+	}
+}
+
+func generateTestMarketOfferCreationRequest(planet models.Planet) request.MarketOfferCreationRequest {
+	return request.MarketOfferCreationRequest{
+		Planet:            planet.Id,
+		OfferedResource:   metalResourceId,
+		OfferedAmount:     500,
+		RequestedResource: crystalResourceId,
+		RequestedAmount:   250,
+		DurationHours:     12,
+	}
+}
+
+// generateTestMarketOffer returns an open offer of 500 metal against 250
+// crystal posted at t1 and expiring at t4.
+func generateTestMarketOffer(planet uuid.UUID) models.MarketOffer {
+	return models.MarketOffer{
+		Id:                uuid.New(),
+		Universe:          marketUniverseId,
+		Player:            uuid.New(),
+		Planet:            planet,
+		OfferedResource:   metalResourceId,
+		OfferedAmount:     500,
+		RequestedResource: crystalResourceId,
+		RequestedAmount:   250,
+		Status:            models.OpenOffer,
+		ExpiresAt:         t4,
+		CreatedAt:         t1,
+		Version:           1,
+	}
+}
@@ -99,10 +99,18 @@ func generateDeleteMutator(moment time.Time) drivenports.PlanetMutator {
 			return false, domainerrors.ErrHomeworldCannotBeDeleted
 		}
 
-		if p.BuildingAction != nil {
+		if len(p.BuildingQueue) > 0 || len(p.ShipyardQueue) > 0 {
 			return false, domainerrors.ErrActionNotCompleted
 		}
 
+		// Fleets sent from the planet would lose their ships and cargo.
+		// The ones flying towards it from other planets are sent back.
+		for _, fleet := range p.Fleets {
+			if fleet.Origin == p.Id {
+				return false, domainerrors.ErrActionNotCompleted
+			}
+		}
+
 		return true, nil
 	}
 }
@@ -98,10 +98,12 @@ func TestUnit_ManagePlanet_Get(t *testing.T) {
 			CreatedAt: t1,
 			UpdatedAt: t1,
 			Version:   2,
-			BuildingAction: &models.BuildingAction{
-				Id:          uuid.New(),
-				CreatedAt:   t1,
-				CompletedAt: t3,
+			BuildingQueue: []models.BuildingAction{
+				{
+					Id:          uuid.New(),
+					CreatedAt:   t1,
+					CompletedAt: t3,
+				},
 			},
 		}
 
@@ -121,10 +123,12 @@ func TestUnit_ManagePlanet_Get(t *testing.T) {
 			CreatedAt: t1,
 			UpdatedAt: t2,
 			Version:   3,
-			BuildingAction: &models.BuildingAction{
-				Id:          planet.BuildingAction.Id,
-				CreatedAt:   t1,
-				CompletedAt: t3,
+			BuildingQueue: []models.BuildingAction{
+				{
+					Id:          planet.BuildingQueue[0].Id,
+					CreatedAt:   t1,
+					CompletedAt: t3,
+				},
 			},
 		}
 		assert.Equal(t, expected, actual)
@@ -140,14 +144,16 @@ func TestUnit_ManagePlanet_Get(t *testing.T) {
 			UpdatedAt: t1,
 			Version:   2,
 			Buildings: []models.PlanetBuilding{
-				{Building: metalMineId, Level: 5},
+				{Building: metalMineId, Name: "metal mine", Level: 5},
 			},
-			BuildingAction: &models.BuildingAction{
-				Id:           uuid.New(),
-				Building:     metalMineId,
-				DesiredLevel: 6,
-				CreatedAt:    t1,
-				CompletedAt:  t3,
+			BuildingQueue: []models.BuildingAction{
+				{
+					Id:           uuid.New(),
+					Building:     metalMineId,
+					DesiredLevel: 6,
+					CreatedAt:    t1,
+					CompletedAt:  t3,
+				},
 			},
 		}
 
@@ -159,6 +165,7 @@ func TestUnit_ManagePlanet_Get(t *testing.T) {
 
 		actual, err := suite.usecase.Get(t.Context(), planet.Id)
 		require.NoError(t, err, "Actual err: %v", err)
+		require.Len(t, actual.Messages, 1)
 
 		expected := models.Planet{
 			Id:        planet.Id,
@@ -168,9 +175,19 @@ func TestUnit_ManagePlanet_Get(t *testing.T) {
 			UpdatedAt: t4,
 			Version:   5,
 			Buildings: []models.PlanetBuilding{
-				{Building: metalMineId, Level: 6},
+				{Building: metalMineId, Name: "metal mine", Level: 6},
+			},
+			BuildingQueue: []models.BuildingAction{},
+			Messages: []models.Message{
+				{
+					Id:        actual.Messages[0].Id,
+					Kind:      models.SystemMessage,
+					Player:    planet.Player,
+					Subject:   "Building upgraded",
+					Content:   "Building metal mine reached level 6 on planet my-planet.",
+					CreatedAt: t3,
+				},
 			},
-			BuildingAction: nil,
 		}
 		assert.Equal(t, expected, actual)
 	})
@@ -305,10 +322,12 @@ func TestUnit_ManagePlanet_ListForPlayer(t *testing.T) {
 			Buildings: []models.PlanetBuilding{
 				{Building: metalMineId, Level: 5},
 			},
-			BuildingAction: &models.BuildingAction{
-				Id:          uuid.New(),
-				CreatedAt:   t1,
-				CompletedAt: t3,
+			BuildingQueue: []models.BuildingAction{
+				{
+					Id:          uuid.New(),
+					CreatedAt:   t1,
+					CompletedAt: t3,
+				},
 			},
 		}
 		p2 := models.Planet{
@@ -348,10 +367,12 @@ func TestUnit_ManagePlanet_ListForPlayer(t *testing.T) {
 				Buildings: []models.PlanetBuilding{
 					{Building: metalMineId, Level: 5},
 				},
-				BuildingAction: &models.BuildingAction{
-					Id:          p1.BuildingAction.Id,
-					CreatedAt:   t1,
-					CompletedAt: t3,
+				BuildingQueue: []models.BuildingAction{
+					{
+						Id:          p1.BuildingQueue[0].Id,
+						CreatedAt:   t1,
+						CompletedAt: t3,
+					},
 				},
 			},
 			{
@@ -377,14 +398,16 @@ func TestUnit_ManagePlanet_ListForPlayer(t *testing.T) {
 			UpdatedAt: t1,
 			Version:   2,
 			Buildings: []models.PlanetBuilding{
-				{Building: metalMineId, Level: 5},
+				{Building: metalMineId, Name: "metal mine", Level: 5},
 			},
-			BuildingAction: &models.BuildingAction{
-				Id:           uuid.New(),
-				Building:     metalMineId,
-				DesiredLevel: 6,
-				CreatedAt:    t1,
-				CompletedAt:  t3,
+			BuildingQueue: []models.BuildingAction{
+				{
+					Id:           uuid.New(),
+					Building:     metalMineId,
+					DesiredLevel: 6,
+					CreatedAt:    t1,
+					CompletedAt:  t3,
+				},
 			},
 		}
 		p2 := models.Planet{
@@ -412,6 +435,8 @@ func TestUnit_ManagePlanet_ListForPlayer(t *testing.T) {
 
 		actual, err := suite.usecase.ListForPlayer(t.Context(), player)
 		require.NoError(t, err, "Actual err: %v", err)
+		require.Len(t, actual, 2)
+		require.Len(t, actual[0].Messages, 1)
 
 		expected := []models.Planet{
 			{
@@ -422,9 +447,19 @@ func TestUnit_ManagePlanet_ListForPlayer(t *testing.T) {
 				CreatedAt: t1,
 				UpdatedAt: t4,
 				Buildings: []models.PlanetBuilding{
-					{Building: metalMineId, Level: 6},
+					{Building: metalMineId, Name: "metal mine", Level: 6},
+				},
+				BuildingQueue: []models.BuildingAction{},
+				Messages: []models.Message{
+					{
+						Id:        actual[0].Messages[0].Id,
+						Kind:      models.SystemMessage,
+						Player:    player,
+						Subject:   "Building upgraded",
+						Content:   "Building metal mine reached level 6 on planet planet-1.",
+						CreatedAt: t3,
+					},
 				},
-				BuildingAction: nil,
 			},
 			{
 				Id:        p2.Id,
@@ -568,6 +603,57 @@ func TestUnit_ManagePlanet_Delete(t *testing.T) {
 
 		assert.ErrorIs(t, err, domainerrors.ErrHomeworldCannotBeDeleted, "Actual err: %v", err)
 	})
+
+	t.Run("returns error when fleets sent from the planet are in flight", func(t *testing.T) {
+		suite := setupPlanetTestSuite(t)
+		planet := models.Planet{
+			Id:        uuid.New(),
+			Player:    uuid.New(),
+			Name:      "my-planet",
+			CreatedAt: t1,
+			UpdatedAt: t1,
+			Version:   2,
+		}
+		fleet := generateTestFleet()
+		fleet.Origin = planet.Id
+		fleet.ArrivesAt = t4
+		planet.Fleets = []models.Fleet{fleet}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Eq(planet.Id), gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Delete(t.Context(), planet.Id)
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionNotCompleted, "Actual err: %v", err)
+	})
+
+	t.Run("deletes planet with fleets of other planets flying towards it", func(t *testing.T) {
+		suite := setupPlanetTestSuite(t)
+		planet := models.Planet{
+			Id:        uuid.New(),
+			Player:    uuid.New(),
+			Name:      "my-planet",
+			CreatedAt: t1,
+			UpdatedAt: t1,
+			Version:   2,
+		}
+		fleet := generateTestFleet()
+		fleet.Target = planet.Id
+		fleet.ArrivesAt = t4
+		planet.Fleets = []models.Fleet{fleet}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Eq(planet.Id), gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		err := suite.usecase.Delete(t.Context(), planet.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+	})
 }
 
 func setupPlanetTestSuite(t *testing.T) *planetTestSuite {
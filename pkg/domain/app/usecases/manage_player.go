@@ -2,29 +2,37 @@ package usecases
 
 import (
 	"context"
+	"time"
 
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
 	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
 	"github.com/google/uuid"
 )
 
 type PlayerUseCase struct {
-	playerRepo   drivenports.ForManagingPlayers
-	universeRepo drivenports.ForManagingUniverses
-	planetRepo   drivenports.ForManagingPlanets
+	playerRepo    drivenports.ForManagingPlayers
+	universeRepo  drivenports.ForManagingUniverses
+	planetRepo    drivenports.ForManagingPlanets
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
 }
 
 func NewPlayerUseCase(
 	playerRepo drivenports.ForManagingPlayers,
 	universeRepo drivenports.ForManagingUniverses,
 	planetRepo drivenports.ForManagingPlanets,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
 ) *PlayerUseCase {
 	return &PlayerUseCase{
-		playerRepo:   playerRepo,
-		universeRepo: universeRepo,
-		planetRepo:   planetRepo,
+		playerRepo:    playerRepo,
+		universeRepo:  universeRepo,
+		planetRepo:    planetRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
 	}
 }
 
@@ -42,7 +50,10 @@ func (p *PlayerUseCase) Create(
 		return models.Player{}, err
 	}
 
-	homeworld := player.CreateHomeworld(universe)
+	homeworld, err := player.CreateHomeworld(universe)
+	if err != nil {
+		return models.Player{}, err
+	}
 
 	// TODO: Could be that the planet was used, in which case an optimistic lock error will be returned
 	err = p.playerRepo.Create(ctx, player, homeworld)
@@ -71,6 +82,17 @@ func (p *PlayerUseCase) Delete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
+	// The planets are deleted through the mutator so that their deletion
+	// is recorded and notified like any other: the repository only deletes
+	// the player.
+	moment := p.clock.Now(ctx)
+	for _, planet := range player.Planets {
+		_, err = p.planetMutator.Mutate(ctx, planet, generatePlayerPlanetDeletionMutator(moment))
+		if err != nil && err != domainerrors.ErrNotFound {
+			return err
+		}
+	}
+
 	err = p.playerRepo.Delete(ctx, player)
 	if err != nil {
 		return err
@@ -78,3 +100,12 @@ func (p *PlayerUseCase) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// generatePlayerPlanetDeletionMutator deletes a planet of a player leaving
+// the game: unlike when the player abandons a planet, the homeworld and the
+// pending actions do not prevent the deletion.
+func generatePlayerPlanetDeletionMutator(moment time.Time) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		return true, domainservices.AdvancePlanetToTime(p, moment)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
 	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -17,11 +18,13 @@ import (
 )
 
 type playerTestSuite struct {
-	ctrl             *gomock.Controller
-	mockPlayerRepo   *drivenportstest.MockForManagingPlayers
-	mockUniverseRepo *drivenportstest.MockForManagingUniverses
-	mockPlanetRepo   *drivenportstest.MockForManagingPlanets
-	usecase          *PlayerUseCase
+	ctrl              *gomock.Controller
+	mockPlayerRepo    *drivenportstest.MockForManagingPlayers
+	mockUniverseRepo  *drivenportstest.MockForManagingUniverses
+	mockPlanetRepo    *drivenportstest.MockForManagingPlanets
+	mockPlanetMutator *drivenportstest.MockForMutatingPlanet
+	mockClock         *drivenportstest.MockForFetchingTime
+	usecase           *PlayerUseCase
 }
 
 func TestUnit_ManagePlayer_Create(t *testing.T) {
@@ -112,7 +115,7 @@ func TestUnit_ManagePlayer_Create(t *testing.T) {
 			},
 		}
 		assert.Equal(t, expectedBuildings, capturedHomeworld.Buildings)
-		assert.Nil(t, capturedHomeworld.BuildingAction)
+		assert.Empty(t, capturedHomeworld.BuildingQueue)
 	})
 
 	t.Run("returns error when universe is not found", func(t *testing.T) {
@@ -233,6 +236,7 @@ func TestUnit_ManagePlayer_Delete(t *testing.T) {
 			Get(gomock.Any(), gomock.Eq(player.Id)).
 			Times(1).
 			Return(player, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
 		suite.mockPlayerRepo.EXPECT().
 			Delete(gomock.Any(), gomock.Eq(player)).
 			Times(1).
@@ -242,6 +246,87 @@ func TestUnit_ManagePlayer_Delete(t *testing.T) {
 		require.NoError(t, err, "Actual err: %v", err)
 	})
 
+	t.Run("deletes planets through mutator before the player", func(t *testing.T) {
+		planet := models.Planet{
+			Id:        uuid.New(),
+			Homeworld: true,
+			UpdatedAt: t1,
+			BuildingQueue: []models.BuildingAction{
+				{Id: uuid.New(), CreatedAt: t1, CompletedAt: t2.Add(time.Hour)},
+			},
+		}
+		player := models.Player{Id: uuid.New(), Planets: []uuid.UUID{planet.Id}}
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(player.Id)).
+			Times(1).
+			Return(player, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		var deleted bool
+		gomock.InOrder(
+			suite.mockPlanetMutator.EXPECT().
+				Mutate(gomock.Any(), gomock.Eq(planet.Id), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(
+					ctx context.Context, id uuid.UUID, m drivenports.PlanetMutator,
+				) (models.PlanetMutationResult, error) {
+					var err error
+					deleted, err = m(&planet)
+					return models.PlanetMutationResult{Deleted: deleted}, err
+				}),
+			suite.mockPlayerRepo.EXPECT().
+				Delete(gomock.Any(), gomock.Eq(player)).
+				Times(1).
+				Return(nil),
+		)
+
+		err := suite.usecase.Delete(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.True(t, deleted)
+		assert.Equal(t, t2, planet.UpdatedAt)
+	})
+
+	t.Run("ignores planets already deleted", func(t *testing.T) {
+		player := models.Player{Id: uuid.New(), Planets: []uuid.UUID{uuid.New()}}
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(player.Id)).
+			Times(1).
+			Return(player, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, domainerrors.ErrNotFound)
+		suite.mockPlayerRepo.EXPECT().
+			Delete(gomock.Any(), gomock.Eq(player)).
+			Times(1).
+			Return(nil)
+
+		err := suite.usecase.Delete(t.Context(), player.Id)
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("does not delete player when planet deletion fails", func(t *testing.T) {
+		player := models.Player{Id: uuid.New(), Planets: []uuid.UUID{uuid.New()}}
+
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(player.Id)).
+			Times(1).
+			Return(player, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
+		expectedErr := errors.New("stubbed error")
+		suite.mockPlanetMutator.EXPECT().
+			Mutate(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, expectedErr)
+
+		err := suite.usecase.Delete(t.Context(), player.Id)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
 	t.Run("succeeds when building action is not found", func(t *testing.T) {
 		playerId := uuid.New()
 
@@ -261,6 +346,7 @@ func TestUnit_ManagePlayer_Delete(t *testing.T) {
 			Get(gomock.Any(), gomock.Eq(player.Id)).
 			Times(1).
 			Return(player, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t1)
 		expectedErr := errors.New("stubbed error")
 		suite.mockPlayerRepo.EXPECT().
 			Delete(gomock.Any(), gomock.Any()).
@@ -280,16 +366,22 @@ func setupPlayerTestSuite(t *testing.T) *playerTestSuite {
 	mockPlayerRepo := drivenportstest.NewMockForManagingPlayers(ctrl)
 	mockUniverseRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
 	mockPlanetRepo := drivenportstest.NewMockForManagingPlanets(ctrl)
+	mockPlanetMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
 
 	return &playerTestSuite{
-		ctrl:             ctrl,
-		mockPlayerRepo:   mockPlayerRepo,
-		mockUniverseRepo: mockUniverseRepo,
-		mockPlanetRepo:   mockPlanetRepo,
+		ctrl:              ctrl,
+		mockPlayerRepo:    mockPlayerRepo,
+		mockUniverseRepo:  mockUniverseRepo,
+		mockPlanetRepo:    mockPlanetRepo,
+		mockPlanetMutator: mockPlanetMutator,
+		mockClock:         mockClock,
 		usecase: NewPlayerUseCase(
 			mockPlayerRepo,
 			mockUniverseRepo,
 			mockPlanetRepo,
+			mockPlanetMutator,
+			mockClock,
 		),
 	}
 }
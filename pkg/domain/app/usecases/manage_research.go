@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type ResearchUseCase struct {
+	technologyRepo drivenports.ForManagingTechnologies
+	planetMutator  drivenports.ForMutatingPlanet
+	clock          drivenports.ForFetchingTime
+}
+
+func NewResearchUseCase(
+	technologyRepo drivenports.ForManagingTechnologies,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *ResearchUseCase {
+	return &ResearchUseCase{
+		technologyRepo: technologyRepo,
+		planetMutator:  planetMutator,
+		clock:          clock,
+	}
+}
+
+// Get returns the technologies of the player. A research action which is
+// already completed is first resolved by updating the planet it runs on.
+func (r *ResearchUseCase) Get(ctx context.Context, player uuid.UUID) (models.Research, error) {
+	moment := r.clock.Now(ctx)
+
+	research, err := r.technologyRepo.GetResearchForPlayer(ctx, player)
+	if err != nil {
+		return models.Research{}, err
+	}
+
+	if research.Action == nil || research.Action.CompletedAt.After(moment) {
+		return research, nil
+	}
+
+	_, err = r.planetMutator.Mutate(ctx, research.Action.Planet, generateUpdateMutator(moment))
+	if err != nil {
+		return models.Research{}, err
+	}
+
+	return r.technologyRepo.GetResearchForPlayer(ctx, player)
+}
+
+func (r *ResearchUseCase) Create(
+	ctx context.Context,
+	req request.ResearchActionCreationRequest,
+) (models.ResearchAction, error) {
+	moment := r.clock.Now(ctx)
+
+	technology, err := r.technologyRepo.Get(ctx, req.Technology)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return models.ResearchAction{}, domainerrors.ErrTechnologyNotFound
+		}
+
+		return models.ResearchAction{}, err
+	}
+
+	research, err := r.technologyRepo.GetResearchForPlayer(ctx, req.Player)
+	if err != nil {
+		return models.ResearchAction{}, err
+	}
+
+	// A player can only research one technology at a time: an action
+	// running on another planet needs to be completed first.
+	if research.Action != nil && research.Action.Planet != req.Planet {
+		if research.Action.CompletedAt.After(moment) {
+			return models.ResearchAction{}, domainerrors.ErrActionAlreadyInProgress
+		}
+
+		_, err := r.planetMutator.Mutate(ctx, research.Action.Planet, generateUpdateMutator(moment))
+		if err != nil {
+			return models.ResearchAction{}, err
+		}
+	}
+
+	mutator := generateResearchMutator(moment, req.Player, technology)
+	result, err := r.planetMutator.Mutate(ctx, req.Planet, mutator)
+	if err != nil {
+		return models.ResearchAction{}, err
+	}
+	if result.Deleted {
+		return models.ResearchAction{}, domainerrors.ErrNotFound
+	}
+
+	if result.Planet.ResearchAction == nil {
+		return models.ResearchAction{}, domainerrors.ErrResourceCreationFailed
+	}
+
+	return *result.Planet.ResearchAction, nil
+}
+
+func generateResearchMutator(
+	moment time.Time,
+	player uuid.UUID,
+	technology models.Technology,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		if p.Player != player {
+			return false, domainerrors.ErrNotFound
+		}
+
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		return false, p.AddResearchAction(technology)
+	}
+}
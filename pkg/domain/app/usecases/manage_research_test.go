@@ -0,0 +1,392 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type researchTestSuite struct {
+	ctrl               *gomock.Controller
+	mockTechnologyRepo *drivenportstest.MockForManagingTechnologies
+	mockMutator        *drivenportstest.MockForMutatingPlanet
+	mockClock          *drivenportstest.MockForFetchingTime
+	usecase            *ResearchUseCase
+}
+
+func TestUnit_ManageResearch_Get(t *testing.T) {
+	t.Run("returns research of the player", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		research.Action = generateTestResearchAction(uuid.New(), t3)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+
+		actual, err := suite.usecase.Get(t.Context(), research.Player)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, research, actual)
+	})
+
+	t.Run("resolves completed research action before returning", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		action := generateTestResearchAction(uuid.New(), t2)
+		research.Action = action
+		resolved := generateTestResearch()
+		resolved.Player = research.Player
+		resolved.Technologies = []models.PlayerTechnology{
+			{Technology: action.Technology, Level: 1},
+		}
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		gomock.InOrder(
+			suite.mockTechnologyRepo.EXPECT().
+				GetResearchForPlayer(gomock.Any(), research.Player).
+				Times(1).
+				Return(research, nil),
+			suite.mockMutator.EXPECT().
+				Mutate(gomock.Any(), action.Planet, gomock.Any()).
+				Times(1).
+				Return(generateMutationResult(models.Planet{}), nil),
+			suite.mockTechnologyRepo.EXPECT().
+				GetResearchForPlayer(gomock.Any(), research.Player).
+				Times(1).
+				Return(resolved, nil),
+		)
+
+		actual, err := suite.usecase.Get(t.Context(), research.Player)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, resolved, actual)
+	})
+
+	t.Run("returns error when player does not exist", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		player := uuid.New()
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), player).
+			Times(1).
+			Return(models.Research{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Get(t.Context(), player)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when resolving research action fails", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		research.Action = generateTestResearchAction(uuid.New(), t2)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), research.Action.Planet, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, expectedErr)
+
+		_, err := suite.usecase.Get(t.Context(), research.Player)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_ManageResearch_Create(t *testing.T) {
+	t.Run("starts research on the planet", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		planet := generateTestPlanetForPlayer(research.Player)
+		technology := generateTestTechnology()
+		req := generateTestResearchRequest(planet, technology)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(technology, nil)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Create(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.ResearchAction{
+			Id:           actual.Id,
+			Player:       research.Player,
+			Planet:       planet.Id,
+			Technology:   technology.Id,
+			DesiredLevel: 1,
+			CreatedAt:    t2,
+			CompletedAt:  t2.Add(216 * time.Second),
+			Costs: []models.ResearchActionCost{
+				{Resource: metalResourceId, Amount: 100},
+				{Resource: crystalResourceId, Amount: 50},
+			},
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, &expected, planet.ResearchAction)
+	})
+
+	t.Run("completes research running on another planet first", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		research.Action = generateTestResearchAction(uuid.New(), t2)
+		planet := generateTestPlanetForPlayer(research.Player)
+		technology := generateTestTechnology()
+		req := generateTestResearchRequest(planet, technology)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(technology, nil)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+		gomock.InOrder(
+			suite.mockMutator.EXPECT().
+				Mutate(gomock.Any(), research.Action.Planet, gomock.Any()).
+				Times(1).
+				Return(generateMutationResult(models.Planet{}), nil),
+			suite.mockMutator.EXPECT().
+				Mutate(gomock.Any(), planet.Id, gomock.Any()).
+				Times(1).
+				DoAndReturn(generateApplyingMutatorMock(&planet)),
+		)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when research is running on another planet", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		research.Action = generateTestResearchAction(uuid.New(), t3)
+		planet := generateTestPlanetForPlayer(research.Player)
+		technology := generateTestTechnology()
+		req := generateTestResearchRequest(planet, technology)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(technology, nil)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrActionAlreadyInProgress, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when technology does not exist", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		planet := generateTestPlanetForPlayer(uuid.New())
+		technology := generateTestTechnology()
+		req := generateTestResearchRequest(planet, technology)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(models.Technology{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrTechnologyNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet belongs to another player", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		planet := generateTestPlanetForPlayer(uuid.New())
+		technology := generateTestTechnology()
+		req := generateTestResearchRequest(planet, technology)
+		req.Player = research.Player
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(technology, nil)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+		assert.Nil(t, planet.ResearchAction)
+	})
+
+	t.Run("returns error when planet does not have enough resources", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		planet := generateTestPlanetForPlayer(research.Player)
+		technology := generateTestTechnology()
+		technology.Costs[0].Cost = 100000
+		req := generateTestResearchRequest(planet, technology)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(technology, nil)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet is deleted", func(t *testing.T) {
+		suite := setupResearchTestSuite(t)
+
+		research := generateTestResearch()
+		planet := generateTestPlanetForPlayer(research.Player)
+		technology := generateTestTechnology()
+		req := generateTestResearchRequest(planet, technology)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockTechnologyRepo.EXPECT().
+			Get(gomock.Any(), technology.Id).
+			Times(1).
+			Return(technology, nil)
+		suite.mockTechnologyRepo.EXPECT().
+			GetResearchForPlayer(gomock.Any(), research.Player).
+			Times(1).
+			Return(research, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.Create(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func setupResearchTestSuite(t *testing.T) *researchTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockTechnologyRepo := drivenportstest.NewMockForManagingTechnologies(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &researchTestSuite{
+		ctrl:               ctrl,
+		mockTechnologyRepo: mockTechnologyRepo,
+		mockMutator:        mockMutator,
+		mockClock:          mockClock,
+		usecase:            NewResearchUseCase(mockTechnologyRepo, mockMutator, mockClock),
+	}
+}
+
+func generateTestResearch() models.Research {
+	return models.Research{
+		Player:       uuid.New(),
+		Technologies: []models.PlayerTechnology{},
+	}
+}
+
+func generateTestResearchAction(planet uuid.UUID, completionTime time.Time) *models.ResearchAction {
+	return &models.ResearchAction{
+		Id:           uuid.New(),
+		Planet:       planet,
+		Technology:   uuid.New(),
+		DesiredLevel: 1,
+		CreatedAt:    t1,
+		CompletedAt:  completionTime,
+	}
+}
+
+func generateTestPlanetForPlayer(player uuid.UUID) models.Planet {
+	p := generateTestPlanet()
+	p.Player = player
+
+	return p
+}
+
+func generateTestTechnology() models.Technology {
+	return models.Technology{
+		Id: uuid.New(),
+		Costs: []models.TechnologyCost{
+			{
+				Resource:              metalResourceId,
+				Cost:                  100,
+				Progress:              2,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+			{
+				Resource:              crystalResourceId,
+				Cost:                  50,
+				Progress:              2,
+				BuildTimeHoursPerUnit: 0.0004,
+			},
+		},
+	}
+}
+
+func generateTestResearchRequest(
+	planet models.Planet,
+	technology models.Technology,
+) request.ResearchActionCreationRequest {
+	return request.ResearchActionCreationRequest{
+		Player:     planet.Player,
+		Planet:     planet.Id,
+		Technology: technology.Id,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
 	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
 	"github.com/google/uuid"
@@ -38,6 +39,29 @@ func (u *UniverseUseCase) List(ctx context.Context) ([]models.Universe, error) {
 	return u.repo.List(ctx)
 }
 
+func (u *UniverseUseCase) GetSolarSystem(
+	ctx context.Context,
+	id uuid.UUID,
+	galaxy int,
+	solarSystem int,
+) (models.SolarSystem, error) {
+	topology, err := u.repo.GetTopology(ctx, id)
+	if err != nil {
+		return models.SolarSystem{}, err
+	}
+
+	if !topology.ContainsSolarSystem(galaxy, solarSystem) {
+		return models.SolarSystem{}, domainerrors.ErrSolarSystemNotFound
+	}
+
+	planets, err := u.repo.ListPlanetsInSolarSystem(ctx, id, galaxy, solarSystem)
+	if err != nil {
+		return models.SolarSystem{}, err
+	}
+
+	return models.NewSolarSystem(id, topology, galaxy, solarSystem, planets), nil
+}
+
 func (u *UniverseUseCase) Delete(ctx context.Context, id uuid.UUID) error {
 	err := u.repo.Delete(ctx, id)
 	if err != nil {
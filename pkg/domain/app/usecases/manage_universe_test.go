@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
 	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
 	"github.com/google/uuid"
@@ -139,6 +140,99 @@ func TestUnit_ManageUniverse_List(t *testing.T) {
 	})
 }
 
+func TestUnit_ManageUniverse_GetSolarSystem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
+
+	universe := models.Universe{
+		Id:   uuid.New(),
+		Name: "my-universe",
+		Topology: models.UniverseTopology{
+			Galaxies:     2,
+			SolarSystems: 3,
+			Orbits:       4,
+		},
+	}
+
+	t.Run("arranges planets in orbits", func(t *testing.T) {
+		planet := models.SolarSystemPlanet{
+			Id:         uuid.New(),
+			Name:       "my-planet",
+			PlayerName: "my-player",
+			Homeworld:  true,
+			Position:   2,
+		}
+
+		mockRepo.EXPECT().
+			GetTopology(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return(universe.Topology, nil)
+		mockRepo.EXPECT().
+			ListPlanetsInSolarSystem(gomock.Any(), gomock.Eq(universe.Id), gomock.Eq(1), gomock.Eq(2)).
+			Times(1).
+			Return([]models.SolarSystemPlanet{planet}, nil)
+
+		usecase := NewUniverseUseCase(mockRepo)
+		actual, err := usecase.GetSolarSystem(t.Context(), universe.Id, 1, 2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.SolarSystem{
+			Universe:    universe.Id,
+			Galaxy:      1,
+			SolarSystem: 2,
+			Slots: []models.SolarSystemSlot{
+				{Position: 0},
+				{Position: 1},
+				{Position: 2, Planet: &planet},
+				{Position: 3},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns error when solar system is not in topology", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetTopology(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return(universe.Topology, nil)
+
+		usecase := NewUniverseUseCase(mockRepo)
+		_, err := usecase.GetSolarSystem(t.Context(), universe.Id, 2, 0)
+
+		assert.ErrorIs(t, err, domainerrors.ErrSolarSystemNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when topology fetch fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+		mockRepo.EXPECT().
+			GetTopology(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.UniverseTopology{}, expectedErr)
+
+		usecase := NewUniverseUseCase(mockRepo)
+		_, err := usecase.GetSolarSystem(t.Context(), uuid.New(), 0, 0)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planets listing fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+		mockRepo.EXPECT().
+			GetTopology(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(universe.Topology, nil)
+		mockRepo.EXPECT().
+			ListPlanetsInSolarSystem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewUniverseUseCase(mockRepo)
+		_, err := usecase.GetSolarSystem(t.Context(), universe.Id, 0, 0)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
 func TestUnit_ManageUniverse_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type BuildingPreviewUseCase struct {
+	buildingRepo drivenports.ForFetchingBuilding
+	planetRepo   drivenports.ForManagingPlanets
+	clock        drivenports.ForFetchingTime
+}
+
+func NewBuildingPreviewUseCase(
+	buildingRepo drivenports.ForFetchingBuilding,
+	planetRepo drivenports.ForManagingPlanets,
+	clock drivenports.ForFetchingTime,
+) *BuildingPreviewUseCase {
+	return &BuildingPreviewUseCase{
+		buildingRepo: buildingRepo,
+		planetRepo:   planetRepo,
+		clock:        clock,
+	}
+}
+
+// Preview describes the next levels of the building on the planet. Unlike
+// fetching the planet, nothing is persisted: the planet is only brought up
+// to date in memory to know which resources are available.
+func (b *BuildingPreviewUseCase) Preview(
+	ctx context.Context,
+	planet uuid.UUID,
+	building uuid.UUID,
+	levels int,
+) ([]models.BuildingLevelPreview, error) {
+	moment := b.clock.Now(ctx)
+
+	target, err := b.buildingRepo.Get(ctx, building)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return nil, domainerrors.ErrBuildingNotFound
+		}
+
+		return nil, err
+	}
+
+	p, err := b.planetRepo.Get(ctx, planet)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domainservices.AdvancePlanetToTime(&p, moment); err != nil {
+		return nil, err
+	}
+
+	return p.PreviewBuildingUpgrades(target, levels)
+}
@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_BuildingPreview_Preview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBuildingRepo := drivenportstest.NewMockForFetchingBuilding(ctrl)
+	mockPlanetRepo := drivenportstest.NewMockForManagingPlanets(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	building := models.Building{
+		Id: metalMineId,
+		Costs: []models.BuildingCost{
+			{Resource: metalResourceId, Cost: 60, Progress: 1.5, BuildTimeHoursPerUnit: 0.0004},
+		},
+		Productions: []models.BuildingResourceProduction{
+			{Resource: metalResourceId, Base: 30, Progress: 1.1},
+		},
+	}
+	planet := models.Planet{
+		Id:        uuid.New(),
+		Fields:    100,
+		UpdatedAt: t1,
+		Version:   2,
+		Resources: []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 40},
+		},
+		Storages: []models.PlanetResourceStorage{
+			{Resource: metalResourceId, Storage: 10000},
+		},
+		Productions: []models.PlanetResourceProduction{
+			{Resource: metalResourceId, Production: 50},
+		},
+		Buildings: []models.PlanetBuilding{
+			{Building: metalMineId, Level: 1},
+		},
+	}
+
+	t.Run("previews levels with resources of the planet at current time", func(t *testing.T) {
+		mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(metalMineId)).
+			Times(1).
+			Return(building, nil)
+		mockPlanetRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(planet.Id)).
+			Times(1).
+			Return(planet, nil)
+
+		usecase := NewBuildingPreviewUseCase(mockBuildingRepo, mockPlanetRepo, mockClock)
+		actual, err := usecase.Preview(t.Context(), planet.Id, metalMineId, 2)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, 2, actual[0].Level)
+		assert.Equal(t, 3, actual[1].Level)
+
+		// The planet produced 50 metal in an hour: level 2 costs 90 metal
+		// and level 3 costs 135.
+		assert.True(t, actual[0].Affordable)
+		assert.False(t, actual[1].Affordable)
+	})
+
+	t.Run("returns error when building does not exist", func(t *testing.T) {
+		mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Building{}, domainerrors.ErrNotFound)
+
+		usecase := NewBuildingPreviewUseCase(mockBuildingRepo, mockPlanetRepo, mockClock)
+		_, err := usecase.Preview(t.Context(), planet.Id, metalMineId, 2)
+
+		assert.ErrorIs(t, err, domainerrors.ErrBuildingNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet does not exist", func(t *testing.T) {
+		mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		mockBuildingRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(building, nil)
+		mockPlanetRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrNotFound)
+
+		usecase := NewBuildingPreviewUseCase(mockBuildingRepo, mockPlanetRepo, mockClock)
+		_, err := usecase.Preview(t.Context(), planet.Id, metalMineId, 2)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when building repository fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		mockBuildingRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Building{}, expectedErr)
+
+		usecase := NewBuildingPreviewUseCase(mockBuildingRepo, mockPlanetRepo, mockClock)
+		_, err := usecase.Preview(t.Context(), planet.Id, metalMineId, 2)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+}
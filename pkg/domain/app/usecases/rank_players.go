@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type RankingUseCase struct {
+	universeRepo drivenports.ForManagingUniverses
+	rankingRepo  drivenports.ForManagingRankings
+}
+
+func NewRankingUseCase(
+	universeRepo drivenports.ForManagingUniverses,
+	rankingRepo drivenports.ForManagingRankings,
+) *RankingUseCase {
+	return &RankingUseCase{
+		universeRepo: universeRepo,
+		rankingRepo:  rankingRepo,
+	}
+}
+
+// Refresh recomputes the rankings universe by universe: a failure for one
+// of them does not prevent the others from being refreshed.
+func (r *RankingUseCase) Refresh(ctx context.Context) error {
+	universes, err := r.universeRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, universe := range universes {
+		err = r.refreshUniverse(ctx, universe)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// List returns a page of the rankings of the universe. Loading the universe
+// is expensive: it is only done to tell an unknown universe apart when the
+// page is empty.
+func (r *RankingUseCase) List(
+	ctx context.Context,
+	universe uuid.UUID,
+	page request.PageRequest,
+) ([]models.Ranking, error) {
+	rankings, err := r.rankingRepo.List(ctx, universe, page.Offset(), page.Size)
+	if err != nil || len(rankings) > 0 {
+		return rankings, err
+	}
+
+	_, err = r.universeRepo.Get(ctx, universe)
+	if err != nil {
+		return nil, err
+	}
+
+	return rankings, nil
+}
+
+func (r *RankingUseCase) refreshUniverse(ctx context.Context, universe models.Universe) error {
+	players, err := r.rankingRepo.ListPlayers(ctx, universe.Id)
+	if err != nil {
+		return err
+	}
+
+	levels, err := r.rankingRepo.ListBuildingLevels(ctx, universe.Id)
+	if err != nil {
+		return err
+	}
+
+	rankings := models.ComputeRankings(universe, players, levels)
+
+	return r.rankingRepo.Replace(ctx, universe.Id, rankings)
+}
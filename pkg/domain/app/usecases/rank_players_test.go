@@ -0,0 +1,236 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_RankPlayers_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUniverseRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
+	mockRankingRepo := drivenportstest.NewMockForManagingRankings(ctrl)
+
+	building := models.Building{
+		Id: uuid.New(),
+		Costs: []models.BuildingCost{
+			{
+				Resource: uuid.New(),
+				Cost:     10,
+				Progress: 2.0,
+			},
+		},
+	}
+	universe := models.Universe{
+		Id:        uuid.New(),
+		Buildings: []models.Building{building},
+	}
+
+	t.Run("persists computed rankings", func(t *testing.T) {
+		player1 := uuid.New()
+		player2 := uuid.New()
+		levels := []models.PlayerBuildingLevel{
+			{Player: player1, Building: building.Id, Level: 1, Count: 2},
+			{Player: player2, Building: building.Id, Level: 3, Count: 1},
+		}
+
+		mockUniverseRepo.EXPECT().
+			List(gomock.Any()).
+			Times(1).
+			Return([]models.Universe{universe}, nil)
+		mockRankingRepo.EXPECT().
+			ListPlayers(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return([]uuid.UUID{player1, player2}, nil)
+		mockRankingRepo.EXPECT().
+			ListBuildingLevels(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return(levels, nil)
+		expected := []models.Ranking{
+			{Player: player2, Points: 70, Rank: 1},
+			{Player: player1, Points: 20, Rank: 2},
+		}
+		mockRankingRepo.EXPECT().
+			Replace(gomock.Any(), gomock.Eq(universe.Id), gomock.Eq(expected)).
+			Times(1).
+			Return(nil)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		err := usecase.Refresh(t.Context())
+
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("refreshes other universes when one fails", func(t *testing.T) {
+		other := models.Universe{Id: uuid.New()}
+		expectedErr := errors.New("stubbed error")
+
+		mockUniverseRepo.EXPECT().
+			List(gomock.Any()).
+			Times(1).
+			Return([]models.Universe{universe, other}, nil)
+		mockRankingRepo.EXPECT().
+			ListPlayers(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return(nil, expectedErr)
+		mockRankingRepo.EXPECT().
+			ListPlayers(gomock.Any(), gomock.Eq(other.Id)).
+			Times(1).
+			Return(nil, nil)
+		mockRankingRepo.EXPECT().
+			ListBuildingLevels(gomock.Any(), gomock.Eq(other.Id)).
+			Times(1).
+			Return(nil, nil)
+		mockRankingRepo.EXPECT().
+			Replace(gomock.Any(), gomock.Eq(other.Id), gomock.Any()).
+			Times(1).
+			Return(nil)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		err := usecase.Refresh(t.Context())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when listing building levels fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockUniverseRepo.EXPECT().
+			List(gomock.Any()).
+			Times(1).
+			Return([]models.Universe{universe}, nil)
+		mockRankingRepo.EXPECT().
+			ListPlayers(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, nil)
+		mockRankingRepo.EXPECT().
+			ListBuildingLevels(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		err := usecase.Refresh(t.Context())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when persisting rankings fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockUniverseRepo.EXPECT().
+			List(gomock.Any()).
+			Times(1).
+			Return([]models.Universe{universe}, nil)
+		mockRankingRepo.EXPECT().
+			ListPlayers(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, nil)
+		mockRankingRepo.EXPECT().
+			ListBuildingLevels(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, nil)
+		mockRankingRepo.EXPECT().
+			Replace(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(expectedErr)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		err := usecase.Refresh(t.Context())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when listing universes fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockUniverseRepo.EXPECT().
+			List(gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		err := usecase.Refresh(t.Context())
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
+
+func TestUnit_RankPlayers_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUniverseRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
+	mockRankingRepo := drivenportstest.NewMockForManagingRankings(ctrl)
+
+	universe := uuid.New()
+
+	t.Run("lists requested page", func(t *testing.T) {
+		expected := []models.Ranking{
+			{Player: uuid.New(), PlayerName: "my-player", Points: 12, Rank: 21},
+		}
+
+		mockRankingRepo.EXPECT().
+			List(gomock.Any(), gomock.Eq(universe), gomock.Eq(20), gomock.Eq(10)).
+			Times(1).
+			Return(expected, nil)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		actual, err := usecase.List(t.Context(), universe, request.PageRequest{Page: 2, Size: 10})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("returns empty page when universe exists", func(t *testing.T) {
+		mockRankingRepo.EXPECT().
+			List(gomock.Any(), gomock.Eq(universe), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return([]models.Ranking{}, nil)
+		mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(universe)).
+			Times(1).
+			Return(models.Universe{Id: universe}, nil)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		actual, err := usecase.List(t.Context(), universe, request.PageRequest{Page: 3, Size: 10})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Empty(t, actual)
+	})
+
+	t.Run("returns error when universe does not exist", func(t *testing.T) {
+		mockRankingRepo.EXPECT().
+			List(gomock.Any(), gomock.Eq(universe), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return([]models.Ranking{}, nil)
+		mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(universe)).
+			Times(1).
+			Return(models.Universe{}, domainerrors.ErrNotFound)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		_, err := usecase.List(t.Context(), universe, request.PageRequest{Page: 0, Size: 10})
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockRankingRepo.EXPECT().
+			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewRankingUseCase(mockUniverseRepo, mockRankingRepo)
+		_, err := usecase.List(t.Context(), universe, request.PageRequest{Page: 0, Size: 10})
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
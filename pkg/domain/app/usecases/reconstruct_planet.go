@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type PlanetReconstructionUseCase struct {
+	planetRepo     drivenports.ForManagingPlanets
+	universeRepo   drivenports.ForManagingUniverses
+	eventRepo      drivenports.ForManagingPlanetEvents
+	technologyRepo drivenports.ForManagingTechnologies
+	clock          drivenports.ForFetchingTime
+}
+
+func NewPlanetReconstructionUseCase(
+	planetRepo drivenports.ForManagingPlanets,
+	universeRepo drivenports.ForManagingUniverses,
+	eventRepo drivenports.ForManagingPlanetEvents,
+	technologyRepo drivenports.ForManagingTechnologies,
+	clock drivenports.ForFetchingTime,
+) *PlanetReconstructionUseCase {
+	return &PlanetReconstructionUseCase{
+		planetRepo:     planetRepo,
+		universeRepo:   universeRepo,
+		eventRepo:      eventRepo,
+		technologyRepo: technologyRepo,
+		clock:          clock,
+	}
+}
+
+// Reconstruct replays the events of the planet. The stored planet is read
+// without being brought up to date: fetching it through the mutator would
+// record a new event and hide the divergences of the last update.
+func (p *PlanetReconstructionUseCase) Reconstruct(
+	ctx context.Context,
+	planet uuid.UUID,
+	moment time.Time,
+) (models.PlanetReconstruction, error) {
+	if moment.IsZero() {
+		moment = p.clock.Now(ctx)
+	}
+
+	stored, err := p.planetRepo.Get(ctx, planet)
+	if err != nil {
+		return models.PlanetReconstruction{}, err
+	}
+
+	universeId, err := p.planetRepo.GetUniverse(ctx, planet)
+	if err != nil {
+		return models.PlanetReconstruction{}, err
+	}
+
+	universe, err := p.universeRepo.Get(ctx, universeId)
+	if err != nil {
+		return models.PlanetReconstruction{}, err
+	}
+
+	events, err := p.eventRepo.ListForPlanetUntil(ctx, planet, moment)
+	if err != nil {
+		return models.PlanetReconstruction{}, err
+	}
+
+	technologies, err := p.technologyRepo.ListLevelsForPlayer(ctx, stored.Player)
+	if err != nil {
+		return models.PlanetReconstruction{}, err
+	}
+
+	return domainservices.ReconstructPlanet(universe, stored, events, technologies, moment)
+}
@@ -0,0 +1,175 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnit_PlanetReconstruction_Reconstruct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPlanetRepo := drivenportstest.NewMockForManagingPlanets(ctrl)
+	mockUniverseRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
+	mockEventRepo := drivenportstest.NewMockForManagingPlanetEvents(ctrl)
+	mockTechnologyRepo := drivenportstest.NewMockForManagingTechnologies(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	universe := models.Universe{
+		Id: uuid.New(),
+		Resources: []models.Resource{
+			{Id: metalResourceId, StartAmount: 500},
+		},
+	}
+	planet := models.Planet{
+		Id:        uuid.New(),
+		Player:    uuid.New(),
+		CreatedAt: t1,
+		UpdatedAt: t2,
+		Version:   1,
+		Resources: []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 400},
+		},
+	}
+	events := []models.PlanetEvent{
+		{
+			Id:            uuid.New(),
+			Planet:        planet.Id,
+			Kind:          models.PlanetActionCreated,
+			VersionBefore: 0,
+			VersionAfter:  1,
+			Resources: []models.PlanetEventResource{
+				{Resource: metalResourceId, Delta: -100},
+			},
+			CreatedAt: t2,
+		},
+	}
+
+	t.Run("rebuilds planet at current time when no moment is given", func(t *testing.T) {
+		mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		mockPlanetRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(planet.Id)).
+			Times(1).
+			Return(planet, nil)
+		mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), gomock.Eq(planet.Id)).
+			Times(1).
+			Return(universe.Id, nil)
+		mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Eq(universe.Id)).
+			Times(1).
+			Return(universe, nil)
+		mockEventRepo.EXPECT().
+			ListForPlanetUntil(gomock.Any(), gomock.Eq(planet.Id), gomock.Eq(t3)).
+			Times(1).
+			Return(events, nil)
+		mockTechnologyRepo.EXPECT().
+			ListLevelsForPlayer(gomock.Any(), gomock.Eq(planet.Player)).
+			Times(1).
+			Return(nil, nil)
+
+		usecase := NewPlanetReconstructionUseCase(mockPlanetRepo, mockUniverseRepo, mockEventRepo, mockTechnologyRepo, mockClock)
+		actual, err := usecase.Reconstruct(t.Context(), planet.Id, time.Time{})
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.PlanetReconstruction{
+			Planet:  planet.Id,
+			At:      t3,
+			Version: 1,
+			Events:  1,
+			Resources: []models.PlanetResource{
+				{Resource: metalResourceId, Amount: 400},
+			},
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rebuilds planet at requested moment", func(t *testing.T) {
+		mockPlanetRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(planet, nil)
+		mockPlanetRepo.EXPECT().GetUniverse(gomock.Any(), gomock.Any()).Times(1).Return(universe.Id, nil)
+		mockUniverseRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(universe, nil)
+		mockEventRepo.EXPECT().
+			ListForPlanetUntil(gomock.Any(), gomock.Eq(planet.Id), gomock.Eq(t1)).
+			Times(1).
+			Return(nil, nil)
+		mockTechnologyRepo.EXPECT().ListLevelsForPlayer(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+
+		usecase := NewPlanetReconstructionUseCase(mockPlanetRepo, mockUniverseRepo, mockEventRepo, mockTechnologyRepo, mockClock)
+		actual, err := usecase.Reconstruct(t.Context(), planet.Id, t1)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t1, actual.At)
+		assert.Zero(t, actual.Events)
+		assert.Equal(t, universe.StartingResources(), actual.Resources)
+	})
+
+	t.Run("returns error when planet does not exist", func(t *testing.T) {
+		mockPlanetRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Planet{}, domainerrors.ErrNotFound)
+
+		usecase := NewPlanetReconstructionUseCase(mockPlanetRepo, mockUniverseRepo, mockEventRepo, mockTechnologyRepo, mockClock)
+		_, err := usecase.Reconstruct(t.Context(), planet.Id, t1)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when universe can not be fetched", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockPlanetRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(planet, nil)
+		mockPlanetRepo.EXPECT().GetUniverse(gomock.Any(), gomock.Any()).Times(1).Return(universe.Id, nil)
+		mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(models.Universe{}, expectedErr)
+
+		usecase := NewPlanetReconstructionUseCase(mockPlanetRepo, mockUniverseRepo, mockEventRepo, mockTechnologyRepo, mockClock)
+		_, err := usecase.Reconstruct(t.Context(), planet.Id, t1)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when events can not be listed", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockPlanetRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(planet, nil)
+		mockPlanetRepo.EXPECT().GetUniverse(gomock.Any(), gomock.Any()).Times(1).Return(universe.Id, nil)
+		mockUniverseRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(universe, nil)
+		mockEventRepo.EXPECT().
+			ListForPlanetUntil(gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewPlanetReconstructionUseCase(mockPlanetRepo, mockUniverseRepo, mockEventRepo, mockTechnologyRepo, mockClock)
+		_, err := usecase.Reconstruct(t.Context(), planet.Id, t1)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when technology levels can not be listed", func(t *testing.T) {
+		expectedErr := errors.New("stubbed error")
+
+		mockPlanetRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(planet, nil)
+		mockPlanetRepo.EXPECT().GetUniverse(gomock.Any(), gomock.Any()).Times(1).Return(universe.Id, nil)
+		mockUniverseRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(universe, nil)
+		mockEventRepo.EXPECT().ListForPlanetUntil(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(events, nil)
+		mockTechnologyRepo.EXPECT().
+			ListLevelsForPlayer(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil, expectedErr)
+
+		usecase := NewPlanetReconstructionUseCase(mockPlanetRepo, mockUniverseRepo, mockEventRepo, mockTechnologyRepo, mockClock)
+		_, err := usecase.Reconstruct(t.Context(), planet.Id, t1)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+}
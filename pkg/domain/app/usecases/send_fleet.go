@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+	"github.com/google/uuid"
+)
+
+type SendFleetUseCase struct {
+	unitRepo      drivenports.ForFetchingShipyardUnits
+	planetRepo    drivenports.ForManagingPlanets
+	fleetRepo     drivenports.ForManagingFleets
+	rolesRepo     drivenports.ForFetchingGameDataRoles
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewSendFleetUseCase(
+	unitRepo drivenports.ForFetchingShipyardUnits,
+	planetRepo drivenports.ForManagingPlanets,
+	fleetRepo drivenports.ForManagingFleets,
+	rolesRepo drivenports.ForFetchingGameDataRoles,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *SendFleetUseCase {
+	return &SendFleetUseCase{
+		unitRepo:      unitRepo,
+		planetRepo:    planetRepo,
+		fleetRepo:     fleetRepo,
+		rolesRepo:     rolesRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+func (s *SendFleetUseCase) Send(
+	ctx context.Context,
+	req request.FleetCreationRequest,
+) (models.Fleet, error) {
+	moment := s.clock.Now(ctx)
+
+	departure := models.FleetDeparture{
+		Mission: req.Mission,
+		Speed:   req.Speed,
+	}
+
+	for _, rs := range req.Ships {
+		ship, err := s.unitRepo.GetShip(ctx, rs.Ship)
+		if err != nil {
+			return models.Fleet{}, toUnitNotFound(err)
+		}
+
+		fs := models.FleetDepartureShip{
+			Ship:  ship,
+			Count: rs.Count,
+		}
+		departure.Ships = append(departure.Ships, fs)
+	}
+
+	for _, rc := range req.Cargo {
+		fc := models.FleetCargo{
+			Resource: rc.Resource,
+			Amount:   rc.Amount,
+		}
+		departure.Cargo = append(departure.Cargo, fc)
+	}
+
+	target, err := s.planetRepo.GetAtCoordinate(ctx, req.Planet, req.Target)
+	if err != nil {
+		if err == domainerrors.ErrNotFound {
+			return models.Fleet{}, domainerrors.ErrFleetTargetNotFound
+		}
+		return models.Fleet{}, err
+	}
+	departure.Target = target
+
+	fleets, err := s.fleetRepo.ListForPlanetOwner(ctx, req.Planet)
+	if err != nil {
+		return models.Fleet{}, err
+	}
+	for _, fleet := range fleets {
+		if fleet.InFlight(moment) {
+			departure.ActiveFleets++
+		}
+	}
+
+	roles, err := s.rolesRepo.GetRoles(ctx)
+	if err != nil {
+		return models.Fleet{}, err
+	}
+
+	var fleetId uuid.UUID
+	mutator := generateSendFleetMutator(moment, departure, roles, &fleetId)
+	result, err := s.planetMutator.Mutate(ctx, req.Planet, mutator)
+	if err != nil {
+		return models.Fleet{}, err
+	}
+	if result.Deleted {
+		return models.Fleet{}, domainerrors.ErrNotFound
+	}
+
+	for _, fleet := range result.Planet.Fleets {
+		if fleet.Id == fleetId {
+			return fleet, nil
+		}
+	}
+
+	return models.Fleet{}, domainerrors.ErrResourceCreationFailed
+}
+
+func generateSendFleetMutator(
+	moment time.Time,
+	departure models.FleetDeparture,
+	roles models.GameDataRoles,
+	fleetId *uuid.UUID,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		fleet, err := p.SendFleet(departure, roles)
+		if err != nil {
+			return false, err
+		}
+
+		*fleetId = fleet.Id
+
+		return false, nil
+	}
+}
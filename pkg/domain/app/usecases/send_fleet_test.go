@@ -0,0 +1,392 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type sendFleetTestSuite struct {
+	ctrl           *gomock.Controller
+	mockUnitRepo   *drivenportstest.MockForFetchingShipyardUnits
+	mockPlanetRepo *drivenportstest.MockForManagingPlanets
+	mockFleetRepo  *drivenportstest.MockForManagingFleets
+	mockRolesRepo  *drivenportstest.MockForFetchingGameDataRoles
+	mockMutator    *drivenportstest.MockForMutatingPlanet
+	mockClock      *drivenportstest.MockForFetchingTime
+	usecase        *SendFleetUseCase
+}
+
+func TestUnit_SendFleet_Send(t *testing.T) {
+	t.Run("creates fleet leaving the planet", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		target := generateTestFleetTarget(planet)
+		req := generateTestFleetRequest(planet, ship)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), ship.Id).
+			Times(1).
+			Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(target, nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Send(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.Fleet{
+			Id:               actual.Id,
+			Player:           planet.Player,
+			Mission:          models.TransportMission,
+			Origin:           planet.Id,
+			OriginCoordinate: planet.Coordinate,
+			Target:           target.Planet,
+			TargetCoordinate: target.Coordinate,
+			Ships: []models.FleetShip{
+				{Ship: ship.Id, Count: 2},
+			},
+			Cargo: []models.FleetCargo{
+				{Resource: metalResourceId, Amount: 6000},
+			},
+			DepartedAt: t2,
+			ArrivesAt:  t2.Add(4997 * time.Second),
+			CreatedAt:  t2,
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, []models.Fleet{expected}, planet.Fleets)
+	})
+
+	t.Run("updates planet to current time", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+
+		initialVersion := planet.Version
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(generateTestFleetTarget(planet), nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Send(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t2, planet.UpdatedAt)
+		// One bump due to the update to the current time, one bump for the fleet
+		assert.Equal(t, initialVersion+2, planet.Version)
+	})
+
+	t.Run("returns error when ship does not exist", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().
+			GetShip(gomock.Any(), ship.Id).
+			Times(1).
+			Return(models.Ship{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrUnitNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when no planet exists at target coordinate", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(models.FleetTarget{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFleetTargetNotFound, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when fetching target fails", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(models.FleetTarget{}, expectedErr)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when player has no fleet slot left", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(generateTestFleetTarget(planet), nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{generateTestFleet()}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrFleetLimitReached, "Actual err: %v", err)
+	})
+
+	t.Run("does not count fleets which completed their mission", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+		fleet := generateTestFleet()
+		returnsAt := t2
+		fleet.ReturnsAt = &returnsAt
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t3)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(generateTestFleetTarget(planet), nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{fleet}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Send(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when planet does not have enough ships", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+		req.Ships[0].Count = 12
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(generateTestFleetTarget(planet), nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughShips, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when transporting to a planet of another player", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		target := generateTestFleetTarget(planet)
+		target.Player = uuid.New()
+		req := generateTestFleetRequest(planet, ship)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(target, nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidFleetTarget, "Actual err: %v", err)
+		assert.Empty(t, planet.Fleets)
+	})
+
+	t.Run("returns error when planet is deleted", func(t *testing.T) {
+		suite := setupSendFleetTestSuite(t)
+
+		ship := generateTestFleetShip()
+		planet := generateTestPlanetWithShips(ship)
+		req := generateTestFleetRequest(planet, ship)
+
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockUnitRepo.EXPECT().GetShip(gomock.Any(), ship.Id).Times(1).Return(ship, nil)
+		suite.mockPlanetRepo.EXPECT().
+			GetAtCoordinate(gomock.Any(), planet.Id, req.Target).
+			Times(1).
+			Return(generateTestFleetTarget(planet), nil)
+		suite.mockFleetRepo.EXPECT().
+			ListForPlanetOwner(gomock.Any(), planet.Id).
+			Times(1).
+			Return([]models.Fleet{}, nil)
+		suite.mockRolesRepo.EXPECT().GetRoles(gomock.Any()).Times(1).Return(testRoles, nil)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.Send(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func setupSendFleetTestSuite(t *testing.T) *sendFleetTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUnitRepo := drivenportstest.NewMockForFetchingShipyardUnits(ctrl)
+	mockPlanetRepo := drivenportstest.NewMockForManagingPlanets(ctrl)
+	mockFleetRepo := drivenportstest.NewMockForManagingFleets(ctrl)
+	mockRolesRepo := drivenportstest.NewMockForFetchingGameDataRoles(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &sendFleetTestSuite{
+		ctrl:           ctrl,
+		mockUnitRepo:   mockUnitRepo,
+		mockPlanetRepo: mockPlanetRepo,
+		mockFleetRepo:  mockFleetRepo,
+		mockRolesRepo:  mockRolesRepo,
+		mockMutator:    mockMutator,
+		mockClock:      mockClock,
+		usecase: NewSendFleetUseCase(
+			mockUnitRepo,
+			mockPlanetRepo,
+			mockFleetRepo,
+			mockRolesRepo,
+			mockMutator,
+			mockClock,
+		),
+	}
+}
+
+func generateTestFleetShip() models.Ship {
+	return models.Ship{
+		Id:            uuid.New(),
+		Speed:         5000,
+		CargoCapacity: 5000,
+	}
+}
+
+func generateTestPlanetWithShips(ship models.Ship) models.Planet {
+	p := generateTestPlanet()
+	p.Player = uuid.New()
+	p.Ships = []models.PlanetShip{
+		{
+			Ship:  ship.Id,
+			Count: 10,
+		},
+	}
+
+	return p
+}
+
+// generateTestFleetTarget returns a planet of the same player located 1015
+// units away from the test planet.
+func generateTestFleetTarget(planet models.Planet) models.FleetTarget {
+	return models.FleetTarget{
+		Planet:     uuid.New(),
+		Player:     planet.Player,
+		Coordinate: models.Coordinate{Position: 3},
+	}
+}
+
+func generateTestFleetRequest(planet models.Planet, ship models.Ship) request.FleetCreationRequest {
+	return request.FleetCreationRequest{
+		Planet:  planet.Id,
+		Mission: models.TransportMission,
+		Target:  models.Coordinate{Position: 3},
+		Ships: []request.FleetShipRequest{
+			{Ship: ship.Id, Count: 2},
+		},
+		Cargo: []request.FleetCargoRequest{
+			{Resource: metalResourceId, Amount: 6000},
+		},
+	}
+}
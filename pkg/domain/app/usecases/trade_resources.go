@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	domainservices "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/services"
+)
+
+type TradeResourcesUseCase struct {
+	universeRepo  drivenports.ForManagingUniverses
+	planetMutator drivenports.ForMutatingPlanet
+	clock         drivenports.ForFetchingTime
+}
+
+func NewTradeResourcesUseCase(
+	universeRepo drivenports.ForManagingUniverses,
+	planetMutator drivenports.ForMutatingPlanet,
+	clock drivenports.ForFetchingTime,
+) *TradeResourcesUseCase {
+	return &TradeResourcesUseCase{
+		universeRepo:  universeRepo,
+		planetMutator: planetMutator,
+		clock:         clock,
+	}
+}
+
+func (t *TradeResourcesUseCase) Trade(
+	ctx context.Context,
+	req request.TradeRequest,
+) (models.Trade, error) {
+	rates, err := t.universeRepo.ListExchangeRatesForPlanet(ctx, req.Planet)
+	if err != nil {
+		return models.Trade{}, err
+	}
+
+	moment := t.clock.Now(ctx)
+
+	var trade models.Trade
+	result, err := t.planetMutator.Mutate(ctx, req.Planet, generateTradeMutator(moment, rates, req, &trade))
+	if err != nil {
+		return models.Trade{}, err
+	}
+	if result.Deleted {
+		return models.Trade{}, domainerrors.ErrNotFound
+	}
+
+	return trade, nil
+}
+
+func generateTradeMutator(
+	moment time.Time,
+	rates []models.ExchangeRate,
+	req request.TradeRequest,
+	trade *models.Trade,
+) drivenports.PlanetMutator {
+	return func(p *models.Planet) (bool, error) {
+		err := domainservices.AdvancePlanetToTime(p, moment)
+		if err != nil {
+			return false, err
+		}
+
+		*trade, err = p.Trade(rates, req.Sold, req.Bought, req.Amount)
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+}
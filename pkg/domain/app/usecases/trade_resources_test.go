@@ -0,0 +1,230 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/Knoblauchpilze/backend-toolkit/pkg/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/request"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type tradeResourcesTestSuite struct {
+	ctrl             *gomock.Controller
+	mockUniverseRepo *drivenportstest.MockForManagingUniverses
+	mockMutator      *drivenportstest.MockForMutatingPlanet
+	mockClock        *drivenportstest.MockForFetchingTime
+	usecase          *TradeResourcesUseCase
+}
+
+var testExchangeRates = []models.ExchangeRate{
+	{Resource: metalResourceId, Rate: 3},
+	{Resource: crystalResourceId, Rate: 2},
+}
+
+func TestUnit_TradeResources_Trade(t *testing.T) {
+	t.Run("exchanges resources of the planet", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		req := generateTestTradeRequest(planet)
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(testExchangeRates, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		actual, err := suite.usecase.Trade(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		expected := models.Trade{
+			Planet:       planet.Id,
+			Sold:         metalResourceId,
+			SoldAmount:   300,
+			Bought:       crystalResourceId,
+			BoughtAmount: 200,
+			CreatedAt:    t2,
+		}
+		assert.Equal(t, expected, actual)
+		expectedResources := []models.PlanetResource{
+			{Resource: metalResourceId, Amount: 99699},
+			{Resource: crystalResourceId, Amount: 100199},
+		}
+		assert.Equal(t, expectedResources, planet.Resources)
+	})
+
+	t.Run("updates planet to current time", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		req := generateTestTradeRequest(planet)
+
+		initialVersion := planet.Version
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(testExchangeRates, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Trade(t.Context(), req)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, t2, planet.UpdatedAt)
+		// One bump due to the update to the current time, one bump for the trade
+		assert.Equal(t, initialVersion+2, planet.Version)
+	})
+
+	t.Run("returns error when exchange rates fail to be fetched", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		req := generateTestTradeRequest(planet)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(nil, expectedErr)
+
+		_, err := suite.usecase.Trade(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when not enough resources", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		req := generateTestTradeRequest(planet)
+		req.Amount = 200000
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(testExchangeRates, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Trade(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotEnoughResources, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when storage is exceeded", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		planet.Storages[1].Storage = 100000
+		req := generateTestTradeRequest(planet)
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(testExchangeRates, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			DoAndReturn(generateApplyingMutatorMock(&planet))
+
+		_, err := suite.usecase.Trade(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrStorageCapacityExceeded, "Actual err: %v", err)
+	})
+
+	t.Run("returns error when mutation fails", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		req := generateTestTradeRequest(planet)
+		expectedErr := errors.New("stubbed error")
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(testExchangeRates, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{}, expectedErr)
+
+		_, err := suite.usecase.Trade(t.Context(), req)
+
+		assert.ErrorIs(t, err, expectedErr, "Actual err: %v", err)
+	})
+
+	t.Run("returns not found when planet is deleted", func(t *testing.T) {
+		suite := setupTradeResourcesTestSuite(t)
+
+		planet := generateTestPlanetWithStorages()
+		req := generateTestTradeRequest(planet)
+
+		suite.mockUniverseRepo.EXPECT().
+			ListExchangeRatesForPlanet(gomock.Any(), planet.Id).
+			Times(1).
+			Return(testExchangeRates, nil)
+		suite.mockClock.EXPECT().Now(gomock.Any()).Times(1).Return(t2)
+		suite.mockMutator.EXPECT().
+			Mutate(gomock.Any(), planet.Id, gomock.Any()).
+			Times(1).
+			Return(models.PlanetMutationResult{Deleted: true}, nil)
+
+		_, err := suite.usecase.Trade(t.Context(), req)
+
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound, "Actual err: %v", err)
+	})
+}
+
+func setupTradeResourcesTestSuite(t *testing.T) *tradeResourcesTestSuite {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUniverseRepo := drivenportstest.NewMockForManagingUniverses(ctrl)
+	mockMutator := drivenportstest.NewMockForMutatingPlanet(ctrl)
+	mockClock := drivenportstest.NewMockForFetchingTime(ctrl)
+
+	return &tradeResourcesTestSuite{
+		ctrl:             ctrl,
+		mockUniverseRepo: mockUniverseRepo,
+		mockMutator:      mockMutator,
+		mockClock:        mockClock,
+		usecase:          NewTradeResourcesUseCase(mockUniverseRepo, mockMutator, mockClock),
+	}
+}
+
+func generateTestPlanetWithStorages() models.Planet {
+	p := generateTestPlanet()
+	p.Storages = []models.PlanetResourceStorage{
+		{Resource: metalResourceId, Storage: 1000000},
+		{Resource: crystalResourceId, Storage: 1000000},
+	}
+
+	return p
+}
+
+func generateTestTradeRequest(planet models.Planet) request.TradeRequest {
+	return request.TradeRequest{
+		Planet: planet.Id,
+		Sold:   metalResourceId,
+		Bought: crystalResourceId,
+		Amount: 300,
+	}
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	drivenports "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/ports/driven"
+	"github.com/google/uuid"
+)
+
+type WatchPlanetChangesUseCase struct {
+	playerRepo   drivenports.ForManagingPlayers
+	planetRepo   drivenports.ForManagingPlanets
+	universeRepo drivenports.ForManagingUniverses
+	changes      drivenports.ForWatchingPlanetChanges
+}
+
+func NewWatchPlanetChangesUseCase(
+	playerRepo drivenports.ForManagingPlayers,
+	planetRepo drivenports.ForManagingPlanets,
+	universeRepo drivenports.ForManagingUniverses,
+	changes drivenports.ForWatchingPlanetChanges,
+) *WatchPlanetChangesUseCase {
+	return &WatchPlanetChangesUseCase{
+		playerRepo:   playerRepo,
+		planetRepo:   planetRepo,
+		universeRepo: universeRepo,
+		changes:      changes,
+	}
+}
+
+func (w *WatchPlanetChangesUseCase) WatchPlayer(
+	ctx context.Context,
+	player uuid.UUID,
+) (<-chan models.PlanetChange, error) {
+	_, err := w.playerRepo.Get(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+
+	topic := models.PlanetChangeTopic{Scope: models.PlayerScope, Id: player}
+	return w.changes.Subscribe(ctx, topic), nil
+}
+
+func (w *WatchPlanetChangesUseCase) WatchPlanet(
+	ctx context.Context,
+	planet uuid.UUID,
+) (<-chan models.PlanetChange, error) {
+	_, err := w.planetRepo.GetUniverse(ctx, planet)
+	if err != nil {
+		return nil, err
+	}
+
+	topic := models.PlanetChangeTopic{Scope: models.PlanetScope, Id: planet}
+	return w.changes.Subscribe(ctx, topic), nil
+}
+
+func (w *WatchPlanetChangesUseCase) WatchUniverse(
+	ctx context.Context,
+	universe uuid.UUID,
+) (<-chan models.PlanetChange, error) {
+	_, err := w.universeRepo.Get(ctx, universe)
+	if err != nil {
+		return nil, err
+	}
+
+	topic := models.PlanetChangeTopic{Scope: models.UniverseScope, Id: universe}
+	changes := w.changes.Subscribe(ctx, topic)
+
+	out := make(chan models.PlanetChange, cap(changes))
+	go func() {
+		defer close(out)
+
+		for change := range changes {
+			if !change.Deleted && change.Kind != models.PlanetCreated {
+				continue
+			}
+
+			select {
+			case out <- change:
+			default:
+			}
+		}
+	}()
+
+	return out, nil
+}
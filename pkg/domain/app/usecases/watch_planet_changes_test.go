@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models"
+	domainerrors "github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/models/errors"
+	"github.com/Knoblauchpilze/galactic-sovereign/pkg/domain/app/usecases/drivenportstest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type watchPlanetChangesTestSuite struct {
+	mockPlayerRepo   *drivenportstest.MockForManagingPlayers
+	mockPlanetRepo   *drivenportstest.MockForManagingPlanets
+	mockUniverseRepo *drivenportstest.MockForManagingUniverses
+	mockChanges      *drivenportstest.MockForWatchingPlanetChanges
+	usecase          *WatchPlanetChangesUseCase
+}
+
+func TestUnit_WatchPlanetChanges_WatchPlayer(t *testing.T) {
+	suite := setupWatchPlanetChangesTestSuite(t)
+	player := uuid.New()
+
+	t.Run("subscribes to changes of existing player", func(t *testing.T) {
+		change := models.PlanetChange{Planet: uuid.New(), Player: player, Version: 3}
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), player).
+			Times(1).
+			Return(models.Player{Id: player}, nil)
+		suite.mockChanges.EXPECT().
+			Subscribe(gomock.Any(), models.PlanetChangeTopic{Scope: models.PlayerScope, Id: player}).
+			Times(1).
+			Return(generateTestPlanetChanges(change))
+
+		actual, err := suite.usecase.WatchPlayer(t.Context(), player)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, change, <-actual)
+	})
+
+	t.Run("does not subscribe when player does not exist", func(t *testing.T) {
+		suite.mockPlayerRepo.EXPECT().
+			Get(gomock.Any(), player).
+			Times(1).
+			Return(models.Player{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.WatchPlayer(t.Context(), player)
+
+		assert.Equal(t, domainerrors.ErrNotFound, err)
+	})
+}
+
+func TestUnit_WatchPlanetChanges_WatchPlanet(t *testing.T) {
+	suite := setupWatchPlanetChangesTestSuite(t)
+	planet := uuid.New()
+
+	t.Run("subscribes to changes of existing planet", func(t *testing.T) {
+		change := models.PlanetChange{Planet: planet, Version: 3}
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), planet).
+			Times(1).
+			Return(uuid.New(), nil)
+		suite.mockChanges.EXPECT().
+			Subscribe(gomock.Any(), models.PlanetChangeTopic{Scope: models.PlanetScope, Id: planet}).
+			Times(1).
+			Return(generateTestPlanetChanges(change))
+
+		actual, err := suite.usecase.WatchPlanet(t.Context(), planet)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		assert.Equal(t, change, <-actual)
+	})
+
+	t.Run("does not subscribe when planet does not exist", func(t *testing.T) {
+		suite.mockPlanetRepo.EXPECT().
+			GetUniverse(gomock.Any(), planet).
+			Times(1).
+			Return(uuid.Nil, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.WatchPlanet(t.Context(), planet)
+
+		assert.Equal(t, domainerrors.ErrNotFound, err)
+	})
+}
+
+func TestUnit_WatchPlanetChanges_WatchUniverse(t *testing.T) {
+	suite := setupWatchPlanetChangesTestSuite(t)
+	universe := uuid.New()
+
+	t.Run("only forwards created and deleted planets", func(t *testing.T) {
+		created := models.PlanetChange{Planet: uuid.New(), Universe: universe, Kind: models.PlanetCreated}
+		updated := models.PlanetChange{Planet: uuid.New(), Universe: universe, Version: 3, Kind: models.PlanetUpdated}
+		deleted := models.PlanetChange{Planet: uuid.New(), Universe: universe, Version: 7, Kind: models.PlanetDeleted, Deleted: true}
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), universe).
+			Times(1).
+			Return(models.Universe{Id: universe}, nil)
+		suite.mockChanges.EXPECT().
+			Subscribe(gomock.Any(), models.PlanetChangeTopic{Scope: models.UniverseScope, Id: universe}).
+			Times(1).
+			Return(generateTestPlanetChanges(created, updated, deleted))
+
+		actual, err := suite.usecase.WatchUniverse(t.Context(), universe)
+		require.NoError(t, err, "Actual err: %v", err)
+
+		var received []models.PlanetChange
+		for change := range actual {
+			received = append(received, change)
+		}
+		assert.Equal(t, []models.PlanetChange{created, deleted}, received)
+	})
+
+	t.Run("does not subscribe when universe does not exist", func(t *testing.T) {
+		suite.mockUniverseRepo.EXPECT().
+			Get(gomock.Any(), universe).
+			Times(1).
+			Return(models.Universe{}, domainerrors.ErrNotFound)
+
+		_, err := suite.usecase.WatchUniverse(t.Context(), universe)
+
+		assert.Equal(t, domainerrors.ErrNotFound, err)
+	})
+}
+
+func setupWatchPlanetChangesTestSuite(t *testing.T) watchPlanetChangesTestSuite {
+	ctrl := gomock.NewController(t)
+
+	suite := watchPlanetChangesTestSuite{
+		mockPlayerRepo:   drivenportstest.NewMockForManagingPlayers(ctrl),
+		mockPlanetRepo:   drivenportstest.NewMockForManagingPlanets(ctrl),
+		mockUniverseRepo: drivenportstest.NewMockForManagingUniverses(ctrl),
+		mockChanges:      drivenportstest.NewMockForWatchingPlanetChanges(ctrl),
+	}
+	suite.usecase = NewWatchPlanetChangesUseCase(
+		suite.mockPlayerRepo,
+		suite.mockPlanetRepo,
+		suite.mockUniverseRepo,
+		suite.mockChanges,
+	)
+
+	return suite
+}
+
+// generateTestPlanetChanges returns a closed channel holding the changes.
+func generateTestPlanetChanges(changes ...models.PlanetChange) <-chan models.PlanetChange {
+	out := make(chan models.PlanetChange, len(changes))
+	for _, change := range changes {
+		out <- change
+	}
+	close(out)
+
+	return out
+}
@@ -20,11 +20,19 @@ else
   echo "Using player planet (${PLANET_ID}) from file"
 fi
 
+SAVED_TOKEN_FILE="sandbox/token"
+if [ ! -f "${SAVED_TOKEN_FILE}" ]; then
+  echo "No token available, call ./create-player.sh first"
+  exit 1
+fi
+TOKEN=$(cat ${SAVED_TOKEN_FILE})
+
 OUTPUT_FILE="/tmp/${PLANET_ID}.json"
 
 BODY="{\"building\":\"${BUILDING}\"}"
 
 curl -sH 'Content-Type: application/json' \
+  -H "Authorization: Bearer ${TOKEN}" \
   http://localhost:60002/v1/galactic-sovereign/planets/${PLANET_ID}/actions \
   -d ${BODY} \
   -o ${OUTPUT_FILE}
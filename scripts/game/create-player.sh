@@ -15,12 +15,16 @@ else
   echo "No player name provided, using ${PLAYER_NAME}"
 fi
 
+SCRIPT_DIR=$(dirname "${0}")
+TOKEN=$(${SCRIPT_DIR}/sign-token.sh ${API_USER})
+
 PLAYER_OUTPUT_FILE="/tmp/${PLAYER_NAME}.json"
 PLANET_OUTPUT_FILE="/tmp/${PLAYER_NAME}_homeworld.json"
 
 BODY="{\"name\":\"${PLAYER_NAME}\",\"api_user\":\"${API_USER}\",\"universe\":\"${UNIVERSE}\"}"
 
 curl -sH 'Content-Type: application/json' \
+  -H "Authorization: Bearer ${TOKEN}" \
   http://localhost:60002/v1/galactic-sovereign/players \
   -d ${BODY} \
   -o ${PLAYER_OUTPUT_FILE}
@@ -39,6 +43,7 @@ PLAYER_ID=$(jq -r '.details.id' ${PLAYER_OUTPUT_FILE})
 HOMEWORLD=$(jq -r '.details.homeworld' ${PLAYER_OUTPUT_FILE})
 
 curl -s \
+  -H "Authorization: Bearer ${TOKEN}" \
   http://localhost:60002/v1/galactic-sovereign/planets/${HOMEWORLD} \
   -o ${PLANET_OUTPUT_FILE}
 
@@ -60,3 +65,6 @@ jq -r '.details' ${PLAYER_OUTPUT_FILE} > ${PLAYER_SAVE_FILE}
 
 PLANET_SAVE_FILE="sandbox/planet.json"
 jq -r '.details' ${PLANET_OUTPUT_FILE} > ${PLANET_SAVE_FILE}
+
+TOKEN_SAVE_FILE="sandbox/token"
+echo ${TOKEN} > ${TOKEN_SAVE_FILE}
@@ -0,0 +1,25 @@
+#!/bin/bash
+
+# Signs a token (HS256) for the api user. The secret should match the one
+# defined in the configuration of the server.
+SECRET=${AUTH_SECRET:-AUTH_SECRET}
+VALIDITY_SECONDS=86400
+
+if [[ $# -lt 1 ]]; then
+  echo "Usage: ./sign-token.sh api-user"
+  exit 1
+fi
+
+API_USER=${1}
+EXPIRES_AT=$(($(date +%s) + ${VALIDITY_SECONDS}))
+
+# https://datatracker.ietf.org/doc/html/rfc7515#appendix-C
+base64url() {
+  openssl base64 -A | tr '+/' '-_' | tr -d '='
+}
+
+HEADER=$(printf '{"alg":"HS256","typ":"JWT"}' | base64url)
+PAYLOAD=$(printf '{"sub":"%s","exp":%d}' "${API_USER}" "${EXPIRES_AT}" | base64url)
+SIGNATURE=$(printf '%s.%s' "${HEADER}" "${PAYLOAD}" | openssl dgst -sha256 -hmac "${SECRET}" -binary | base64url)
+
+echo "${HEADER}.${PAYLOAD}.${SIGNATURE}"